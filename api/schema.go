@@ -1,4 +1,9 @@
 // Package api provides embedded TrueNAS API method definitions keyed by version.
+//
+// Each version lives in <version>/methods.json (e.g. 25.04/methods.json), the
+// output of the middleware's core.get_methods. To add a release, capture it
+// with go run ./cmd/apidump, regenerate wire types with go generate ./wire,
+// and review the changes with go run ./cmd/featurematrix diff.
 package api
 
 import (
@@ -7,6 +12,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"sync"
 )
 
 //go:embed */methods.json
@@ -14,17 +20,138 @@ var methodsFS embed.FS
 
 // MethodDef describes a single TrueNAS API method.
 type MethodDef struct {
-	Description  *string `json:"description"`
-	Job          bool    `json:"job"`
-	Filterable   bool    `json:"filterable"`
-	Downloadable bool    `json:"downloadable"`
-	Uploadable   bool    `json:"uploadable"`
-	ItemMethod   bool    `json:"item_method"`
-	RequireWS    bool    `json:"require_websocket"`
+	Description  *string   `json:"description"`
+	Job          bool      `json:"job"`
+	Filterable   bool      `json:"filterable"`
+	Downloadable bool      `json:"downloadable"`
+	Uploadable   bool      `json:"uploadable"`
+	ItemMethod   bool      `json:"item_method"`
+	RequireWS    bool      `json:"require_websocket"`
+	Accepts      []*Schema `json:"accepts"` // one schema per positional parameter
+	Returns      []*Schema `json:"returns"`
 }
 
-// Methods returns all API methods for a given TrueNAS version (e.g. "25.04").
-func Methods(version string) (map[string]MethodDef, error) {
+// Schema is a JSON Schema as published by the TrueNAS middleware, including
+// its _name_ and _required_ extensions.
+type Schema struct {
+	Name                 string             `json:"_name_,omitempty"`
+	Title                string             `json:"title,omitempty"`
+	Description          *string            `json:"description,omitempty"`
+	Type                 SchemaType         `json:"type,omitempty"`
+	Required             bool               `json:"_required_,omitempty"`
+	Nullable             bool               `json:"nullable,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	RequiredProperties   []string           `json:"required,omitempty"`
+	AdditionalProperties *Additional        `json:"additionalProperties,omitempty"`
+	AttrsOrder           []string           `json:"_attrs_order_,omitempty"`
+	Items                SchemaList         `json:"items,omitempty"`
+	AnyOf                []*Schema          `json:"anyOf,omitempty"`
+	OneOf                []*Schema          `json:"oneOf,omitempty"`
+	Enum                 []any              `json:"enum,omitempty"`
+	Const                json.RawMessage    `json:"const,omitempty"`
+	Default              json.RawMessage    `json:"default,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	Maximum              *float64           `json:"maximum,omitempty"`
+	MinLength            *int               `json:"minLength,omitempty"`
+	MaxLength            *int               `json:"maxLength,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+	Format               string             `json:"format,omitempty"`
+}
+
+// SchemaType holds the "type" keyword, which may be a single type name or a
+// list such as ["string", "null"].
+type SchemaType []string
+
+// UnmarshalJSON accepts either a string or an array of strings.
+func (t *SchemaType) UnmarshalJSON(data []byte) error {
+	var one string
+	if err := json.Unmarshal(data, &one); err == nil {
+		*t = SchemaType{one}
+		return nil
+	}
+	var many []string
+	if err := json.Unmarshal(data, &many); err != nil {
+		return fmt.Errorf("schema type: %w", err)
+	}
+	*t = many
+	return nil
+}
+
+// MarshalJSON writes a single type as a string.
+func (t SchemaType) MarshalJSON() ([]byte, error) {
+	if len(t) == 1 {
+		return json.Marshal(t[0])
+	}
+	return json.Marshal([]string(t))
+}
+
+// Has reports whether name is one of the allowed types.
+func (t SchemaType) Has(name string) bool {
+	for _, n := range t {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// SchemaList holds the "items" keyword. The middleware publishes a list of
+// allowed item schemas; a single schema object is also accepted.
+type SchemaList []*Schema
+
+// UnmarshalJSON accepts either a schema object or an array of schemas.
+func (l *SchemaList) UnmarshalJSON(data []byte) error {
+	var many []*Schema
+	if err := json.Unmarshal(data, &many); err == nil {
+		*l = many
+		return nil
+	}
+	var one Schema
+	if err := json.Unmarshal(data, &one); err != nil {
+		return fmt.Errorf("schema items: %w", err)
+	}
+	*l = SchemaList{&one}
+	return nil
+}
+
+// Additional holds the "additionalProperties" keyword, which is either a
+// boolean or a schema for the extra values.
+type Additional struct {
+	Allowed bool
+	Schema  *Schema
+}
+
+// UnmarshalJSON accepts either a boolean or a schema object.
+func (a *Additional) UnmarshalJSON(data []byte) error {
+	var allowed bool
+	if err := json.Unmarshal(data, &allowed); err == nil {
+		*a = Additional{Allowed: allowed}
+		return nil
+	}
+	var s Schema
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("schema additionalProperties: %w", err)
+	}
+	*a = Additional{Allowed: true, Schema: &s}
+	return nil
+}
+
+// MarshalJSON writes the boolean form unless a schema is set.
+func (a Additional) MarshalJSON() ([]byte, error) {
+	if a.Schema != nil {
+		return json.Marshal(a.Schema)
+	}
+	return json.Marshal(a.Allowed)
+}
+
+// catalogs caches parsed methods.json files keyed by version.
+var catalogs sync.Map // string → map[string]MethodDef
+
+// loadMethods parses and caches the method catalog for version.
+func loadMethods(version string) (map[string]MethodDef, error) {
+	if cached, ok := catalogs.Load(version); ok {
+		return cached.(map[string]MethodDef), nil
+	}
 	data, err := methodsFS.ReadFile(version + "/methods.json")
 	if err != nil {
 		return nil, fmt.Errorf("no methods for version %s: %w", version, err)
@@ -33,7 +160,36 @@ func Methods(version string) (map[string]MethodDef, error) {
 	if err := json.Unmarshal(data, &methods); err != nil {
 		return nil, fmt.Errorf("parsing methods for %s: %w", version, err)
 	}
-	return methods, nil
+	actual, _ := catalogs.LoadOrStore(version, methods)
+	return actual.(map[string]MethodDef), nil
+}
+
+// Methods returns all API methods for a given TrueNAS version (e.g. "25.04").
+// Schemas in the returned definitions are shared and must not be modified.
+func Methods(version string) (map[string]MethodDef, error) {
+	methods, err := loadMethods(version)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[string]MethodDef, len(methods))
+	for name, def := range methods {
+		out[name] = def
+	}
+	return out, nil
+}
+
+// Method returns the definition of a single API method for a version.
+// Returns an error wrapping ErrUnknownMethod if the version lacks it.
+func Method(version, name string) (MethodDef, error) {
+	methods, err := loadMethods(version)
+	if err != nil {
+		return MethodDef{}, err
+	}
+	def, ok := methods[name]
+	if !ok {
+		return MethodDef{}, fmt.Errorf("%s on %s: %w", name, version, ErrUnknownMethod)
+	}
+	return def, nil
 }
 
 // LatestVersion returns the highest embedded version string.
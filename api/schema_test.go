@@ -1,6 +1,8 @@
 package api
 
 import (
+	"encoding/json"
+	"errors"
 	"testing"
 )
 
@@ -91,3 +93,57 @@ func TestMethods_KnownFields(t *testing.T) {
 		}
 	}
 }
+
+func TestMethod(t *testing.T) {
+	m, err := Method("25.04", "zfs.snapshot.delete")
+	if err != nil {
+		t.Fatalf("Method error: %v", err)
+	}
+	if len(m.Accepts) != 2 {
+		t.Fatalf("expected 2 accepted params, got %d", len(m.Accepts))
+	}
+	if m.Accepts[0].Name != "id" || !m.Accepts[0].Type.Has("string") {
+		t.Errorf("unexpected first param: %+v", m.Accepts[0])
+	}
+	opts := m.Accepts[1]
+	if opts.AdditionalProperties == nil || opts.AdditionalProperties.Allowed {
+		t.Error("expected options to disallow additional properties")
+	}
+	if _, ok := opts.Properties["recursive"]; !ok {
+		t.Error("expected options.recursive property")
+	}
+	if len(m.Returns) != 1 || !m.Returns[0].Type.Has("boolean") {
+		t.Errorf("unexpected returns: %+v", m.Returns)
+	}
+}
+
+func TestMethod_Unknown(t *testing.T) {
+	_, err := Method("25.04", "no.such.method")
+	if !errors.Is(err, ErrUnknownMethod) {
+		t.Fatalf("expected ErrUnknownMethod, got %v", err)
+	}
+}
+
+func TestSchemaType_JSON(t *testing.T) {
+	var s Schema
+	if err := json.Unmarshal([]byte(`{"type":["string","null"],"items":{"type":"integer"},"additionalProperties":{"type":"string"}}`), &s); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if !s.Type.Has("string") || !s.Type.Has("null") {
+		t.Errorf("unexpected type: %v", s.Type)
+	}
+	if len(s.Items) != 1 || !s.Items[0].Type.Has("integer") {
+		t.Errorf("unexpected items: %+v", s.Items)
+	}
+	if s.AdditionalProperties == nil || s.AdditionalProperties.Schema == nil {
+		t.Fatal("expected additionalProperties schema")
+	}
+
+	data, err := json.Marshal(&Schema{Type: SchemaType{"string"}, AdditionalProperties: &Additional{}})
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	if got := string(data); got != `{"type":"string","additionalProperties":false}` {
+		t.Errorf("marshal = %s", got)
+	}
+}
@@ -0,0 +1,406 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ErrUnknownMethod is returned when a method is not in a version's catalog.
+var ErrUnknownMethod = errors.New("unknown method")
+
+// ErrInvalidParams is wrapped by every ValidationError.
+var ErrInvalidParams = errors.New("invalid params")
+
+// ValidationError reports params that do not match a method's accepts schema.
+type ValidationError struct {
+	Method string
+	Path   string // e.g. "params[0].schedule.hour"
+	Reason string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s: %s", e.Method, e.Path, e.Reason)
+}
+
+// Unwrap lets errors.Is match ErrInvalidParams.
+func (e *ValidationError) Unwrap() error {
+	return ErrInvalidParams
+}
+
+// Validate checks params against the accepts schema of method for version.
+// params follows the client convention: nil means no arguments, a []any is
+// one argument per element, and any other value is a single argument.
+// Go values are compared by their JSON encoding.
+// Returns a *ValidationError for mismatched params, or an error wrapping
+// ErrUnknownMethod if the method is not in the version's catalog.
+func Validate(version, method string, params any) error {
+	def, err := Method(version, method)
+	if err != nil {
+		return err
+	}
+
+	args, err := positionalArgs(params)
+	if err != nil {
+		return fmt.Errorf("%s: encode params: %w", method, err)
+	}
+
+	if len(args) > len(def.Accepts) {
+		return &ValidationError{
+			Method: method,
+			Path:   "params",
+			Reason: fmt.Sprintf("got %d arguments, method accepts at most %d", len(args), len(def.Accepts)),
+		}
+	}
+	for i, schema := range def.Accepts {
+		path := fmt.Sprintf("params[%d]", i)
+		if i >= len(args) {
+			if schema.Required {
+				return &ValidationError{Method: method, Path: path, Reason: fmt.Sprintf("missing required argument %q", schema.Name)}
+			}
+			continue
+		}
+		if reason, at := check(schema, args[i], path); reason != "" {
+			return &ValidationError{Method: method, Path: at, Reason: reason}
+		}
+	}
+	return nil
+}
+
+// positionalArgs normalizes params into JSON-decoded positional arguments.
+func positionalArgs(params any) ([]any, error) {
+	if params == nil {
+		return nil, nil
+	}
+	if _, ok := params.([]any); !ok {
+		params = []any{params}
+	}
+	data, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+	var args []any
+	if err := json.Unmarshal(data, &args); err != nil {
+		return nil, err
+	}
+	return args, nil
+}
+
+// check validates value against s, returning a reason and the path of the
+// first mismatch, or an empty reason if value is valid.
+func check(s *Schema, value any, path string) (string, string) {
+	if s == nil {
+		return "", ""
+	}
+
+	if value == nil && (s.Nullable || s.Type.Has("null")) {
+		return "", ""
+	}
+
+	if len(s.AnyOf) > 0 {
+		return checkAlternatives(s.AnyOf, value, path)
+	}
+	if len(s.OneOf) > 0 {
+		return checkAlternatives(s.OneOf, value, path)
+	}
+
+	if len(s.Type) > 0 && !matchesType(s.Type, value) {
+		return fmt.Sprintf("expected %s, got %s", strings.Join(s.Type, " or "), jsonTypeName(value)), path
+	}
+
+	if len(s.Const) > 0 {
+		var want any
+		if err := json.Unmarshal(s.Const, &want); err == nil && !reflect.DeepEqual(want, value) {
+			return fmt.Sprintf("must be %s", string(s.Const)), path
+		}
+	}
+	if len(s.Enum) > 0 && !containsValue(s.Enum, value) {
+		return fmt.Sprintf("must be one of %s", formatEnum(s.Enum)), path
+	}
+
+	switch v := value.(type) {
+	case string:
+		return checkString(s, v, path)
+	case float64:
+		return checkNumber(s, v, path)
+	case map[string]any:
+		return checkObject(s, v, path)
+	case []any:
+		return checkArray(s, v, path)
+	}
+	return "", ""
+}
+
+// checkAlternatives passes if value matches any of the schemas. When none
+// match, the reason from the closest alternative (the one failing deepest)
+// is reported.
+func checkAlternatives(alts []*Schema, value any, path string) (string, string) {
+	var bestReason, bestPath string
+	for _, alt := range alts {
+		reason, at := check(alt, value, path)
+		if reason == "" {
+			return "", ""
+		}
+		if len(at) > len(bestPath) {
+			bestReason, bestPath = reason, at
+		}
+	}
+	if bestPath == path {
+		return fmt.Sprintf("does not match any allowed schema (%s)", bestReason), path
+	}
+	return bestReason, bestPath
+}
+
+func checkString(s *Schema, v string, path string) (string, string) {
+	n := len([]rune(v))
+	if s.MinLength != nil && n < *s.MinLength {
+		return fmt.Sprintf("must be at least %d characters", *s.MinLength), path
+	}
+	if s.MaxLength != nil && n > *s.MaxLength {
+		return fmt.Sprintf("must be at most %d characters", *s.MaxLength), path
+	}
+	if s.Pattern != "" {
+		if re := compilePattern(s.Pattern); re != nil && !re.MatchString(v) {
+			return fmt.Sprintf("must match %s", s.Pattern), path
+		}
+	}
+	return "", ""
+}
+
+func checkNumber(s *Schema, v float64, path string) (string, string) {
+	if s.Minimum != nil && v < *s.Minimum {
+		return fmt.Sprintf("must be >= %s", formatNumber(*s.Minimum)), path
+	}
+	if s.Maximum != nil && v > *s.Maximum {
+		return fmt.Sprintf("must be <= %s", formatNumber(*s.Maximum)), path
+	}
+	return "", ""
+}
+
+func checkObject(s *Schema, v map[string]any, path string) (string, string) {
+	required := make(map[string]bool, len(s.RequiredProperties))
+	for _, name := range s.RequiredProperties {
+		required[name] = true
+	}
+	for name, prop := range s.Properties {
+		if prop != nil && prop.Required {
+			required[name] = true
+		}
+	}
+	for _, name := range sortedKeys(required) {
+		if _, ok := v[name]; !ok {
+			return fmt.Sprintf("missing required field %q", name), path
+		}
+	}
+
+	keys := make([]string, 0, len(v))
+	for k := range v {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		fieldPath := path + "." + k
+		if prop, ok := s.Properties[k]; ok {
+			if reason, at := check(prop, v[k], fieldPath); reason != "" {
+				return reason, at
+			}
+			continue
+		}
+		if s.AdditionalProperties == nil {
+			continue
+		}
+		if !s.AdditionalProperties.Allowed {
+			return fmt.Sprintf("unknown field %q", k), fieldPath
+		}
+		if reason, at := check(s.AdditionalProperties.Schema, v[k], fieldPath); reason != "" {
+			return reason, at
+		}
+	}
+	return "", ""
+}
+
+func checkArray(s *Schema, v []any, path string) (string, string) {
+	if len(s.Items) == 0 {
+		return "", ""
+	}
+	for i, item := range v {
+		itemPath := fmt.Sprintf("%s[%d]", path, i)
+		if len(s.Items) == 1 {
+			if reason, at := check(s.Items[0], item, itemPath); reason != "" {
+				return reason, at
+			}
+			continue
+		}
+		if reason, at := checkAlternatives(s.Items, item, itemPath); reason != "" {
+			return reason, at
+		}
+	}
+	return "", ""
+}
+
+// matchesType reports whether value has one of the JSON types in t.
+func matchesType(t SchemaType, value any) bool {
+	for _, name := range t {
+		switch name {
+		case "null":
+			if value == nil {
+				return true
+			}
+		case "string":
+			if _, ok := value.(string); ok {
+				return true
+			}
+		case "boolean":
+			if _, ok := value.(bool); ok {
+				return true
+			}
+		case "integer":
+			if f, ok := value.(float64); ok && f == math.Trunc(f) {
+				return true
+			}
+		case "number", "float":
+			if _, ok := value.(float64); ok {
+				return true
+			}
+		case "object":
+			if _, ok := value.(map[string]any); ok {
+				return true
+			}
+		case "array":
+			if _, ok := value.([]any); ok {
+				return true
+			}
+		default:
+			// Unknown type names are not enforced.
+			return true
+		}
+	}
+	return false
+}
+
+// jsonTypeName names the JSON type of a decoded value.
+func jsonTypeName(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case float64:
+		if v == math.Trunc(v) {
+			return "integer"
+		}
+		return "number"
+	case map[string]any:
+		return "object"
+	case []any:
+		return "array"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+func containsValue(values []any, value any) bool {
+	for _, v := range values {
+		if reflect.DeepEqual(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func formatEnum(values []any) string {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		b, _ := json.Marshal(v)
+		parts[i] = string(b)
+	}
+	return "[" + strings.Join(parts, ", ") + "]"
+}
+
+func formatNumber(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// patterns caches compiled schema patterns; nil marks one Go cannot compile.
+var patterns sync.Map // string → *regexp.Regexp
+
+func compilePattern(pattern string) *regexp.Regexp {
+	if cached, ok := patterns.Load(pattern); ok {
+		return cached.(*regexp.Regexp)
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		re = nil
+	}
+	patterns.Store(pattern, re)
+	return re
+}
+
+// NearestVersion returns the highest embedded version not newer than
+// version (e.g. "25.10" → "25.04" when only 25.04 is embedded), or "" if
+// every embedded version is newer.
+func NearestVersion(version string) string {
+	want, ok := parseMajorMinor(version)
+	if !ok {
+		return ""
+	}
+	best := ""
+	for _, v := range Versions() {
+		got, ok := parseMajorMinor(v)
+		if ok && got <= want {
+			best = v
+		}
+	}
+	return best
+}
+
+// MatchVersion returns the embedded version with the same major.minor as
+// version (e.g. "25.04.2.4" → "25.04"), or "" if that release has no
+// catalog.
+func MatchVersion(version string) string {
+	want, ok := parseMajorMinor(version)
+	if !ok {
+		return ""
+	}
+	for _, v := range Versions() {
+		if got, ok := parseMajorMinor(v); ok && got == want {
+			return v
+		}
+	}
+	return ""
+}
+
+// parseMajorMinor encodes "major.minor[...]" as major*100+minor.
+func parseMajorMinor(version string) (int, bool) {
+	parts := strings.SplitN(version, ".", 3)
+	if len(parts) < 2 {
+		return 0, false
+	}
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, false
+	}
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, false
+	}
+	return major*100 + minor, true
+}
@@ -0,0 +1,221 @@
+package api
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidate_Valid(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		params any
+	}{
+		{"no params", "system.info", nil},
+		{"single object", "zfs.snapshot.create", map[string]any{"dataset": "tank/data", "name": "snap", "recursive": true}},
+		{"nested object", "cronjob.create", map[string]any{"command": "ls", "user": "root", "schedule": map[string]any{"hour": "1"}}},
+		{"query filters", "zfs.snapshot.query", [][]any{{"id", "=", "tank@snap"}}},
+		{"scalar", "zfs.snapshot.delete", "tank@snap"},
+		{"positional", "pool.dataset.update", []any{"tank/data", map[string]any{"comments": "hi"}}},
+		{"integer id", "vm.stop", []any{1, map[string]any{"force": true}}},
+		{"nullable", "pool.dataset.query", []any{[]any{}, map[string]any{"extend": nil}}},
+		{"struct", "zfs.snapshot.create", struct {
+			Dataset string `json:"dataset"`
+			Name    string `json:"name"`
+		}{Dataset: "tank/data", Name: "snap"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := Validate("25.04", tt.method, tt.params); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestValidate_Invalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   string
+		params   any
+		wantPath string
+		wantMsg  string
+	}{
+		{
+			name:     "unknown field",
+			method:   "cronjob.create",
+			params:   map[string]any{"command": "ls", "user": "root", "scheduel": map[string]any{}},
+			wantPath: "params[0].scheduel",
+			wantMsg:  `unknown field "scheduel"`,
+		},
+		{
+			name:     "missing required field",
+			method:   "cronjob.create",
+			params:   map[string]any{"user": "root"},
+			wantPath: "params[0]",
+			wantMsg:  `missing required field "command"`,
+		},
+		{
+			name:     "wrong type",
+			method:   "zfs.snapshot.create",
+			params:   map[string]any{"dataset": "tank", "name": "x", "recursive": "yes"},
+			wantPath: "params[0].recursive",
+			wantMsg:  "expected boolean, got string",
+		},
+		{
+			name:     "enum",
+			method:   "pool.dataset.create",
+			params:   map[string]any{"name": "tank/x", "type": "FILESYTEM"},
+			wantPath: "params[0].type",
+			wantMsg:  `must be one of ["FILESYSTEM", "VOLUME"]`,
+		},
+		{
+			name:     "too many arguments",
+			method:   "system.info",
+			params:   "extra",
+			wantPath: "params",
+			wantMsg:  "got 1 arguments, method accepts at most 0",
+		},
+		{
+			name:     "non-integer",
+			method:   "vm.stop",
+			params:   []any{1.5},
+			wantPath: "params[0]",
+			wantMsg:  "expected integer, got number",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate("25.04", tt.method, tt.params)
+			if !errors.Is(err, ErrInvalidParams) {
+				t.Fatalf("expected ErrInvalidParams, got %v", err)
+			}
+			var verr *ValidationError
+			if !errors.As(err, &verr) {
+				t.Fatalf("expected *ValidationError, got %T", err)
+			}
+			if verr.Method != tt.method {
+				t.Errorf("Method = %q, want %q", verr.Method, tt.method)
+			}
+			if verr.Path != tt.wantPath {
+				t.Errorf("Path = %q, want %q", verr.Path, tt.wantPath)
+			}
+			if !strings.Contains(verr.Reason, tt.wantMsg) {
+				t.Errorf("Reason = %q, want it to contain %q", verr.Reason, tt.wantMsg)
+			}
+		})
+	}
+}
+
+func TestValidate_UnknownMethod(t *testing.T) {
+	err := Validate("25.04", "cronjob.craete", nil)
+	if !errors.Is(err, ErrUnknownMethod) {
+		t.Fatalf("expected ErrUnknownMethod, got %v", err)
+	}
+}
+
+func TestValidate_InvalidVersion(t *testing.T) {
+	if err := Validate("99.99", "system.info", nil); err == nil {
+		t.Fatal("expected error for invalid version")
+	}
+}
+
+func TestValidate_Unencodable(t *testing.T) {
+	err := Validate("25.04", "zfs.snapshot.delete", func() {})
+	if err == nil || errors.Is(err, ErrInvalidParams) {
+		t.Fatalf("expected encode error, got %v", err)
+	}
+}
+
+func TestCheck_Keywords(t *testing.T) {
+	min, max := 1.0, 10.0
+	minLen, maxLen := 2, 4
+	tests := []struct {
+		name   string
+		schema *Schema
+		value  any
+		want   string
+	}{
+		{"minimum", &Schema{Type: SchemaType{"integer"}, Minimum: &min}, 0.0, "must be >= 1"},
+		{"maximum", &Schema{Type: SchemaType{"integer"}, Maximum: &max}, 11.0, "must be <= 10"},
+		{"minLength", &Schema{Type: SchemaType{"string"}, MinLength: &minLen}, "a", "at least 2"},
+		{"maxLength", &Schema{Type: SchemaType{"string"}, MaxLength: &maxLen}, "abcde", "at most 4"},
+		{"pattern", &Schema{Type: SchemaType{"string"}, Pattern: "^[a-z]+$"}, "A1", "must match"},
+		{"const", &Schema{Const: []byte(`"a"`)}, "b", `must be "a"`},
+		{"null not allowed", &Schema{Type: SchemaType{"string"}}, nil, "expected string, got null"},
+		{
+			"additional schema",
+			&Schema{Type: SchemaType{"object"}, AdditionalProperties: &Additional{Allowed: true, Schema: &Schema{Type: SchemaType{"string"}}}},
+			map[string]any{"k": 1.0},
+			"expected string, got integer",
+		},
+		{
+			"array items",
+			&Schema{Type: SchemaType{"array"}, Items: SchemaList{{Type: SchemaType{"string"}}}},
+			[]any{"a", true},
+			"expected string, got boolean",
+		},
+		{
+			"anyOf",
+			&Schema{AnyOf: []*Schema{{Type: SchemaType{"string"}}, {Type: SchemaType{"integer"}}}},
+			true,
+			"does not match any allowed schema",
+		},
+		{"valid nullable", &Schema{Type: SchemaType{"string"}, Nullable: true}, nil, ""},
+		{"valid anyOf", &Schema{AnyOf: []*Schema{{Type: SchemaType{"string"}}, {Type: SchemaType{"null"}}}}, nil, ""},
+		{"unknown type ignored", &Schema{Type: SchemaType{"custom"}}, 1.0, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _ := check(tt.schema, tt.value, "v")
+			if tt.want == "" {
+				if got != "" {
+					t.Errorf("unexpected failure: %s", got)
+				}
+				return
+			}
+			if !strings.Contains(got, tt.want) {
+				t.Errorf("reason = %q, want it to contain %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchVersion(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"25.04", "25.04"},
+		{"25.4", "25.04"},
+		{"25.04.2.4", "25.04"},
+		{"25.10", ""},
+		{"24.10", ""},
+		{"bogus", ""},
+	}
+	for _, tt := range tests {
+		if got := MatchVersion(tt.in); got != tt.want {
+			t.Errorf("MatchVersion(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestNearestVersion(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"25.04", "25.04"},
+		{"25.4", "25.04"},
+		{"25.10", "25.04"},
+		{"25.04.2.4", "25.04"},
+		{"24.10", ""},
+		{"bogus", ""},
+	}
+	for _, tt := range tests {
+		if got := NearestVersion(tt.in); got != tt.want {
+			t.Errorf("NearestVersion(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
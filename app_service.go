@@ -144,7 +144,7 @@ func NewAppService(c SubscribeCaller, v Version) *AppService {
 // CreateApp creates an app and returns the full object.
 func (s *AppService) CreateApp(ctx context.Context, opts CreateAppOpts) (*App, error) {
 	params := createAppParams(opts)
-	_, err := s.client.CallAndWait(ctx, "app.create", params)
+	_, err := callMethodAndWait(ctx, s.client, s.version, "app.create", params)
 	if err != nil {
 		return nil, err
 	}
@@ -162,7 +162,7 @@ func (s *AppService) CreateApp(ctx context.Context, opts CreateAppOpts) (*App, e
 // GetApp returns an app by name, or nil if not found.
 func (s *AppService) GetApp(ctx context.Context, name string) (*App, error) {
 	filter := [][]any{{"name", "=", name}}
-	result, err := s.client.Call(ctx, "app.query", filter)
+	result, err := callMethod(ctx, s.client, s.version, "app.query", filter)
 	if err != nil {
 		return nil, err
 	}
@@ -184,7 +184,7 @@ func (s *AppService) GetApp(ctx context.Context, name string) (*App, error) {
 func (s *AppService) GetAppWithConfig(ctx context.Context, name string) (*App, error) {
 	filter := [][]any{{"name", "=", name}}
 	params := []any{filter, map[string]any{"extra": map[string]any{"retrieve_config": true}}}
-	result, err := s.client.Call(ctx, "app.query", params)
+	result, err := callMethod(ctx, s.client, s.version, "app.query", params)
 	if err != nil {
 		return nil, err
 	}
@@ -205,7 +205,7 @@ func (s *AppService) GetAppWithConfig(ctx context.Context, name string) (*App, e
 // UpdateApp updates an app and returns the full object.
 func (s *AppService) UpdateApp(ctx context.Context, name string, opts UpdateAppOpts) (*App, error) {
 	params := []any{name, updateAppParams(opts)}
-	_, err := s.client.CallAndWait(ctx, "app.update", params)
+	_, err := callMethodAndWait(ctx, s.client, s.version, "app.update", params)
 	if err != nil {
 		return nil, err
 	}
@@ -222,7 +222,7 @@ func (s *AppService) UpdateApp(ctx context.Context, name string, opts UpdateAppO
 
 // ListApps returns all apps.
 func (s *AppService) ListApps(ctx context.Context) ([]App, error) {
-	result, err := s.client.Call(ctx, "app.query", nil)
+	result, err := callMethod(ctx, s.client, s.version, "app.query", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -241,26 +241,26 @@ func (s *AppService) ListApps(ctx context.Context) ([]App, error) {
 
 // StartApp starts an app by name.
 func (s *AppService) StartApp(ctx context.Context, name string) error {
-	_, err := s.client.CallAndWait(ctx, "app.start", name)
+	_, err := callMethodAndWait(ctx, s.client, s.version, "app.start", name)
 	return err
 }
 
 // StopApp stops an app by name.
 func (s *AppService) StopApp(ctx context.Context, name string) error {
-	_, err := s.client.CallAndWait(ctx, "app.stop", name)
+	_, err := callMethodAndWait(ctx, s.client, s.version, "app.stop", name)
 	return err
 }
 
 // DeleteApp deletes an app by name.
 func (s *AppService) DeleteApp(ctx context.Context, name string) error {
-	_, err := s.client.CallAndWait(ctx, "app.delete", name)
+	_, err := callMethodAndWait(ctx, s.client, s.version, "app.delete", name)
 	return err
 }
 
 // CreateRegistry creates a registry and returns the full object.
 func (s *AppService) CreateRegistry(ctx context.Context, opts CreateRegistryOpts) (*Registry, error) {
 	params := registryParams(opts)
-	result, err := s.client.Call(ctx, "app.registry.create", params)
+	result, err := callMethod(ctx, s.client, s.version, "app.registry.create", params)
 	if err != nil {
 		return nil, err
 	}
@@ -285,7 +285,7 @@ func (s *AppService) CreateRegistry(ctx context.Context, opts CreateRegistryOpts
 // GetRegistry returns a registry by ID, or nil if not found.
 func (s *AppService) GetRegistry(ctx context.Context, id int64) (*Registry, error) {
 	filter := [][]any{{"id", "=", id}}
-	result, err := s.client.Call(ctx, "app.registry.query", filter)
+	result, err := callMethod(ctx, s.client, s.version, "app.registry.query", filter)
 	if err != nil {
 		return nil, err
 	}
@@ -305,7 +305,7 @@ func (s *AppService) GetRegistry(ctx context.Context, id int64) (*Registry, erro
 
 // ListRegistries returns all registries.
 func (s *AppService) ListRegistries(ctx context.Context) ([]Registry, error) {
-	result, err := s.client.Call(ctx, "app.registry.query", nil)
+	result, err := callMethod(ctx, s.client, s.version, "app.registry.query", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -325,7 +325,7 @@ func (s *AppService) ListRegistries(ctx context.Context) ([]Registry, error) {
 // UpdateRegistry updates a registry and returns the full object.
 func (s *AppService) UpdateRegistry(ctx context.Context, id int64, opts UpdateRegistryOpts) (*Registry, error) {
 	params := registryParams(opts)
-	_, err := s.client.Call(ctx, "app.registry.update", []any{id, params})
+	_, err := callMethod(ctx, s.client, s.version, "app.registry.update", []any{id, params})
 	if err != nil {
 		return nil, err
 	}
@@ -342,13 +342,13 @@ func (s *AppService) UpdateRegistry(ctx context.Context, id int64, opts UpdateRe
 
 // DeleteRegistry deletes a registry by ID.
 func (s *AppService) DeleteRegistry(ctx context.Context, id int64) error {
-	_, err := s.client.Call(ctx, "app.registry.delete", id)
+	_, err := callMethod(ctx, s.client, s.version, "app.registry.delete", id)
 	return err
 }
 
 // UpgradeSummary returns the upgrade summary for an app.
 func (s *AppService) UpgradeSummary(ctx context.Context, name string) (*AppUpgradeSummary, error) {
-	result, err := s.client.Call(ctx, "app.upgrade_summary", []any{name})
+	result, err := callMethod(ctx, s.client, s.version, "app.upgrade_summary", []any{name})
 	if err != nil {
 		return nil, err
 	}
@@ -364,7 +364,7 @@ func (s *AppService) UpgradeSummary(ctx context.Context, name string) (*AppUpgra
 
 // ListImages returns all container images.
 func (s *AppService) ListImages(ctx context.Context) ([]AppImage, error) {
-	result, err := s.client.Call(ctx, "app.image.query", nil)
+	result, err := callMethod(ctx, s.client, s.version, "app.image.query", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -383,7 +383,7 @@ func (s *AppService) ListImages(ctx context.Context) ([]AppImage, error) {
 
 // AvailableSpace returns the available space in bytes for app storage.
 func (s *AppService) AvailableSpace(ctx context.Context) (int64, error) {
-	result, err := s.client.Call(ctx, "app.available_space", nil)
+	result, err := callMethod(ctx, s.client, s.version, "app.available_space", nil)
 	if err != nil {
 		return 0, err
 	}
@@ -397,19 +397,19 @@ func (s *AppService) AvailableSpace(ctx context.Context) (int64, error) {
 
 // UpgradeApp upgrades an app by name.
 func (s *AppService) UpgradeApp(ctx context.Context, name string) error {
-	_, err := s.client.CallAndWait(ctx, "app.upgrade", []any{name})
+	_, err := callMethodAndWait(ctx, s.client, s.version, "app.upgrade", []any{name})
 	return err
 }
 
 // RedeployApp redeploys an app by name.
 func (s *AppService) RedeployApp(ctx context.Context, name string) error {
-	_, err := s.client.CallAndWait(ctx, "app.redeploy", name)
+	_, err := callMethodAndWait(ctx, s.client, s.version, "app.redeploy", name)
 	return err
 }
 
 // SubscribeStats subscribes to app.stats events for real-time app resource usage.
 func (s *AppService) SubscribeStats(ctx context.Context) (*Subscription[[]AppStats], error) {
-	rawSub, err := s.client.Subscribe(ctx, "app.stats", nil)
+	rawSub, err := subscribeMethod(ctx, s.client, s.version, "app.stats", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -444,7 +444,7 @@ func (s *AppService) SubscribeContainerLogs(ctx context.Context, opts ContainerL
 		"tail_lines":   opts.TailLines,
 	}
 
-	rawSub, err := s.client.Subscribe(ctx, "app.container_log_follow", params)
+	rawSub, err := subscribeMethod(ctx, s.client, s.version, "app.container_log_follow", params)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,125 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// defaultBatchConcurrency bounds the calls a Batch runs at once through
+// clients without a native batch mechanism, such as wrappers.
+const defaultBatchConcurrency = 5
+
+// ErrBatchUnsupported is returned for calls of a JSON-RPC batch the server
+// rejected as a whole. WebSocketClient then pipelines the calls instead.
+var ErrBatchUnsupported = errors.New("server does not support JSON-RPC batches")
+
+// BatchResult is the outcome of one call in a Batch.
+type BatchResult struct {
+	Result json.RawMessage
+	Err    error
+}
+
+type batchCall struct {
+	method string
+	params any
+	job    bool // wait for the job like CallAndWait
+}
+
+// batchRunner is implemented by transports with their own way of running
+// several calls at once.
+type batchRunner interface {
+	runBatch(ctx context.Context, calls []batchCall) []BatchResult
+}
+
+// Batch queues calls to send together, saving a round-trip per call. Build
+// one with NewBatch or a transport's Batch method, add calls, then Run it.
+//
+// WebSocketClient sends the calls as one JSON-RPC 2.0 batch, or pipelines
+// them when the server does not accept batches. SSHClient runs them
+// concurrently within its MaxSessions. Other clients, such as wrappers, run
+// them concurrently through their Call and CallAndWait.
+//
+// The calls of a batch are independent: the server may run them in any
+// order, and a failed call does not stop the others.
+type Batch struct {
+	client Client
+	calls  []batchCall
+}
+
+// NewBatch creates an empty batch for c.
+func NewBatch(c Client) *Batch {
+	return &Batch{client: c}
+}
+
+// Add queues a call and returns its index in the results.
+func (b *Batch) Add(method string, params any) int {
+	b.calls = append(b.calls, batchCall{method: method, params: params})
+	return len(b.calls) - 1
+}
+
+// AddJob queues a call whose job is waited for, as with CallAndWait, and
+// returns its index in the results.
+func (b *Batch) AddJob(method string, params any) int {
+	b.calls = append(b.calls, batchCall{method: method, params: params, job: true})
+	return len(b.calls) - 1
+}
+
+// Len returns the number of queued calls.
+func (b *Batch) Len() int {
+	return len(b.calls)
+}
+
+// Run sends the queued calls and returns one result per call, in the order
+// they were added. The error joins the errors of the failed calls, each
+// prefixed with its index and method, and is nil if every call succeeded.
+// The batch can be run again.
+func (b *Batch) Run(ctx context.Context) ([]BatchResult, error) {
+	if len(b.calls) == 0 {
+		return nil, nil
+	}
+	var results []BatchResult
+	if r, ok := b.client.(batchRunner); ok {
+		results = r.runBatch(ctx, b.calls)
+	} else {
+		results = runConcurrently(ctx, b.client, b.calls, defaultBatchConcurrency)
+	}
+
+	var errs []error
+	for i, r := range results {
+		if r.Err != nil {
+			errs = append(errs, fmt.Errorf("call %d (%s): %w", i, b.calls[i].method, r.Err))
+		}
+	}
+	return results, errors.Join(errs...)
+}
+
+// runConcurrently runs calls through c, at most limit at a time.
+func runConcurrently(ctx context.Context, c Client, calls []batchCall, limit int) []BatchResult {
+	results := make([]BatchResult, len(calls))
+	sem := make(chan struct{}, limit)
+	var wg sync.WaitGroup
+	for i, call := range calls {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				results[i].Err = ctx.Err()
+				return
+			}
+			defer func() { <-sem }()
+
+			if call.job {
+				results[i].Result, results[i].Err = c.CallAndWait(ctx, call.method, call.params)
+			} else {
+				results[i].Result, results[i].Err = c.Call(ctx, call.method, call.params)
+			}
+		}()
+	}
+	wg.Wait()
+	return results
+}
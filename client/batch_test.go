@@ -0,0 +1,319 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestBatch_Run(t *testing.T) {
+	var mu sync.Mutex
+	var jobs []string
+	mock := &MockClient{
+		CallFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			if method == "cronjob.delete" {
+				return nil, errors.New("[ENOENT] cronjob 9 not found")
+			}
+			return json.Marshal(params)
+		},
+		CallAndWaitFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			mu.Lock()
+			jobs = append(jobs, method)
+			mu.Unlock()
+			return json.RawMessage(`"done"`), nil
+		},
+	}
+
+	b := NewBatch(mock)
+	if results, err := b.Run(context.Background()); results != nil || err != nil {
+		t.Errorf("empty Run = %v, %v; want nil, nil", results, err)
+	}
+	for i := range 3 {
+		if idx := b.Add("pool.dataset.create", map[string]any{"n": i}); idx != i {
+			t.Errorf("Add returned %d, want %d", idx, i)
+		}
+	}
+	b.Add("cronjob.delete", 9)
+	b.AddJob("app.redeploy", "web")
+	if b.Len() != 5 {
+		t.Errorf("Len = %d, want 5", b.Len())
+	}
+
+	results, err := b.Run(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "call 3 (cronjob.delete): [ENOENT]") {
+		t.Errorf("err = %v, want the failed call's error", err)
+	}
+	if len(results) != 5 {
+		t.Fatalf("got %d results, want 5", len(results))
+	}
+	for i := range 3 {
+		want := `{"n":` + string(rune('0'+i)) + `}`
+		if string(results[i].Result) != want || results[i].Err != nil {
+			t.Errorf("results[%d] = %s, %v; want %s", i, results[i].Result, results[i].Err, want)
+		}
+	}
+	if results[3].Err == nil {
+		t.Error("results[3]: expected error")
+	}
+	if string(results[4].Result) != `"done"` || len(jobs) != 1 || jobs[0] != "app.redeploy" {
+		t.Errorf("results[4] = %s with jobs %v, want the job waited for", results[4].Result, jobs)
+	}
+}
+
+func TestBatch_ConcurrencyLimit(t *testing.T) {
+	var running, peak atomic.Int32
+	mock := &MockClient{
+		CallFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			n := running.Add(1)
+			defer running.Add(-1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			return json.RawMessage(`true`), nil
+		},
+	}
+
+	results := runConcurrently(context.Background(), mock, make([]batchCall, 12), 3)
+	if len(results) != 12 {
+		t.Fatalf("got %d results, want 12", len(results))
+	}
+	if p := peak.Load(); p > 3 || p < 2 {
+		t.Errorf("peak concurrency = %d, want 2 or 3", p)
+	}
+}
+
+func TestBatch_ContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	mock := &MockClient{
+		CallFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return nil, ctx.Err()
+		},
+	}
+
+	b := NewBatch(mock)
+	b.Add("system.info", nil)
+	results, err := b.Run(ctx)
+	if !errors.Is(err, context.Canceled) || !errors.Is(results[0].Err, context.Canceled) {
+		t.Errorf("Run = %v, %v; want context.Canceled", results, err)
+	}
+}
+
+func TestSSHClient_Batch_WithinMaxSessions(t *testing.T) {
+	client, _ := NewSSHClient(&SSHConfig{
+		Host:               "truenas.local",
+		PrivateKey:         testPrivateKey,
+		HostKeyFingerprint: testHostKeyFingerprint,
+		MaxSessions:        2,
+	})
+	var running, peak atomic.Int32
+	client.clientWrapper = &mockSSHClient{
+		newSessionFunc: func() (sshSession, error) {
+			return &mockSession{
+				combinedOutputFunc: func(cmd string) ([]byte, error) {
+					n := running.Add(1)
+					defer running.Add(-1)
+					for {
+						p := peak.Load()
+						if n <= p || peak.CompareAndSwap(p, n) {
+							break
+						}
+					}
+					time.Sleep(10 * time.Millisecond)
+					method := strings.Fields(cmd)[3]
+					return json.Marshal(method)
+				},
+			}, nil
+		},
+	}
+
+	b := client.Batch()
+	methods := []string{"a.query", "b.query", "c.query", "d.query", "e.query", "f.query"}
+	for _, m := range methods {
+		b.Add(m, nil)
+	}
+	results, err := b.Run(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for i, m := range methods {
+		if string(results[i].Result) != `"`+m+`"` {
+			t.Errorf("results[%d] = %s, want %q", i, results[i].Result, m)
+		}
+	}
+	if p := peak.Load(); p > 2 {
+		t.Errorf("peak sessions = %d, want at most 2", p)
+	}
+}
+
+// newBatchTestServer creates a WebSocket server that answers each call with
+// its method name, or an error for "fail". Batches are answered in reverse
+// order, or rejected as a whole if acceptBatches is false. A non-empty stray
+// message is sent before the answer to each batch. frames counts the messages
+// carrying calls.
+func newBatchTestServer(t *testing.T, acceptBatches bool, stray string, frames *atomic.Int32) *httptest.Server {
+	t.Helper()
+	respond := func(req JSONRPCRequest) JSONRPCResponse {
+		if req.Method == "fail" {
+			return JSONRPCResponse{JSONRPC: "2.0", Error: &JSONRPCError{Code: -32001, Message: "[EINVAL] failed"}, ID: req.ID}
+		}
+		result, _ := json.Marshal(req.Method)
+		return JSONRPCResponse{JSONRPC: "2.0", Result: result, ID: req.ID}
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		upgrader := websocket.Upgrader{}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		for {
+			_, msg, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			if strings.HasPrefix(string(msg), "[") {
+				frames.Add(1)
+				if stray != "" {
+					_ = conn.WriteMessage(websocket.TextMessage, []byte(stray))
+				}
+				if !acceptBatches {
+					_ = conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc": "2.0", "error": {"code": -32600, "message": "Invalid Request"}, "id": null}`))
+					continue
+				}
+				var reqs []JSONRPCRequest
+				if err := json.Unmarshal(msg, &reqs); err != nil {
+					return
+				}
+				resps := make([]JSONRPCResponse, len(reqs))
+				for i, req := range reqs {
+					resps[len(reqs)-1-i] = respond(req)
+				}
+				_ = conn.WriteJSON(resps)
+				continue
+			}
+
+			var req JSONRPCRequest
+			if err := json.Unmarshal(msg, &req); err != nil {
+				return
+			}
+			switch req.Method {
+			case "auth.login_ex":
+				_ = conn.WriteJSON(JSONRPCResponse{JSONRPC: "2.0", Result: json.RawMessage(`{"response_type":"SUCCESS"}`), ID: req.ID})
+				continue
+			case "core.subscribe":
+				_ = conn.WriteJSON(JSONRPCResponse{JSONRPC: "2.0", Result: json.RawMessage(`true`), ID: req.ID})
+				continue
+			}
+			frames.Add(1)
+			_ = conn.WriteJSON(respond(req))
+		}
+	}))
+}
+
+func TestWebSocketClient_Batch(t *testing.T) {
+	var frames atomic.Int32
+	server := newBatchTestServer(t, true, "", &frames)
+	defer server.Close()
+
+	client := newSubscribeTestClient(t, server)
+	defer client.Close()
+
+	b := client.Batch()
+	b.Add("pool.dataset.create", map[string]any{"name": "tank/a"})
+	b.Add("fail", nil)
+	b.Add("vm.device.create", map[string]any{"vm": 1})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	results, err := b.Run(ctx)
+	if err == nil || !strings.Contains(err.Error(), "call 1 (fail)") {
+		t.Errorf("err = %v, want call 1 to fail", err)
+	}
+	if string(results[0].Result) != `"pool.dataset.create"` || string(results[2].Result) != `"vm.device.create"` {
+		t.Errorf("results = %s, %s; want the responses in call order", results[0].Result, results[2].Result)
+	}
+	if results[1].Err == nil || !strings.Contains(results[1].Err.Error(), "[EINVAL] failed") {
+		t.Errorf("results[1].Err = %v", results[1].Err)
+	}
+	if n := frames.Load(); n != 1 {
+		t.Errorf("sent %d messages, want 1 batch", n)
+	}
+}
+
+func TestWebSocketClient_Batch_Unsupported(t *testing.T) {
+	var frames atomic.Int32
+	server := newBatchTestServer(t, false, "", &frames)
+	defer server.Close()
+
+	client := newSubscribeTestClient(t, server)
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	for run := range 2 {
+		frames.Store(0)
+		b := client.Batch()
+		b.Add("pool.dataset.create", nil)
+		b.Add("pool.dataset.update", nil)
+		results, err := b.Run(ctx)
+		if err != nil {
+			t.Fatalf("run %d: unexpected error: %v", run, err)
+		}
+		if string(results[0].Result) != `"pool.dataset.create"` || string(results[1].Result) != `"pool.dataset.update"` {
+			t.Errorf("run %d: results = %s, %s", run, results[0].Result, results[1].Result)
+		}
+		// The first run tries a batch before pipelining; later runs pipeline
+		// straight away.
+		want := int32(3)
+		if run > 0 {
+			want = 2
+		}
+		if n := frames.Load(); n != want {
+			t.Errorf("run %d: sent %d messages, want %d", run, n, want)
+		}
+	}
+}
+
+func TestWebSocketClient_Batch_UnrelatedErrorWithoutID(t *testing.T) {
+	var frames atomic.Int32
+	stray := `{"jsonrpc": "2.0", "error": {"code": -32700, "message": "Parse error"}, "id": null}`
+	server := newBatchTestServer(t, true, stray, &frames)
+	defer server.Close()
+
+	client := newSubscribeTestClient(t, server)
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	b := client.Batch()
+	b.Add("pool.dataset.create", nil)
+	b.Add("pool.dataset.update", nil)
+	results, err := b.Run(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(results[0].Result) != `"pool.dataset.create"` || string(results[1].Result) != `"pool.dataset.update"` {
+		t.Errorf("results = %s, %s", results[0].Result, results[1].Result)
+	}
+	if n := frames.Load(); n != 1 {
+		t.Errorf("sent %d messages, want 1 batch", n)
+	}
+}
@@ -0,0 +1,399 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io/fs"
+	"sync"
+	"time"
+
+	truenas "github.com/deevus/truenas-go"
+)
+
+// CachingClient wraps a Client with a read-through cache of query results,
+// keyed by method and params.
+//
+// Results of *.query and *.get_instance calls are cached for a TTL. On first
+// use of a namespace the cache also subscribes to its collection (e.g.
+// pool.query for pool.query and pool.get_instance) and keeps entries current
+// from its events: an added or changed object is patched into cached
+// unfiltered queries and get_instance results, while other queries of the
+// collection are dropped. Events without an object, such as removals, drop
+// every entry of the collection. Where subscriptions are unavailable, as over
+// SSH, entries live for their TTL.
+//
+// Mutating calls through the CachingClient drop the entries of their
+// namespace once they return.
+type CachingClient struct {
+	client Client
+	ttl    time.Duration
+	now    func() time.Time
+
+	mu       sync.Mutex
+	ttls     map[string]time.Duration
+	entries  map[string]*cacheEntry
+	gens     map[string]uint64 // namespace → invalidation count
+	subs     map[string]*truenas.Subscription[json.RawMessage]
+	noEvents map[string]bool // namespaces whose subscription failed
+	closed   bool
+}
+
+type cacheEntry struct {
+	ns      string
+	tail    string
+	id      string // idKey of the get_instance id
+	plain   bool   // query without filters or options
+	result  json.RawMessage
+	expires time.Time
+}
+
+// Compile-time check that CachingClient implements Client.
+var _ Client = (*CachingClient)(nil)
+
+// NewCachingClient creates a client wrapper that caches query results for
+// ttl. A ttl of 0 or less disables caching until enabled per method with
+// SetTTL.
+func NewCachingClient(client Client, ttl time.Duration) *CachingClient {
+	return &CachingClient{
+		client:   client,
+		ttl:      ttl,
+		now:      time.Now,
+		ttls:     make(map[string]time.Duration),
+		entries:  make(map[string]*cacheEntry),
+		gens:     make(map[string]uint64),
+		subs:     make(map[string]*truenas.Subscription[json.RawMessage]),
+		noEvents: make(map[string]bool),
+	}
+}
+
+// SetTTL overrides the TTL of a read-only method. A ttl of 0 or less stops
+// caching it. Methods other than *.query and *.get_instance, such as
+// system.info, are only refreshed by their TTL.
+func (c *CachingClient) SetTTL(method string, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ttls[method] = ttl
+}
+
+// Invalidate drops the cached entries of a namespace, e.g. "pool.dataset".
+func (c *CachingClient) Invalidate(namespace string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.invalidateLocked(namespace)
+}
+
+// Flush drops every cached entry.
+func (c *CachingClient) Flush() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for ns := range c.gens {
+		c.gens[ns]++
+	}
+	c.entries = make(map[string]*cacheEntry)
+}
+
+// Connect delegates to the underlying client.
+func (c *CachingClient) Connect(ctx context.Context) error {
+	return c.client.Connect(ctx)
+}
+
+// Version delegates to the underlying client.
+func (c *CachingClient) Version() truenas.Version {
+	return c.client.Version()
+}
+
+// Call answers cacheable methods from the cache, and drops the namespace's
+// entries after a mutating call.
+func (c *CachingClient) Call(ctx context.Context, method string, params any) (json.RawMessage, error) {
+	ttl := c.methodTTL(method)
+	if ttl <= 0 {
+		result, err := c.client.Call(ctx, method, params)
+		c.afterCall(method)
+		return result, err
+	}
+
+	key, err := cacheKey(method, params)
+	if err != nil {
+		return c.client.Call(ctx, method, params)
+	}
+	ns, tail := splitMethod(method)
+	if tail == "query" || tail == "get_instance" {
+		c.subscribe(ctx, ns)
+	}
+
+	c.mu.Lock()
+	if e, ok := c.entries[key]; ok && c.now().Before(e.expires) {
+		result := e.result
+		c.mu.Unlock()
+		return result, nil
+	}
+	gen := c.gens[ns]
+	c.mu.Unlock()
+
+	result, err := c.client.Call(ctx, method, params)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// An event or mutation during the call may have made result stale.
+	if c.gens[ns] == gen && !c.closed {
+		c.entries[key] = &cacheEntry{
+			ns:      ns,
+			tail:    tail,
+			id:      idKey(firstParam(params)),
+			plain:   tail == "query" && isPlainQuery(params),
+			result:  result,
+			expires: c.now().Add(ttl),
+		}
+	}
+	return result, nil
+}
+
+// CallAndWait delegates to the underlying client and drops the namespace's
+// entries after a mutating job.
+func (c *CachingClient) CallAndWait(ctx context.Context, method string, params any) (json.RawMessage, error) {
+	result, err := c.client.CallAndWait(ctx, method, params)
+	c.afterCall(method)
+	return result, err
+}
+
+// Subscribe delegates to the underlying client.
+func (c *CachingClient) Subscribe(ctx context.Context, collection string, params any) (*truenas.Subscription[json.RawMessage], error) {
+	return c.client.Subscribe(ctx, collection, params)
+}
+
+// SubscribeEvents delegates to the underlying client.
+func (c *CachingClient) SubscribeEvents(ctx context.Context, collection string, params any) (*truenas.Subscription[json.RawMessage], error) {
+	return subscribeEvents(ctx, c.client, collection, params)
+}
+
+// WriteFile delegates to the underlying client.
+func (c *CachingClient) WriteFile(ctx context.Context, path string, params truenas.WriteFileParams) error {
+	return c.client.WriteFile(ctx, path, params)
+}
+
+// ReadFile delegates to the underlying client.
+func (c *CachingClient) ReadFile(ctx context.Context, path string) ([]byte, error) {
+	return c.client.ReadFile(ctx, path)
+}
+
+// DeleteFile delegates to the underlying client.
+func (c *CachingClient) DeleteFile(ctx context.Context, path string) error {
+	return c.client.DeleteFile(ctx, path)
+}
+
+// RemoveDir delegates to the underlying client.
+func (c *CachingClient) RemoveDir(ctx context.Context, path string) error {
+	return c.client.RemoveDir(ctx, path)
+}
+
+// RemoveAll delegates to the underlying client.
+func (c *CachingClient) RemoveAll(ctx context.Context, path string) error {
+	return c.client.RemoveAll(ctx, path)
+}
+
+// FileExists delegates to the underlying client.
+func (c *CachingClient) FileExists(ctx context.Context, path string) (bool, error) {
+	return c.client.FileExists(ctx, path)
+}
+
+// Chown delegates to the underlying client.
+func (c *CachingClient) Chown(ctx context.Context, path string, uid, gid int) error {
+	return c.client.Chown(ctx, path, uid, gid)
+}
+
+// ChmodRecursive delegates to the underlying client.
+func (c *CachingClient) ChmodRecursive(ctx context.Context, path string, mode fs.FileMode) error {
+	return c.client.ChmodRecursive(ctx, path, mode)
+}
+
+// MkdirAll delegates to the underlying client.
+func (c *CachingClient) MkdirAll(ctx context.Context, path string, mode fs.FileMode) error {
+	return c.client.MkdirAll(ctx, path, mode)
+}
+
+// Close ends the cache's subscriptions and closes the underlying client.
+func (c *CachingClient) Close() error {
+	c.mu.Lock()
+	c.closed = true
+	subs := c.subs
+	c.subs = make(map[string]*truenas.Subscription[json.RawMessage])
+	c.entries = make(map[string]*cacheEntry)
+	c.mu.Unlock()
+
+	for _, sub := range subs {
+		sub.Close()
+	}
+	return c.client.Close()
+}
+
+// methodTTL returns how long results of method are cached, or 0 if they are
+// not.
+func (c *CachingClient) methodTTL(method string) time.Duration {
+	if !IsReadOnlyMethod(method) {
+		return 0
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ttl, ok := c.ttls[method]; ok {
+		return ttl
+	}
+	if _, tail := splitMethod(method); tail == "query" || tail == "get_instance" {
+		return c.ttl
+	}
+	return 0
+}
+
+func (c *CachingClient) afterCall(method string) {
+	if IsReadOnlyMethod(method) {
+		return
+	}
+	ns, _ := splitMethod(method)
+	c.Invalidate(ns)
+}
+
+// subscribe starts following the namespace's collection unless it already
+// is, or cannot be.
+func (c *CachingClient) subscribe(ctx context.Context, ns string) {
+	c.mu.Lock()
+	if c.closed || c.subs[ns] != nil || c.noEvents[ns] {
+		c.mu.Unlock()
+		return
+	}
+	// Reserve the slot so concurrent callers do not subscribe twice.
+	c.noEvents[ns] = true
+	c.mu.Unlock()
+
+	sub, err := c.client.Subscribe(ctx, ns+".query", nil)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err != nil || sub == nil {
+		return // rely on the TTL
+	}
+	if c.closed {
+		sub.Close()
+		return
+	}
+	delete(c.noEvents, ns)
+	c.subs[ns] = sub
+	go c.follow(ns, sub)
+}
+
+// follow applies the events of a namespace's collection until the
+// subscription ends, then drops the namespace's entries, since events may
+// have been missed, and lets the next query subscribe again.
+func (c *CachingClient) follow(ns string, sub *truenas.Subscription[json.RawMessage]) {
+	for event := range sub.C {
+		c.mu.Lock()
+		c.applyEventLocked(ns, event)
+		c.mu.Unlock()
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.subs[ns] == sub {
+		delete(c.subs, ns)
+	}
+	c.invalidateLocked(ns)
+}
+
+// applyEventLocked patches the namespace's entries with the object carried by
+// a collection event, or drops them if the event carries none.
+func (c *CachingClient) applyEventLocked(ns string, event json.RawMessage) {
+	var fields map[string]any
+	if err := json.Unmarshal(event, &fields); err != nil || fields["id"] == nil {
+		c.invalidateLocked(ns)
+		return
+	}
+	c.gens[ns]++
+	id := idKey(fields["id"])
+
+	for key, e := range c.entries {
+		if e.ns != ns {
+			continue
+		}
+		switch {
+		case e.tail == "get_instance" && e.id == id:
+			var obj map[string]any
+			if json.Unmarshal(e.result, &obj) != nil {
+				delete(c.entries, key)
+				continue
+			}
+			e.result = mustMarshal(mergeFields(obj, fields))
+		case e.tail == "get_instance":
+		case e.plain:
+			var items []map[string]any
+			if json.Unmarshal(e.result, &items) != nil {
+				delete(c.entries, key)
+				continue
+			}
+			e.result = mustMarshal(patchItems(items, fields, id))
+		default:
+			delete(c.entries, key)
+		}
+	}
+}
+
+func (c *CachingClient) invalidateLocked(ns string) {
+	c.gens[ns]++
+	for key, e := range c.entries {
+		if e.ns == ns {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// patchItems merges fields into the item with the given id, or appends them
+// as a new item.
+func patchItems(items []map[string]any, fields map[string]any, id string) []map[string]any {
+	for i, item := range items {
+		if idKey(item["id"]) == id {
+			items[i] = mergeFields(item, fields)
+			return items
+		}
+	}
+	return append(items, fields)
+}
+
+func mergeFields(obj, fields map[string]any) map[string]any {
+	for k, v := range fields {
+		obj[k] = v
+	}
+	return obj
+}
+
+func mustMarshal(v any) json.RawMessage {
+	data, _ := json.Marshal(v)
+	return data
+}
+
+func cacheKey(method string, params any) (string, error) {
+	data, err := json.Marshal(params)
+	if err != nil {
+		return "", err
+	}
+	return method + " " + string(data), nil
+}
+
+// isPlainQuery reports whether query params select every object in full:
+// no filters and no options.
+func isPlainQuery(params any) bool {
+	args, _ := toAny(params).([]any)
+	for _, arg := range args {
+		switch arg := arg.(type) {
+		case []any:
+			if len(arg) > 0 {
+				return false
+			}
+		case map[string]any:
+			if len(arg) > 0 {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return params == nil || args != nil
+}
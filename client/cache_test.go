@@ -0,0 +1,288 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	truenas "github.com/deevus/truenas-go"
+)
+
+// cacheSystem is a fake system holding pools, with a controllable pool.query
+// collection.
+type cacheSystem struct {
+	mu     sync.Mutex
+	pools  string
+	calls  map[string]int
+	events chan json.RawMessage
+	subErr error
+}
+
+func newCacheSystem() *cacheSystem {
+	return &cacheSystem{
+		pools:  `[{"id": 1, "name": "tank", "status": "ONLINE"}]`,
+		calls:  make(map[string]int),
+		events: make(chan json.RawMessage, 10),
+	}
+}
+
+func (s *cacheSystem) client() *MockClient {
+	return &MockClient{
+		CallFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			s.calls[method]++
+			switch method {
+			case "pool.query":
+				return json.RawMessage(s.pools), nil
+			case "pool.get_instance":
+				return json.RawMessage(`{"id": 1, "name": "tank", "status": "ONLINE"}`), nil
+			case "pool.update", "system.info":
+				return json.RawMessage(`{}`), nil
+			}
+			return nil, errors.New("unexpected method " + method)
+		},
+		SubscribeFunc: func(ctx context.Context, collection string, params any) (*truenas.Subscription[json.RawMessage], error) {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			s.calls["subscribe "+collection]++
+			if s.subErr != nil {
+				return nil, s.subErr
+			}
+			return truenas.NewSubscription[json.RawMessage](s.events, func() {}), nil
+		},
+	}
+}
+
+func (s *cacheSystem) count(method string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls[method]
+}
+
+// waitFor polls until cond holds, for events applied by the cache's goroutine.
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for condition")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func mustCall(t *testing.T, c Client, method string, params any) string {
+	t.Helper()
+	result, err := c.Call(context.Background(), method, params)
+	if err != nil {
+		t.Fatalf("%s: %v", method, err)
+	}
+	return string(result)
+}
+
+func TestCachingClient_CachesQueries(t *testing.T) {
+	sys := newCacheSystem()
+	c := NewCachingClient(sys.client(), time.Minute)
+
+	first := mustCall(t, c, "pool.query", nil)
+	second := mustCall(t, c, "pool.query", nil)
+	if first != second {
+		t.Errorf("cached result = %s, want %s", second, first)
+	}
+	if n := sys.count("pool.query"); n != 1 {
+		t.Errorf("pool.query reached the system %d times, want 1", n)
+	}
+	if n := sys.count("subscribe pool.query"); n != 1 {
+		t.Errorf("subscribed %d times, want 1", n)
+	}
+
+	// Different params are a different entry.
+	mustCall(t, c, "pool.query", []any{[]any{[]any{"name", "=", "tank"}}})
+	if n := sys.count("pool.query"); n != 2 {
+		t.Errorf("pool.query reached the system %d times, want 2", n)
+	}
+
+	// Methods that are not queries are not cached by default.
+	mustCall(t, c, "system.info", nil)
+	mustCall(t, c, "system.info", nil)
+	if n := sys.count("system.info"); n != 2 {
+		t.Errorf("system.info reached the system %d times, want 2", n)
+	}
+}
+
+func TestCachingClient_TTL(t *testing.T) {
+	sys := newCacheSystem()
+	c := NewCachingClient(sys.client(), time.Minute)
+	now := time.Now()
+	c.now = func() time.Time { return now }
+	c.SetTTL("system.info", time.Second)
+	c.SetTTL("pool.update", time.Hour) // mutating methods are never cached
+
+	mustCall(t, c, "pool.query", nil)
+	mustCall(t, c, "system.info", nil)
+	mustCall(t, c, "system.info", nil)
+	if n := sys.count("system.info"); n != 1 {
+		t.Errorf("system.info reached the system %d times, want 1", n)
+	}
+
+	now = now.Add(2 * time.Second)
+	mustCall(t, c, "system.info", nil)
+	mustCall(t, c, "pool.query", nil)
+	if n := sys.count("system.info"); n != 2 {
+		t.Errorf("system.info reached the system %d times after its TTL, want 2", n)
+	}
+	if n := sys.count("pool.query"); n != 1 {
+		t.Errorf("pool.query reached the system %d times within its TTL, want 1", n)
+	}
+
+	now = now.Add(time.Minute)
+	mustCall(t, c, "pool.query", nil)
+	if n := sys.count("pool.query"); n != 2 {
+		t.Errorf("pool.query reached the system %d times after its TTL, want 2", n)
+	}
+
+	mustCall(t, c, "pool.update", []any{1, map[string]any{}})
+	mustCall(t, c, "pool.update", []any{1, map[string]any{}})
+	if n := sys.count("pool.update"); n != 2 {
+		t.Errorf("pool.update reached the system %d times, want 2", n)
+	}
+}
+
+func TestCachingClient_MutationInvalidates(t *testing.T) {
+	sys := newCacheSystem()
+	c := NewCachingClient(sys.client(), time.Minute)
+
+	mustCall(t, c, "pool.query", nil)
+	mustCall(t, c, "pool.update", []any{1, map[string]any{"autotrim": "ON"}})
+	mustCall(t, c, "pool.query", nil)
+	if n := sys.count("pool.query"); n != 2 {
+		t.Errorf("pool.query reached the system %d times, want 2", n)
+	}
+
+	c.Invalidate("pool")
+	mustCall(t, c, "pool.query", nil)
+	c.Flush()
+	mustCall(t, c, "pool.query", nil)
+	if n := sys.count("pool.query"); n != 4 {
+		t.Errorf("pool.query reached the system %d times, want 4", n)
+	}
+}
+
+func TestCachingClient_Events(t *testing.T) {
+	sys := newCacheSystem()
+	c := NewCachingClient(sys.client(), time.Hour)
+	filtered := []any{[]any{[]any{"status", "=", "ONLINE"}}}
+
+	mustCall(t, c, "pool.query", nil)
+	mustCall(t, c, "pool.query", filtered)
+	mustCall(t, c, "pool.get_instance", 1)
+
+	// A changed object is patched into the plain query and its instance.
+	sys.events <- json.RawMessage(`{"id": 1, "status": "DEGRADED"}`)
+	waitFor(t, func() bool {
+		return mustCall(t, c, "pool.get_instance", 1) == `{"id":1,"name":"tank","status":"DEGRADED"}`
+	})
+	if got, want := mustCall(t, c, "pool.query", nil), `[{"id":1,"name":"tank","status":"DEGRADED"}]`; got != want {
+		t.Errorf("pool.query = %s, want %s", got, want)
+	}
+	// The filtered query may no longer match, so it was dropped.
+	mustCall(t, c, "pool.query", filtered)
+	if n := sys.count("pool.query"); n != 3 {
+		t.Errorf("pool.query reached the system %d times, want 3", n)
+	}
+	if n := sys.count("pool.get_instance"); n != 1 {
+		t.Errorf("pool.get_instance reached the system %d times, want 1", n)
+	}
+
+	// An added object is appended.
+	sys.events <- json.RawMessage(`{"id": 2, "name": "backup", "status": "ONLINE"}`)
+	want := `[{"id":1,"name":"tank","status":"DEGRADED"},{"id":2,"name":"backup","status":"ONLINE"}]`
+	waitFor(t, func() bool { return mustCall(t, c, "pool.query", nil) == want })
+
+	// A removal carries no object and drops the collection.
+	sys.events <- nil
+	waitFor(t, func() bool {
+		mustCall(t, c, "pool.query", nil)
+		return sys.count("pool.query") == 4
+	})
+}
+
+func TestCachingClient_SubscriptionEnds(t *testing.T) {
+	sys := newCacheSystem()
+	c := NewCachingClient(sys.client(), time.Hour)
+
+	mustCall(t, c, "pool.query", nil)
+	close(sys.events)
+	sys.mu.Lock()
+	sys.events = make(chan json.RawMessage, 10)
+	sys.mu.Unlock()
+
+	// Events may have been missed, so the entries are dropped and the next
+	// query subscribes again.
+	waitFor(t, func() bool {
+		mustCall(t, c, "pool.query", nil)
+		return sys.count("pool.query") == 2
+	})
+	if n := sys.count("subscribe pool.query"); n != 2 {
+		t.Errorf("subscribed %d times, want 2", n)
+	}
+}
+
+func TestCachingClient_WithoutSubscriptions(t *testing.T) {
+	sys := newCacheSystem()
+	sys.subErr = ErrUnsupportedOperation
+	c := NewCachingClient(sys.client(), time.Minute)
+
+	mustCall(t, c, "pool.query", nil)
+	mustCall(t, c, "pool.query", nil)
+	mustCall(t, c, "pool.get_instance", 1)
+	if n := sys.count("pool.query"); n != 1 {
+		t.Errorf("pool.query reached the system %d times, want 1", n)
+	}
+	if n := sys.count("subscribe pool.query"); n != 1 {
+		t.Errorf("subscribed %d times, want 1", n)
+	}
+}
+
+func TestCachingClient_ErrorsNotCached(t *testing.T) {
+	calls := 0
+	c := NewCachingClient(&MockClient{
+		CallFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			calls++
+			return nil, errors.New("boom")
+		},
+	}, time.Minute)
+
+	for range 2 {
+		if _, err := c.Call(context.Background(), "app.query", nil); err == nil {
+			t.Fatal("expected error")
+		}
+	}
+	if calls != 2 {
+		t.Errorf("app.query reached the system %d times, want 2", calls)
+	}
+}
+
+func TestIsPlainQuery(t *testing.T) {
+	tests := []struct {
+		params any
+		want   bool
+	}{
+		{nil, true},
+		{[]any{}, true},
+		{[]any{[]any{}}, true},
+		{[]any{[]any{}, map[string]any{}}, true},
+		{[]any{[]any{[]any{"name", "=", "tank"}}}, false},
+		{[]any{[]any{}, map[string]any{"select": []string{"name"}}}, false},
+		{"tank", false},
+	}
+	for _, tt := range tests {
+		if got := isPlainQuery(tt.params); got != tt.want {
+			t.Errorf("isPlainQuery(%v) = %v, want %v", tt.params, got, tt.want)
+		}
+	}
+}
@@ -60,23 +60,34 @@ type Client interface {
 	Close() error
 }
 
+// subscribeEvents calls SubscribeEvents on clients implementing
+// truenas.EventSubscriber, for wrappers that delegate it.
+func subscribeEvents(ctx context.Context, c Client, collection string, params any) (*truenas.Subscription[json.RawMessage], error) {
+	events, ok := c.(truenas.EventSubscriber)
+	if !ok {
+		return nil, ErrUnsupportedOperation
+	}
+	return events.SubscribeEvents(ctx, collection, params)
+}
+
 // MockClient is a test double for Client.
 type MockClient struct {
-	ConnectFunc        func(ctx context.Context) error
-	VersionVal         truenas.Version
-	CallFunc           func(ctx context.Context, method string, params any) (json.RawMessage, error)
-	CallAndWaitFunc    func(ctx context.Context, method string, params any) (json.RawMessage, error)
-	WriteFileFunc      func(ctx context.Context, path string, params truenas.WriteFileParams) error
-	ReadFileFunc       func(ctx context.Context, path string) ([]byte, error)
-	DeleteFileFunc     func(ctx context.Context, path string) error
-	RemoveDirFunc      func(ctx context.Context, path string) error
-	RemoveAllFunc      func(ctx context.Context, path string) error
-	FileExistsFunc     func(ctx context.Context, path string) (bool, error)
-	ChownFunc          func(ctx context.Context, path string, uid, gid int) error
-	ChmodRecursiveFunc func(ctx context.Context, path string, mode fs.FileMode) error
-	MkdirAllFunc       func(ctx context.Context, path string, mode fs.FileMode) error
-	SubscribeFunc      func(ctx context.Context, collection string, params any) (*truenas.Subscription[json.RawMessage], error)
-	CloseFunc          func() error
+	ConnectFunc         func(ctx context.Context) error
+	VersionVal          truenas.Version
+	CallFunc            func(ctx context.Context, method string, params any) (json.RawMessage, error)
+	CallAndWaitFunc     func(ctx context.Context, method string, params any) (json.RawMessage, error)
+	WriteFileFunc       func(ctx context.Context, path string, params truenas.WriteFileParams) error
+	ReadFileFunc        func(ctx context.Context, path string) ([]byte, error)
+	DeleteFileFunc      func(ctx context.Context, path string) error
+	RemoveDirFunc       func(ctx context.Context, path string) error
+	RemoveAllFunc       func(ctx context.Context, path string) error
+	FileExistsFunc      func(ctx context.Context, path string) (bool, error)
+	ChownFunc           func(ctx context.Context, path string, uid, gid int) error
+	ChmodRecursiveFunc  func(ctx context.Context, path string, mode fs.FileMode) error
+	MkdirAllFunc        func(ctx context.Context, path string, mode fs.FileMode) error
+	SubscribeFunc       func(ctx context.Context, collection string, params any) (*truenas.Subscription[json.RawMessage], error)
+	SubscribeEventsFunc func(ctx context.Context, collection string, params any) (*truenas.Subscription[json.RawMessage], error)
+	CloseFunc           func() error
 }
 
 func (m *MockClient) Connect(ctx context.Context) error {
@@ -174,6 +185,13 @@ func (m *MockClient) Subscribe(ctx context.Context, collection string, params an
 	return nil, nil
 }
 
+func (m *MockClient) SubscribeEvents(ctx context.Context, collection string, params any) (*truenas.Subscription[json.RawMessage], error) {
+	if m.SubscribeEventsFunc != nil {
+		return m.SubscribeEventsFunc(ctx, collection, params)
+	}
+	return nil, nil
+}
+
 func (m *MockClient) Close() error {
 	if m.CloseFunc != nil {
 		return m.CloseFunc()
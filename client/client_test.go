@@ -3,8 +3,10 @@ package client
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"io/fs"
 	"testing"
+	"time"
 
 	truenas "github.com/deevus/truenas-go"
 )
@@ -398,3 +400,31 @@ func TestMockClient_ChmodRecursive_WithFunc(t *testing.T) {
 		t.Error("expected ChmodRecursiveFunc to be called")
 	}
 }
+
+func TestWrappers_SubscribeEvents(t *testing.T) {
+	delegated := false
+	mock := &MockClient{
+		SubscribeEventsFunc: func(ctx context.Context, collection string, params any) (*truenas.Subscription[json.RawMessage], error) {
+			delegated = true
+			return nil, nil
+		},
+	}
+	wrappers := map[string]func(Client) truenas.EventSubscriber{
+		"caching":      func(c Client) truenas.EventSubscriber { return NewCachingClient(c, time.Minute) },
+		"dry run":      func(c Client) truenas.EventSubscriber { return NewDryRunClient(c) },
+		"rate limited": func(c Client) truenas.EventSubscriber { return NewRateLimitedClient(c, 0, 0, nil) },
+		"validating":   func(c Client) truenas.EventSubscriber { return NewValidatingClient(c, "") },
+	}
+	for name, wrap := range wrappers {
+		t.Run(name, func(t *testing.T) {
+			delegated = false
+			if _, err := wrap(mock).SubscribeEvents(context.Background(), "app.query", nil); err != nil || !delegated {
+				t.Errorf("expected delegation, got err %v, delegated %v", err, delegated)
+			}
+			// UnsupportedClient does not implement truenas.EventSubscriber.
+			if _, err := wrap(&UnsupportedClient{}).SubscribeEvents(context.Background(), "app.query", nil); !errors.Is(err, ErrUnsupportedOperation) {
+				t.Errorf("expected ErrUnsupportedOperation, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,473 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// Transport names accepted in profiles and TRUENAS_TRANSPORT.
+const (
+	TransportWebSocket = "websocket"
+	TransportSSH       = "ssh"
+)
+
+// DefaultProfileName is used when no profile is requested and the config file
+// does not set default_profile.
+const DefaultProfileName = "default"
+
+// Environment variables read by LoadConfig. Values set in the environment
+// override the corresponding field of the selected profile.
+const (
+	EnvConfig             = "TRUENAS_CONFIG"
+	EnvProfile            = "TRUENAS_PROFILE"
+	EnvHost               = "TRUENAS_HOST"
+	EnvPort               = "TRUENAS_PORT"
+	EnvUsername           = "TRUENAS_USERNAME"
+	EnvAPIKey             = "TRUENAS_API_KEY"
+	EnvAPIKeyFile         = "TRUENAS_API_KEY_FILE"
+	EnvTransport          = "TRUENAS_TRANSPORT"
+	EnvInsecureSkipVerify = "TRUENAS_INSECURE_SKIP_VERIFY"
+	EnvCACertFile         = "TRUENAS_CA_CERT_FILE"
+	EnvSSHUser            = "TRUENAS_SSH_USER"
+	EnvSSHPort            = "TRUENAS_SSH_PORT"
+	EnvSSHKeyFile         = "TRUENAS_SSH_KEY_FILE"
+	EnvSSHFingerprint     = "TRUENAS_SSH_HOST_KEY_FINGERPRINT"
+	EnvSSHMaxSessions     = "TRUENAS_SSH_MAX_SESSIONS"
+)
+
+// Profile holds the connection settings for a single TrueNAS system.
+// Profiles are loaded from a config file and/or TRUENAS_* environment
+// variables by LoadConfig, and turned into a connected Client by New.
+type Profile struct {
+	Name               string     `toml:"-" yaml:"-"`
+	Host               string     `toml:"host" yaml:"host"`
+	Port               int        `toml:"port" yaml:"port"`
+	Username           string     `toml:"username" yaml:"username"`
+	APIKey             string     `toml:"api_key" yaml:"api_key"`
+	APIKeyFile         string     `toml:"api_key_file" yaml:"api_key_file"`
+	Transport          string     `toml:"transport" yaml:"transport"` // "websocket" (default) or "ssh"
+	InsecureSkipVerify bool       `toml:"insecure_skip_verify" yaml:"insecure_skip_verify"`
+	CACertFile         string     `toml:"ca_cert_file" yaml:"ca_cert_file"`
+	ConnectTimeout     Duration   `toml:"connect_timeout" yaml:"connect_timeout"`
+	SSH                SSHProfile `toml:"ssh" yaml:"ssh"`
+
+	// Labels describe the system for selecting hosts of a fleet, e.g.
+	// env = "prod" or site = "ams".
+	Labels map[string]string `toml:"labels" yaml:"labels"`
+}
+
+// SSHProfile holds the SSH settings of a Profile. When a websocket profile
+// also has SSH settings, New wires the SSH client in as the fallback.
+type SSHProfile struct {
+	User               string `toml:"user" yaml:"user"`
+	Port               int    `toml:"port" yaml:"port"`
+	PrivateKeyFile     string `toml:"private_key_file" yaml:"private_key_file"`
+	HostKeyFingerprint string `toml:"host_key_fingerprint" yaml:"host_key_fingerprint"`
+	MaxSessions        int    `toml:"max_sessions" yaml:"max_sessions"`
+}
+
+// Duration is a time.Duration that decodes from strings like "30s" in
+// TOML and YAML config files.
+type Duration time.Duration
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *Duration) UnmarshalText(text []byte) error {
+	v, err := time.ParseDuration(string(text))
+	if err != nil {
+		return err
+	}
+	*d = Duration(v)
+	return nil
+}
+
+// configFile is the on-disk layout of a TOML or YAML config file.
+type configFile struct {
+	DefaultProfile string             `toml:"default_profile" yaml:"default_profile"`
+	Profiles       map[string]Profile `toml:"profiles" yaml:"profiles"`
+}
+
+// configFileNames are searched in order inside DefaultConfigDir.
+var configFileNames = []string{"config.toml", "config.yaml", "config.yml"}
+
+// DefaultConfigDir returns the directory searched for a config file when no
+// path is given, e.g. ~/.config/truenas on Linux.
+func DefaultConfigDir() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "truenas"), nil
+}
+
+// LoadConfig resolves a connection profile.
+//
+// The config file is taken from path, then TRUENAS_CONFIG, then the first of
+// config.toml, config.yaml or config.yml in DefaultConfigDir. A missing
+// default file is not an error, so a profile may come purely from the
+// environment. The profile name is taken from name, then TRUENAS_PROFILE,
+// then the file's default_profile, then "default". A profile named by any of
+// the first three must exist in the file; only the implicit "default" may be
+// absent, leaving the profile to the environment.
+//
+// TRUENAS_* environment variables are applied on top of the file profile.
+// The returned profile has been validated but key files have not been read;
+// that happens in New.
+func LoadConfig(path, name string) (*Profile, error) {
+	explicit := path != ""
+	if path == "" {
+		path = os.Getenv(EnvConfig)
+		explicit = path != ""
+	}
+	if path == "" {
+		path = findDefaultConfig()
+	}
+
+	var cfg configFile
+	if path != "" {
+		var err error
+		cfg, err = readConfigFile(path)
+		if err != nil && (explicit || !errors.Is(err, os.ErrNotExist)) {
+			return nil, err
+		}
+	}
+
+	if name == "" {
+		name = os.Getenv(EnvProfile)
+	}
+	if name == "" {
+		name = cfg.DefaultProfile
+	}
+	requested := name != ""
+	if name == "" {
+		name = DefaultProfileName
+	}
+
+	profile, ok := cfg.Profiles[name]
+	if !ok && (requested || len(cfg.Profiles) > 0 && os.Getenv(EnvHost) == "") {
+		if len(cfg.Profiles) == 0 {
+			return nil, fmt.Errorf("profile %q not found: no config file", name)
+		}
+		return nil, fmt.Errorf("profile %q not found in %s", name, path)
+	}
+	profile.Name = name
+
+	if err := profile.applyEnv(); err != nil {
+		return nil, err
+	}
+	if err := profile.Validate(); err != nil {
+		return nil, fmt.Errorf("profile %q: %w", name, err)
+	}
+	return &profile, nil
+}
+
+// LoadProfiles returns every profile of a config file, sorted by name, for
+// managing several systems at once. The file is found as in LoadConfig.
+// Unlike LoadConfig, TRUENAS_* variables are not applied, since they would
+// override every profile alike.
+func LoadProfiles(path string) ([]*Profile, error) {
+	if path == "" {
+		path = os.Getenv(EnvConfig)
+	}
+	if path == "" {
+		path = findDefaultConfig()
+	}
+	if path == "" {
+		return nil, errors.New("no config file found")
+	}
+
+	cfg, err := readConfigFile(path)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(cfg.Profiles))
+	for name := range cfg.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	profiles := make([]*Profile, 0, len(names))
+	for _, name := range names {
+		profile := cfg.Profiles[name]
+		profile.Name = name
+		if err := profile.Validate(); err != nil {
+			return nil, fmt.Errorf("profile %q: %w", name, err)
+		}
+		profiles = append(profiles, &profile)
+	}
+	return profiles, nil
+}
+
+// findDefaultConfig returns the first existing config file in
+// DefaultConfigDir, or "" if there is none.
+func findDefaultConfig() string {
+	dir, err := DefaultConfigDir()
+	if err != nil {
+		return ""
+	}
+	for _, name := range configFileNames {
+		p := filepath.Join(dir, name)
+		if _, err := os.Stat(p); err == nil {
+			return p
+		}
+	}
+	return ""
+}
+
+// readConfigFile decodes a TOML or YAML config file based on its extension.
+func readConfigFile(path string) (configFile, error) {
+	var cfg configFile
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return cfg, fmt.Errorf("read config %s: %w", path, err)
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".toml":
+		if err := toml.Unmarshal(data, &cfg); err != nil {
+			return cfg, fmt.Errorf("parse config %s: %w", path, err)
+		}
+	case ".yaml", ".yml":
+		if err := yaml.Unmarshal(data, &cfg); err != nil {
+			return cfg, fmt.Errorf("parse config %s: %w", path, err)
+		}
+	default:
+		return cfg, fmt.Errorf("unsupported config format %q (want .toml, .yaml or .yml)", filepath.Ext(path))
+	}
+	return cfg, nil
+}
+
+// applyEnv overrides profile fields with any TRUENAS_* variables that are set.
+func (p *Profile) applyEnv() error {
+	setString := func(key string, dst *string) {
+		if v, ok := os.LookupEnv(key); ok {
+			*dst = v
+		}
+	}
+	setInt := func(key string, dst *int) error {
+		if v, ok := os.LookupEnv(key); ok {
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				return fmt.Errorf("%s: invalid integer %q", key, v)
+			}
+			*dst = n
+		}
+		return nil
+	}
+
+	setString(EnvHost, &p.Host)
+	setString(EnvUsername, &p.Username)
+	setString(EnvAPIKey, &p.APIKey)
+	setString(EnvAPIKeyFile, &p.APIKeyFile)
+	setString(EnvTransport, &p.Transport)
+	setString(EnvCACertFile, &p.CACertFile)
+	setString(EnvSSHUser, &p.SSH.User)
+	setString(EnvSSHKeyFile, &p.SSH.PrivateKeyFile)
+	setString(EnvSSHFingerprint, &p.SSH.HostKeyFingerprint)
+
+	if err := setInt(EnvPort, &p.Port); err != nil {
+		return err
+	}
+	if err := setInt(EnvSSHPort, &p.SSH.Port); err != nil {
+		return err
+	}
+	if err := setInt(EnvSSHMaxSessions, &p.SSH.MaxSessions); err != nil {
+		return err
+	}
+
+	if v, ok := os.LookupEnv(EnvInsecureSkipVerify); ok {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("%s: invalid boolean %q", EnvInsecureSkipVerify, v)
+		}
+		p.InsecureSkipVerify = b
+	}
+	return nil
+}
+
+// Validate checks that the profile has enough settings for its transport
+// and normalizes Transport.
+func (p *Profile) Validate() error {
+	if p.Host == "" {
+		return errors.New("host is required")
+	}
+
+	p.Transport = strings.ToLower(p.Transport)
+	switch p.Transport {
+	case "", TransportWebSocket:
+		p.Transport = TransportWebSocket
+		if p.APIKey == "" && p.APIKeyFile == "" {
+			return errors.New("api_key or api_key_file is required for websocket transport")
+		}
+		if p.hasSSH() && (p.SSH.PrivateKeyFile == "" || p.SSH.HostKeyFingerprint == "") {
+			return errors.New("ssh fallback requires both private_key_file and host_key_fingerprint")
+		}
+	case TransportSSH:
+		if p.SSH.PrivateKeyFile == "" {
+			return errors.New("ssh.private_key_file is required for ssh transport")
+		}
+		if p.SSH.HostKeyFingerprint == "" {
+			return errors.New("ssh.host_key_fingerprint is required for ssh transport")
+		}
+	default:
+		return fmt.Errorf("unknown transport %q (want %q or %q)", p.Transport, TransportWebSocket, TransportSSH)
+	}
+	return nil
+}
+
+// hasSSH reports whether any SSH key settings are present.
+func (p *Profile) hasSSH() bool {
+	return p.SSH.PrivateKeyFile != "" || p.SSH.HostKeyFingerprint != ""
+}
+
+// SSHConfig builds an SSHConfig from the profile, reading the private key file.
+func (p *Profile) SSHConfig() (*SSHConfig, error) {
+	key, err := readSecretFile(p.SSH.PrivateKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("read ssh private key: %w", err)
+	}
+
+	user := p.SSH.User
+	if user == "" {
+		user = p.Username
+	}
+
+	return &SSHConfig{
+		Host:               p.Host,
+		Port:               p.SSH.Port,
+		User:               user,
+		PrivateKey:         key,
+		HostKeyFingerprint: p.SSH.HostKeyFingerprint,
+		MaxSessions:        p.SSH.MaxSessions,
+	}, nil
+}
+
+// WebSocketConfig builds a WebSocketConfig from the profile, reading the API
+// key and CA certificate files. The Fallback field is left unset.
+func (p *Profile) WebSocketConfig() (WebSocketConfig, error) {
+	apiKey := p.APIKey
+	if apiKey == "" {
+		var err error
+		apiKey, err = readSecretFile(p.APIKeyFile)
+		if err != nil {
+			return WebSocketConfig{}, fmt.Errorf("read api key: %w", err)
+		}
+	}
+
+	username := p.Username
+	if username == "" {
+		username = "root"
+	}
+
+	config := WebSocketConfig{
+		Host:               p.Host,
+		Port:               p.Port,
+		Username:           username,
+		APIKey:             apiKey,
+		InsecureSkipVerify: p.InsecureSkipVerify,
+		ConnectTimeout:     time.Duration(p.ConnectTimeout),
+	}
+
+	if p.CACertFile != "" {
+		pem, err := os.ReadFile(expandHome(p.CACertFile))
+		if err != nil {
+			return WebSocketConfig{}, fmt.Errorf("read ca cert: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return WebSocketConfig{}, fmt.Errorf("no certificates found in %s", p.CACertFile)
+		}
+		config.TLSConfig = &tls.Config{RootCAs: pool}
+	}
+
+	return config, nil
+}
+
+// New builds and connects a Client for the given profile.
+//
+// SSH profiles return a connected *SSHClient. WebSocket profiles return a
+// connected *WebSocketClient; if the profile also carries SSH settings, an
+// SSH client is created and wired in as the WebSocket fallback.
+func New(ctx context.Context, profile *Profile) (Client, error) {
+	if profile == nil {
+		return nil, errors.New("profile is required")
+	}
+	if err := profile.Validate(); err != nil {
+		return nil, err
+	}
+
+	var sshClient *SSHClient
+	if profile.hasSSH() {
+		sshConfig, err := profile.SSHConfig()
+		if err != nil {
+			return nil, err
+		}
+		sshClient, err = NewSSHClient(sshConfig)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var c Client
+	if profile.Transport == TransportSSH {
+		c = sshClient
+	} else {
+		wsConfig, err := profile.WebSocketConfig()
+		if err != nil {
+			return nil, err
+		}
+		if sshClient != nil {
+			wsConfig.Fallback = sshClient
+		}
+		ws, err := NewWebSocketClient(wsConfig)
+		if err != nil {
+			return nil, err
+		}
+		c = ws
+	}
+
+	if err := c.Connect(ctx); err != nil {
+		_ = c.Close()
+		if sshClient != nil && c != Client(sshClient) {
+			_ = sshClient.Close()
+		}
+		return nil, err
+	}
+	return c, nil
+}
+
+// readSecretFile reads a key file, expanding a leading ~ and trimming
+// surrounding whitespace.
+func readSecretFile(path string) (string, error) {
+	if path == "" {
+		return "", errors.New("no file configured")
+	}
+	data, err := os.ReadFile(expandHome(path))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// expandHome replaces a leading "~/" with the user's home directory.
+func expandHome(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, strings.TrimPrefix(path, "~"))
+}
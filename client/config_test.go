@@ -0,0 +1,563 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// clearConfigEnv unsets every TRUENAS_* variable so tests are not affected
+// by the environment they run in.
+func clearConfigEnv(t *testing.T) {
+	t.Helper()
+	for _, key := range []string{
+		EnvConfig, EnvProfile, EnvHost, EnvPort, EnvUsername, EnvAPIKey,
+		EnvAPIKeyFile, EnvTransport, EnvInsecureSkipVerify, EnvCACertFile,
+		EnvSSHUser, EnvSSHPort, EnvSSHKeyFile, EnvSSHFingerprint, EnvSSHMaxSessions,
+	} {
+		t.Setenv(key, "")
+		os.Unsetenv(key)
+	}
+	// Point the default config dir at an empty location.
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+}
+
+func writeFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatalf("write %s: %v", path, err)
+	}
+	return path
+}
+
+const testTOMLConfig = `
+default_profile = "prod"
+
+[profiles.prod]
+host = "nas.example.com"
+username = "admin"
+api_key = "1-prod"
+connect_timeout = "15s"
+
+[profiles.prod.ssh]
+private_key_file = "/keys/prod"
+host_key_fingerprint = "SHA256:prod"
+max_sessions = 3
+
+[profiles.prod.labels]
+env = "prod"
+site = "ams"
+
+[profiles.lab]
+host = "lab.local"
+transport = "ssh"
+
+[profiles.lab.ssh]
+user = "ops"
+port = 2222
+private_key_file = "/keys/lab"
+host_key_fingerprint = "SHA256:lab"
+`
+
+const testYAMLConfig = `
+profiles:
+  default:
+    host: yaml.local
+    api_key_file: /keys/api
+    insecure_skip_verify: true
+    port: 8443
+`
+
+func TestLoadConfig_TOMLDefaultProfile(t *testing.T) {
+	clearConfigEnv(t)
+	path := writeFile(t, t.TempDir(), "config.toml", testTOMLConfig)
+
+	p, err := LoadConfig(path, "")
+	if err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+	if p.Name != "prod" {
+		t.Errorf("Name = %q, want prod", p.Name)
+	}
+	if p.Host != "nas.example.com" || p.Username != "admin" || p.APIKey != "1-prod" {
+		t.Errorf("unexpected profile: %+v", p)
+	}
+	if p.Transport != TransportWebSocket {
+		t.Errorf("Transport = %q, want %q", p.Transport, TransportWebSocket)
+	}
+	if time.Duration(p.ConnectTimeout) != 15*time.Second {
+		t.Errorf("ConnectTimeout = %v, want 15s", time.Duration(p.ConnectTimeout))
+	}
+	if p.SSH.PrivateKeyFile != "/keys/prod" || p.SSH.HostKeyFingerprint != "SHA256:prod" || p.SSH.MaxSessions != 3 {
+		t.Errorf("unexpected ssh profile: %+v", p.SSH)
+	}
+}
+
+func TestLoadConfig_TOMLNamedProfile(t *testing.T) {
+	clearConfigEnv(t)
+	path := writeFile(t, t.TempDir(), "config.toml", testTOMLConfig)
+
+	p, err := LoadConfig(path, "lab")
+	if err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+	if p.Transport != TransportSSH {
+		t.Errorf("Transport = %q, want ssh", p.Transport)
+	}
+	if p.SSH.User != "ops" || p.SSH.Port != 2222 {
+		t.Errorf("unexpected ssh profile: %+v", p.SSH)
+	}
+}
+
+func TestLoadConfig_YAML(t *testing.T) {
+	clearConfigEnv(t)
+	path := writeFile(t, t.TempDir(), "config.yaml", testYAMLConfig)
+
+	p, err := LoadConfig(path, "")
+	if err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+	if p.Name != DefaultProfileName {
+		t.Errorf("Name = %q, want %q", p.Name, DefaultProfileName)
+	}
+	if p.Host != "yaml.local" || p.APIKeyFile != "/keys/api" || !p.InsecureSkipVerify || p.Port != 8443 {
+		t.Errorf("unexpected profile: %+v", p)
+	}
+}
+
+func TestLoadConfig_ProfileFromEnv(t *testing.T) {
+	clearConfigEnv(t)
+	path := writeFile(t, t.TempDir(), "config.toml", testTOMLConfig)
+	t.Setenv(EnvConfig, path)
+	t.Setenv(EnvProfile, "lab")
+
+	p, err := LoadConfig("", "")
+	if err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+	if p.Name != "lab" {
+		t.Errorf("Name = %q, want lab", p.Name)
+	}
+}
+
+func TestLoadConfig_EnvOverridesFile(t *testing.T) {
+	clearConfigEnv(t)
+	path := writeFile(t, t.TempDir(), "config.toml", testTOMLConfig)
+	t.Setenv(EnvHost, "override.local")
+	t.Setenv(EnvPort, "9443")
+	t.Setenv(EnvInsecureSkipVerify, "true")
+	t.Setenv(EnvSSHFingerprint, "SHA256:env")
+
+	p, err := LoadConfig(path, "prod")
+	if err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+	if p.Host != "override.local" {
+		t.Errorf("Host = %q, want override.local", p.Host)
+	}
+	if p.Port != 9443 {
+		t.Errorf("Port = %d, want 9443", p.Port)
+	}
+	if !p.InsecureSkipVerify {
+		t.Error("InsecureSkipVerify = false, want true")
+	}
+	if p.SSH.HostKeyFingerprint != "SHA256:env" {
+		t.Errorf("HostKeyFingerprint = %q, want SHA256:env", p.SSH.HostKeyFingerprint)
+	}
+	// Fields not set in the environment keep their file values.
+	if p.Username != "admin" {
+		t.Errorf("Username = %q, want admin", p.Username)
+	}
+}
+
+func TestLoadConfig_EnvOnly(t *testing.T) {
+	clearConfigEnv(t)
+	t.Setenv(EnvHost, "env.local")
+	t.Setenv(EnvAPIKey, "1-env")
+
+	p, err := LoadConfig("", "")
+	if err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+	if p.Host != "env.local" || p.APIKey != "1-env" {
+		t.Errorf("unexpected profile: %+v", p)
+	}
+}
+
+func TestLoadConfig_EnvOnlyRequestedProfile(t *testing.T) {
+	clearConfigEnv(t)
+	t.Setenv(EnvHost, "env.local")
+	t.Setenv(EnvAPIKey, "1-env")
+
+	_, err := LoadConfig("", "prod")
+	if err == nil || !strings.Contains(err.Error(), `profile "prod" not found: no config file`) {
+		t.Errorf("LoadConfig() error = %v, want profile not found", err)
+	}
+}
+
+func TestLoadConfig_DefaultConfigDir(t *testing.T) {
+	clearConfigEnv(t)
+	dir, err := DefaultConfigDir()
+	if err != nil {
+		t.Skipf("no user config dir: %v", err)
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	writeFile(t, dir, "config.yml", testYAMLConfig)
+
+	p, err := LoadConfig("", "")
+	if err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+	if p.Host != "yaml.local" {
+		t.Errorf("Host = %q, want yaml.local", p.Host)
+	}
+}
+
+func TestLoadConfig_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		content string
+		profile string
+		env     map[string]string
+		wantErr string
+	}{
+		{
+			name:    "unknown profile",
+			file:    "config.toml",
+			content: testTOMLConfig,
+			profile: "missing",
+			wantErr: `profile "missing" not found`,
+		},
+		{
+			name:    "unknown profile with host env",
+			file:    "config.toml",
+			content: testTOMLConfig,
+			profile: "missing",
+			env:     map[string]string{EnvHost: "env.local", EnvAPIKey: "1-env"},
+			wantErr: `profile "missing" not found`,
+		},
+		{
+			name:    "unknown profile env with host env",
+			file:    "config.toml",
+			content: testTOMLConfig,
+			env:     map[string]string{EnvProfile: "missing", EnvHost: "env.local", EnvAPIKey: "1-env"},
+			wantErr: `profile "missing" not found`,
+		},
+		{
+			name:    "unsupported extension",
+			file:    "config.json",
+			content: `{}`,
+			wantErr: "unsupported config format",
+		},
+		{
+			name:    "invalid toml",
+			file:    "config.toml",
+			content: `[profiles`,
+			wantErr: "parse config",
+		},
+		{
+			name:    "missing host",
+			file:    "config.yaml",
+			content: "profiles:\n  default:\n    api_key: x\n",
+			wantErr: "host is required",
+		},
+		{
+			name:    "missing api key",
+			file:    "config.yaml",
+			content: "profiles:\n  default:\n    host: nas\n",
+			wantErr: "api_key or api_key_file is required",
+		},
+		{
+			name:    "unknown transport",
+			file:    "config.yaml",
+			content: "profiles:\n  default:\n    host: nas\n    transport: telnet\n",
+			wantErr: "unknown transport",
+		},
+		{
+			name:    "ssh transport without key",
+			file:    "config.yaml",
+			content: "profiles:\n  default:\n    host: nas\n    transport: ssh\n",
+			wantErr: "private_key_file is required",
+		},
+		{
+			name:    "partial ssh fallback",
+			file:    "config.yaml",
+			content: "profiles:\n  default:\n    host: nas\n    api_key: x\n    ssh:\n      private_key_file: /k\n",
+			wantErr: "requires both private_key_file and host_key_fingerprint",
+		},
+		{
+			name:    "invalid port env",
+			file:    "config.yaml",
+			content: testYAMLConfig,
+			env:     map[string]string{EnvPort: "abc"},
+			wantErr: "TRUENAS_PORT: invalid integer",
+		},
+		{
+			name:    "invalid bool env",
+			file:    "config.yaml",
+			content: testYAMLConfig,
+			env:     map[string]string{EnvInsecureSkipVerify: "maybe"},
+			wantErr: "TRUENAS_INSECURE_SKIP_VERIFY: invalid boolean",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clearConfigEnv(t)
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+			path := writeFile(t, t.TempDir(), tt.file, tt.content)
+
+			_, err := LoadConfig(path, tt.profile)
+			if err == nil {
+				t.Fatal("LoadConfig() error = nil, want error")
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("LoadConfig() error = %v, want containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoadProfiles(t *testing.T) {
+	clearConfigEnv(t)
+	path := writeFile(t, t.TempDir(), "config.toml", testTOMLConfig)
+	t.Setenv(EnvHost, "ignored.local")
+
+	profiles, err := LoadProfiles(path)
+	if err != nil {
+		t.Fatalf("LoadProfiles() error = %v", err)
+	}
+	if len(profiles) != 2 || profiles[0].Name != "lab" || profiles[1].Name != "prod" {
+		t.Fatalf("profiles = %+v, want lab and prod", profiles)
+	}
+	if profiles[0].Host != "lab.local" || profiles[1].Host != "nas.example.com" {
+		t.Errorf("hosts = %q, %q; want the file's, not TRUENAS_HOST", profiles[0].Host, profiles[1].Host)
+	}
+	if profiles[1].Labels["env"] != "prod" || profiles[1].Labels["site"] != "ams" {
+		t.Errorf("prod labels = %v", profiles[1].Labels)
+	}
+	if profiles[1].Transport != TransportWebSocket {
+		t.Errorf("Transport = %q, want it normalized", profiles[1].Transport)
+	}
+}
+
+func TestLoadProfiles_Errors(t *testing.T) {
+	clearConfigEnv(t)
+	if _, err := LoadProfiles(""); err == nil || !strings.Contains(err.Error(), "no config file found") {
+		t.Errorf("LoadProfiles() error = %v, want no config file", err)
+	}
+
+	path := writeFile(t, t.TempDir(), "config.yaml", "profiles:\n  broken:\n    transport: ssh\n")
+	if _, err := LoadProfiles(path); err == nil || !strings.Contains(err.Error(), `profile "broken": host is required`) {
+		t.Errorf("LoadProfiles() error = %v, want invalid profile", err)
+	}
+}
+
+func TestLoadConfig_ExplicitPathMissing(t *testing.T) {
+	clearConfigEnv(t)
+	_, err := LoadConfig(filepath.Join(t.TempDir(), "nope.toml"), "")
+	if err == nil {
+		t.Fatal("LoadConfig() error = nil, want error for missing explicit file")
+	}
+}
+
+func TestProfile_SSHConfig(t *testing.T) {
+	dir := t.TempDir()
+	keyPath := writeFile(t, dir, "id", testPrivateKey+"\n")
+
+	p := &Profile{
+		Host:     "nas",
+		Username: "admin",
+		SSH: SSHProfile{
+			PrivateKeyFile:     keyPath,
+			HostKeyFingerprint: "SHA256:abc",
+			Port:               2222,
+		},
+	}
+	cfg, err := p.SSHConfig()
+	if err != nil {
+		t.Fatalf("SSHConfig() error = %v", err)
+	}
+	if cfg.PrivateKey != testPrivateKey {
+		t.Error("PrivateKey not read from file")
+	}
+	if cfg.User != "admin" {
+		t.Errorf("User = %q, want admin (falls back to Username)", cfg.User)
+	}
+	if cfg.Port != 2222 || cfg.HostKeyFingerprint != "SHA256:abc" {
+		t.Errorf("unexpected config: %+v", cfg)
+	}
+}
+
+func TestProfile_WebSocketConfig(t *testing.T) {
+	dir := t.TempDir()
+	keyPath := writeFile(t, dir, "api", "  1-from-file\n")
+
+	p := &Profile{Host: "nas", APIKeyFile: keyPath, InsecureSkipVerify: true, ConnectTimeout: Duration(5 * time.Second)}
+	cfg, err := p.WebSocketConfig()
+	if err != nil {
+		t.Fatalf("WebSocketConfig() error = %v", err)
+	}
+	if cfg.APIKey != "1-from-file" {
+		t.Errorf("APIKey = %q, want 1-from-file", cfg.APIKey)
+	}
+	if cfg.Username != "root" {
+		t.Errorf("Username = %q, want root", cfg.Username)
+	}
+	if !cfg.InsecureSkipVerify || cfg.ConnectTimeout != 5*time.Second {
+		t.Errorf("unexpected config: %+v", cfg)
+	}
+	if cfg.TLSConfig != nil {
+		t.Error("TLSConfig should be nil without ca_cert_file")
+	}
+}
+
+func TestProfile_WebSocketConfig_BadCACert(t *testing.T) {
+	caPath := writeFile(t, t.TempDir(), "ca.pem", "not a cert")
+	p := &Profile{Host: "nas", APIKey: "x", CACertFile: caPath}
+	if _, err := p.WebSocketConfig(); err == nil {
+		t.Fatal("WebSocketConfig() error = nil, want error for invalid CA file")
+	}
+}
+
+func TestProfile_WebSocketConfig_MissingKeyFile(t *testing.T) {
+	p := &Profile{Host: "nas", APIKeyFile: filepath.Join(t.TempDir(), "missing")}
+	if _, err := p.WebSocketConfig(); err == nil {
+		t.Fatal("WebSocketConfig() error = nil, want error for missing key file")
+	}
+}
+
+func TestExpandHome(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	if got := expandHome("~/keys/id"); got != filepath.Join(home, "keys/id") {
+		t.Errorf("expandHome(~/keys/id) = %q", got)
+	}
+	if got := expandHome("/abs/path"); got != "/abs/path" {
+		t.Errorf("expandHome(/abs/path) = %q", got)
+	}
+}
+
+func TestNew_NilProfile(t *testing.T) {
+	if _, err := New(context.Background(), nil); err == nil {
+		t.Fatal("New(nil) error = nil, want error")
+	}
+}
+
+func TestNew_SSHKeyFileMissing(t *testing.T) {
+	p := &Profile{
+		Host:      "nas",
+		Transport: TransportSSH,
+		SSH: SSHProfile{
+			PrivateKeyFile:     filepath.Join(t.TempDir(), "missing"),
+			HostKeyFingerprint: "SHA256:abc",
+		},
+	}
+	_, err := New(context.Background(), p)
+	if err == nil || !strings.Contains(err.Error(), "read ssh private key") {
+		t.Fatalf("New() error = %v, want ssh key read error", err)
+	}
+}
+
+func TestNew_WebSocketWithCACert(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		upgrader := websocket.Upgrader{}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		for {
+			var req JSONRPCRequest
+			if err := conn.ReadJSON(&req); err != nil {
+				return
+			}
+			result := json.RawMessage(`true`)
+			switch req.Method {
+			case "auth.login_ex":
+				if params, ok := req.Params.([]any); ok {
+					creds := params[0].(map[string]any)
+					if creds["api_key"] != "1-file-key" {
+						t.Errorf("api_key = %v, want 1-file-key", creds["api_key"])
+					}
+				}
+				result = json.RawMessage(`{"response_type":"SUCCESS"}`)
+			case "system.version":
+				result = json.RawMessage(`"TrueNAS-25.04.2.4"`)
+			}
+			_ = conn.WriteJSON(JSONRPCResponse{JSONRPC: "2.0", Result: result, ID: req.ID})
+		}
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	caPath := writeFile(t, dir, "ca.pem", string(caPEM))
+	keyPath := writeFile(t, dir, "api", "1-file-key\n")
+
+	host := strings.TrimPrefix(server.URL, "https://")
+	p := &Profile{
+		Host:           strings.Split(host, ":")[0],
+		Port:           mustParsePort(strings.Split(host, ":")[1]),
+		APIKeyFile:     keyPath,
+		CACertFile:     caPath,
+		ConnectTimeout: Duration(5 * time.Second),
+	}
+
+	c, err := New(context.Background(), p)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer c.Close()
+
+	if _, ok := c.(*WebSocketClient); !ok {
+		t.Fatalf("New() returned %T, want *WebSocketClient", c)
+	}
+	if v := c.Version(); v.Major != 25 || v.Minor != 4 {
+		t.Errorf("Version() = %v, want 25.04", v)
+	}
+}
+
+func TestNew_WebSocketWiresSSHFallback(t *testing.T) {
+	dir := t.TempDir()
+	keyPath := writeFile(t, dir, "id", testPrivateKey)
+
+	p := &Profile{
+		Host:   "127.0.0.1",
+		Port:   1,
+		APIKey: "x",
+		SSH: SSHProfile{
+			Port:               1,
+			PrivateKeyFile:     keyPath,
+			HostKeyFingerprint: "SHA256:abc",
+		},
+		ConnectTimeout: Duration(time.Second),
+	}
+
+	// The SSH fallback connects first, so the error comes from the SSH dial.
+	_, err := New(context.Background(), p)
+	if err == nil {
+		t.Fatal("New() error = nil, want connection error")
+	}
+	if !strings.Contains(err.Error(), "Cannot connect to 127.0.0.1:1") {
+		t.Errorf("New() error = %v, want SSH connection error", err)
+	}
+}
@@ -0,0 +1,494 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"slices"
+	"strings"
+	"sync"
+
+	truenas "github.com/deevus/truenas-go"
+	"github.com/deevus/truenas-go/api"
+)
+
+// DryRunCall is a mutating call intercepted by a DryRunClient.
+type DryRunCall struct {
+	Method string // API method, or the Client method for file operations (e.g. "WriteFile")
+	Params any
+	Job    bool // made with CallAndWait
+}
+
+// DryRunClient wraps a Client so scripts can be previewed without changing
+// anything. Read-only methods (see IsReadOnlyMethod) go to the underlying
+// client; every other call, including jobs and file writes, is recorded and
+// answered with a synthesized response.
+//
+// Objects created during the dry run are remembered so that services reading
+// them back, like DatasetService.CreateDataset, find a placeholder holding
+// only the object's id and name. Objects deleted during the dry run are
+// hidden from later queries. Jobs started without waiting get a synthetic
+// job ID, which core.job_wait and core.get_jobs report as succeeded.
+type DryRunClient struct {
+	client  Client
+	mu      sync.Mutex
+	calls   []DryRunCall
+	allowed map[string]bool
+	created map[string][]map[string]any // namespace → placeholders
+	deleted map[string]map[string]bool  // namespace → JSON-encoded ids
+	jobs    map[int64]string            // synthetic job id → method
+	nextID  int64
+}
+
+// Compile-time check that DryRunClient implements Client.
+var _ Client = (*DryRunClient)(nil)
+
+// NewDryRunClient wraps client in dry-run mode.
+func NewDryRunClient(client Client) *DryRunClient {
+	return &DryRunClient{
+		client:  client,
+		allowed: make(map[string]bool),
+		created: make(map[string][]map[string]any),
+		deleted: make(map[string]map[string]bool),
+		jobs:    make(map[int64]string),
+	}
+}
+
+// readOnlySuffixes are the method name tails that never change state.
+var readOnlySuffixes = map[string]bool{
+	"query":           true,
+	"get_instance":    true,
+	"config":          true,
+	"choices":         true,
+	"info":            true,
+	"version":         true,
+	"ping":            true,
+	"stat":            true,
+	"listdir":         true,
+	"upgrade_summary": true,
+	"available_space": true,
+	"used_ports":      true,
+}
+
+// IsReadOnlyMethod reports whether method only reads state: *.query,
+// *.get_instance, *.config, *.*_choices, *.get_* and a few known readers
+// such as system.info. Unknown methods are assumed to mutate.
+func IsReadOnlyMethod(method string) bool {
+	tail := method[strings.LastIndex(method, ".")+1:]
+	return readOnlySuffixes[tail] || strings.HasPrefix(tail, "get_") || strings.HasSuffix(tail, "_choices")
+}
+
+// Allow passes additional read-only methods through to the underlying client.
+func (d *DryRunClient) Allow(methods ...string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, m := range methods {
+		d.allowed[m] = true
+	}
+}
+
+// Calls returns the intercepted calls in order.
+func (d *DryRunClient) Calls() []DryRunCall {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	out := make([]DryRunCall, len(d.calls))
+	copy(out, d.calls)
+	return out
+}
+
+// Reset forgets the intercepted calls and the objects they created or deleted.
+func (d *DryRunClient) Reset() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.calls = nil
+	d.created = make(map[string][]map[string]any)
+	d.deleted = make(map[string]map[string]bool)
+	d.jobs = make(map[int64]string)
+}
+
+// Connect delegates to the underlying client.
+func (d *DryRunClient) Connect(ctx context.Context) error {
+	return d.client.Connect(ctx)
+}
+
+// Version delegates to the underlying client.
+func (d *DryRunClient) Version() truenas.Version {
+	return d.client.Version()
+}
+
+// Call passes read-only methods through and intercepts the rest.
+func (d *DryRunClient) Call(ctx context.Context, method string, params any) (json.RawMessage, error) {
+	if d.waitsForSyntheticJob(method, params) {
+		return json.RawMessage("null"), nil
+	}
+	if d.passThrough(method) {
+		result, err := d.client.Call(ctx, method, params)
+		if err != nil {
+			return d.readCreated(method, params, err)
+		}
+		if method == "core.get_jobs" {
+			return d.mergeJobs(params, result)
+		}
+		return d.mergeQuery(method, params, result)
+	}
+	return d.intercept(method, params, false)
+}
+
+// CallAndWait passes read-only methods through and intercepts the rest.
+func (d *DryRunClient) CallAndWait(ctx context.Context, method string, params any) (json.RawMessage, error) {
+	if d.waitsForSyntheticJob(method, params) {
+		return json.RawMessage("null"), nil
+	}
+	if d.passThrough(method) {
+		return d.client.CallAndWait(ctx, method, params)
+	}
+	return d.intercept(method, params, true)
+}
+
+// Subscribe delegates to the underlying client if it supports subscriptions.
+func (d *DryRunClient) Subscribe(ctx context.Context, collection string, params any) (*truenas.Subscription[json.RawMessage], error) {
+	return d.client.Subscribe(ctx, collection, params)
+}
+
+// SubscribeEvents delegates to the underlying client.
+func (d *DryRunClient) SubscribeEvents(ctx context.Context, collection string, params any) (*truenas.Subscription[json.RawMessage], error) {
+	return subscribeEvents(ctx, d.client, collection, params)
+}
+
+// ReadFile delegates to the underlying client.
+func (d *DryRunClient) ReadFile(ctx context.Context, path string) ([]byte, error) {
+	return d.client.ReadFile(ctx, path)
+}
+
+// FileExists delegates to the underlying client.
+func (d *DryRunClient) FileExists(ctx context.Context, path string) (bool, error) {
+	return d.client.FileExists(ctx, path)
+}
+
+// WriteFile records the write without performing it.
+func (d *DryRunClient) WriteFile(ctx context.Context, path string, params truenas.WriteFileParams) error {
+	d.record(DryRunCall{Method: "WriteFile", Params: []any{path, params}})
+	return nil
+}
+
+// DeleteFile records the deletion without performing it.
+func (d *DryRunClient) DeleteFile(ctx context.Context, path string) error {
+	d.record(DryRunCall{Method: "DeleteFile", Params: path})
+	return nil
+}
+
+// RemoveDir records the removal without performing it.
+func (d *DryRunClient) RemoveDir(ctx context.Context, path string) error {
+	d.record(DryRunCall{Method: "RemoveDir", Params: path})
+	return nil
+}
+
+// RemoveAll records the removal without performing it.
+func (d *DryRunClient) RemoveAll(ctx context.Context, path string) error {
+	d.record(DryRunCall{Method: "RemoveAll", Params: path})
+	return nil
+}
+
+// Chown records the ownership change without performing it.
+func (d *DryRunClient) Chown(ctx context.Context, path string, uid, gid int) error {
+	d.record(DryRunCall{Method: "Chown", Params: []any{path, uid, gid}})
+	return nil
+}
+
+// ChmodRecursive records the mode change without performing it.
+func (d *DryRunClient) ChmodRecursive(ctx context.Context, path string, mode fs.FileMode) error {
+	d.record(DryRunCall{Method: "ChmodRecursive", Params: []any{path, mode}})
+	return nil
+}
+
+// MkdirAll records the directory creation without performing it.
+func (d *DryRunClient) MkdirAll(ctx context.Context, path string, mode fs.FileMode) error {
+	d.record(DryRunCall{Method: "MkdirAll", Params: []any{path, mode}})
+	return nil
+}
+
+// Close closes the underlying client.
+func (d *DryRunClient) Close() error {
+	return d.client.Close()
+}
+
+func (d *DryRunClient) passThrough(method string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.allowed[method] || IsReadOnlyMethod(method)
+}
+
+func (d *DryRunClient) record(call DryRunCall) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.calls = append(d.calls, call)
+}
+
+// intercept records a mutating call and synthesizes its response: a job ID
+// for job methods called without waiting, a placeholder object for create,
+// {"id": id} for update, true for delete and null otherwise.
+func (d *DryRunClient) intercept(method string, params any, job bool) (json.RawMessage, error) {
+	startsJob := !job && d.isJobMethod(method)
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.calls = append(d.calls, DryRunCall{Method: method, Params: params, Job: job})
+
+	ns, tail := splitMethod(method)
+	var resp any
+	switch {
+	case startsJob:
+		d.nextID--
+		d.jobs[d.nextID] = method
+		resp = d.nextID
+	case tail == "create":
+		obj := d.placeholder(ns, params)
+		d.created[ns] = append(d.created[ns], obj)
+		resp = obj
+	case tail == "update":
+		resp = map[string]any{"id": firstParam(params)}
+	case tail == "delete":
+		if d.deleted[ns] == nil {
+			d.deleted[ns] = make(map[string]bool)
+		}
+		d.deleted[ns][idKey(firstParam(params))] = true
+		resp = true
+	}
+	return json.Marshal(resp)
+}
+
+// isJobMethod reports whether the catalog nearest the connected version
+// marks method as a job. Unknown versions use the latest catalog.
+func (d *DryRunClient) isJobMethod(method string) bool {
+	v := d.client.Version()
+	version := api.NearestVersion(fmt.Sprintf("%d.%d", v.Major, v.Minor))
+	if version == "" {
+		version = api.LatestVersion()
+	}
+	def, err := api.Method(version, method)
+	return err == nil && def.Job
+}
+
+// waitsForSyntheticJob reports whether method is core.job_wait for a job
+// started during the dry run. Such jobs succeed at once with a null result.
+func (d *DryRunClient) waitsForSyntheticJob(method string, params any) bool {
+	if method != "core.job_wait" {
+		return false
+	}
+	id, ok := jobID(firstParam(params))
+	if !ok {
+		return false
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	_, synthetic := d.jobs[id]
+	return synthetic
+}
+
+// mergeJobs adds the synthetic jobs matching a core.get_jobs filter to its
+// result, all in the SUCCESS state.
+func (d *DryRunClient) mergeJobs(params any, result json.RawMessage) (json.RawMessage, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if len(d.jobs) == 0 {
+		return result, nil
+	}
+
+	var items []map[string]any
+	if err := json.Unmarshal(result, &items); err != nil {
+		return result, nil
+	}
+	ids := make([]int64, 0, len(d.jobs))
+	for id := range d.jobs {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	slices.Reverse(ids) // in the order they were started
+	filters := queryFilters(params)
+	for _, id := range ids {
+		job := map[string]any{
+			"id":        id,
+			"method":    d.jobs[id],
+			"abortable": false,
+			"progress":  map[string]any{"percent": 100},
+			"state":     string(truenas.JobStateSuccess),
+			"result":    nil,
+		}
+		if matchesFilters(job, filters) {
+			items = append(items, job)
+		}
+	}
+	return json.Marshal(items)
+}
+
+// jobID converts a job ID param, which may have been decoded from JSON.
+func jobID(v any) (int64, bool) {
+	switch id := v.(type) {
+	case int64:
+		return id, true
+	case int:
+		return int64(id), true
+	case float64:
+		return int64(id), true
+	}
+	return 0, false
+}
+
+// placeholder builds the id and name of an object created by params. Named
+// objects use their name as id, as datasets and apps do; snapshots use
+// dataset@name; everything else gets a negative id.
+func (d *DryRunClient) placeholder(ns string, params any) map[string]any {
+	fields, _ := firstParam(params).(map[string]any)
+	if fields == nil {
+		fields = toMap(firstParam(params))
+	}
+	name, _ := fields["name"].(string)
+	if name == "" {
+		name, _ = fields["app_name"].(string)
+	}
+
+	obj := make(map[string]any)
+	switch {
+	case strings.HasSuffix(ns, "snapshot") && fields["dataset"] != nil && name != "":
+		obj["id"] = fmt.Sprintf("%v@%s", fields["dataset"], name)
+	case name != "":
+		obj["id"] = name
+	default:
+		d.nextID--
+		obj["id"] = d.nextID
+	}
+	if name != "" {
+		obj["name"] = name
+	}
+	return obj
+}
+
+// mergeQuery adds placeholders matching a query's "=" filters to its result
+// and drops objects deleted during the dry run.
+func (d *DryRunClient) mergeQuery(method string, params any, result json.RawMessage) (json.RawMessage, error) {
+	ns, tail := splitMethod(method)
+	if tail != "query" {
+		return result, nil
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if len(d.created[ns]) == 0 && len(d.deleted[ns]) == 0 {
+		return result, nil
+	}
+
+	var items []map[string]any
+	if err := json.Unmarshal(result, &items); err != nil {
+		return result, nil // not a list, e.g. a query with the get option
+	}
+	out := make([]map[string]any, 0, len(items))
+	for _, item := range items {
+		if !d.deleted[ns][idKey(item["id"])] {
+			out = append(out, item)
+		}
+	}
+	filters := queryFilters(params)
+	for _, obj := range d.created[ns] {
+		if matchesFilters(obj, filters) && !d.deleted[ns][idKey(obj["id"])] {
+			out = append(out, obj)
+		}
+	}
+	return json.Marshal(out)
+}
+
+// readCreated answers get_instance for placeholders, which the underlying
+// client cannot find.
+func (d *DryRunClient) readCreated(method string, params any, err error) (json.RawMessage, error) {
+	ns, tail := splitMethod(method)
+	if tail != "get_instance" {
+		return nil, err
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	id := idKey(firstParam(params))
+	for _, obj := range d.created[ns] {
+		if idKey(obj["id"]) == id {
+			return json.Marshal(obj)
+		}
+	}
+	return nil, err
+}
+
+func splitMethod(method string) (namespace, tail string) {
+	i := strings.LastIndex(method, ".")
+	if i < 0 {
+		return "", method
+	}
+	return method[:i], method[i+1:]
+}
+
+// firstParam returns the first positional param, or params itself.
+func firstParam(params any) any {
+	if args, ok := params.([]any); ok {
+		if len(args) == 0 {
+			return nil
+		}
+		return args[0]
+	}
+	return params
+}
+
+// toMap converts a params struct to its JSON object form.
+func toMap(v any) map[string]any {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	var m map[string]any
+	_ = json.Unmarshal(data, &m)
+	return m
+}
+
+// idKey normalizes ids for comparison, e.g. int64(5) and float64(5).
+func idKey(id any) string {
+	data, _ := json.Marshal(id)
+	return string(data)
+}
+
+// queryFilters extracts the filter list from query params: either the
+// filters themselves or []any{filters, options}.
+func queryFilters(params any) []any {
+	args, _ := toAny(params).([]any)
+	if len(args) > 0 {
+		if first, ok := args[0].([]any); ok && (len(first) == 0 || isList(first[0])) {
+			return first
+		}
+	}
+	return args
+}
+
+func isList(v any) bool {
+	_, ok := v.([]any)
+	return ok
+}
+
+func toAny(v any) any {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	var out any
+	_ = json.Unmarshal(data, &out)
+	return out
+}
+
+// matchesFilters reports whether obj satisfies every [field, "=", value]
+// filter. Placeholders only have id and name, so other filters never match.
+func matchesFilters(obj map[string]any, filters []any) bool {
+	for _, f := range filters {
+		cond, ok := f.([]any)
+		if !ok || len(cond) != 3 || cond[1] != "=" {
+			return false
+		}
+		field, _ := cond[0].(string)
+		v, ok := obj[field]
+		if !ok || idKey(v) != idKey(cond[2]) {
+			return false
+		}
+	}
+	return true
+}
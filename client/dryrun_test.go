@@ -0,0 +1,305 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	truenas "github.com/deevus/truenas-go"
+)
+
+func TestIsReadOnlyMethod(t *testing.T) {
+	tests := map[string]bool{
+		"pool.dataset.query":               true,
+		"cronjob.get_instance":             true,
+		"smb.config":                       true,
+		"system.info":                      true,
+		"app.upgrade_summary":              true,
+		"pool.dataset.compression_choices": true,
+		"vm.get_display_devices":           true,
+		"pool.dataset.create":              false,
+		"app.update":                       false,
+		"cronjob.run":                      false,
+		"service.restart":                  false,
+		"unknown":                          false,
+	}
+	for method, want := range tests {
+		if got := IsReadOnlyMethod(method); got != want {
+			t.Errorf("IsReadOnlyMethod(%q) = %v, want %v", method, got, want)
+		}
+	}
+}
+
+// newDryRunSystem returns a dry-run client over a system that holds one
+// dataset, tank/data, and no apps, cron jobs or running jobs.
+func newDryRunSystem(t *testing.T) (*DryRunClient, *[]string) {
+	t.Helper()
+	var sent []string
+	mock := &MockClient{
+		VersionVal: truenas.Version{Major: 25, Minor: 4},
+		CallFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			sent = append(sent, method)
+			switch method {
+			case "pool.dataset.query":
+				if params != nil && !matchesFilters(map[string]any{"id": "tank/data"}, queryFilters(params)) {
+					return json.RawMessage(`[]`), nil
+				}
+				return json.RawMessage(`[{"id": "tank/data", "name": "tank/data", "type": "FILESYSTEM"}]`), nil
+			case "app.query", "core.get_jobs":
+				return json.RawMessage(`[]`), nil
+			case "cronjob.get_instance":
+				return nil, errors.New("[ENOENT] None: cronjob not found")
+			}
+			t.Errorf("unexpected call %s reached the system", method)
+			return nil, nil
+		},
+		CallAndWaitFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			t.Errorf("unexpected job %s reached the system", method)
+			return nil, nil
+		},
+		WriteFileFunc: func(ctx context.Context, path string, params truenas.WriteFileParams) error {
+			t.Errorf("unexpected write to %s", path)
+			return nil
+		},
+	}
+	return NewDryRunClient(mock), &sent
+}
+
+func TestDryRunClient_Services(t *testing.T) {
+	d, sent := newDryRunSystem(t)
+	ctx := context.Background()
+	v := d.Version()
+
+	datasets := truenas.NewDatasetService(d, v)
+	ds, err := datasets.CreateDataset(ctx, truenas.CreateDatasetOpts{Name: "tank/new", Compression: "LZ4"})
+	if err != nil {
+		t.Fatalf("CreateDataset: %v", err)
+	}
+	if ds == nil || ds.ID != "tank/new" {
+		t.Errorf("expected placeholder dataset, got %+v", ds)
+	}
+
+	apps := truenas.NewAppService(d, v)
+	app, err := apps.CreateApp(ctx, truenas.CreateAppOpts{Name: "web", CustomApp: true, CustomComposeConfig: "services: {}"})
+	if err != nil {
+		t.Fatalf("CreateApp: %v", err)
+	}
+	if app == nil || app.Name != "web" {
+		t.Errorf("expected placeholder app, got %+v", app)
+	}
+
+	cron := truenas.NewCronService(d, v)
+	job, err := cron.Create(ctx, truenas.CreateCronJobOpts{Command: "true", Description: "noop"})
+	if err != nil {
+		t.Fatalf("cron Create: %v", err)
+	}
+	if job == nil || job.ID >= 0 {
+		t.Errorf("expected placeholder job with a synthetic id, got %+v", job)
+	}
+
+	if err := datasets.DeleteDataset(ctx, "tank/data", false); err != nil {
+		t.Fatalf("DeleteDataset: %v", err)
+	}
+	list, err := datasets.ListDatasets(ctx)
+	if err != nil {
+		t.Fatalf("ListDatasets: %v", err)
+	}
+	if len(list) != 0 {
+		// The deleted dataset is hidden; the placeholder has no type, so it
+		// is not listed as a filesystem.
+		t.Errorf("expected deleted dataset hidden, got %+v", list)
+	}
+
+	calls := d.Calls()
+	var methods []string
+	for _, c := range calls {
+		methods = append(methods, c.Method)
+	}
+	wantMethods := []string{"pool.dataset.create", "app.create", "cronjob.create", "pool.dataset.delete"}
+	if !reflect.DeepEqual(methods, wantMethods) {
+		t.Errorf("intercepted %q, want %q", methods, wantMethods)
+	}
+	if !calls[1].Job || calls[0].Job {
+		t.Errorf("expected only app.create to be a job, got %+v", calls)
+	}
+	for _, m := range *sent {
+		if !IsReadOnlyMethod(m) {
+			t.Errorf("mutating call %s reached the system", m)
+		}
+	}
+}
+
+func TestDryRunClient_Responses(t *testing.T) {
+	d := NewDryRunClient(&MockClient{})
+	ctx := context.Background()
+
+	tests := []struct {
+		method string
+		params any
+		wait   bool
+		want   string
+	}{
+		{"zfs.snapshot.create", map[string]any{"dataset": "tank/a", "name": "s1"}, false, `{"id":"tank/a@s1","name":"s1"}`},
+		{"app.create", map[string]any{"app_name": "web"}, true, `{"id":"web","name":"web"}`},
+		{"cronjob.create", map[string]any{"command": "x"}, false, `{"id":-1}`},
+		{"iscsi.target.create", map[string]any{"alias": "x"}, false, `{"id":-2}`},
+		{"cronjob.update", []any{int64(3), map[string]any{"command": "y"}}, false, `{"id":3}`},
+		{"cronjob.delete", int64(3), false, `true`},
+		{"service.restart", []any{"nfs"}, false, `null`},
+		{"pool.scrub.scrub", []any{"tank", "START"}, false, `-3`}, // job started without waiting
+	}
+	for _, tt := range tests {
+		call := d.Call
+		if tt.wait {
+			call = d.CallAndWait
+		}
+		got, err := call(ctx, tt.method, tt.params)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.method, err)
+		}
+		if string(got) != tt.want {
+			t.Errorf("%s: got %s, want %s", tt.method, got, tt.want)
+		}
+	}
+	if n := len(d.Calls()); n != len(tests) {
+		t.Errorf("expected %d recorded calls, got %d", len(tests), n)
+	}
+
+	d.Reset()
+	if len(d.Calls()) != 0 {
+		t.Error("expected Reset to clear calls")
+	}
+}
+
+func TestDryRunClient_Jobs(t *testing.T) {
+	d, sent := newDryRunSystem(t)
+	ctx := context.Background()
+	v := d.Version()
+
+	job, err := truenas.NewScrubService(d, v).Start(ctx, "tank")
+	if err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	if job.ID >= 0 {
+		t.Errorf("expected a synthetic job id, got %d", job.ID)
+	}
+	if result, err := job.Wait(ctx); err != nil || string(result) != "null" {
+		t.Errorf("Wait = %s, %v; want null, nil", result, err)
+	}
+	info, err := job.Status(ctx)
+	if err != nil {
+		t.Fatalf("Status: %v", err)
+	}
+	if info == nil || info.ID != job.ID || info.Method != "pool.scrub.scrub" || info.State != truenas.JobStateSuccess || !info.Done() {
+		t.Errorf("expected succeeded scrub job, got %+v", info)
+	}
+
+	run, err := truenas.NewReplicationService(d, v).Run(ctx, 3)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if run.ID == job.ID {
+		t.Errorf("expected distinct job ids, got %d twice", run.ID)
+	}
+	if info, err := run.Status(ctx); err != nil || info == nil || info.Method != "replication.run" {
+		t.Errorf("Status = %+v, %v; want replication.run", info, err)
+	}
+
+	var methods []string
+	for _, c := range d.Calls() {
+		methods = append(methods, c.Method)
+	}
+	if want := []string{"pool.scrub.scrub", "replication.run"}; !reflect.DeepEqual(methods, want) {
+		t.Errorf("intercepted %q, want %q", methods, want)
+	}
+	for _, m := range *sent {
+		if m != "core.get_jobs" {
+			t.Errorf("unexpected call %s reached the system", m)
+		}
+	}
+
+	d.Reset()
+	if info, err := job.Status(ctx); err != nil || info != nil {
+		t.Errorf("expected Reset to forget jobs, got %+v, %v", info, err)
+	}
+}
+
+func TestDryRunClient_QueryFilters(t *testing.T) {
+	d := NewDryRunClient(&MockClient{
+		CallFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return json.RawMessage(`[]`), nil
+		},
+	})
+	ctx := context.Background()
+	if _, err := d.CallAndWait(ctx, "app.create", map[string]any{"app_name": "web"}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		params any
+		want   int
+	}{
+		{nil, 1},
+		{[][]any{{"name", "=", "web"}}, 1},
+		{[][]any{{"name", "=", "other"}}, 0},
+		{[]any{[][]any{{"name", "=", "web"}}, map[string]any{"extra": map[string]any{}}}, 1},
+		{[]any{[]any{}, map[string]any{}}, 1},
+		{[][]any{{"state", "=", "RUNNING"}}, 0},
+		{[][]any{{"name", "^", "w"}}, 0},
+	}
+	for _, tt := range tests {
+		got, err := d.Call(ctx, "app.query", tt.params)
+		if err != nil {
+			t.Fatalf("%v: unexpected error: %v", tt.params, err)
+		}
+		var items []map[string]any
+		if err := json.Unmarshal(got, &items); err != nil {
+			t.Fatalf("%v: invalid result %s", tt.params, got)
+		}
+		if len(items) != tt.want {
+			t.Errorf("%v: got %d items, want %d", tt.params, len(items), tt.want)
+		}
+	}
+}
+
+func TestDryRunClient_Allow(t *testing.T) {
+	var sent []string
+	d := NewDryRunClient(&MockClient{
+		CallFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			sent = append(sent, method)
+			return json.RawMessage(`"ok"`), nil
+		},
+	})
+	d.Allow("docker.status")
+	if _, err := d.Call(context.Background(), "docker.status", nil); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(sent, []string{"docker.status"}) || len(d.Calls()) != 0 {
+		t.Errorf("expected allowed method passed through, sent %q, intercepted %+v", sent, d.Calls())
+	}
+}
+
+func TestDryRunClient_FileOperations(t *testing.T) {
+	d := NewDryRunClient(&MockClient{
+		ReadFileFunc: func(ctx context.Context, path string) ([]byte, error) {
+			return []byte("data"), nil
+		},
+	})
+	ctx := context.Background()
+	if data, err := d.ReadFile(ctx, "/mnt/tank/a"); err != nil || string(data) != "data" {
+		t.Errorf("expected ReadFile passed through, got %q, %v", data, err)
+	}
+	_ = d.WriteFile(ctx, "/mnt/tank/a", truenas.WriteFileParams{Content: []byte("x")})
+	_ = d.MkdirAll(ctx, "/mnt/tank/dir", 0o755)
+	_ = d.RemoveAll(ctx, "/mnt/tank/old")
+
+	var got []string
+	for _, c := range d.Calls() {
+		got = append(got, c.Method)
+	}
+	if want := []string{"WriteFile", "MkdirAll", "RemoveAll"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("recorded %q, want %q", got, want)
+	}
+}
@@ -1,6 +1,9 @@
 package client
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"errors"
+)
 
 // JSONRPCRequest represents a JSON-RPC 2.0 request.
 type JSONRPCRequest struct {
@@ -44,4 +47,13 @@ func (e *JSONRPCError) Error() string {
 const (
 	ErrCodeTooManyConcurrent = -32000 // TOO_MANY_CONCURRENT_CALLS
 	ErrCodeTrueNASCall       = -32001 // TRUENAS_CALL_ERROR
+	ErrCodeInvalidRequest    = -32600 // INVALID_REQUEST
+	ErrCodeMethodNotFound    = -32601 // METHOD_NOT_FOUND
 )
+
+// isMethodNotFound reports whether err is a JSON-RPC METHOD_NOT_FOUND error,
+// i.e. the connected middleware does not provide the requested method.
+func isMethodNotFound(err error) bool {
+	var rpcErr *JSONRPCError
+	return errors.As(err, &rpcErr) && rpcErr.Code == ErrCodeMethodNotFound
+}
@@ -2,6 +2,8 @@ package client
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"testing"
 )
 
@@ -234,4 +236,28 @@ func TestJSONRPCErrorCodes(t *testing.T) {
 	if ErrCodeTrueNASCall != -32001 {
 		t.Errorf("ErrCodeTrueNASCall = %d, want -32001", ErrCodeTrueNASCall)
 	}
+	if ErrCodeMethodNotFound != -32601 {
+		t.Errorf("ErrCodeMethodNotFound = %d, want -32601", ErrCodeMethodNotFound)
+	}
+}
+
+func TestIsMethodNotFound(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"method not found", &JSONRPCError{Code: ErrCodeMethodNotFound}, true},
+		{"wrapped", fmt.Errorf("call: %w", &JSONRPCError{Code: ErrCodeMethodNotFound}), true},
+		{"other rpc error", &JSONRPCError{Code: ErrCodeTrueNASCall}, false},
+		{"plain error", errors.New("boom"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isMethodNotFound(tt.err); got != tt.want {
+				t.Errorf("isMethodNotFound() = %v, want %v", got, tt.want)
+			}
+		})
+	}
 }
@@ -0,0 +1,53 @@
+package client
+
+import "context"
+
+// JobProgress is a progress update for a running job.
+type JobProgress struct {
+	JobID       int64
+	Method      string // API method that started the job, if known
+	State       string // RUNNING or WAITING
+	Percent     float64
+	Description string
+}
+
+// JobProgressFunc receives progress updates while CallAndWait waits for a job.
+type JobProgressFunc func(JobProgress)
+
+type jobProgressKey struct{}
+
+// WithJobProgress returns a context that reports job progress to fn for every
+// CallAndWait made with it, including calls made inside service methods.
+//
+// Progress is reported by the WebSocket client and by the SSH client when it
+// polls for job completion (TrueNAS 24.x). On 25.x the SSH client waits with
+// midclt -j, which does not expose progress.
+func WithJobProgress(ctx context.Context, fn JobProgressFunc) context.Context {
+	return context.WithValue(ctx, jobProgressKey{}, fn)
+}
+
+// ReportJobProgress calls the JobProgressFunc attached to ctx, if any.
+// Client implementations outside this package, such as test doubles, use
+// it to report the progress of jobs they wait for.
+func ReportJobProgress(ctx context.Context, p JobProgress) {
+	if fn, ok := ctx.Value(jobProgressKey{}).(JobProgressFunc); ok && fn != nil {
+		fn(p)
+	}
+}
+
+// jobProgressWire is the progress object of a core.get_jobs entry.
+type jobProgressWire struct {
+	Percent     *float64 `json:"percent"`
+	Description *string  `json:"description"`
+}
+
+func (w *jobProgressWire) toProgress(jobID int64, method, state string) *JobProgress {
+	p := &JobProgress{JobID: jobID, Method: method, State: state}
+	if w.Percent != nil {
+		p.Percent = *w.Percent
+	}
+	if w.Description != nil {
+		p.Description = *w.Description
+	}
+	return p
+}
@@ -0,0 +1,112 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	truenas "github.com/deevus/truenas-go"
+)
+
+func TestWithJobProgress(t *testing.T) {
+	var got []JobProgress
+	ctx := WithJobProgress(context.Background(), func(p JobProgress) {
+		got = append(got, p)
+	})
+
+	ReportJobProgress(ctx, JobProgress{JobID: 1, Percent: 50})
+	ReportJobProgress(context.Background(), JobProgress{JobID: 2}) // no func attached
+
+	if len(got) != 1 || got[0].JobID != 1 || got[0].Percent != 50 {
+		t.Errorf("unexpected progress: %+v", got)
+	}
+}
+
+func TestJobProgressWire_ToProgress(t *testing.T) {
+	var w jobProgressWire
+	if err := json.Unmarshal([]byte(`{"percent": 42.5, "description": "Pulling images", "extra": null}`), &w); err != nil {
+		t.Fatal(err)
+	}
+	p := w.toProgress(7, "app.create", "RUNNING")
+	want := JobProgress{JobID: 7, Method: "app.create", State: "RUNNING", Percent: 42.5, Description: "Pulling images"}
+	if *p != want {
+		t.Errorf("toProgress = %+v, want %+v", *p, want)
+	}
+
+	// Null percent/description (e.g. a job that has not reported yet).
+	var empty jobProgressWire
+	if err := json.Unmarshal([]byte(`{"percent": null, "description": null}`), &empty); err != nil {
+		t.Fatal(err)
+	}
+	if p := empty.toProgress(7, "", "WAITING"); p.Percent != 0 || p.Description != "" {
+		t.Errorf("expected zero progress, got %+v", *p)
+	}
+}
+
+func TestWebSocketClient_HandleJobEvent_Progress(t *testing.T) {
+	c := &WebSocketClient{}
+	ch := make(chan JobEvent, 1)
+	jobSubs := map[int64]chan<- JobEvent{123: ch}
+
+	msg := JSONRPCResponse{Result: json.RawMessage(`{
+		"msg": "method",
+		"method": "collection_update",
+		"params": {
+			"msg": "changed",
+			"collection": "core.get_jobs",
+			"id": 123,
+			"fields": {"method": "app.create", "state": "RUNNING", "progress": {"percent": 30, "description": "Creating"}}
+		}
+	}`)}
+	c.handleJobEvent(msg, jobSubs, &jobEventBuffer{})
+
+	event := <-ch
+	if event.State != "RUNNING" || event.Progress == nil {
+		t.Fatalf("expected RUNNING event with progress, got %+v", event)
+	}
+	want := JobProgress{JobID: 123, Method: "app.create", State: "RUNNING", Percent: 30, Description: "Creating"}
+	if *event.Progress != want {
+		t.Errorf("Progress = %+v, want %+v", *event.Progress, want)
+	}
+}
+
+func TestSSHClient_CallAndWait_V24_ReportsProgress(t *testing.T) {
+	client, _ := NewSSHClient(&SSHConfig{
+		Host:               "truenas.local",
+		PrivateKey:         testPrivateKey,
+		HostKeyFingerprint: testHostKeyFingerprint,
+	})
+	client.version = truenas.Version{Major: 24, Minor: 10, Raw: "TrueNAS-24.10"}
+	client.connected = true
+
+	responses := []string{
+		"12345",
+		`{"id":12345,"method":"app.create","state":"RUNNING","progress":{"percent":60,"description":"Deploying"},"result":null,"error":null}`,
+		`{"id":12345,"state":"SUCCESS","result":null,"error":null}`,
+	}
+	callCount := 0
+	client.clientWrapper = &mockSSHClient{
+		newSessionFunc: func() (sshSession, error) {
+			return &mockSession{
+				combinedOutputFunc: func(cmd string) ([]byte, error) {
+					resp := responses[callCount]
+					callCount++
+					return []byte(resp), nil
+				},
+			}, nil
+		},
+	}
+
+	var got []JobProgress
+	ctx := WithJobProgress(context.Background(), func(p JobProgress) {
+		got = append(got, p)
+	})
+	if _, err := client.CallAndWait(ctx, "app.create", map[string]string{"name": "test"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := JobProgress{JobID: 12345, Method: "app.create", State: "RUNNING", Percent: 60, Description: "Deploying"}
+	if len(got) != 1 || got[0] != want {
+		t.Errorf("progress = %+v, want [%+v]", got, want)
+	}
+}
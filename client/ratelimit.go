@@ -215,6 +215,11 @@ func (r *RateLimitedClient) Subscribe(ctx context.Context, collection string, pa
 	return r.client.Subscribe(ctx, collection, params)
 }
 
+// SubscribeEvents delegates to the underlying client.
+func (r *RateLimitedClient) SubscribeEvents(ctx context.Context, collection string, params any) (*truenas.Subscription[json.RawMessage], error) {
+	return subscribeEvents(ctx, r.client, collection, params)
+}
+
 // Close closes the underlying client.
 func (r *RateLimitedClient) Close() error {
 	return r.client.Close()
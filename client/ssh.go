@@ -167,6 +167,16 @@ func NewSSHClient(config *SSHConfig, opts ...SSHOption) (*SSHClient, error) {
 	return c, nil
 }
 
+// Batch returns an empty batch whose calls run concurrently, at most
+// MaxSessions at a time.
+func (c *SSHClient) Batch() *Batch {
+	return NewBatch(c)
+}
+
+func (c *SSHClient) runBatch(ctx context.Context, calls []batchCall) []BatchResult {
+	return runConcurrently(ctx, c, calls, cap(c.sessionSem))
+}
+
 // acquireSession blocks until a session slot is available and returns a release function.
 func (c *SSHClient) acquireSession() func() {
 	c.sessionSem <- struct{}{}
@@ -397,11 +407,13 @@ func (c *SSHClient) callAndWaitWithPolling(ctx context.Context, method string, p
 
 // jobStatus represents a job from core.get_jobs.
 type jobStatus struct {
-	ID        int64           `json:"id"`
-	State     string          `json:"state"`
-	Result    json.RawMessage `json:"result"`
-	Error     *string         `json:"error"`
-	Exception *string         `json:"exception"`
+	ID        int64            `json:"id"`
+	Method    string           `json:"method"`
+	State     string           `json:"state"`
+	Progress  *jobProgressWire `json:"progress"`
+	Result    json.RawMessage  `json:"result"`
+	Error     *string          `json:"error"`
+	Exception *string          `json:"exception"`
 	ExcInfo   *struct {
 		Type  string `json:"type"`
 		Errno *int   `json:"errno"`
@@ -455,6 +467,9 @@ func (c *SSHClient) pollJobCompletion(ctx context.Context, jobID int64) (json.Ra
 			return nil, tnErr
 		case "RUNNING", "WAITING":
 			// Job still in progress, wait before polling again
+			if job.Progress != nil {
+				ReportJobProgress(ctx, *job.Progress.toProgress(job.ID, job.Method, job.State))
+			}
 			select {
 			case <-ctx.Done():
 				return nil, ctx.Err()
@@ -346,3 +346,55 @@ func TestNewSubscription_Constructor(t *testing.T) {
 		t.Error("expected cancel to be called")
 	}
 }
+
+func TestWebSocketClient_SubscribeEvents_ReceivesEnvelopes(t *testing.T) {
+	server := newSubscribeTestServer(t, func(conn *websocket.Conn) {
+		_ = conn.WriteJSON(map[string]any{
+			"msg": "method", "method": "collection_update",
+			"params": map[string]any{
+				"msg": "removed", "collection": "pool.dataset.query", "id": "tank/old",
+			},
+		})
+	})
+	defer server.Close()
+
+	client := newSubscribeTestClient(t, server)
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	events, err := client.SubscribeEvents(ctx, "pool.dataset.query", nil)
+	if err != nil {
+		t.Fatalf("SubscribeEvents failed: %v", err)
+	}
+	defer events.Close()
+	fields, err := client.Subscribe(ctx, "pool.dataset.query", nil)
+	if err != nil {
+		t.Fatalf("Subscribe failed: %v", err)
+	}
+	defer fields.Close()
+
+	select {
+	case msg := <-events.C:
+		var data map[string]any
+		if err := json.Unmarshal(msg, &data); err != nil {
+			t.Fatalf("unmarshal event: %v", err)
+		}
+		if data["msg"] != "removed" || data["id"] != "tank/old" || data["collection"] != "pool.dataset.query" {
+			t.Errorf("event = %v, want the whole notification", data)
+		}
+	case <-ctx.Done():
+		t.Fatal("timed out waiting for event")
+	}
+
+	// Plain subscribers of the same collection still get only the fields.
+	select {
+	case msg := <-fields.C:
+		if msg != nil {
+			t.Errorf("Subscribe event = %s, want the (absent) fields", msg)
+		}
+	case <-ctx.Done():
+		t.Fatal("timed out waiting for event")
+	}
+}
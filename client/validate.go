@@ -0,0 +1,71 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	truenas "github.com/deevus/truenas-go"
+	"github.com/deevus/truenas-go/api"
+)
+
+// ValidatingClient wraps a Client and checks Call and CallAndWait params
+// against the embedded API schemas before sending them, so typos in ad-hoc
+// params fail locally with an *api.ValidationError.
+//
+// Methods missing from the schema catalog (private or newer methods) and
+// releases without an embedded catalog are sent unchecked, since another
+// release's schema may reject params the connected one accepts.
+type ValidatingClient struct {
+	Client
+	version string
+}
+
+// Compile-time check that ValidatingClient implements Client.
+var _ Client = (*ValidatingClient)(nil)
+
+// NewValidatingClient wraps client with params validation. version selects
+// the schema catalog (e.g. "25.04"); if empty, the catalog matching the
+// connected TrueNAS major.minor release is used.
+func NewValidatingClient(client Client, version string) *ValidatingClient {
+	return &ValidatingClient{Client: client, version: version}
+}
+
+// Call validates params, then delegates to the underlying client.
+func (v *ValidatingClient) Call(ctx context.Context, method string, params any) (json.RawMessage, error) {
+	if err := v.validate(method, params); err != nil {
+		return nil, err
+	}
+	return v.Client.Call(ctx, method, params)
+}
+
+// CallAndWait validates params, then delegates to the underlying client.
+func (v *ValidatingClient) CallAndWait(ctx context.Context, method string, params any) (json.RawMessage, error) {
+	if err := v.validate(method, params); err != nil {
+		return nil, err
+	}
+	return v.Client.CallAndWait(ctx, method, params)
+}
+
+// SubscribeEvents delegates to the underlying client.
+func (v *ValidatingClient) SubscribeEvents(ctx context.Context, collection string, params any) (*truenas.Subscription[json.RawMessage], error) {
+	return subscribeEvents(ctx, v.Client, collection, params)
+}
+
+// validate returns schema mismatches; catalog lookup failures are ignored.
+func (v *ValidatingClient) validate(method string, params any) error {
+	version := v.version
+	if version == "" {
+		tv := v.Client.Version()
+		version = api.MatchVersion(fmt.Sprintf("%d.%d", tv.Major, tv.Minor))
+	}
+	if version == "" {
+		return nil
+	}
+	err := api.Validate(version, method, params)
+	if errors.Is(err, api.ErrInvalidParams) {
+		return err
+	}
+	return nil
+}
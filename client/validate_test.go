@@ -0,0 +1,154 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	truenas "github.com/deevus/truenas-go"
+	"github.com/deevus/truenas-go/api"
+)
+
+func TestValidatingClient_Call_Valid(t *testing.T) {
+	called := false
+	mock := &MockClient{
+		CallFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			called = true
+			return json.RawMessage(`true`), nil
+		},
+	}
+
+	c := NewValidatingClient(mock, "25.04")
+	result, err := c.Call(context.Background(), "zfs.snapshot.delete", "tank@snap")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !called {
+		t.Error("expected underlying Call")
+	}
+	if string(result) != "true" {
+		t.Errorf("expected true, got %s", result)
+	}
+}
+
+func TestValidatingClient_Call_Invalid(t *testing.T) {
+	mock := &MockClient{
+		CallFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			t.Fatal("Call should not reach the server")
+			return nil, nil
+		},
+	}
+
+	c := NewValidatingClient(mock, "25.04")
+	_, err := c.Call(context.Background(), "zfs.snapshot.create", map[string]any{
+		"dataset": "tank", "name": "snap", "recursiv": true,
+	})
+	var verr *api.ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("expected *api.ValidationError, got %v", err)
+	}
+	if verr.Path != "params[0].recursiv" {
+		t.Errorf("Path = %q", verr.Path)
+	}
+}
+
+func TestValidatingClient_CallAndWait_Invalid(t *testing.T) {
+	mock := &MockClient{
+		CallAndWaitFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			t.Fatal("CallAndWait should not reach the server")
+			return nil, nil
+		},
+	}
+
+	c := NewValidatingClient(mock, "25.04")
+	_, err := c.CallAndWait(context.Background(), "app.start", []any{"a", "b"})
+	if !errors.Is(err, api.ErrInvalidParams) {
+		t.Fatalf("expected ErrInvalidParams, got %v", err)
+	}
+}
+
+func TestValidatingClient_UnknownMethodPassesThrough(t *testing.T) {
+	called := false
+	mock := &MockClient{
+		CallFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			called = true
+			return nil, nil
+		},
+	}
+
+	c := NewValidatingClient(mock, "25.04")
+	if _, err := c.Call(context.Background(), "filesystem.file_receive", []any{"/x"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !called {
+		t.Error("expected unknown method to be sent unchecked")
+	}
+}
+
+func TestValidatingClient_VersionFromClient(t *testing.T) {
+	tests := []struct {
+		name      string
+		version   truenas.Version
+		wantError bool
+	}{
+		{"25.04 validates", truenas.Version{Major: 25, Minor: 4}, true},
+		{"25.04.2 validates", truenas.Version{Major: 25, Minor: 4, Patch: 2}, true},
+		{"25.10 has no catalog", truenas.Version{Major: 25, Minor: 10}, false},
+		{"24.10 has no catalog", truenas.Version{Major: 24, Minor: 10}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &MockClient{VersionVal: tt.version}
+			c := NewValidatingClient(mock, "")
+			_, err := c.Call(context.Background(), "system.info", "extra")
+			if got := errors.Is(err, api.ErrInvalidParams); got != tt.wantError {
+				t.Errorf("validation error = %v, want %v (err: %v)", got, tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestValidatingClient_NewerReleaseSendsUnchecked(t *testing.T) {
+	called := false
+	mock := &MockClient{
+		VersionVal: truenas.Version{Major: 25, Minor: 10},
+		CallFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			called = true
+			return json.RawMessage(`{}`), nil
+		},
+	}
+
+	// The 25.04 catalog rejects the field, but a newer release may accept it.
+	params := map[string]any{"dataset": "tank", "name": "snap", "added_in_2510": true}
+	if err := api.Validate("25.04", "zfs.snapshot.create", params); !errors.Is(err, api.ErrInvalidParams) {
+		t.Fatalf("expected the 25.04 catalog to reject the field, got %v", err)
+	}
+
+	c := NewValidatingClient(mock, "")
+	if _, err := c.Call(context.Background(), "zfs.snapshot.create", params); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !called {
+		t.Error("expected the call to reach the 25.10 server")
+	}
+}
+
+func TestValidatingClient_DelegatesOtherMethods(t *testing.T) {
+	closed := false
+	mock := &MockClient{
+		VersionVal: truenas.Version{Major: 25, Minor: 4},
+		CloseFunc: func() error {
+			closed = true
+			return nil
+		},
+	}
+
+	c := NewValidatingClient(mock, "")
+	if c.Version().Minor != 4 {
+		t.Errorf("expected delegated Version, got %v", c.Version())
+	}
+	if err := c.Close(); err != nil || !closed {
+		t.Errorf("expected delegated Close, err=%v closed=%v", err, closed)
+	}
+}
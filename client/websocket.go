@@ -7,9 +7,13 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"io/fs"
 	"net/http"
+	"path/filepath"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	truenas "github.com/deevus/truenas-go"
@@ -26,12 +30,13 @@ type WebSocketConfig struct {
 	APIKey             string
 	Port               int
 	InsecureSkipVerify bool
+	TLSConfig          *tls.Config // Optional TLS settings (e.g. custom RootCAs); InsecureSkipVerify still applies
 	MaxConcurrent      int
 	ConnectTimeout     time.Duration
 	MaxRetries         int
 	PingInterval       time.Duration // Interval between pings (0 = disabled, default: 30s)
 	PingTimeout        time.Duration // Time to wait for pong (default: 10s)
-	Fallback           Client        // Optional SSH client for file operations (defaults to UnsupportedClient)
+	Fallback           Client        // Optional SSH client for file operations the middleware lacks (defaults to UnsupportedClient)
 }
 
 // Validate validates the WebSocketConfig and sets defaults.
@@ -72,8 +77,12 @@ func (c *WebSocketConfig) Validate() error {
 	return nil
 }
 
-// Compile-time check that WebSocketClient implements Client.
-var _ Client = (*WebSocketClient)(nil)
+// Compile-time checks that WebSocketClient implements Client and delivers
+// whole events for truenas.Watch.
+var (
+	_ Client                  = (*WebSocketClient)(nil)
+	_ truenas.EventSubscriber = (*WebSocketClient)(nil)
+)
 
 // wsRequest is sent from callers to the writer goroutine.
 type wsRequest struct {
@@ -81,6 +90,7 @@ type wsRequest struct {
 	params   any
 	response chan<- wsResponse
 	ctx      context.Context
+	batch    []wsRequest // sent as one JSON-RPC batch; method and response unused
 }
 
 // wsResponse is sent back to callers.
@@ -101,6 +111,7 @@ type wsCollectionSub struct {
 	params     any
 	ch         chan<- json.RawMessage
 	unsub      bool
+	envelope   bool // deliver the whole collection_update params, not just fields
 }
 
 // jobEventBuffer maintains recent events for replay to new subscribers.
@@ -138,10 +149,11 @@ func (b *jobEventBuffer) getByJobID(jobID int64) *JobEvent {
 //   - "DISCONNECTED" - Synthetic: WebSocket connection lost
 //   - "RECONNECTED" - Synthetic: WebSocket connection restored
 type JobEvent struct {
-	ID     int64           `json:"id"`
-	State  string          `json:"state"`
-	Result json.RawMessage `json:"result,omitempty"`
-	Error  string          `json:"error,omitempty"`
+	ID       int64           `json:"id"`
+	State    string          `json:"state"`
+	Result   json.RawMessage `json:"result,omitempty"`
+	Error    string          `json:"error,omitempty"`
+	Progress *JobProgress    `json:"progress,omitempty"` // set on RUNNING events that carry progress
 }
 
 // Synthetic job event states (not from TrueNAS)
@@ -165,6 +177,9 @@ type WebSocketClient struct {
 	stopChan          chan struct{}
 	pongChan          chan struct{} // Receives pong notifications from reader
 
+	httpClient *http.Client // Fetches core.download URLs
+	noBatch    atomic.Bool  // server rejected a JSON-RPC batch; pipeline instead
+
 	testInsecure bool   // For testing with httptest servers
 	wsPath       string // Cached WebSocket path
 
@@ -182,13 +197,20 @@ func NewWebSocketClient(config WebSocketConfig) (*WebSocketClient, error) {
 	dialer := &websocket.Dialer{
 		HandshakeTimeout: config.ConnectTimeout,
 	}
+	if config.TLSConfig != nil {
+		dialer.TLSClientConfig = config.TLSConfig.Clone()
+	}
 	if config.InsecureSkipVerify {
-		dialer.TLSClientConfig = &tls.Config{InsecureSkipVerify: true} //nolint:gosec
+		if dialer.TLSClientConfig == nil {
+			dialer.TLSClientConfig = &tls.Config{}
+		}
+		dialer.TLSClientConfig.InsecureSkipVerify = true //nolint:gosec
 	}
 
 	c := &WebSocketClient{
 		config:            config,
 		dialer:            dialer,
+		httpClient:        &http.Client{Transport: &http.Transport{TLSClientConfig: dialer.TLSClientConfig}},
 		requestChan:       make(chan wsRequest, 100),
 		readChan:          make(chan JSONRPCResponse, 100),
 		eventChan:         make(chan JSONRPCResponse, 100),
@@ -210,12 +232,24 @@ func NewWebSocketClient(config WebSocketConfig) (*WebSocketClient, error) {
 // The writerLoop sends core.subscribe to the server and routes collection_update
 // events to the subscriber channel. Close the subscription to stop receiving events.
 func (c *WebSocketClient) Subscribe(ctx context.Context, collection string, params any) (*truenas.Subscription[json.RawMessage], error) {
+	return c.subscribe(ctx, collection, params, false)
+}
+
+// SubscribeEvents is like Subscribe, but delivers each collection_update
+// notification whole (msg, collection, id, fields and cleared), so that
+// removals and the kind of change can be told apart.
+func (c *WebSocketClient) SubscribeEvents(ctx context.Context, collection string, params any) (*truenas.Subscription[json.RawMessage], error) {
+	return c.subscribe(ctx, collection, params, true)
+}
+
+func (c *WebSocketClient) subscribe(ctx context.Context, collection string, params any, envelope bool) (*truenas.Subscription[json.RawMessage], error) {
 	ch := make(chan json.RawMessage, 100)
 
 	sub := wsCollectionSub{
 		collection: collection,
 		params:     params,
 		ch:         ch,
+		envelope:   envelope,
 	}
 
 	select {
@@ -264,6 +298,8 @@ func (c *WebSocketClient) writerLoop() {
 	// Collection subscription state (must be declared before handleDisconnect closure)
 	collectionSubs := make(map[string][]chan<- json.RawMessage) // collection (or collection:{params}) -> subscriber channels
 	activeCollections := make(map[string]bool)                  // collections we've sent core.subscribe for
+	envelopeSubs := make(map[chan<- json.RawMessage]bool)       // subscribers receiving whole notifications
+	var inFlightBatches [][]string                              // request IDs of batches sent but not yet answered, oldest first
 
 	// Ping/pong state
 	var pingTicker *time.Ticker
@@ -290,6 +326,7 @@ func (c *WebSocketClient) writerLoop() {
 			req.response <- wsResponse{err: err}
 			delete(pending, id)
 		}
+		inFlightBatches = nil
 
 		// Notify job subscribers (only once per disconnect)
 		if !notifiedDisconnect {
@@ -306,6 +343,34 @@ func (c *WebSocketClient) writerLoop() {
 		}
 	}
 
+	// Helper to fail a request, or every call of a batch
+	failRequest := func(req wsRequest, err error) {
+		if req.batch == nil {
+			req.response <- wsResponse{err: err}
+			return
+		}
+		for _, call := range req.batch {
+			call.response <- wsResponse{err: err}
+		}
+	}
+
+	// Helper to fail the oldest batch in flight for an INVALID_REQUEST error
+	// without an ID: the server rejected the batch itself. Reports whether
+	// the error was matched to a batch.
+	rejectBatch := func(rpcErr *JSONRPCError) bool {
+		if rpcErr == nil || rpcErr.Code != ErrCodeInvalidRequest || len(inFlightBatches) == 0 {
+			return false
+		}
+		for _, id := range inFlightBatches[0] {
+			if req, ok := pending[id]; ok {
+				req.response <- wsResponse{err: fmt.Errorf("%w: %v", ErrBatchUnsupported, rpcErr)}
+				delete(pending, id)
+			}
+		}
+		inFlightBatches = inFlightBatches[1:]
+		return true
+	}
+
 	for {
 		select {
 		case req := <-c.requestChan:
@@ -314,7 +379,7 @@ func (c *WebSocketClient) writerLoop() {
 				var err error
 				conn, err = c.connect(req.ctx)
 				if err != nil {
-					req.response <- wsResponse{err: err}
+					failRequest(req, err)
 					continue
 				}
 				go c.readerLoop(conn)
@@ -338,7 +403,7 @@ func (c *WebSocketClient) writerLoop() {
 						nextID++
 						if err := conn.WriteJSON(subReq); err != nil {
 							handleDisconnect(err)
-							req.response <- wsResponse{err: err}
+							failRequest(req, err)
 							resubFailed = true
 							break
 						}
@@ -350,6 +415,31 @@ func (c *WebSocketClient) writerLoop() {
 				}
 			}
 
+			if req.batch != nil {
+				rpcReqs := make([]JSONRPCRequest, len(req.batch))
+				ids := make([]string, len(req.batch))
+				for i, call := range req.batch {
+					ids[i] = fmt.Sprintf("req-%d", nextID)
+					nextID++
+					rpcReqs[i] = JSONRPCRequest{
+						JSONRPC: "2.0",
+						Method:  call.method,
+						Params:  c.wrapParams(call.params),
+						ID:      ids[i],
+					}
+				}
+				if err := conn.WriteJSON(rpcReqs); err != nil {
+					failRequest(req, err)
+					handleDisconnect(err)
+					continue
+				}
+				for i, call := range req.batch {
+					pending[ids[i]] = call
+				}
+				inFlightBatches = append(inFlightBatches, ids)
+				continue
+			}
+
 			// Build JSON-RPC request
 			id := fmt.Sprintf("req-%d", nextID)
 			nextID++
@@ -377,6 +467,7 @@ func (c *WebSocketClient) writerLoop() {
 					for i, ch := range subs {
 						if ch == colSub.ch {
 							collectionSubs[key] = append(subs[:i], subs[i+1:]...)
+							delete(envelopeSubs, ch)
 							close(ch)
 							break
 						}
@@ -391,6 +482,9 @@ func (c *WebSocketClient) writerLoop() {
 					subName = colSub.collection + ":" + string(paramJSON)
 				}
 				collectionSubs[subName] = append(collectionSubs[subName], colSub.ch)
+				if colSub.envelope {
+					envelopeSubs[colSub.ch] = true
+				}
 				// Subscribe on server if connection exists and not already subscribed
 				if conn != nil && !activeCollections[subName] {
 					subReq := JSONRPCRequest{
@@ -404,6 +498,7 @@ func (c *WebSocketClient) writerLoop() {
 						// Failed — remove and close the subscriber
 						subs := collectionSubs[subName]
 						collectionSubs[subName] = subs[:len(subs)-1]
+						delete(envelopeSubs, colSub.ch)
 						close(colSub.ch)
 						continue
 					}
@@ -429,6 +524,10 @@ func (c *WebSocketClient) writerLoop() {
 			}
 
 		case msg := <-c.readChan:
+			if msg.ID == "" && rejectBatch(msg.Error) {
+				continue
+			}
+			inFlightBatches = settleBatch(inFlightBatches, msg.ID)
 			if req, ok := pending[msg.ID]; ok {
 				delete(pending, msg.ID)
 				if msg.Error != nil {
@@ -452,7 +551,11 @@ func (c *WebSocketClient) writerLoop() {
 						Fields     json.RawMessage `json:"fields"`
 					} `json:"params"`
 				}
+				var raw struct {
+					Params json.RawMessage `json:"params"`
+				}
 				if err := json.Unmarshal(msg.Result, &envelope); err == nil && envelope.Method == "collection_update" {
+					_ = json.Unmarshal(msg.Result, &raw)
 					if envelope.Params.Collection != "core.get_jobs" {
 						// Route to collection subscribers.
 						// Check exact match first, then parameterized keys (collection:{params}).
@@ -467,8 +570,12 @@ func (c *WebSocketClient) writerLoop() {
 							}
 						}
 						for _, ch := range allSubs {
+							payload := envelope.Params.Fields
+							if envelopeSubs[ch] {
+								payload = raw.Params
+							}
 							select {
-							case ch <- envelope.Params.Fields:
+							case ch <- payload:
 							default:
 								// Channel full, skip to avoid blocking writer loop
 							}
@@ -552,9 +659,11 @@ func (c *WebSocketClient) handleJobEvent(msg JSONRPCResponse, jobSubs map[int64]
 			Collection string `json:"collection"`
 			ID         int64  `json:"id"`
 			Fields     struct {
-				State  string          `json:"state"`
-				Result json.RawMessage `json:"result"`
-				Error  string          `json:"error"`
+				Method   string           `json:"method"`
+				State    string           `json:"state"`
+				Result   json.RawMessage  `json:"result"`
+				Error    string           `json:"error"`
+				Progress *jobProgressWire `json:"progress"`
 			} `json:"fields"`
 		} `json:"params"`
 	}
@@ -570,27 +679,25 @@ func (c *WebSocketClient) handleJobEvent(msg JSONRPCResponse, jobSubs map[int64]
 			Result: envelope.Params.Fields.Result,
 			Error:  envelope.Params.Fields.Error,
 		}
+		if p := envelope.Params.Fields.Progress; p != nil {
+			event.Progress = p.toProgress(event.ID, envelope.Params.Fields.Method, event.State)
+		}
 
 		// Buffer terminal events so new subscribers can find already-completed jobs
 		if event.State == "SUCCESS" || event.State == "FAILED" || event.State == "ABORTED" {
 			buffer.add(event)
 		}
 
-		c.routeJobEvent(event.ID, event.State, event.Result, event.Error, jobSubs)
+		c.routeJobEvent(event, jobSubs)
 	}
 }
 
 // routeJobEvent sends the event to the appropriate subscriber.
-func (c *WebSocketClient) routeJobEvent(jobID int64, state string, result json.RawMessage, errStr string, jobSubs map[int64]chan<- JobEvent) {
-	if ch, ok := jobSubs[jobID]; ok {
-		ch <- JobEvent{
-			ID:     jobID,
-			State:  state,
-			Result: result,
-			Error:  errStr,
-		}
-		if state == "SUCCESS" || state == "FAILED" || state == "ABORTED" {
-			delete(jobSubs, jobID)
+func (c *WebSocketClient) routeJobEvent(event JobEvent, jobSubs map[int64]chan<- JobEvent) {
+	if ch, ok := jobSubs[event.ID]; ok {
+		ch <- event
+		if event.State == "SUCCESS" || event.State == "FAILED" || event.State == "ABORTED" {
+			delete(jobSubs, event.ID)
 		}
 	}
 }
@@ -702,6 +809,15 @@ func (c *WebSocketClient) endpoint() string {
 	return fmt.Sprintf("%s://%s:%d%s", scheme, c.config.Host, c.config.Port, c.wsPath)
 }
 
+// baseURL returns the HTTP(S) origin used for file downloads.
+func (c *WebSocketClient) baseURL() string {
+	scheme := "https"
+	if c.testInsecure {
+		scheme = "http"
+	}
+	return fmt.Sprintf("%s://%s:%d", scheme, c.config.Host, c.config.Port)
+}
+
 // authenticate sends auth.login_ex and waits for response.
 func (c *WebSocketClient) authenticate(ctx context.Context, conn *websocket.Conn) error {
 	req := JSONRPCRequest{
@@ -761,9 +877,23 @@ func (c *WebSocketClient) readerLoop(conn *websocket.Conn) {
 			return
 		}
 
-		// Try to parse as JSON-RPC response first
+		// Responses to a batch arrive together as an array
+		if trimmed := strings.TrimLeft(string(rawMsg), " \t\r\n"); strings.HasPrefix(trimmed, "[") {
+			var batch []JSONRPCResponse
+			if err := json.Unmarshal(rawMsg, &batch); err == nil {
+				for _, rpcResp := range batch {
+					if rpcResp.ID != "" {
+						c.readChan <- rpcResp
+					}
+				}
+				continue
+			}
+		}
+
+		// Try to parse as JSON-RPC response first. Errors without an ID may
+		// answer a batch the server could not accept.
 		var rpcResp JSONRPCResponse
-		if err := json.Unmarshal(rawMsg, &rpcResp); err == nil && rpcResp.ID != "" {
+		if err := json.Unmarshal(rawMsg, &rpcResp); err == nil && (rpcResp.ID != "" || rpcResp.Error != nil) {
 			c.readChan <- rpcResp
 			continue
 		}
@@ -831,13 +961,105 @@ func (c *WebSocketClient) doCall(ctx context.Context, method string, params any)
 	}
 }
 
+// Batch returns an empty batch sent as one JSON-RPC 2.0 batch.
+func (c *WebSocketClient) Batch() *Batch {
+	return NewBatch(c)
+}
+
+// runBatch sends calls as a JSON-RPC batch. If the server rejects batches,
+// it pipelines them from then on: every call is written without waiting for
+// the previous response.
+func (c *WebSocketClient) runBatch(ctx context.Context, calls []batchCall) []BatchResult {
+	if !c.noBatch.Load() {
+		results, err := c.sendBatch(ctx, calls)
+		if !errors.Is(err, ErrBatchUnsupported) {
+			return results
+		}
+		c.noBatch.Store(true)
+	}
+	return runConcurrently(ctx, c, calls, len(calls))
+}
+
+// sendBatch sends calls in one message and waits for their jobs. It returns
+// ErrBatchUnsupported if the server rejected the batch as a whole. Unlike
+// Call, it does not retry: some calls may already have run.
+func (c *WebSocketClient) sendBatch(ctx context.Context, calls []batchCall) ([]BatchResult, error) {
+	responses := make([]chan wsResponse, len(calls))
+	req := wsRequest{ctx: ctx, batch: make([]wsRequest, len(calls))}
+	for i, call := range calls {
+		responses[i] = make(chan wsResponse, 1)
+		req.batch[i] = wsRequest{method: call.method, params: call.params, response: responses[i], ctx: ctx}
+	}
+
+	results := make([]BatchResult, len(calls))
+	select {
+	case c.requestChan <- req:
+	case <-ctx.Done():
+		for i := range results {
+			results[i].Err = ctx.Err()
+		}
+		return results, nil
+	}
+
+	rejected := 0
+	for i, ch := range responses {
+		select {
+		case resp := <-ch:
+			results[i] = BatchResult{Result: resp.result, Err: resp.err}
+			if errors.Is(resp.err, ErrBatchUnsupported) {
+				rejected++
+			}
+		case <-ctx.Done():
+			results[i].Err = ctx.Err()
+		}
+	}
+	if rejected == len(calls) {
+		return results, ErrBatchUnsupported
+	}
+
+	var wg sync.WaitGroup
+	for i, call := range calls {
+		if !call.job || results[i].Err != nil {
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i].Result, results[i].Err = c.awaitJob(ctx, results[i].Result)
+		}()
+	}
+	wg.Wait()
+	return results, nil
+}
+
+// settleBatch drops the batch containing id from the batches in flight: a
+// response to any of its calls means the server accepted it.
+func settleBatch(batches [][]string, id string) [][]string {
+	if id == "" {
+		return batches
+	}
+	for i, ids := range batches {
+		for _, bid := range ids {
+			if bid == id {
+				return append(batches[:i], batches[i+1:]...)
+			}
+		}
+	}
+	return batches
+}
+
 // CallAndWait executes a method and waits for job completion.
 func (c *WebSocketClient) CallAndWait(ctx context.Context, method string, params any) (json.RawMessage, error) {
 	result, err := c.Call(ctx, method, params)
 	if err != nil {
 		return nil, err
 	}
+	return c.awaitJob(ctx, result)
+}
 
+// awaitJob waits for the job whose ID a call returned, or returns result
+// unchanged if it is not a job ID.
+func (c *WebSocketClient) awaitJob(ctx context.Context, result json.RawMessage) (json.RawMessage, error) {
 	// Check if result is a job ID
 	var jobID int64
 	if err := json.Unmarshal(result, &jobID); err != nil {
@@ -908,6 +1130,9 @@ func (c *WebSocketClient) CallAndWait(ctx context.Context, method string, params
 				continue
 			default:
 				// RUNNING, WAITING - continue
+				if event.Progress != nil {
+					ReportJobProgress(ctx, *event.Progress)
+				}
 				continue
 			}
 		case <-ctx.Done():
@@ -1070,15 +1295,62 @@ func (c *WebSocketClient) WriteFile(ctx context.Context, path string, params tru
 	return nil
 }
 
-// ReadFile delegates to fallback client (requires SSH).
+// ReadFile reads a file natively via core.download of filesystem.get.
+// Falls back to the fallback client if the middleware lacks the download endpoint.
 func (c *WebSocketClient) ReadFile(ctx context.Context, path string) ([]byte, error) {
-	data, err := c.config.Fallback.ReadFile(ctx, path)
-	if errors.Is(err, ErrUnsupportedOperation) {
-		return nil, fmt.Errorf("ReadFile requires SSH fallback client: configure Fallback in WebSocketConfig: %w", err)
+	data, err := c.downloadFile(ctx, path)
+	if isMethodNotFound(err) {
+		data, err = c.config.Fallback.ReadFile(ctx, path)
+		if errors.Is(err, ErrUnsupportedOperation) {
+			return nil, fmt.Errorf("ReadFile requires SSH fallback client on this TrueNAS version: configure Fallback in WebSocketConfig: %w", err)
+		}
+		return data, err
 	}
-	return data, err
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file %q: %w", path, err)
+	}
+	return data, nil
 }
 
+// downloadFile starts a filesystem.get download job and fetches its content
+// from the URL returned by core.download.
+func (c *WebSocketClient) downloadFile(ctx context.Context, path string) ([]byte, error) {
+	result, err := c.Call(ctx, "core.download", []any{"filesystem.get", []any{path}, filepath.Base(path)})
+	if err != nil {
+		return nil, err
+	}
+
+	// core.download returns [job_id, url]
+	var download []json.RawMessage
+	if err := json.Unmarshal(result, &download); err != nil || len(download) != 2 {
+		return nil, fmt.Errorf("unexpected core.download response: %s", string(result))
+	}
+	var url string
+	if err := json.Unmarshal(download[1], &url); err != nil {
+		return nil, fmt.Errorf("unexpected core.download url: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL()+url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("download failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return nil, fmt.Errorf("download failed: %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// The middleware has no file deletion endpoint (filesystem.* in 25.04 covers
+// reads, listings, permissions and uploads only), so deletes always go
+// through the fallback client.
+
 // DeleteFile delegates to fallback client (requires SSH).
 func (c *WebSocketClient) DeleteFile(ctx context.Context, path string) error {
 	err := c.config.Fallback.DeleteFile(ctx, path)
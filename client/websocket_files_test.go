@@ -0,0 +1,238 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	truenas "github.com/deevus/truenas-go"
+	"github.com/gorilla/websocket"
+)
+
+// fileOpsHandler answers a single JSON-RPC call in a file ops test server.
+type fileOpsHandler func(method string, params []any) (result json.RawMessage, rpcErr *JSONRPCError)
+
+// fileOpsServer is a test server that speaks enough JSON-RPC for file
+// operations and serves /_download/ URLs over plain HTTP.
+type fileOpsServer struct {
+	*httptest.Server
+	mu      sync.Mutex
+	methods []string
+}
+
+func (s *fileOpsServer) called(method string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := 0
+	for _, m := range s.methods {
+		if m == method {
+			n++
+		}
+	}
+	return n
+}
+
+func newFileOpsServer(t *testing.T, handle fileOpsHandler, download http.HandlerFunc) *fileOpsServer {
+	t.Helper()
+	s := &fileOpsServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/_download/") {
+			if download == nil {
+				http.NotFound(w, r)
+				return
+			}
+			download(w, r)
+			return
+		}
+
+		upgrader := websocket.Upgrader{}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		var writeMu sync.Mutex
+		write := func(v any) {
+			writeMu.Lock()
+			defer writeMu.Unlock()
+			_ = conn.WriteJSON(v)
+		}
+
+		for {
+			var req JSONRPCRequest
+			if err := conn.ReadJSON(&req); err != nil {
+				return
+			}
+
+			switch req.Method {
+			case "auth.login_ex":
+				write(JSONRPCResponse{JSONRPC: "2.0", Result: json.RawMessage(`{"response_type":"SUCCESS"}`), ID: req.ID})
+				continue
+			case "core.subscribe":
+				write(JSONRPCResponse{JSONRPC: "2.0", Result: json.RawMessage(`true`), ID: req.ID})
+				continue
+			}
+
+			s.mu.Lock()
+			s.methods = append(s.methods, req.Method)
+			s.mu.Unlock()
+
+			params, _ := req.Params.([]any)
+			result, rpcErr := handle(req.Method, params)
+			if rpcErr != nil {
+				write(JSONRPCResponse{JSONRPC: "2.0", Error: rpcErr, ID: req.ID})
+				continue
+			}
+			write(JSONRPCResponse{JSONRPC: "2.0", Result: result, ID: req.ID})
+		}
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// newFileOpsClient connects a client to the server with the given fallback.
+func newFileOpsClient(t *testing.T, server *fileOpsServer, fallback Client) *WebSocketClient {
+	t.Helper()
+	host := strings.TrimPrefix(server.URL, "http://")
+
+	if fallback == nil {
+		fallback = &UnsupportedClient{}
+	}
+	client, err := NewWebSocketClient(WebSocketConfig{
+		Host:           strings.Split(host, ":")[0],
+		Port:           mustParsePort(strings.Split(host, ":")[1]),
+		Username:       "root",
+		APIKey:         "test-key",
+		Fallback:       fallback,
+		ConnectTimeout: 5 * time.Second,
+		MaxRetries:     1,
+	})
+	if err != nil {
+		t.Fatalf("NewWebSocketClient() error = %v", err)
+	}
+	client.testInsecure = true
+	client.connected = true
+	client.version = truenas.Version{Major: 25, Minor: 4}
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+var errMethodNotFound = &JSONRPCError{Code: ErrCodeMethodNotFound, Message: "Method does not exist"}
+
+func TestWebSocketClient_ReadFile_Native(t *testing.T) {
+	server := newFileOpsServer(t,
+		func(method string, params []any) (json.RawMessage, *JSONRPCError) {
+			if method != "core.download" {
+				t.Errorf("method = %q, want core.download", method)
+			}
+			if params[0] != "filesystem.get" {
+				t.Errorf("download method = %v, want filesystem.get", params[0])
+			}
+			if args := params[1].([]any); args[0] != "/mnt/tank/app.conf" {
+				t.Errorf("download args = %v, want [/mnt/tank/app.conf]", args)
+			}
+			if params[2] != "app.conf" {
+				t.Errorf("download filename = %v, want app.conf", params[2])
+			}
+			return json.RawMessage(`[77, "/_download/77?auth_token=tok"]`), nil
+		},
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("auth_token") != "tok" {
+				t.Errorf("auth_token = %q, want tok", r.URL.Query().Get("auth_token"))
+			}
+			_, _ = w.Write([]byte("key=value\n"))
+		},
+	)
+
+	fallbackCalled := false
+	client := newFileOpsClient(t, server, &MockClient{
+		ReadFileFunc: func(ctx context.Context, path string) ([]byte, error) {
+			fallbackCalled = true
+			return nil, nil
+		},
+	})
+
+	data, err := client.ReadFile(context.Background(), "/mnt/tank/app.conf")
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if string(data) != "key=value\n" {
+		t.Errorf("ReadFile() = %q, want %q", data, "key=value\n")
+	}
+	if fallbackCalled {
+		t.Error("ReadFile() used fallback, want native download")
+	}
+}
+
+func TestWebSocketClient_ReadFile_DownloadHTTPError(t *testing.T) {
+	server := newFileOpsServer(t,
+		func(method string, params []any) (json.RawMessage, *JSONRPCError) {
+			return json.RawMessage(`[77, "/_download/77?auth_token=tok"]`), nil
+		},
+		func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "[ENOENT] /mnt/missing not found", http.StatusInternalServerError)
+		},
+	)
+	client := newFileOpsClient(t, server, nil)
+
+	_, err := client.ReadFile(context.Background(), "/mnt/missing")
+	if err == nil {
+		t.Fatal("ReadFile() error = nil, want error")
+	}
+	if !strings.Contains(err.Error(), "ENOENT") {
+		t.Errorf("ReadFile() error = %v, want containing ENOENT", err)
+	}
+}
+
+func TestWebSocketClient_ReadFile_BadDownloadResponse(t *testing.T) {
+	server := newFileOpsServer(t,
+		func(method string, params []any) (json.RawMessage, *JSONRPCError) {
+			return json.RawMessage(`"not a pair"`), nil
+		}, nil)
+	client := newFileOpsClient(t, server, nil)
+
+	_, err := client.ReadFile(context.Background(), "/mnt/file")
+	if err == nil || !strings.Contains(err.Error(), "unexpected core.download response") {
+		t.Errorf("ReadFile() error = %v, want unexpected response error", err)
+	}
+}
+
+func TestWebSocketClient_ReadFile_FallsBackWhenDownloadMissing(t *testing.T) {
+	server := newFileOpsServer(t,
+		func(method string, params []any) (json.RawMessage, *JSONRPCError) {
+			return nil, errMethodNotFound
+		}, nil)
+
+	client := newFileOpsClient(t, server, &MockClient{
+		ReadFileFunc: func(ctx context.Context, path string) ([]byte, error) {
+			return []byte("from ssh"), nil
+		},
+	})
+
+	data, err := client.ReadFile(context.Background(), "/mnt/file")
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if string(data) != "from ssh" {
+		t.Errorf("ReadFile() = %q, want %q", data, "from ssh")
+	}
+}
+
+func TestWebSocketClient_ReadFile_DownloadMissingWithoutFallback(t *testing.T) {
+	server := newFileOpsServer(t,
+		func(method string, params []any) (json.RawMessage, *JSONRPCError) {
+			return nil, errMethodNotFound
+		}, nil)
+	client := newFileOpsClient(t, server, nil)
+
+	_, err := client.ReadFile(context.Background(), "/mnt/file")
+	if !errors.Is(err, ErrUnsupportedOperation) {
+		t.Errorf("ReadFile() error = %v, want ErrUnsupportedOperation", err)
+	}
+}
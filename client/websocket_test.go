@@ -2204,127 +2204,6 @@ func TestWebSocketClient_CallAndWait_EventArrivesBeforeSubscription(t *testing.T
 
 // Phase 2: Delegated Methods Tests
 
-func TestWebSocketClient_ReadFile_DelegatesToFallback(t *testing.T) {
-	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		upgrader := websocket.Upgrader{}
-		conn, err := upgrader.Upgrade(w, r, nil)
-		if err != nil {
-			return
-		}
-		defer conn.Close()
-
-		for {
-			_, msg, err := conn.ReadMessage()
-			if err != nil {
-				return
-			}
-
-			var req JSONRPCRequest
-			json.Unmarshal(msg, &req)
-
-			if req.Method == "auth.login_ex" {
-				conn.WriteJSON(JSONRPCResponse{
-					JSONRPC: "2.0",
-					Result:  json.RawMessage(`{"response_type":"SUCCESS"}`),
-					ID:      req.ID,
-				})
-				continue
-			}
-
-			if req.Method == "core.subscribe" {
-				conn.WriteJSON(JSONRPCResponse{
-					JSONRPC: "2.0",
-					Result:  json.RawMessage(`true`),
-					ID:      req.ID,
-				})
-				continue
-			}
-		}
-	}))
-	defer server.Close()
-
-	wsURL := "ws" + strings.TrimPrefix(server.URL, "http")
-	host := strings.TrimPrefix(wsURL, "ws://")
-
-	readFileCalled := false
-	expectedContent := []byte("file content from fallback")
-	mock := &MockClient{
-		VersionVal:  truenas.Version{Major: 25, Minor: 0},
-		ConnectFunc: func(ctx context.Context) error { return nil },
-		ReadFileFunc: func(ctx context.Context, path string) ([]byte, error) {
-			readFileCalled = true
-			if path != "/mnt/test/file.txt" {
-				t.Errorf("ReadFile path = %q, want %q", path, "/mnt/test/file.txt")
-			}
-			return expectedContent, nil
-		},
-	}
-
-	config := WebSocketConfig{
-		Host:           strings.Split(host, ":")[0],
-		Port:           mustParsePort(strings.Split(host, ":")[1]),
-		Username:       "root",
-		APIKey:         "test-key",
-		Fallback:       mock,
-		ConnectTimeout: 5 * time.Second,
-		MaxRetries:     1,
-	}
-
-	client, err := NewWebSocketClient(config)
-	if err != nil {
-		t.Fatalf("NewWebSocketClient() error = %v", err)
-	}
-	client.testInsecure = true
-	defer client.Close()
-
-	content, err := client.ReadFile(context.Background(), "/mnt/test/file.txt")
-	if err != nil {
-		t.Fatalf("ReadFile() error = %v", err)
-	}
-
-	if !readFileCalled {
-		t.Error("ReadFile() did not delegate to fallback")
-	}
-
-	if string(content) != string(expectedContent) {
-		t.Errorf("ReadFile() = %q, want %q", content, expectedContent)
-	}
-}
-
-func TestWebSocketClient_ReadFile_PropagatesError(t *testing.T) {
-	mock := &MockClient{
-		VersionVal:  truenas.Version{Major: 25, Minor: 0},
-		ConnectFunc: func(ctx context.Context) error { return nil },
-		ReadFileFunc: func(ctx context.Context, path string) ([]byte, error) {
-			return nil, errors.New("ssh connection failed")
-		},
-	}
-
-	config := WebSocketConfig{
-		Host:           "localhost",
-		Port:           443,
-		Username:       "root",
-		APIKey:         "test-key",
-		Fallback:       mock,
-		ConnectTimeout: 5 * time.Second,
-		MaxRetries:     1,
-	}
-
-	client, err := NewWebSocketClient(config)
-	if err != nil {
-		t.Fatalf("NewWebSocketClient() error = %v", err)
-	}
-	defer client.Close()
-
-	_, err = client.ReadFile(context.Background(), "/mnt/test/file.txt")
-	if err == nil {
-		t.Error("ReadFile() expected error, got nil")
-	}
-	if !strings.Contains(err.Error(), "ssh connection failed") {
-		t.Errorf("ReadFile() error = %v, want containing 'ssh connection failed'", err)
-	}
-}
-
 func TestWebSocketClient_DeleteFile_DelegatesToFallback(t *testing.T) {
 	deleteFileCalled := false
 	mock := &MockClient{
@@ -3158,6 +3037,17 @@ func TestWebSocketClient_CallAndWait_FailingJob_WithAppLifecycleEnrichment(t *te
 					ID:      req.ID,
 				})
 
+			case "core.download":
+				// Simulate a middleware without native downloads so the
+				// log is read through the fallback client.
+				writeMu.Lock()
+				conn.WriteJSON(JSONRPCResponse{
+					JSONRPC: "2.0",
+					Error:   &JSONRPCError{Code: ErrCodeMethodNotFound, Message: "Method does not exist"},
+					ID:      req.ID,
+				})
+				writeMu.Unlock()
+
 			case "app.failing":
 				writeMu.Lock()
 				conn.WriteJSON(JSONRPCResponse{
@@ -3287,6 +3177,17 @@ func TestWebSocketClient_CallAndWait_FailingJob_EnrichmentFailsSilently(t *testi
 					ID:      req.ID,
 				})
 
+			case "core.download":
+				// Simulate a middleware without native downloads so the
+				// log is read through the fallback client.
+				writeMu.Lock()
+				conn.WriteJSON(JSONRPCResponse{
+					JSONRPC: "2.0",
+					Error:   &JSONRPCError{Code: ErrCodeMethodNotFound, Message: "Method does not exist"},
+					ID:      req.ID,
+				})
+				writeMu.Unlock()
+
 			case "app.failing":
 				writeMu.Lock()
 				conn.WriteJSON(JSONRPCResponse{
@@ -3418,6 +3319,17 @@ func TestWebSocketClient_CallAndWait_AbortedJob_WithEnrichment(t *testing.T) {
 					ID:      req.ID,
 				})
 
+			case "core.download":
+				// Simulate a middleware without native downloads so the
+				// log is read through the fallback client.
+				writeMu.Lock()
+				conn.WriteJSON(JSONRPCResponse{
+					JSONRPC: "2.0",
+					Error:   &JSONRPCError{Code: ErrCodeMethodNotFound, Message: "Method does not exist"},
+					ID:      req.ID,
+				})
+				writeMu.Unlock()
+
 			case "app.aborting":
 				writeMu.Lock()
 				conn.WriteJSON(JSONRPCResponse{
@@ -4679,30 +4591,6 @@ func TestWebSocketClient_Connect_NativeVersionDetection(t *testing.T) {
 	}
 }
 
-func TestWebSocketClient_UnsupportedFallback_ReadFile(t *testing.T) {
-	config := WebSocketConfig{
-		Host:     "localhost",
-		Port:     443,
-		Username: "root",
-		APIKey:   "test-key",
-	}
-
-	client, err := NewWebSocketClient(config)
-	if err != nil {
-		t.Fatalf("NewWebSocketClient() error = %v", err)
-	}
-	defer client.Close()
-
-	// Manually mark as connected to test ReadFile delegation
-	client.connected = true
-	client.version = truenas.Version{Major: 25, Minor: 0}
-
-	_, err = client.ReadFile(context.Background(), "/test")
-	if !errors.Is(err, ErrUnsupportedOperation) {
-		t.Errorf("ReadFile() error = %v, want ErrUnsupportedOperation", err)
-	}
-}
-
 func TestWebSocketClient_UnsupportedFallback_DeleteFile(t *testing.T) {
 	config := WebSocketConfig{
 		Host:     "localhost",
@@ -90,7 +90,7 @@ func (s *CloudSyncService) CreateCredential(ctx context.Context, opts CreateCred
 	attrs := credentialOptsToAttrsAny(opts.Attributes)
 	params := BuildCredentialsParams(s.version, opts.Name, opts.ProviderType, attrs)
 
-	result, err := s.client.Call(ctx, "cloudsync.credentials.create", params)
+	result, err := callMethod(ctx, s.client, s.version, "cloudsync.credentials.create", params)
 	if err != nil {
 		return nil, err
 	}
@@ -108,7 +108,7 @@ func (s *CloudSyncService) CreateCredential(ctx context.Context, opts CreateCred
 // GetCredential returns a cloud sync credential by ID, or nil if not found.
 func (s *CloudSyncService) GetCredential(ctx context.Context, id int64) (*CloudSyncCredential, error) {
 	filter := [][]any{{"id", "=", id}}
-	result, err := s.client.Call(ctx, "cloudsync.credentials.query", filter)
+	result, err := callMethod(ctx, s.client, s.version, "cloudsync.credentials.query", filter)
 	if err != nil {
 		return nil, err
 	}
@@ -128,7 +128,7 @@ func (s *CloudSyncService) GetCredential(ctx context.Context, id int64) (*CloudS
 
 // ListCredentials returns all cloud sync credentials.
 func (s *CloudSyncService) ListCredentials(ctx context.Context) ([]CloudSyncCredential, error) {
-	result, err := s.client.Call(ctx, "cloudsync.credentials.query", nil)
+	result, err := callMethod(ctx, s.client, s.version, "cloudsync.credentials.query", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -150,7 +150,7 @@ func (s *CloudSyncService) UpdateCredential(ctx context.Context, id int64, opts
 	attrs := credentialOptsToAttrsAny(opts.Attributes)
 	params := BuildCredentialsParams(s.version, opts.Name, opts.ProviderType, attrs)
 
-	_, err := s.client.Call(ctx, "cloudsync.credentials.update", []any{id, params})
+	_, err := callMethod(ctx, s.client, s.version, "cloudsync.credentials.update", []any{id, params})
 	if err != nil {
 		return nil, err
 	}
@@ -160,7 +160,7 @@ func (s *CloudSyncService) UpdateCredential(ctx context.Context, id int64, opts
 
 // DeleteCredential deletes a cloud sync credential by ID.
 func (s *CloudSyncService) DeleteCredential(ctx context.Context, id int64) error {
-	_, err := s.client.Call(ctx, "cloudsync.credentials.delete", id)
+	_, err := callMethod(ctx, s.client, s.version, "cloudsync.credentials.delete", id)
 	return err
 }
 
@@ -168,7 +168,7 @@ func (s *CloudSyncService) DeleteCredential(ctx context.Context, id int64) error
 func (s *CloudSyncService) CreateTask(ctx context.Context, opts CreateCloudSyncTaskOpts) (*CloudSyncTask, error) {
 	params := taskOptsToParams(opts)
 
-	result, err := s.client.Call(ctx, "cloudsync.create", params)
+	result, err := callMethod(ctx, s.client, s.version, "cloudsync.create", params)
 	if err != nil {
 		return nil, err
 	}
@@ -186,7 +186,7 @@ func (s *CloudSyncService) CreateTask(ctx context.Context, opts CreateCloudSyncT
 // GetTask returns a cloud sync task by ID, or nil if not found.
 func (s *CloudSyncService) GetTask(ctx context.Context, id int64) (*CloudSyncTask, error) {
 	filter := [][]any{{"id", "=", id}}
-	result, err := s.client.Call(ctx, "cloudsync.query", filter)
+	result, err := callMethod(ctx, s.client, s.version, "cloudsync.query", filter)
 	if err != nil {
 		return nil, err
 	}
@@ -206,7 +206,7 @@ func (s *CloudSyncService) GetTask(ctx context.Context, id int64) (*CloudSyncTas
 
 // ListTasks returns all cloud sync tasks.
 func (s *CloudSyncService) ListTasks(ctx context.Context) ([]CloudSyncTask, error) {
-	result, err := s.client.Call(ctx, "cloudsync.query", nil)
+	result, err := callMethod(ctx, s.client, s.version, "cloudsync.query", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -227,7 +227,7 @@ func (s *CloudSyncService) ListTasks(ctx context.Context) ([]CloudSyncTask, erro
 func (s *CloudSyncService) UpdateTask(ctx context.Context, id int64, opts UpdateCloudSyncTaskOpts) (*CloudSyncTask, error) {
 	params := taskOptsToParams(opts)
 
-	_, err := s.client.Call(ctx, "cloudsync.update", []any{id, params})
+	_, err := callMethod(ctx, s.client, s.version, "cloudsync.update", []any{id, params})
 	if err != nil {
 		return nil, err
 	}
@@ -237,13 +237,13 @@ func (s *CloudSyncService) UpdateTask(ctx context.Context, id int64, opts Update
 
 // DeleteTask deletes a cloud sync task by ID.
 func (s *CloudSyncService) DeleteTask(ctx context.Context, id int64) error {
-	_, err := s.client.Call(ctx, "cloudsync.delete", id)
+	_, err := callMethod(ctx, s.client, s.version, "cloudsync.delete", id)
 	return err
 }
 
 // Sync triggers a cloud sync task and waits for it to complete.
 func (s *CloudSyncService) Sync(ctx context.Context, id int64) error {
-	_, err := s.client.CallAndWait(ctx, "cloudsync.sync", id)
+	_, err := callMethodAndWait(ctx, s.client, s.version, "cloudsync.sync", id)
 	return err
 }
 
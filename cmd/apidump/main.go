@@ -0,0 +1,96 @@
+// Command apidump captures the method catalog of a running TrueNAS system
+// into api/<version>/methods.json, so new releases can be embedded next to
+// the existing ones.
+//
+// It connects using a client profile (see client.LoadConfig) and calls
+// core.get_methods, which returns every method with its flags and
+// accepts/returns schemas.
+//
+//	go run ./cmd/apidump -profile truenas-2510
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	truenas "github.com/deevus/truenas-go"
+	"github.com/deevus/truenas-go/api"
+	"github.com/deevus/truenas-go/client"
+)
+
+func main() {
+	config := flag.String("config", "", "config file path (default: $TRUENAS_CONFIG or user config dir)")
+	profile := flag.String("profile", "", "profile name (default: $TRUENAS_PROFILE or default_profile)")
+	output := flag.String("o", "api", "directory to write <version>/methods.json into")
+	timeout := flag.Duration("timeout", 2*time.Minute, "overall timeout")
+	flag.Parse()
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	if err := run(ctx, *config, *profile, *output); err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, config, profile, output string) error {
+	p, err := client.LoadConfig(config, profile)
+	if err != nil {
+		return err
+	}
+	c, err := client.New(ctx, p)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	path, count, err := dump(ctx, c, c.Version(), output)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("wrote %d methods to %s\n", count, path)
+	return nil
+}
+
+// dump fetches the catalog from c and writes it under output, returning the
+// file written and the number of methods.
+func dump(ctx context.Context, c truenas.Caller, v truenas.Version, output string) (string, int, error) {
+	if v.IsZero() {
+		return "", 0, fmt.Errorf("unknown TrueNAS version")
+	}
+
+	result, err := c.Call(ctx, "core.get_methods", nil)
+	if err != nil {
+		return "", 0, fmt.Errorf("core.get_methods: %w", err)
+	}
+
+	// Check the catalog parses the way the api package reads it.
+	var methods map[string]api.MethodDef
+	if err := json.Unmarshal(result, &methods); err != nil {
+		return "", 0, fmt.Errorf("parse core.get_methods response: %w", err)
+	}
+	if len(methods) == 0 {
+		return "", 0, fmt.Errorf("core.get_methods returned no methods")
+	}
+
+	dir := filepath.Join(output, catalogVersion(v))
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", 0, err
+	}
+	path := filepath.Join(dir, "methods.json")
+	if err := os.WriteFile(path, result, 0o644); err != nil {
+		return "", 0, err
+	}
+	return path, len(methods), nil
+}
+
+// catalogVersion names the catalog directory for v, e.g. "25.04".
+func catalogVersion(v truenas.Version) string {
+	return fmt.Sprintf("%d.%02d", v.Major, v.Minor)
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	truenas "github.com/deevus/truenas-go"
+	"github.com/deevus/truenas-go/client"
+)
+
+func TestCatalogVersion(t *testing.T) {
+	tests := []struct {
+		v    truenas.Version
+		want string
+	}{
+		{truenas.Version{Major: 25, Minor: 4, Patch: 2}, "25.04"},
+		{truenas.Version{Major: 25, Minor: 10}, "25.10"},
+		{truenas.Version{Major: 24, Minor: 10, Build: 4}, "24.10"},
+	}
+	for _, tt := range tests {
+		if got := catalogVersion(tt.v); got != tt.want {
+			t.Errorf("catalogVersion(%v) = %q, want %q", tt.v, got, tt.want)
+		}
+	}
+}
+
+func TestDump(t *testing.T) {
+	catalog := `{"system.info": {"job": false, "accepts": [], "returns": [{"type": "object"}]}, "app.create": {"job": true}}`
+	var gotMethod string
+	mock := &client.MockClient{
+		CallFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			gotMethod = method
+			return json.RawMessage(catalog), nil
+		},
+	}
+
+	dir := t.TempDir()
+	path, count, err := dump(context.Background(), mock, truenas.Version{Major: 25, Minor: 10, Patch: 1}, dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotMethod != "core.get_methods" {
+		t.Errorf("expected core.get_methods, got %q", gotMethod)
+	}
+	if count != 2 {
+		t.Errorf("expected 2 methods, got %d", count)
+	}
+	if want := filepath.Join(dir, "25.10", "methods.json"); path != want {
+		t.Errorf("path = %q, want %q", path, want)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != catalog {
+		t.Errorf("expected catalog written verbatim, got %s", data)
+	}
+}
+
+func TestDump_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		version truenas.Version
+		result  string
+		callErr error
+		wantErr string
+	}{
+		{"unknown version", truenas.Version{}, `{}`, nil, "unknown TrueNAS version"},
+		{"call error", truenas.Version{Major: 25, Minor: 4}, "", errors.New("boom"), "core.get_methods: boom"},
+		{"bad json", truenas.Version{Major: 25, Minor: 4}, `[]`, nil, "parse core.get_methods response"},
+		{"empty", truenas.Version{Major: 25, Minor: 4}, `{}`, nil, "no methods"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &client.MockClient{
+				CallFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+					return json.RawMessage(tt.result), tt.callErr
+				},
+			}
+			_, _, err := dump(context.Background(), mock, tt.version, t.TempDir())
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,622 @@
+// Command apigen generates versioned wire-format Go types and typed call
+// stubs from the embedded TrueNAS API schemas.
+//
+// For each embedded version (e.g. 25.04) it writes a package (e.g.
+// wire/v2504) with one file per top-level namespace. Every method gets a
+// function taking its positional parameters as typed arguments and
+// returning its decoded result.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/deevus/truenas-go/api"
+)
+
+// generatedHeader marks files written by apigen so stale ones can be removed.
+const generatedHeader = "// Code generated by apigen. DO NOT EDIT.\n"
+
+func main() {
+	output := flag.String("o", "wire", "output directory; one package per version is created inside")
+	version := flag.String("version", "", "TrueNAS version to generate (default: all embedded)")
+	flag.Parse()
+
+	if err := run(*output, *version); err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(output, version string) error {
+	versions := api.Versions()
+	if version != "" {
+		versions = []string{version}
+	}
+	if len(versions) == 0 {
+		return fmt.Errorf("no embedded API versions found")
+	}
+
+	for _, v := range versions {
+		methods, err := api.Methods(v)
+		if err != nil {
+			return err
+		}
+		files, err := generate(v, methods)
+		if err != nil {
+			return fmt.Errorf("generating %s: %w", v, err)
+		}
+		if err := writePackage(filepath.Join(output, packageName(v)), files); err != nil {
+			return fmt.Errorf("writing %s: %w", v, err)
+		}
+	}
+	return nil
+}
+
+// packageName converts a version like "25.04" into a package name like "v2504".
+func packageName(version string) string {
+	return "v" + strings.ReplaceAll(version, ".", "")
+}
+
+// writePackage replaces the generated files in dir with files.
+func writePackage(dir string, files map[string][]byte) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".go") {
+			continue
+		}
+		path := filepath.Join(dir, e.Name())
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if bytes.HasPrefix(data, []byte(generatedHeader)) {
+			if err := os.Remove(path); err != nil {
+				return err
+			}
+		}
+	}
+	for name, data := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), data, 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// generate renders the package for one version, keyed by file name.
+func generate(version string, methods map[string]api.MethodDef) (map[string][]byte, error) {
+	pkg := packageName(version)
+	g := &generator{pkg: pkg, version: version, used: make(map[string]bool)}
+
+	byTop := make(map[string][]string)
+	for name := range methods {
+		top := strings.SplitN(name, ".", 2)[0]
+		byTop[top] = append(byTop[top], name)
+	}
+	tops := make([]string, 0, len(byTop))
+	for top := range byTop {
+		tops = append(tops, top)
+	}
+	sort.Strings(tops)
+
+	// Reserve function names first so types never take them.
+	for _, top := range tops {
+		for _, name := range byTop[top] {
+			g.used[exportName(name)] = true
+		}
+	}
+
+	files := make(map[string][]byte)
+	for _, top := range tops {
+		names := byTop[top]
+		sort.Strings(names)
+
+		var body bytes.Buffer
+		for _, name := range names {
+			g.method(&body, name, methods[name])
+		}
+
+		src := g.fileHeader() + "import (\n\t\"context\"\n\n\ttruenas \"github.com/deevus/truenas-go\"\n)\n\n" + body.String()
+		formatted, err := format.Source([]byte(src))
+		if err != nil {
+			return nil, fmt.Errorf("format %s.go: %w", top, err)
+		}
+		files[top+".go"] = formatted
+	}
+
+	support, err := format.Source([]byte(g.supportFile()))
+	if err != nil {
+		return nil, fmt.Errorf("format call.go: %w", err)
+	}
+	files["call.go"] = support
+
+	doc, err := format.Source([]byte(g.docFile()))
+	if err != nil {
+		return nil, fmt.Errorf("format doc.go: %w", err)
+	}
+	files["doc.go"] = doc
+	return files, nil
+}
+
+// generator holds per-package state while rendering.
+type generator struct {
+	pkg     string
+	version string
+	used    map[string]bool // exported identifiers already declared
+}
+
+func (g *generator) fileHeader() string {
+	return generatedHeader + "\npackage " + g.pkg + "\n\n"
+}
+
+func (g *generator) docFile() string {
+	return generatedHeader + "\n" +
+		"// Package " + g.pkg + " contains wire-format types and typed call stubs for\n" +
+		"// the TrueNAS " + g.version + " API, generated from api/" + g.version + "/methods.json.\n" +
+		"//\n" +
+		"// Each API method maps to a function named after it (zfs.snapshot.create →\n" +
+		"// ZFSSnapshotCreate) that takes the method's positional parameters and\n" +
+		"// decodes its result. Job methods wait for the job to finish.\n" +
+		"package " + g.pkg + "\n"
+}
+
+func (g *generator) supportFile() string {
+	return g.fileHeader() + `import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	truenas "github.com/deevus/truenas-go"
+)
+
+// call invokes method and decodes the result into out, if non-nil.
+func call(ctx context.Context, c truenas.Caller, method string, args []any, out any) error {
+	result, err := c.Call(ctx, method, params(args))
+	if err != nil {
+		return err
+	}
+	return decode(method, result, out)
+}
+
+// callJob invokes a job method, waits for it, and decodes the result into out, if non-nil.
+func callJob(ctx context.Context, c truenas.AsyncCaller, method string, args []any, out any) error {
+	result, err := c.CallAndWait(ctx, method, params(args))
+	if err != nil {
+		return err
+	}
+	return decode(method, result, out)
+}
+
+// params converts positional arguments to the client params convention.
+func params(args []any) any {
+	if len(args) == 0 {
+		return nil
+	}
+	return args
+}
+
+// trimArgs drops trailing arguments that were not set, so the middleware
+// applies its defaults for them.
+func trimArgs(args []any, set ...bool) []any {
+	n := len(args)
+	for n > 0 && !set[n-1] {
+		n--
+	}
+	return args[:n]
+}
+
+func decode(method string, result json.RawMessage, out any) error {
+	if out == nil || len(result) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(result, out); err != nil {
+		return fmt.Errorf("%s: parse response: %w", method, err)
+	}
+	return nil
+}
+`
+}
+
+// method renders the call stub and types for one API method.
+func (g *generator) method(w *bytes.Buffer, name string, def api.MethodDef) {
+	funcName := exportName(name)
+	var types bytes.Buffer
+
+	type param struct {
+		name, typ, isSet string
+	}
+	var params []param
+	seen := map[string]bool{"ctx": true, "c": true}
+	for i, s := range def.Accepts {
+		pname := paramName(s, i)
+		for seen[pname] {
+			pname += "_"
+		}
+		seen[pname] = true
+
+		typ := g.fieldType(&types, s, paramTypeName(funcName, schemaName(s, i)), s.Required)
+		isSet := "true"
+		if nilable(typ) {
+			isSet = pname + " != nil"
+		}
+		params = append(params, param{pname, typ, isSet})
+	}
+
+	resultType := ""
+	if len(def.Returns) > 0 && !isNull(def.Returns[0]) {
+		ret := def.Returns[0]
+		if def.Filterable {
+			ret = queryListSchema(ret)
+		}
+		resultType = g.fieldType(&types, ret, funcName+"Result", true)
+	}
+
+	w.Write(types.Bytes())
+
+	fmt.Fprintf(w, "// %s calls %s.\n", funcName, name)
+	if def.Description != nil {
+		if summary := summarize(*def.Description); summary != "" {
+			fmt.Fprintf(w, "//\n// %s\n", summary)
+		}
+	}
+
+	caller, helper := "truenas.Caller", "call"
+	if def.Job {
+		caller, helper = "truenas.AsyncCaller", "callJob"
+	}
+
+	var sig []string
+	var argNames, setExprs []string
+	for _, p := range params {
+		sig = append(sig, p.name+" "+p.typ)
+		argNames = append(argNames, p.name)
+		setExprs = append(setExprs, p.isSet)
+	}
+	sigStr := "ctx context.Context, c " + caller
+	if len(sig) > 0 {
+		sigStr += ", " + strings.Join(sig, ", ")
+	}
+
+	args := "nil"
+	if len(params) > 0 {
+		args = fmt.Sprintf("trimArgs([]any{%s}, %s)", strings.Join(argNames, ", "), strings.Join(setExprs, ", "))
+	}
+
+	if resultType == "" {
+		fmt.Fprintf(w, "func %s(%s) error {\n", funcName, sigStr)
+		fmt.Fprintf(w, "\treturn %s(ctx, c, %q, %s, nil)\n}\n\n", helper, name, args)
+		return
+	}
+	fmt.Fprintf(w, "func %s(%s) (%s, error) {\n", funcName, sigStr, resultType)
+	fmt.Fprintf(w, "\tvar out %s\n", resultType)
+	fmt.Fprintf(w, "\terr := %s(ctx, c, %q, %s, &out)\n", helper, name, args)
+	fmt.Fprintf(w, "\treturn out, err\n}\n\n")
+}
+
+// fieldType returns the Go type for a parameter, result or struct field.
+// Optional and nullable scalars become pointers so that unset values are
+// distinguishable from zero values.
+func (g *generator) fieldType(w *bytes.Buffer, s *api.Schema, hint string, required bool) string {
+	base := g.typeOf(w, s, hint)
+	if nilable(base) {
+		return base
+	}
+	if !required || isNullable(s) {
+		return "*" + base
+	}
+	return base
+}
+
+// typeOf returns the base (non-pointer) Go type for s, declaring named
+// struct types in w as needed.
+func (g *generator) typeOf(w *bytes.Buffer, s *api.Schema, hint string) string {
+	if s == nil {
+		return "any"
+	}
+
+	if alts := unionAlternatives(s); alts != nil {
+		if len(alts) == 1 {
+			return g.typeOf(w, alts[0], hint)
+		}
+		return "any"
+	}
+
+	types := nonNullTypes(s.Type)
+	if len(types) == 0 && len(s.Properties) > 0 {
+		types = []string{"object"}
+	}
+	if len(types) != 1 {
+		return "any"
+	}
+
+	switch types[0] {
+	case "string":
+		return "string"
+	case "integer":
+		return "int64"
+	case "number", "float":
+		return "float64"
+	case "boolean":
+		return "bool"
+	case "array":
+		if len(s.Items) == 1 {
+			return "[]" + g.typeOf(w, s.Items[0], hint+"Item")
+		}
+		return "[]any"
+	case "object":
+		if len(s.Properties) > 0 {
+			return g.structType(w, s, hint)
+		}
+		if s.AdditionalProperties != nil && s.AdditionalProperties.Schema != nil {
+			return "map[string]" + g.typeOf(w, s.AdditionalProperties.Schema, hint+"Value")
+		}
+		return "map[string]any"
+	}
+	return "any"
+}
+
+// structType declares a struct for an object schema and returns its name.
+func (g *generator) structType(w *bytes.Buffer, s *api.Schema, hint string) string {
+	name := g.unique(hint)
+
+	var fields bytes.Buffer
+	seen := make(map[string]bool)
+	for _, key := range propertyOrder(s) {
+		prop := s.Properties[key]
+		required := prop.Required
+		for _, r := range s.RequiredProperties {
+			if r == key {
+				required = true
+			}
+		}
+
+		fieldName := exportName(key)
+		for seen[fieldName] {
+			fieldName += "_"
+		}
+		seen[fieldName] = true
+
+		typ := g.fieldType(w, prop, name+fieldName, required)
+		tag := key
+		if !required {
+			tag += ",omitempty"
+		}
+		if prop.Description != nil {
+			if summary := summarize(*prop.Description); summary != "" {
+				fmt.Fprintf(&fields, "\t// %s\n", summary)
+			}
+		}
+		fmt.Fprintf(&fields, "\t%s %s `json:%q`\n", fieldName, typ, tag)
+	}
+
+	fmt.Fprintf(w, "// %s is the %q object.\n", name, schemaTitle(s))
+	fmt.Fprintf(w, "type %s struct {\n%s}\n\n", name, fields.String())
+	return name
+}
+
+// unique reserves an exported identifier, adding a numeric suffix on clash.
+func (g *generator) unique(name string) string {
+	candidate := name
+	for i := 2; g.used[candidate]; i++ {
+		candidate = fmt.Sprintf("%s%d", name, i)
+	}
+	g.used[candidate] = true
+	return candidate
+}
+
+// unionAlternatives returns the non-null anyOf/oneOf alternatives of s, or
+// nil if s is not a union.
+func unionAlternatives(s *api.Schema) []*api.Schema {
+	alts := s.AnyOf
+	if len(alts) == 0 {
+		alts = s.OneOf
+	}
+	if len(alts) == 0 {
+		return nil
+	}
+	var out []*api.Schema
+	for _, a := range alts {
+		if !isNull(a) {
+			out = append(out, a)
+		}
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
+
+// queryListSchema picks the list-of-entries shape from a query method's
+// result. Query results are a union of list, single entry (get) and count
+// depending on options; the plain list is the default.
+func queryListSchema(s *api.Schema) *api.Schema {
+	for _, alt := range unionAlternatives(s) {
+		if alt.Type.Has("array") {
+			return alt
+		}
+	}
+	return s
+}
+
+// isNull reports whether s only admits null.
+func isNull(s *api.Schema) bool {
+	return s != nil && len(s.Type) == 1 && s.Type[0] == "null"
+}
+
+// isNullable reports whether s admits null alongside other values.
+func isNullable(s *api.Schema) bool {
+	if s.Nullable || s.Type.Has("null") {
+		return true
+	}
+	for _, alts := range [][]*api.Schema{s.AnyOf, s.OneOf} {
+		for _, a := range alts {
+			if isNull(a) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func nonNullTypes(t api.SchemaType) []string {
+	var out []string
+	for _, name := range t {
+		if name != "null" {
+			out = append(out, name)
+		}
+	}
+	return out
+}
+
+// nilable reports whether a Go type already has a nil value.
+func nilable(typ string) bool {
+	return typ == "any" || strings.HasPrefix(typ, "[]") || strings.HasPrefix(typ, "map[") || strings.HasPrefix(typ, "*")
+}
+
+// propertyOrder returns property keys in declared order, then any others sorted.
+func propertyOrder(s *api.Schema) []string {
+	var keys []string
+	seen := make(map[string]bool)
+	for _, k := range s.AttrsOrder {
+		if _, ok := s.Properties[k]; ok && !seen[k] {
+			keys = append(keys, k)
+			seen[k] = true
+		}
+	}
+	var rest []string
+	for k := range s.Properties {
+		if !seen[k] {
+			rest = append(rest, k)
+		}
+	}
+	sort.Strings(rest)
+	return append(keys, rest...)
+}
+
+// paramTypeName names the type of a method parameter. Parameters named after
+// the method itself (zfs.snapshot.create's "snapshot_create") become
+// <Func>Params rather than repeating the name.
+func paramTypeName(funcName, param string) string {
+	suffix := exportName(param)
+	if strings.HasSuffix(funcName, suffix) {
+		return funcName + "Params"
+	}
+	return funcName + suffix
+}
+
+func schemaName(s *api.Schema, index int) string {
+	if s.Name != "" {
+		return s.Name
+	}
+	return fmt.Sprintf("arg%d", index)
+}
+
+func schemaTitle(s *api.Schema) string {
+	if s.Title != "" {
+		return s.Title
+	}
+	return s.Name
+}
+
+// summarize returns the first sentence of a description's first paragraph,
+// joined onto one line and trimmed for a comment.
+func summarize(desc string) string {
+	paragraph, _, _ := strings.Cut(strings.TrimSpace(desc), "\n\n")
+	line := strings.Join(strings.Fields(paragraph), " ")
+	for i := 0; i+2 < len(line); i++ {
+		if line[i] == '.' && line[i+1] == ' ' && unicode.IsUpper(rune(line[i+2])) {
+			line = line[:i+1]
+			break
+		}
+	}
+	const max = 120
+	if len(line) > max {
+		cut := strings.LastIndexByte(line[:max], ' ')
+		if cut <= 0 {
+			cut = max
+		}
+		line = strings.TrimSpace(line[:cut]) + "..."
+	}
+	return line
+}
+
+// initialisms are rendered in upper case in exported names.
+var initialisms = map[string]bool{
+	"ACL": true, "API": true, "CPU": true, "DNS": true, "GID": true, "GPU": true,
+	"GUI": true, "HTTP": true, "HTTPS": true, "ID": true, "IO": true, "IP": true,
+	"JSON": true, "LDAP": true, "MTU": true, "NFS": true, "NTP": true, "OS": true,
+	"SMB": true, "SNMP": true, "SQL": true, "SSH": true, "SSL": true, "TCP": true, "TLS": true,
+	"TTL": true, "UDP": true, "UID": true, "UPS": true, "URI": true, "URL": true,
+	"UUID": true, "VM": true, "VNC": true, "ZFS": true,
+}
+
+// exportName converts an API name like "zfs.snapshot.create" or
+// "query-filters" into an exported Go identifier.
+func exportName(s string) string {
+	var b strings.Builder
+	for _, part := range splitWords(s) {
+		upper := strings.ToUpper(part)
+		if initialisms[upper] {
+			b.WriteString(upper)
+			continue
+		}
+		b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	name := b.String()
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "X" + name
+	}
+	return name
+}
+
+// paramName converts a schema's _name_ into an unexported parameter name.
+func paramName(s *api.Schema, index int) string {
+	words := splitWords(schemaName(s, index))
+	if len(words) == 0 {
+		return fmt.Sprintf("arg%d", index)
+	}
+	var b strings.Builder
+	b.WriteString(strings.ToLower(words[0]))
+	for _, w := range words[1:] {
+		b.WriteString(strings.ToUpper(w[:1]) + w[1:])
+	}
+	name := b.String()
+	if name[0] >= '0' && name[0] <= '9' {
+		name = "arg" + name
+	}
+	if goKeywords[name] {
+		name += "_"
+	}
+	return name
+}
+
+var goKeywords = map[string]bool{
+	"break": true, "case": true, "chan": true, "const": true, "continue": true,
+	"default": true, "defer": true, "else": true, "fallthrough": true, "for": true,
+	"func": true, "go": true, "goto": true, "if": true, "import": true,
+	"interface": true, "map": true, "package": true, "range": true, "return": true,
+	"select": true, "struct": true, "switch": true, "type": true, "var": true,
+	// Predeclared identifiers used by generated code.
+	"any": true, "err": true, "out": true, "true": true, "nil": true,
+}
+
+// splitWords splits s on any character that is not a letter or digit.
+func splitWords(s string) []string {
+	return strings.FieldsFunc(s, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9')
+	})
+}
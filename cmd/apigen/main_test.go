@@ -0,0 +1,285 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/deevus/truenas-go/api"
+)
+
+func TestExportName(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"zfs.snapshot.create", "ZFSSnapshotCreate"},
+		{"pool.dataset.query", "PoolDatasetQuery"},
+		{"vm.get_instance", "VMGetInstance"},
+		{"query-filters", "QueryFilters"},
+		{"force_sql_filters", "ForceSQLFilters"},
+		{"AES-128-GCM", "AES128GCM"},
+		{"LDAP server name", "LDAPServerName"},
+		{"1password", "X1password"},
+		{"", "X"},
+	}
+	for _, tt := range tests {
+		if got := exportName(tt.in); got != tt.want {
+			t.Errorf("exportName(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParamName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"query-filters", "queryFilters"},
+		{"id", "id"},
+		{"type", "type_"},
+		{"err", "err_"},
+		{"2fa", "arg2fa"},
+		{"", "arg3"},
+	}
+	for _, tt := range tests {
+		if got := paramName(&api.Schema{Name: tt.name}, 3); got != tt.want {
+			t.Errorf("paramName(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestParamTypeName(t *testing.T) {
+	if got := paramTypeName("ZFSSnapshotCreate", "snapshot_create"); got != "ZFSSnapshotCreateParams" {
+		t.Errorf("got %q, want ZFSSnapshotCreateParams", got)
+	}
+	if got := paramTypeName("ZFSSnapshotDelete", "options"); got != "ZFSSnapshotDeleteOptions" {
+		t.Errorf("got %q, want ZFSSnapshotDeleteOptions", got)
+	}
+}
+
+func TestPackageName(t *testing.T) {
+	if got := packageName("25.04"); got != "v2504" {
+		t.Errorf("packageName = %q, want v2504", got)
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	tests := []struct {
+		name string
+		desc string
+		want string
+	}{
+		{"one line", "Size in bytes.", "Size in bytes."},
+		{"first sentence", "Returns instance matching `id`. If `id` is not found, Validation error is raised.", "Returns instance matching `id`."},
+		{"wrapped sentence", "Leave Active Directory domain. This will remove computer\nobject from AD.", "Leave Active Directory domain."},
+		{"joined lines", "Get information about long-running jobs\nowned by the session.\nMore detail.", "Get information about long-running jobs owned by the session."},
+		{"first paragraph", "Download logs of job `id`\n\nSee `core.download`.", "Download logs of job `id`"},
+		{"abbreviation", "Bind to a port, e.g. 8080. Defaults to any.", "Bind to a port, e.g. 8080."},
+		{"long", strings.Repeat("word ", 30), strings.TrimSpace(strings.Repeat("word ", 24)) + "..."},
+		{"empty", "  \n", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := summarize(tt.desc); got != tt.want {
+				t.Errorf("summarize(%q) = %q, want %q", tt.desc, got, tt.want)
+			}
+		})
+	}
+}
+
+func parseSchema(t *testing.T, src string) *api.Schema {
+	t.Helper()
+	var s api.Schema
+	if err := json.Unmarshal([]byte(src), &s); err != nil {
+		t.Fatalf("unmarshal schema: %v", err)
+	}
+	return &s
+}
+
+func TestFieldType(t *testing.T) {
+	tests := []struct {
+		name     string
+		schema   string
+		required bool
+		want     string
+	}{
+		{"string", `{"type":"string"}`, true, "string"},
+		{"optional string", `{"type":"string"}`, false, "*string"},
+		{"nullable integer", `{"type":["integer","null"]}`, true, "*int64"},
+		{"nullable flag", `{"type":"boolean","nullable":true}`, true, "*bool"},
+		{"float", `{"type":"float"}`, true, "float64"},
+		{"array of strings", `{"type":"array","items":[{"type":"string"}]}`, false, "[]string"},
+		{"untyped array", `{"type":"array","items":[]}`, true, "[]any"},
+		{"free object", `{"type":"object","properties":{},"additionalProperties":true}`, true, "map[string]any"},
+		{"typed map", `{"type":"object","additionalProperties":{"type":"string"}}`, true, "map[string]string"},
+		{"nullable anyOf", `{"anyOf":[{"type":"string"},{"type":"null"}]}`, true, "*string"},
+		{"union", `{"anyOf":[{"type":"string"},{"type":"integer"}]}`, true, "any"},
+		{"no type", `{}`, true, "any"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &generator{used: make(map[string]bool)}
+			var w bytes.Buffer
+			if got := g.fieldType(&w, parseSchema(t, tt.schema), "T", tt.required); got != tt.want {
+				t.Errorf("fieldType = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStructType(t *testing.T) {
+	g := &generator{used: map[string]bool{"Thing": true}}
+	var w bytes.Buffer
+	s := parseSchema(t, `{
+		"type": "object",
+		"title": "thing",
+		"properties": {
+			"name": {"type": "string", "_required_": true},
+			"size": {"type": "integer", "description": "Size in bytes.\nMore detail."},
+			"labels": {"type": "object", "properties": {"key": {"type": "string"}}}
+		},
+		"_attrs_order_": ["size", "name"]
+	}`)
+
+	name := g.typeOf(&w, s, "Thing")
+	if name != "Thing2" {
+		t.Errorf("expected unique name Thing2, got %q", name)
+	}
+
+	out := w.String()
+	for _, want := range []string{
+		"type Thing2Labels struct",
+		"Key *string `json:\"key,omitempty\"`",
+		"type Thing2 struct",
+		"// Size in bytes.",
+		"Size *int64 `json:\"size,omitempty\"`",
+		"Name string `json:\"name\"`",
+		"Labels *Thing2Labels `json:\"labels,omitempty\"`",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in output:\n%s", want, out)
+		}
+	}
+	if strings.Index(out, "Size") > strings.Index(out, "Name string") {
+		t.Error("expected _attrs_order_ to put size before name")
+	}
+}
+
+func TestGenerate_Method(t *testing.T) {
+	desc := "Do a thing.\nDetails."
+	methods := map[string]api.MethodDef{
+		"thing.do": {
+			Description: &desc,
+			Accepts: []*api.Schema{
+				parseSchema(t, `{"_name_":"id","type":"integer","_required_":true}`),
+				parseSchema(t, `{"_name_":"options","type":"object","properties":{"force":{"type":"boolean"}}}`),
+			},
+			Returns: []*api.Schema{parseSchema(t, `{"type":"boolean"}`)},
+		},
+		"thing.run": {
+			Job:     true,
+			Returns: []*api.Schema{parseSchema(t, `{"type":"null"}`)},
+		},
+		"thing.query": {
+			Filterable: true,
+			Accepts:    []*api.Schema{parseSchema(t, `{"_name_":"filters","type":"array","items":[]}`)},
+			Returns: []*api.Schema{parseSchema(t, `{"anyOf":[
+				{"type":"array","items":[{"type":"object","properties":{"id":{"type":"integer","_required_":true}}}]},
+				{"type":"integer"}
+			]}`)},
+		},
+	}
+
+	files, err := generate("25.04", methods)
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+	for _, name := range []string{"thing.go", "call.go", "doc.go"} {
+		data, ok := files[name]
+		if !ok {
+			t.Fatalf("expected %s", name)
+		}
+		if !bytes.HasPrefix(data, []byte(generatedHeader)) {
+			t.Errorf("%s: missing generated header", name)
+		}
+		if !bytes.Contains(data, []byte("package v2504")) {
+			t.Errorf("%s: missing package clause", name)
+		}
+	}
+
+	src := string(files["thing.go"])
+	for _, want := range []string{
+		"// ThingDo calls thing.do.\n//\n// Do a thing.\n",
+		"func ThingDo(ctx context.Context, c truenas.Caller, id int64, options *ThingDoOptions) (bool, error)",
+		`call(ctx, c, "thing.do", trimArgs([]any{id, options}, true, options != nil), &out)`,
+		"func ThingRun(ctx context.Context, c truenas.AsyncCaller) error",
+		`callJob(ctx, c, "thing.run", nil, nil)`,
+		"func ThingQuery(ctx context.Context, c truenas.Caller, filters []any) ([]ThingQueryResultItem, error)",
+		"ID int64 `json:\"id\"`",
+	} {
+		if !strings.Contains(src, want) {
+			t.Errorf("expected %q in thing.go:\n%s", want, src)
+		}
+	}
+}
+
+func TestWritePackage_ReplacesGeneratedFiles(t *testing.T) {
+	dir := t.TempDir()
+	stale := filepath.Join(dir, "stale.go")
+	manual := filepath.Join(dir, "manual.go")
+	if err := os.WriteFile(stale, []byte(generatedHeader+"\npackage x\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(manual, []byte("package x\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := writePackage(dir, map[string][]byte{"new.go": []byte(generatedHeader)}); err != nil {
+		t.Fatalf("writePackage: %v", err)
+	}
+
+	if _, err := os.Stat(stale); !os.IsNotExist(err) {
+		t.Error("expected stale generated file to be removed")
+	}
+	if _, err := os.Stat(manual); err != nil {
+		t.Error("expected hand-written file to be kept")
+	}
+	if _, err := os.Stat(filepath.Join(dir, "new.go")); err != nil {
+		t.Error("expected new file to be written")
+	}
+}
+
+func TestRun_InvalidVersion(t *testing.T) {
+	if err := run(t.TempDir(), "99.99"); err == nil {
+		t.Fatal("expected error for invalid version")
+	}
+}
+
+// TestGeneratedUpToDate fails when wire/ is stale; run go generate ./wire.
+func TestGeneratedUpToDate(t *testing.T) {
+	for _, version := range api.Versions() {
+		methods, err := api.Methods(version)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files, err := generate(version, methods)
+		if err != nil {
+			t.Fatalf("generate %s: %v", version, err)
+		}
+		dir := filepath.Join("..", "..", "wire", packageName(version))
+		for name, want := range files {
+			got, err := os.ReadFile(filepath.Join(dir, name))
+			if err != nil {
+				t.Errorf("%s: %v (run go generate ./wire)", name, err)
+				continue
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("%s/%s is out of date (run go generate ./wire)", packageName(version), name)
+			}
+		}
+	}
+}
@@ -0,0 +1,352 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	truenas "github.com/deevus/truenas-go"
+	"github.com/deevus/truenas-go/api"
+)
+
+// catalog is a method catalog loaded from an embedded version or a file.
+type catalog struct {
+	Label   string          // e.g. "25.04" or "api/25.10/methods.json"
+	Version truenas.Version // zero if the label is not a version
+	Methods map[string]api.MethodDef
+}
+
+// rename records a method that moved between versions.
+type rename struct {
+	From, To string
+	Source   string // "registry" or "heuristic"
+}
+
+// flagChange records a changed boolean method flag.
+type flagChange struct {
+	Method   string
+	Flag     string
+	From, To bool
+}
+
+// breakage records an implemented Go method that would fail on the target version.
+type breakage struct {
+	ServiceStruct string
+	GoMethodName  string
+	APIMethod     string
+	Reason        string
+}
+
+// catalogDiff is the difference between two method catalogs.
+type catalogDiff struct {
+	Added    []string
+	Removed  []string
+	Renamed  []rename
+	Changed  []flagChange
+	Breaking []breakage
+}
+
+// runDiff implements "featurematrix diff".
+func runDiff(args []string) error {
+	fs := flag.NewFlagSet("diff", flag.ContinueOnError)
+	dir := fs.String("dir", ".", "project root directory")
+	output := fs.String("o", "", "output file path (default: stdout)")
+	from := fs.String("from", "", "base version or methods.json path (required)")
+	to := fs.String("to", "", "target version or methods.json path (default: latest embedded)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *from == "" {
+		return fmt.Errorf("diff: -from is required")
+	}
+	if *to == "" {
+		*to = api.LatestVersion()
+	}
+
+	fromCat, err := loadCatalog(*from)
+	if err != nil {
+		return err
+	}
+	toCat, err := loadCatalog(*to)
+	if err != nil {
+		return err
+	}
+
+	goMethods, err := scanGoMethods(*dir)
+	if err != nil {
+		return fmt.Errorf("scanning go source: %w", err)
+	}
+
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	writeDiff(w, fromCat, toCat, diffCatalogs(fromCat, toCat, goMethods))
+	return nil
+}
+
+// loadCatalog loads an embedded version (e.g. "25.10") or, if ref names a
+// .json file, a catalog captured with cmd/apidump. For files under a
+// version directory (api/25.10/methods.json) the version and label are
+// taken from the directory name.
+func loadCatalog(ref string) (*catalog, error) {
+	if !strings.HasSuffix(ref, ".json") {
+		methods, err := api.Methods(ref)
+		if err != nil {
+			return nil, err
+		}
+		return &catalog{Label: ref, Version: parseCatalogVersion(ref), Methods: methods}, nil
+	}
+
+	data, err := os.ReadFile(ref)
+	if err != nil {
+		return nil, err
+	}
+	var methods map[string]api.MethodDef
+	if err := json.Unmarshal(data, &methods); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", ref, err)
+	}
+	label := ref
+	dirName := filepath.Base(filepath.Dir(ref))
+	version := parseCatalogVersion(dirName)
+	if !version.IsZero() {
+		label = dirName
+	}
+	return &catalog{Label: label, Version: version, Methods: methods}, nil
+}
+
+// parseCatalogVersion parses "25.10" into a Version, or returns the zero Version.
+func parseCatalogVersion(s string) truenas.Version {
+	v, err := truenas.ParseVersion(s + ".0")
+	if err != nil {
+		return truenas.Version{}
+	}
+	return v
+}
+
+// diffCatalogs compares two catalogs and checks the Go methods against the target.
+func diffCatalogs(from, to *catalog, goMethods []goMethod) catalogDiff {
+	var d catalogDiff
+
+	added := make(map[string]bool)
+	removed := make(map[string]bool)
+	for name := range to.Methods {
+		if _, ok := from.Methods[name]; !ok {
+			added[name] = true
+		}
+	}
+	for name, fromDef := range from.Methods {
+		toDef, ok := to.Methods[name]
+		if !ok {
+			removed[name] = true
+			continue
+		}
+		for _, c := range []flagChange{
+			{Method: name, Flag: "job", From: fromDef.Job, To: toDef.Job},
+			{Method: name, Flag: "filterable", From: fromDef.Filterable, To: toDef.Filterable},
+		} {
+			if c.From != c.To {
+				d.Changed = append(d.Changed, c)
+			}
+		}
+	}
+
+	// Renames declared in the method registry.
+	for _, op := range truenas.RegisteredOperations() {
+		fromSpec, errFrom := truenas.ResolveMethod(from.Version, op)
+		toSpec, errTo := truenas.ResolveMethod(to.Version, op)
+		if errFrom != nil || errTo != nil || fromSpec.Method == toSpec.Method {
+			continue
+		}
+		if removed[fromSpec.Method] && added[toSpec.Method] {
+			d.Renamed = append(d.Renamed, rename{From: fromSpec.Method, To: toSpec.Method, Source: "registry"})
+			delete(removed, fromSpec.Method)
+			delete(added, toSpec.Method)
+		}
+	}
+
+	// Heuristic renames: a removed and an added method sharing the same
+	// trailing "resource.action" (zfs.snapshot.create → pool.snapshot.create),
+	// when the pairing is unambiguous.
+	addedByTail := make(map[string][]string)
+	for name := range added {
+		addedByTail[methodTail(name)] = append(addedByTail[methodTail(name)], name)
+	}
+	removedByTail := make(map[string][]string)
+	for name := range removed {
+		removedByTail[methodTail(name)] = append(removedByTail[methodTail(name)], name)
+	}
+	for tail, rs := range removedByTail {
+		as := addedByTail[tail]
+		if tail == "" || len(rs) != 1 || len(as) != 1 {
+			continue
+		}
+		d.Renamed = append(d.Renamed, rename{From: rs[0], To: as[0], Source: "heuristic"})
+		delete(removed, rs[0])
+		delete(added, as[0])
+	}
+
+	d.Added = sortedSet(added)
+	d.Removed = sortedSet(removed)
+	sort.Slice(d.Renamed, func(i, j int) bool { return d.Renamed[i].From < d.Renamed[j].From })
+	sort.Slice(d.Changed, func(i, j int) bool {
+		if d.Changed[i].Method != d.Changed[j].Method {
+			return d.Changed[i].Method < d.Changed[j].Method
+		}
+		return d.Changed[i].Flag < d.Changed[j].Flag
+	})
+
+	d.Breaking = findBreakages(from, to, goMethods)
+	return d
+}
+
+// findBreakages reports Go methods whose API method on the base version
+// would be missing or behave differently on the target version, after the
+// method registry's routing is applied.
+func findBreakages(from, to *catalog, goMethods []goMethod) []breakage {
+	opOf := make(map[string]string) // API method → registered operation
+	for _, op := range truenas.RegisteredOperations() {
+		for _, spec := range truenas.OperationMethods(op) {
+			opOf[spec.Method] = op
+		}
+	}
+
+	var out []breakage
+	seen := make(map[string]bool)
+	for _, gm := range goMethods {
+		fromDef, ok := from.Methods[gm.APIMethod]
+		if !ok {
+			// Not a method this Go code calls on the base version.
+			continue
+		}
+
+		target := gm.APIMethod
+		if op, ok := opOf[gm.APIMethod]; ok {
+			spec, err := truenas.ResolveMethod(to.Version, op)
+			if err != nil {
+				out = appendBreakage(out, seen, gm, "not supported on "+to.Label+" per method registry")
+				continue
+			}
+			target = spec.Method
+		}
+
+		toDef, ok := to.Methods[target]
+		switch {
+		case !ok:
+			out = appendBreakage(out, seen, gm, fmt.Sprintf("%s missing in %s", target, to.Label))
+		case fromDef.Job != toDef.Job:
+			out = appendBreakage(out, seen, gm, fmt.Sprintf("%s job flag changed (%t → %t)", target, fromDef.Job, toDef.Job))
+		case fromDef.Filterable && !toDef.Filterable:
+			out = appendBreakage(out, seen, gm, fmt.Sprintf("%s no longer filterable", target))
+		}
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].ServiceStruct != out[j].ServiceStruct {
+			return out[i].ServiceStruct < out[j].ServiceStruct
+		}
+		if out[i].GoMethodName != out[j].GoMethodName {
+			return out[i].GoMethodName < out[j].GoMethodName
+		}
+		return out[i].APIMethod < out[j].APIMethod
+	})
+	return out
+}
+
+func appendBreakage(out []breakage, seen map[string]bool, gm goMethod, reason string) []breakage {
+	key := gm.ServiceStruct + "." + gm.GoMethodName + "." + gm.APIMethod
+	if seen[key] {
+		return out
+	}
+	seen[key] = true
+	return append(out, breakage{
+		ServiceStruct: gm.ServiceStruct,
+		GoMethodName:  gm.GoMethodName,
+		APIMethod:     gm.APIMethod,
+		Reason:        reason,
+	})
+}
+
+// methodTail returns the last two segments of a method name
+// ("zfs.snapshot.create" → "snapshot.create"), or "" for shorter names.
+func methodTail(method string) string {
+	parts := strings.Split(method, ".")
+	if len(parts) < 3 {
+		return ""
+	}
+	return strings.Join(parts[len(parts)-2:], ".")
+}
+
+func sortedSet(m map[string]bool) []string {
+	out := make([]string, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// writeDiff renders the diff as markdown.
+func writeDiff(w io.Writer, from, to *catalog, d catalogDiff) {
+	fmt.Fprintf(w, "# TrueNAS API Diff: %s → %s\n\n", from.Label, to.Label)
+	fmt.Fprintf(w, "> Auto-generated by `go run ./cmd/featurematrix diff`. Do not edit manually.\n\n")
+	fmt.Fprintf(w, "Added: %d | Removed: %d | Renamed: %d | Flag changes: %d | Breaking Go methods: %d\n\n",
+		len(d.Added), len(d.Removed), len(d.Renamed), len(d.Changed), len(d.Breaking))
+
+	if len(d.Breaking) > 0 {
+		fmt.Fprintf(w, "## Breaking Go Methods (%d)\n\n", len(d.Breaking))
+		fmt.Fprintf(w, "| Go Service | Go Method | API Method | Reason |\n")
+		fmt.Fprintf(w, "|------------|-----------|------------|--------|\n")
+		for _, b := range d.Breaking {
+			fmt.Fprintf(w, "| %s | %s | %s | %s |\n", b.ServiceStruct, b.GoMethodName, b.APIMethod, b.Reason)
+		}
+		fmt.Fprintln(w)
+	}
+
+	if len(d.Renamed) > 0 {
+		fmt.Fprintf(w, "## Renamed (%d)\n\n", len(d.Renamed))
+		fmt.Fprintf(w, "| From | To | Source |\n")
+		fmt.Fprintf(w, "|------|----|--------|\n")
+		for _, r := range d.Renamed {
+			fmt.Fprintf(w, "| %s | %s | %s |\n", r.From, r.To, r.Source)
+		}
+		fmt.Fprintln(w)
+	}
+
+	if len(d.Changed) > 0 {
+		fmt.Fprintf(w, "## Flag Changes (%d)\n\n", len(d.Changed))
+		fmt.Fprintf(w, "| API Method | Flag | %s | %s |\n", from.Label, to.Label)
+		fmt.Fprintf(w, "|------------|------|:--:|:--:|\n")
+		for _, c := range d.Changed {
+			fmt.Fprintf(w, "| %s | %s | %t | %t |\n", c.Method, c.Flag, c.From, c.To)
+		}
+		fmt.Fprintln(w)
+	}
+
+	writeMethodList(w, "Removed", d.Removed)
+	writeMethodList(w, "Added", d.Added)
+}
+
+func writeMethodList(w io.Writer, title string, methods []string) {
+	if len(methods) == 0 {
+		return
+	}
+	fmt.Fprintf(w, "## %s (%d)\n\n", title, len(methods))
+	fmt.Fprintf(w, "| Namespace | API Method |\n")
+	fmt.Fprintf(w, "|-----------|------------|\n")
+	for _, m := range methods {
+		fmt.Fprintf(w, "| %s | %s |\n", api.Namespace(m), m)
+	}
+	fmt.Fprintln(w)
+}
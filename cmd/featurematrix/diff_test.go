@@ -0,0 +1,254 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/deevus/truenas-go/api"
+)
+
+func testCatalog(label string, methods map[string]api.MethodDef) *catalog {
+	return &catalog{Label: label, Version: parseCatalogVersion(label), Methods: methods}
+}
+
+func TestMethodTail(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"zfs.snapshot.create", "snapshot.create"},
+		{"pool.dataset.user_prop.set", "user_prop.set"},
+		{"app.query", ""},
+		{"ping", ""},
+	}
+	for _, tt := range tests {
+		if got := methodTail(tt.in); got != tt.want {
+			t.Errorf("methodTail(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseCatalogVersion(t *testing.T) {
+	if got := parseCatalogVersion("25.10"); got.Major != 25 || got.Minor != 10 {
+		t.Errorf("parseCatalogVersion(25.10) = %v", got)
+	}
+	if got := parseCatalogVersion("api"); !got.IsZero() {
+		t.Errorf("expected zero version for non-version label, got %v", got)
+	}
+}
+
+func TestLoadCatalog_Embedded(t *testing.T) {
+	cat, err := loadCatalog("25.04")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cat.Label != "25.04" || cat.Version.Major != 25 || cat.Version.Minor != 4 {
+		t.Errorf("unexpected label/version: %q %v", cat.Label, cat.Version)
+	}
+	if len(cat.Methods) == 0 {
+		t.Error("expected methods")
+	}
+}
+
+func TestLoadCatalog_File(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "25.10")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "methods.json")
+	if err := os.WriteFile(path, []byte(`{"pool.snapshot.create": {"job": false}}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cat, err := loadCatalog(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cat.Label != "25.10" || cat.Version.Major != 25 || cat.Version.Minor != 10 {
+		t.Errorf("unexpected label/version: %q %v", cat.Label, cat.Version)
+	}
+	if _, ok := cat.Methods["pool.snapshot.create"]; !ok {
+		t.Error("expected pool.snapshot.create")
+	}
+}
+
+func TestLoadCatalog_FileWithoutVersionDir(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "methods.json")
+	if err := os.WriteFile(path, []byte(`{}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	cat, err := loadCatalog(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cat.Label != path || !cat.Version.IsZero() {
+		t.Errorf("expected path label and zero version, got %q %v", cat.Label, cat.Version)
+	}
+}
+
+func TestLoadCatalog_Errors(t *testing.T) {
+	if _, err := loadCatalog("99.99"); err == nil {
+		t.Error("expected error for unknown embedded version")
+	}
+	if _, err := loadCatalog(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("expected error for missing file")
+	}
+	bad := filepath.Join(t.TempDir(), "bad.json")
+	if err := os.WriteFile(bad, []byte(`[]`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := loadCatalog(bad); err == nil {
+		t.Error("expected error for malformed catalog")
+	}
+}
+
+func TestDiffCatalogs(t *testing.T) {
+	from := testCatalog("25.04", map[string]api.MethodDef{
+		"zfs.snapshot.create": {},
+		"zfs.snapshot.query":  {Filterable: true},
+		"app.query":           {Filterable: true},
+		"app.upgrade":         {Job: false},
+		"old.thing.do":        {},
+		"gone.method":         {},
+		"virt.instance.query": {Filterable: true},
+	})
+	to := testCatalog("25.10", map[string]api.MethodDef{
+		"pool.snapshot.create": {},
+		"pool.snapshot.query":  {Filterable: true},
+		"app.query":            {Filterable: false},
+		"app.upgrade":          {Job: true},
+		"new.thing.do":         {},
+		"brand.new":            {},
+	})
+	goMethods := []goMethod{
+		{ServiceStruct: "SnapshotService", GoMethodName: "Create", APIMethod: "zfs.snapshot.create"},
+		{ServiceStruct: "SnapshotService", GoMethodName: "Create", APIMethod: "pool.snapshot.create"},
+		{ServiceStruct: "AppService", GoMethodName: "ListApps", APIMethod: "app.query"},
+		{ServiceStruct: "AppService", GoMethodName: "UpgradeApp", APIMethod: "app.upgrade"},
+		{ServiceStruct: "VirtService", GoMethodName: "ListInstances", APIMethod: "virt.instance.query"},
+	}
+
+	d := diffCatalogs(from, to, goMethods)
+
+	if strings.Join(d.Added, ",") != "brand.new" {
+		t.Errorf("Added = %v", d.Added)
+	}
+	if strings.Join(d.Removed, ",") != "gone.method,virt.instance.query" {
+		t.Errorf("Removed = %v", d.Removed)
+	}
+
+	wantRenames := map[string]rename{
+		"old.thing.do":        {From: "old.thing.do", To: "new.thing.do", Source: "heuristic"},
+		"zfs.snapshot.create": {From: "zfs.snapshot.create", To: "pool.snapshot.create", Source: "registry"},
+		"zfs.snapshot.query":  {From: "zfs.snapshot.query", To: "pool.snapshot.query", Source: "registry"},
+	}
+	if len(d.Renamed) != len(wantRenames) {
+		t.Fatalf("Renamed = %+v", d.Renamed)
+	}
+	for _, r := range d.Renamed {
+		if wantRenames[r.From] != r {
+			t.Errorf("unexpected rename %+v", r)
+		}
+	}
+
+	if len(d.Changed) != 2 ||
+		d.Changed[0] != (flagChange{Method: "app.query", Flag: "filterable", From: true, To: false}) ||
+		d.Changed[1] != (flagChange{Method: "app.upgrade", Flag: "job", From: false, To: true}) {
+		t.Errorf("Changed = %+v", d.Changed)
+	}
+
+	// The snapshot rename is routed by the registry, so SnapshotService is
+	// not broken; the others are.
+	if len(d.Breaking) != 3 {
+		t.Fatalf("Breaking = %+v", d.Breaking)
+	}
+	for i, want := range []struct{ method, reason string }{
+		{"ListApps", "no longer filterable"},
+		{"UpgradeApp", "job flag changed (false → true)"},
+		{"ListInstances", "virt.instance.query missing in 25.10"},
+	} {
+		b := d.Breaking[i]
+		if b.GoMethodName != want.method || !strings.Contains(b.Reason, want.reason) {
+			t.Errorf("Breaking[%d] = %+v, want %s: %s", i, b, want.method, want.reason)
+		}
+	}
+}
+
+func TestDiffCatalogs_AmbiguousHeuristicRename(t *testing.T) {
+	from := testCatalog("a.json", map[string]api.MethodDef{"a.item.get": {}, "b.item.get": {}})
+	to := testCatalog("b.json", map[string]api.MethodDef{"c.item.get": {}})
+
+	d := diffCatalogs(from, to, nil)
+	if len(d.Renamed) != 0 {
+		t.Errorf("expected no rename for ambiguous match, got %+v", d.Renamed)
+	}
+	if len(d.Removed) != 2 || len(d.Added) != 1 {
+		t.Errorf("Removed = %v, Added = %v", d.Removed, d.Added)
+	}
+}
+
+func TestFindBreakages_UnsupportedPerRegistry(t *testing.T) {
+	// virt.* is registered from 25.04; a 24.10 target is rejected by the
+	// registry even if the catalog still lists the method.
+	from := testCatalog("25.04", map[string]api.MethodDef{"virt.instance.query": {}})
+	to := testCatalog("24.10", map[string]api.MethodDef{"virt.instance.query": {}})
+	goMethods := []goMethod{{ServiceStruct: "VirtService", GoMethodName: "ListInstances", APIMethod: "virt.instance.query"}}
+
+	got := findBreakages(from, to, goMethods)
+	if len(got) != 1 || got[0].Reason != "not supported on 24.10 per method registry" {
+		t.Errorf("unexpected breakages: %+v", got)
+	}
+}
+
+func TestWriteDiff(t *testing.T) {
+	from := testCatalog("25.04", nil)
+	to := testCatalog("25.10", nil)
+	d := catalogDiff{
+		Added:    []string{"pool.snapshot.new"},
+		Removed:  []string{"zfs.gone"},
+		Renamed:  []rename{{From: "zfs.snapshot.create", To: "pool.snapshot.create", Source: "registry"}},
+		Changed:  []flagChange{{Method: "app.upgrade", Flag: "job", From: false, To: true}},
+		Breaking: []breakage{{ServiceStruct: "AppService", GoMethodName: "UpgradeApp", APIMethod: "app.upgrade", Reason: "app.upgrade job flag changed (false → true)"}},
+	}
+
+	var buf bytes.Buffer
+	writeDiff(&buf, from, to, d)
+	out := buf.String()
+
+	for _, want := range []string{
+		"# TrueNAS API Diff: 25.04 → 25.10",
+		"Added: 1 | Removed: 1 | Renamed: 1 | Flag changes: 1 | Breaking Go methods: 1",
+		"## Breaking Go Methods (1)",
+		"| AppService | UpgradeApp | app.upgrade | app.upgrade job flag changed (false → true) |",
+		"## Renamed (1)",
+		"| zfs.snapshot.create | pool.snapshot.create | registry |",
+		"| API Method | Flag | 25.04 | 25.10 |",
+		"| app.upgrade | job | false | true |",
+		"## Removed (1)",
+		"| zfs | zfs.gone |",
+		"## Added (1)",
+		"| pool.snapshot | pool.snapshot.new |",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in output:\n%s", want, out)
+		}
+	}
+}
+
+func TestWriteDiff_Empty(t *testing.T) {
+	var buf bytes.Buffer
+	writeDiff(&buf, testCatalog("25.04", nil), testCatalog("25.04", nil), catalogDiff{})
+	if strings.Contains(buf.String(), "## ") {
+		t.Errorf("expected no sections for empty diff:\n%s", buf.String())
+	}
+}
+
+func TestRunDiff_RequiresFrom(t *testing.T) {
+	err := runDiff([]string{"-to", "25.04"})
+	if err == nil || !strings.Contains(err.Error(), "-from is required") {
+		t.Errorf("expected -from error, got %v", err)
+	}
+}
@@ -1,5 +1,20 @@
 // Command featurematrix generates a markdown feature matrix comparing
 // implemented Go service methods against the full TrueNAS API surface.
+//
+// -format json or csv writes the same data per API method for dashboards,
+// with links to each Go method's source line. -min-coverage, -require-tested
+// and -baseline (a previous -format json report) make it exit non-zero when
+// coverage falls short or regresses:
+//
+//	featurematrix -format json -o matrix.json
+//	featurematrix -require-tested -baseline matrix.json -o FEATURES.md
+//
+// The diff subcommand compares two method catalogs instead:
+//
+//	featurematrix diff -from 24.10 -to 25.10
+//
+// listing added, removed and renamed methods, job/filterable flag changes,
+// and implemented Go methods that would break on the target version.
 package main
 
 import (
@@ -14,6 +29,7 @@ import (
 	"sort"
 	"strings"
 
+	truenas "github.com/deevus/truenas-go"
 	"github.com/deevus/truenas-go/api"
 )
 
@@ -22,6 +38,8 @@ type goMethod struct {
 	ServiceStruct string // e.g. "SnapshotService"
 	GoMethodName  string // e.g. "Create"
 	APIMethod     string // e.g. "zfs.snapshot.create"
+	File          string // source file relative to the project root
+	Line          int    // line of the Go method declaration
 }
 
 // serviceMethodInfo combines API and Go information for one service method.
@@ -40,18 +58,45 @@ type serviceGroup struct {
 }
 
 func main() {
-	dir := flag.String("dir", ".", "project root directory")
-	output := flag.String("o", "", "output file path (default: stdout)")
-	version := flag.String("version", "", "TrueNAS version (default: latest embedded)")
+	if len(os.Args) > 1 && os.Args[1] == "diff" {
+		if err := runDiff(os.Args[2:]); err != nil {
+			fmt.Fprintf(os.Stderr, "error: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	var opts options
+	flag.StringVar(&opts.dir, "dir", ".", "project root directory")
+	flag.StringVar(&opts.output, "o", "", "output file path (default: stdout)")
+	flag.StringVar(&opts.version, "version", "", "TrueNAS version (default: latest embedded)")
+	flag.StringVar(&opts.format, "format", "markdown", "output format: markdown, json or csv")
+	flag.StringVar(&opts.sourceBase, "source-base", "", "URL prefix for Go source links (default: paths relative to the project root)")
+	flag.Float64Var(&opts.minCoverage, "min-coverage", 0, "fail if less than this percentage of API methods is implemented")
+	flag.BoolVar(&opts.requireTested, "require-tested", false, "fail if any implemented API method has no tests")
+	flag.StringVar(&opts.baseline, "baseline", "", "JSON report (from -format json) to fail on coverage regressions against")
 	flag.Parse()
 
-	if err := run(*dir, *output, *version); err != nil {
+	if err := run(opts); err != nil {
 		fmt.Fprintf(os.Stderr, "error: %v\n", err)
 		os.Exit(1)
 	}
 }
 
-func run(dir, output, version string) error {
+// options holds the command-line flags of the matrix mode.
+type options struct {
+	dir           string
+	output        string
+	version       string
+	format        string
+	sourceBase    string
+	minCoverage   float64
+	requireTested bool
+	baseline      string
+}
+
+func run(opts options) error {
+	version := opts.version
 	if version == "" {
 		version = api.LatestVersion()
 	}
@@ -64,19 +109,32 @@ func run(dir, output, version string) error {
 		return err
 	}
 
-	goMethods, err := scanGoMethods(dir)
+	switch opts.format {
+	case "", "markdown", "json", "csv":
+	default:
+		return fmt.Errorf("unknown format %q (want markdown, json or csv)", opts.format)
+	}
+
+	var baseline *report
+	if opts.baseline != "" {
+		if baseline, err = loadReport(opts.baseline); err != nil {
+			return err
+		}
+	}
+
+	goMethods, err := scanGoMethods(opts.dir)
 	if err != nil {
 		return fmt.Errorf("scanning go source: %w", err)
 	}
 
-	testFuncs, err := scanTestFunctions(dir)
+	testFuncs, err := scanTestFunctions(opts.dir)
 	if err != nil {
 		return fmt.Errorf("scanning tests: %w", err)
 	}
 
 	var w io.Writer = os.Stdout
-	if output != "" {
-		f, err := os.Create(output)
+	if opts.output != "" {
+		f, err := os.Create(opts.output)
 		if err != nil {
 			return err
 		}
@@ -84,8 +142,20 @@ func run(dir, output, version string) error {
 		w = f
 	}
 
-	writeMatrix(w, version, apiMethods, goMethods, testFuncs)
-	return nil
+	rep := buildReport(version, apiMethods, goMethods, testFuncs, opts.sourceBase)
+	switch opts.format {
+	case "json":
+		err = writeReportJSON(w, rep)
+	case "csv":
+		err = writeReportCSV(w, rep)
+	default:
+		writeMatrix(w, version, apiMethods, goMethods, testFuncs, opts.sourceBase)
+	}
+	if err != nil {
+		return err
+	}
+
+	return checkCoverage(rep, baseline, opts.minCoverage, opts.requireTested)
 }
 
 // scanGoMethods parses *_service.go files and extracts API method calls.
@@ -101,42 +171,60 @@ func scanGoMethods(dir string) ([]goMethod, error) {
 		if strings.HasSuffix(name, "_service.go") &&
 			!strings.HasSuffix(name, "_iface.go") &&
 			!strings.HasSuffix(name, "_test.go") {
-			files = append(files, filepath.Join(dir, name))
+			files = append(files, name)
 		}
 	}
 
 	var results []goMethod
 	fset := token.NewFileSet()
 
-	for _, file := range files {
-		f, err := parser.ParseFile(fset, file, nil, 0)
+	for _, name := range files {
+		src, err := os.ReadFile(filepath.Join(dir, name))
 		if err != nil {
-			return nil, fmt.Errorf("parsing %s: %w", file, err)
+			return nil, err
+		}
+		// Parse under the relative name so positions double as source links.
+		f, err := parser.ParseFile(fset, name, src, 0)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", filepath.Join(dir, name), err)
 		}
 
-		methods := extractAPICalls(f)
+		methods := extractAPICalls(fset, f)
 		results = append(results, methods...)
 	}
 
 	return results, nil
 }
 
-// extractAPICalls walks an AST file and finds Call/CallAndWait/Subscribe calls
-// with string literal API method names. Also handles indirect method resolution
-// (e.g. resolveSnapshotMethod) by scanning for resolver function calls and
-// extracting the method suffix from constants.
-func extractAPICalls(f *ast.File) []goMethod {
-	// First pass: collect string constants (e.g. methodSnapshotCreate = "create")
-	constants := extractStringConstants(f)
-
-	// Second pass: find resolver patterns (e.g. resolveSnapshotMethod)
-	resolvers := extractResolverPrefixes(f)
+// registryCallFuncs are the package helpers that resolve a logical operation
+// through the method registry before calling it. The operation is the fourth
+// argument: callMethod(ctx, s.client, s.version, "op", params).
+var registryCallFuncs = map[string]bool{
+	"callMethod":        true,
+	"callMethodAndWait": true,
+	"subscribeMethod":   true,
+}
 
-	var results []goMethod
+// extractAPICalls walks an AST file and finds Call/CallAndWait/Subscribe calls
+// with string literal API method names. Calls made through the method registry
+// (callMethod and friends) are expanded to every API method registered for the
+// operation, so renamed methods (e.g. zfs.snapshot.* → pool.snapshot.*) map to
+// the same Go method. Calls made inside unexported helpers of the same service
+// (e.g. s.query) are credited to the exported methods that use them.
+func extractAPICalls(fset *token.FileSet, f *ast.File) []goMethod {
+	type serviceMethod struct {
+		recvType string
+		decl     *ast.FuncDecl
+		ops      []string // logical operations called directly
+		helpers  []string // methods called on the receiver
+	}
+
+	var methods []*serviceMethod
+	byName := make(map[[2]string]*serviceMethod)
 
 	for _, decl := range f.Decls {
 		fn, ok := decl.(*ast.FuncDecl)
-		if !ok || fn.Recv == nil || len(fn.Recv.List) == 0 {
+		if !ok || fn.Recv == nil || len(fn.Recv.List) == 0 || fn.Body == nil {
 			continue
 		}
 
@@ -144,169 +232,107 @@ func extractAPICalls(f *ast.File) []goMethod {
 		if recvType == "" || !strings.HasSuffix(recvType, "Service") {
 			continue
 		}
-
-		methodName := fn.Name.Name
-		if !ast.IsExported(methodName) {
-			continue
+		var recvName string
+		if names := fn.Recv.List[0].Names; len(names) > 0 {
+			recvName = names[0].Name
 		}
 
-		// Track resolved method variables: varName → []apiMethod (multiple prefixes)
-		resolvedVars := make(map[string][]string)
+		m := &serviceMethod{recvType: recvType, decl: fn}
+		methods = append(methods, m)
+		byName[[2]string{recvType, fn.Name.Name}] = m
 
 		ast.Inspect(fn.Body, func(n ast.Node) bool {
-			// Check for resolver calls: method := resolveXxxMethod(v, constant)
-			if assign, ok := n.(*ast.AssignStmt); ok {
-				for i, rhs := range assign.Rhs {
-					if call, ok := rhs.(*ast.CallExpr); ok {
-						if ident, ok := call.Fun.(*ast.Ident); ok {
-							if prefixes, found := resolvers[ident.Name]; found && len(call.Args) >= 2 {
-								suffix := resolveArgToString(call.Args[1], constants)
-								if suffix != "" && i < len(assign.Lhs) {
-									if lhsIdent, ok := assign.Lhs[i].(*ast.Ident); ok {
-										var apiMethods []string
-										for _, prefix := range prefixes {
-											apiMethods = append(apiMethods, prefix+"."+suffix)
-										}
-										resolvedVars[lhsIdent.Name] = apiMethods
-									}
-								}
-							}
-						}
-					}
-				}
-			}
-
 			call, ok := n.(*ast.CallExpr)
 			if !ok {
 				return true
 			}
 
-			sel, ok := call.Fun.(*ast.SelectorExpr)
-			if !ok {
-				return true
-			}
-
-			funcName := sel.Sel.Name
-			if funcName != "Call" && funcName != "CallAndWait" && funcName != "Subscribe" {
+			var arg ast.Expr
+			switch fun := call.Fun.(type) {
+			case *ast.Ident:
+				if !registryCallFuncs[fun.Name] || len(call.Args) < 4 {
+					return true
+				}
+				arg = call.Args[3]
+			case *ast.SelectorExpr:
+				name := fun.Sel.Name
+				if x, ok := fun.X.(*ast.Ident); ok && recvName != "" && x.Name == recvName {
+					m.helpers = append(m.helpers, name)
+					return true
+				}
+				if name != "Call" && name != "CallAndWait" && name != "Subscribe" {
+					return true
+				}
+				if len(call.Args) < 2 {
+					return true
+				}
+				arg = call.Args[1]
+			default:
 				return true
 			}
 
-			if len(call.Args) < 2 {
+			lit, ok := arg.(*ast.BasicLit)
+			if !ok || lit.Kind != token.STRING {
 				return true
 			}
-
-			// Try string literal first
-			if lit, ok := call.Args[1].(*ast.BasicLit); ok && lit.Kind == token.STRING {
-				apiMethod := strings.Trim(lit.Value, `"`)
-				results = append(results, goMethod{
-					ServiceStruct: recvType,
-					GoMethodName:  methodName,
-					APIMethod:     apiMethod,
-				})
-				return true
-			}
-
-			// Try resolved variable (may have multiple prefixes)
-			if ident, ok := call.Args[1].(*ast.Ident); ok {
-				if apiMethods, found := resolvedVars[ident.Name]; found {
-					for _, apiMethod := range apiMethods {
-						results = append(results, goMethod{
-							ServiceStruct: recvType,
-							GoMethodName:  methodName,
-							APIMethod:     apiMethod,
-						})
-					}
-				}
-			}
-
+			m.ops = append(m.ops, strings.Trim(lit.Value, `"`))
 			return true
 		})
 	}
 
-	return results
-}
-
-// extractStringConstants collects top-level const string values from a file.
-func extractStringConstants(f *ast.File) map[string]string {
-	consts := make(map[string]string)
-	for _, decl := range f.Decls {
-		gd, ok := decl.(*ast.GenDecl)
-		if !ok || gd.Tok != token.CONST {
-			continue
-		}
-		for _, spec := range gd.Specs {
-			vs, ok := spec.(*ast.ValueSpec)
-			if !ok || len(vs.Names) == 0 || len(vs.Values) == 0 {
+	// collect appends the operations of m and of the unexported helpers it
+	// reaches, visiting each helper once.
+	var collect func(m *serviceMethod, seen map[*serviceMethod]bool, ops []string) []string
+	collect = func(m *serviceMethod, seen map[*serviceMethod]bool, ops []string) []string {
+		ops = append(ops, m.ops...)
+		for _, name := range m.helpers {
+			helper, ok := byName[[2]string{m.recvType, name}]
+			if !ok || ast.IsExported(name) || seen[helper] {
 				continue
 			}
-			for i, name := range vs.Names {
-				if i < len(vs.Values) {
-					if lit, ok := vs.Values[i].(*ast.BasicLit); ok && lit.Kind == token.STRING {
-						consts[name.Name] = strings.Trim(lit.Value, `"`)
-					}
-				}
-			}
+			seen[helper] = true
+			ops = collect(helper, seen, ops)
 		}
+		return ops
 	}
-	return consts
-}
-
-// extractResolverPrefixes finds functions like resolveSnapshotMethod that build
-// API method names from a prefix + suffix. Returns a map of function name to
-// all possible prefixes.
-func extractResolverPrefixes(f *ast.File) map[string][]string {
-	resolvers := make(map[string][]string)
 
-	for _, decl := range f.Decls {
-		fn, ok := decl.(*ast.FuncDecl)
-		if !ok || fn.Recv != nil {
-			continue
-		}
-
-		if !strings.HasPrefix(fn.Name.Name, "resolve") || !strings.Contains(fn.Name.Name, "Method") {
+	var results []goMethod
+	for _, m := range methods {
+		methodName := m.decl.Name.Name
+		if !ast.IsExported(methodName) {
 			continue
 		}
+		pos := fset.Position(m.decl.Pos())
 
-		// Collect all string prefixes used in prefix + "." + method patterns
-		var prefixes []string
-		ast.Inspect(fn.Body, func(n ast.Node) bool {
-			assign, ok := n.(*ast.AssignStmt)
-			if !ok {
-				return true
-			}
-			for _, rhs := range assign.Rhs {
-				if lit, ok := rhs.(*ast.BasicLit); ok && lit.Kind == token.STRING {
-					val := strings.Trim(lit.Value, `"`)
-					if val != "" {
-						prefixes = append(prefixes, val)
-					}
-				}
+		ops := collect(m, map[*serviceMethod]bool{m: true}, nil)
+		for _, op := range ops {
+			for _, apiMethod := range registeredMethodNames(op) {
+				results = append(results, goMethod{
+					ServiceStruct: m.recvType,
+					GoMethodName:  methodName,
+					APIMethod:     apiMethod,
+					File:          filepath.ToSlash(pos.Filename),
+					Line:          pos.Line,
+				})
 			}
-			return true
-		})
-
-		if len(prefixes) > 0 {
-			resolvers[fn.Name.Name] = prefixes
 		}
 	}
 
-	return resolvers
+	return results
 }
 
-// resolveArgToString attempts to resolve a function argument to a string value,
-// either from a string literal or a constant reference.
-func resolveArgToString(expr ast.Expr, constants map[string]string) string {
-	switch e := expr.(type) {
-	case *ast.BasicLit:
-		if e.Kind == token.STRING {
-			return strings.Trim(e.Value, `"`)
-		}
-	case *ast.Ident:
-		if val, ok := constants[e.Name]; ok {
-			return val
-		}
+// registeredMethodNames returns every API method registered for op, or op
+// itself when it has no registry entry.
+func registeredMethodNames(op string) []string {
+	specs := truenas.OperationMethods(op)
+	if len(specs) == 0 {
+		return []string{op}
 	}
-	return ""
+	names := make([]string, 0, len(specs))
+	for _, spec := range specs {
+		names = append(names, spec.Method)
+	}
+	return names
 }
 
 // receiverTypeName extracts the type name from a receiver expression,
@@ -413,12 +439,6 @@ func buildAPIMapping(goMethods []goMethod) map[string]*apiMethodMapping {
 
 	for _, gm := range goMethods {
 		addMapping(gm.APIMethod, gm)
-
-		// Snapshot aliases: zfs.snapshot.* → pool.snapshot.*
-		if strings.HasPrefix(gm.APIMethod, "zfs.snapshot.") {
-			alias := "pool.snapshot." + strings.TrimPrefix(gm.APIMethod, "zfs.snapshot.")
-			addMapping(alias, gm)
-		}
 	}
 
 	return m
@@ -433,6 +453,29 @@ func (m *apiMethodMapping) goMethodNames() string {
 	return strings.Join(names, ", ")
 }
 
+// goMethodLinks returns a comma-separated list of Go method names, each
+// linked to its declaration when the source position is known.
+func (m *apiMethodMapping) goMethodLinks(sourceBase string) string {
+	names := make([]string, len(m.goMethods))
+	for i, gm := range m.goMethods {
+		if link := sourceLink(sourceBase, gm); link != "" {
+			names[i] = fmt.Sprintf("[%s](%s)", gm.GoMethodName, link)
+		} else {
+			names[i] = gm.GoMethodName
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+// sourceLink returns the location of a Go method declaration, e.g.
+// "snapshot_service.go#L42" or, with a base URL, the same appended to it.
+func sourceLink(sourceBase string, gm goMethod) string {
+	if gm.File == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s%s#L%d", sourceBase, gm.File, gm.Line)
+}
+
 // totalTestCount returns the total number of tests across all Go methods.
 func (m *apiMethodMapping) totalTestCount(testFuncs []string) int {
 	total := 0
@@ -442,8 +485,71 @@ func (m *apiMethodMapping) totalTestCount(testFuncs []string) int {
 	return total
 }
 
+// namespaceOwners maps each namespace with at least one Go implementation to
+// the service that owns it: the one calling the most of the namespace's API
+// methods, then the most methods that resolve there on other versions (e.g.
+// renamed ones), then the first by name. Several services may call into one
+// namespace (DatasetService.ListPools and PoolService both call pool.query),
+// so the owner must not depend on map iteration order.
+func namespaceOwners(apiMethods map[string]api.MethodDef, goMethods []goMethod) map[string]string {
+	namespaces := make(map[string]bool)
+	for method := range apiMethods {
+		namespaces[api.Namespace(method)] = true
+	}
+
+	type score struct {
+		inAPI, other map[string]bool // distinct API methods called
+	}
+	scores := make(map[string]map[string]*score) // namespace → service → score
+	for _, gm := range goMethods {
+		ns := api.Namespace(gm.APIMethod)
+		if !namespaces[ns] {
+			continue
+		}
+		if scores[ns] == nil {
+			scores[ns] = make(map[string]*score)
+		}
+		sc := scores[ns][gm.ServiceStruct]
+		if sc == nil {
+			sc = &score{inAPI: make(map[string]bool), other: make(map[string]bool)}
+			scores[ns][gm.ServiceStruct] = sc
+		}
+		if _, ok := apiMethods[gm.APIMethod]; ok {
+			sc.inAPI[gm.APIMethod] = true
+		} else {
+			sc.other[gm.APIMethod] = true
+		}
+	}
+
+	owners := make(map[string]string)
+	for ns, bySvc := range scores {
+		var best string
+		for svc, sc := range bySvc {
+			if best == "" {
+				best = svc
+				continue
+			}
+			b := bySvc[best]
+			switch {
+			case len(sc.inAPI) != len(b.inAPI):
+				if len(sc.inAPI) > len(b.inAPI) {
+					best = svc
+				}
+			case len(sc.other) != len(b.other):
+				if len(sc.other) > len(b.other) {
+					best = svc
+				}
+			case svc < best:
+				best = svc
+			}
+		}
+		owners[ns] = best
+	}
+	return owners
+}
+
 // writeMatrix generates the markdown feature matrix.
-func writeMatrix(w io.Writer, version string, apiMethods map[string]api.MethodDef, goMethods []goMethod, testFuncs []string) {
+func writeMatrix(w io.Writer, version string, apiMethods map[string]api.MethodDef, goMethods []goMethod, testFuncs []string, sourceBase string) {
 	apiToGo := buildAPIMapping(goMethods)
 
 	// Group API methods by namespace
@@ -463,23 +569,7 @@ func writeMatrix(w io.Writer, version string, apiMethods map[string]api.MethodDe
 	}
 
 	// Determine which namespaces are covered (have at least one Go implementation)
-	coveredNS := make(map[string]string) // namespace → service struct
-	for method, mapping := range apiToGo {
-		if _, inAPI := apiMethods[method]; inAPI {
-			ns := api.Namespace(method)
-			coveredNS[ns] = mapping.service
-		}
-	}
-
-	// Also mark namespaces covered if a Go method targets a method that resolves there
-	for _, gm := range goMethods {
-		ns := api.Namespace(gm.APIMethod)
-		if _, exists := coveredNS[ns]; !exists {
-			if _, exists := nsMap[ns]; exists {
-				coveredNS[ns] = gm.ServiceStruct
-			}
-		}
-	}
+	coveredNS := namespaceOwners(apiMethods, goMethods) // namespace → service struct
 
 	// Group covered namespaces by service
 	serviceNS := make(map[string][]string)
@@ -573,7 +663,7 @@ func writeMatrix(w io.Writer, version string, apiMethods map[string]api.MethodDe
 
 				if impl {
 					implStr = "✓"
-					goMethodStr = mapping.goMethodNames()
+					goMethodStr = mapping.goMethodLinks(sourceBase)
 					tc := mapping.totalTestCount(testFuncs)
 					if tc > 0 {
 						testedStr = "✓"
@@ -41,36 +41,6 @@ func TestReceiverTypeName_NonIdent(t *testing.T) {
 	}
 }
 
-func TestExtractStringConstants(t *testing.T) {
-	src := `package p
-const (
-	methodFoo = "foo"
-	methodBar = "bar"
-	intConst  = 42
-)
-const single = "single"
-`
-	fset := token.NewFileSet()
-	f, err := parser.ParseFile(fset, "", src, 0)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	consts := extractStringConstants(f)
-	if consts["methodFoo"] != "foo" {
-		t.Errorf("methodFoo = %q, want %q", consts["methodFoo"], "foo")
-	}
-	if consts["methodBar"] != "bar" {
-		t.Errorf("methodBar = %q, want %q", consts["methodBar"], "bar")
-	}
-	if _, ok := consts["intConst"]; ok {
-		t.Error("intConst should not be in string constants")
-	}
-	if consts["single"] != "single" {
-		t.Errorf("single = %q, want %q", consts["single"], "single")
-	}
-}
-
 func TestExtractAPICalls_DirectLiteral(t *testing.T) {
 	src := `package p
 import "context"
@@ -86,7 +56,7 @@ func (s *FooService) DoStuff(ctx context.Context) error {
 		t.Fatal(err)
 	}
 
-	methods := extractAPICalls(f)
+	methods := extractAPICalls(fset, f)
 	if len(methods) != 1 {
 		t.Fatalf("expected 1 method, got %d", len(methods))
 	}
@@ -116,7 +86,7 @@ func (s *BarService) Run(ctx context.Context) error {
 		t.Fatal(err)
 	}
 
-	methods := extractAPICalls(f)
+	methods := extractAPICalls(fset, f)
 	if len(methods) != 1 {
 		t.Fatalf("expected 1 method, got %d", len(methods))
 	}
@@ -140,7 +110,7 @@ func (s *SubService) Watch(ctx context.Context) error {
 		t.Fatal(err)
 	}
 
-	methods := extractAPICalls(f)
+	methods := extractAPICalls(fset, f)
 	if len(methods) != 1 {
 		t.Fatalf("expected 1 method, got %d", len(methods))
 	}
@@ -164,12 +134,45 @@ func (s *FooService) doPrivate(ctx context.Context) error {
 		t.Fatal(err)
 	}
 
-	methods := extractAPICalls(f)
+	methods := extractAPICalls(fset, f)
 	if len(methods) != 0 {
 		t.Errorf("expected 0 methods for unexported, got %d", len(methods))
 	}
 }
 
+func TestExtractAPICalls_FollowsUnexportedHelpers(t *testing.T) {
+	src := `package p
+import "context"
+type FooService struct { client interface{ Call(context.Context, string, any) (any, error) } }
+func (s *FooService) List(ctx context.Context) (any, error) {
+	return s.query(ctx)
+}
+func (s *FooService) Get(ctx context.Context) (any, error) {
+	s.List(ctx)
+	return s.query(ctx)
+}
+func (s *FooService) query(ctx context.Context) (any, error) {
+	s.query(ctx)
+	return s.client.Call(ctx, "foo.query", nil)
+}
+`
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	methods := extractAPICalls(fset, f)
+	if len(methods) != 2 {
+		t.Fatalf("expected 2 methods, got %d: %+v", len(methods), methods)
+	}
+	for i, want := range []string{"List", "Get"} {
+		if methods[i].GoMethodName != want || methods[i].APIMethod != "foo.query" {
+			t.Errorf("methods[%d] = %s → %s, want %s → foo.query", i, methods[i].GoMethodName, methods[i].APIMethod, want)
+		}
+	}
+}
+
 func TestExtractAPICalls_SkipsNonService(t *testing.T) {
 	src := `package p
 import "context"
@@ -185,37 +188,28 @@ func (h *Helper) DoStuff(ctx context.Context) error {
 		t.Fatal(err)
 	}
 
-	methods := extractAPICalls(f)
+	methods := extractAPICalls(fset, f)
 	if len(methods) != 0 {
 		t.Errorf("expected 0 methods for non-service, got %d", len(methods))
 	}
 }
 
-func TestExtractAPICalls_Resolver(t *testing.T) {
+func TestExtractAPICalls_Registry(t *testing.T) {
 	src := `package p
 import "context"
 
-const (
-	methodCreate = "create"
-	methodQuery  = "query"
-)
-
-func resolveMethod(v int, method string) string {
-	prefix := "old.ns"
-	if v > 10 {
-		prefix = "new.ns"
-	}
-	return prefix + "." + method
-}
-
 type MyService struct {
-	client  interface{ Call(context.Context, string, any) (any, error) }
+	client  any
 	version int
 }
 
 func (s *MyService) Create(ctx context.Context) error {
-	method := resolveMethod(s.version, methodCreate)
-	_, err := s.client.Call(ctx, method, nil)
+	_, err := callMethod(ctx, s.client, s.version, "zfs.snapshot.create", nil)
+	return err
+}
+
+func (s *MyService) Start(ctx context.Context) error {
+	_, err := callMethodAndWait(ctx, s.client, s.version, "unregistered.start", nil)
 	return err
 }
 `
@@ -225,75 +219,35 @@ func (s *MyService) Create(ctx context.Context) error {
 		t.Fatal(err)
 	}
 
-	methods := extractAPICalls(f)
-	if len(methods) != 2 {
-		t.Fatalf("expected 2 methods (both prefixes), got %d: %+v", len(methods), methods)
+	methods := extractAPICalls(fset, f)
+	if len(methods) != 3 {
+		t.Fatalf("expected 3 methods (2 registered variants + 1 unregistered), got %d: %+v", len(methods), methods)
 	}
 
-	apiMethods := make(map[string]bool)
+	apiMethods := make(map[string]string)
 	for _, m := range methods {
-		apiMethods[m.APIMethod] = true
-	}
-	if !apiMethods["old.ns.create"] {
-		t.Error("expected old.ns.create")
-	}
-	if !apiMethods["new.ns.create"] {
-		t.Error("expected new.ns.create")
-	}
-}
-
-func TestExtractResolverPrefixes(t *testing.T) {
-	src := `package p
-
-func resolveSnapshotMethod(v int, method string) string {
-	prefix := "zfs.snapshot"
-	if v > 10 {
-		prefix = "pool.snapshot"
+		apiMethods[m.APIMethod] = m.GoMethodName
 	}
-	return prefix + "." + method
-}
-`
-	fset := token.NewFileSet()
-	f, err := parser.ParseFile(fset, "", src, 0)
-	if err != nil {
-		t.Fatal(err)
+	if apiMethods["zfs.snapshot.create"] != "Create" {
+		t.Error("expected zfs.snapshot.create → Create")
 	}
-
-	resolvers := extractResolverPrefixes(f)
-	prefixes, ok := resolvers["resolveSnapshotMethod"]
-	if !ok {
-		t.Fatal("expected resolveSnapshotMethod in resolvers")
+	if apiMethods["pool.snapshot.create"] != "Create" {
+		t.Error("expected pool.snapshot.create → Create")
 	}
-	if len(prefixes) != 2 {
-		t.Fatalf("expected 2 prefixes, got %d: %v", len(prefixes), prefixes)
+	if apiMethods["unregistered.start"] != "Start" {
+		t.Error("expected unregistered.start → Start")
 	}
 }
 
-func TestResolveArgToString(t *testing.T) {
-	consts := map[string]string{"methodFoo": "foo"}
-
-	// String literal
-	lit := &ast.BasicLit{Kind: token.STRING, Value: `"bar"`}
-	if got := resolveArgToString(lit, consts); got != "bar" {
-		t.Errorf("literal: got %q, want %q", got, "bar")
+func TestRegisteredMethodNames(t *testing.T) {
+	if got := registeredMethodNames("cronjob.create"); len(got) != 1 || got[0] != "cronjob.create" {
+		t.Errorf("cronjob.create: got %v", got)
 	}
-
-	// Constant reference
-	ident := &ast.Ident{Name: "methodFoo"}
-	if got := resolveArgToString(ident, consts); got != "foo" {
-		t.Errorf("const ref: got %q, want %q", got, "foo")
-	}
-
-	// Unknown ident
-	unknown := &ast.Ident{Name: "unknown"}
-	if got := resolveArgToString(unknown, consts); got != "" {
-		t.Errorf("unknown: got %q, want empty", got)
+	if got := registeredMethodNames("zfs.snapshot.query"); len(got) != 2 || got[1] != "pool.snapshot.query" {
+		t.Errorf("zfs.snapshot.query: got %v", got)
 	}
-
-	// Non-string literal
-	intLit := &ast.BasicLit{Kind: token.INT, Value: "42"}
-	if got := resolveArgToString(intLit, consts); got != "" {
-		t.Errorf("int lit: got %q, want empty", got)
+	if got := registeredMethodNames("foo.bar"); len(got) != 1 || got[0] != "foo.bar" {
+		t.Errorf("foo.bar: got %v", got)
 	}
 }
 
@@ -371,22 +325,6 @@ func TestBuildAPIMapping(t *testing.T) {
 	}
 }
 
-func TestBuildAPIMapping_SnapshotAliases(t *testing.T) {
-	goMethods := []goMethod{
-		{ServiceStruct: "SnapshotService", GoMethodName: "Create", APIMethod: "zfs.snapshot.create"},
-	}
-
-	m := buildAPIMapping(goMethods)
-
-	// Both zfs.snapshot.create and pool.snapshot.create should be mapped
-	if _, ok := m["zfs.snapshot.create"]; !ok {
-		t.Error("expected zfs.snapshot.create in mapping")
-	}
-	if _, ok := m["pool.snapshot.create"]; !ok {
-		t.Error("expected pool.snapshot.create alias in mapping")
-	}
-}
-
 func TestBuildAPIMapping_Deduplication(t *testing.T) {
 	goMethods := []goMethod{
 		{ServiceStruct: "FooService", GoMethodName: "Get", APIMethod: "foo.query"},
@@ -437,7 +375,7 @@ func TestWriteMatrix_BasicOutput(t *testing.T) {
 	}
 
 	var buf bytes.Buffer
-	writeMatrix(&buf, "99.99", apiMethods, goMethods, testFuncs)
+	writeMatrix(&buf, "99.99", apiMethods, goMethods, testFuncs, "")
 	output := buf.String()
 
 	// Check header
@@ -486,7 +424,7 @@ func TestWriteMatrix_UnmappedMethods(t *testing.T) {
 	}
 
 	var buf bytes.Buffer
-	writeMatrix(&buf, "1.0", apiMethods, goMethods, nil)
+	writeMatrix(&buf, "1.0", apiMethods, goMethods, nil, "")
 	output := buf.String()
 
 	if !strings.Contains(output, "Go Methods Not in API Schema") {
@@ -509,7 +447,7 @@ func TestWriteMatrix_NoUncovered(t *testing.T) {
 	}
 
 	var buf bytes.Buffer
-	writeMatrix(&buf, "1.0", apiMethods, goMethods, nil)
+	writeMatrix(&buf, "1.0", apiMethods, goMethods, nil, "")
 	output := buf.String()
 
 	if strings.Contains(output, "Uncovered Namespaces") {
@@ -517,6 +455,44 @@ func TestWriteMatrix_NoUncovered(t *testing.T) {
 	}
 }
 
+func TestNamespaceOwners(t *testing.T) {
+	apiMethods := map[string]api.MethodDef{
+		"pool.query":          {},
+		"pool.create":         {},
+		"pool.dataset.query":  {},
+		"pool.snapshot.query": {},
+		"smb.config":          {},
+	}
+	goMethods := []goMethod{
+		{ServiceStruct: "DatasetService", GoMethodName: "ListPools", APIMethod: "pool.query"},
+		{ServiceStruct: "DatasetService", GoMethodName: "List", APIMethod: "pool.dataset.query"},
+		{ServiceStruct: "PoolService", GoMethodName: "List", APIMethod: "pool.query"},
+		{ServiceStruct: "PoolService", GoMethodName: "Create", APIMethod: "pool.create"},
+		{ServiceStruct: "SnapshotService", GoMethodName: "List", APIMethod: "pool.snapshot.query"},
+		{ServiceStruct: "OtherService", GoMethodName: "List", APIMethod: "pool.snapshot.query"},
+		{ServiceStruct: "SMBService", GoMethodName: "Shares", APIMethod: "smb.sharesec.query"},
+		{ServiceStruct: "ASMBService", GoMethodName: "Config", APIMethod: "smb.config"},
+	}
+
+	want := map[string]string{
+		"pool":          "PoolService",    // most methods
+		"pool.dataset":  "DatasetService", // only caller
+		"pool.snapshot": "OtherService",   // tie, first by name
+		"smb":           "ASMBService",    // catalog methods beat unknown ones
+	}
+	for i := 0; i < 20; i++ {
+		got := namespaceOwners(apiMethods, goMethods)
+		if len(got) != len(want) {
+			t.Fatalf("namespaceOwners() = %v, want %v", got, want)
+		}
+		for ns, svc := range want {
+			if got[ns] != svc {
+				t.Fatalf("owner of %s = %q, want %q", ns, got[ns], svc)
+			}
+		}
+	}
+}
+
 func TestScanGoMethods_InvalidDir(t *testing.T) {
 	_, err := scanGoMethods("/nonexistent/path")
 	if err == nil {
@@ -660,7 +636,7 @@ func TestSysService_GetInfo(t *testing.T) {}
 	}
 
 	outFile := dir + "/output.md"
-	err := run(dir, outFile, "25.04")
+	err := run(options{dir: dir, output: outFile, version: "25.04"})
 	if err != nil {
 		t.Fatalf("run error: %v", err)
 	}
@@ -680,7 +656,7 @@ func TestSysService_GetInfo(t *testing.T) {}
 
 func TestRun_InvalidVersion(t *testing.T) {
 	dir := t.TempDir()
-	err := run(dir, "", "99.99")
+	err := run(options{dir: dir, version: "99.99"})
 	if err == nil {
 		t.Error("expected error for invalid version")
 	}
@@ -688,7 +664,7 @@ func TestRun_InvalidVersion(t *testing.T) {
 
 func TestRun_InvalidOutputPath(t *testing.T) {
 	dir := t.TempDir()
-	err := run(dir, "/nonexistent/dir/output.md", "25.04")
+	err := run(options{dir: dir, output: "/nonexistent/dir/output.md", version: "25.04"})
 	if err == nil {
 		t.Error("expected error for invalid output path")
 	}
@@ -697,3 +673,131 @@ func TestRun_InvalidOutputPath(t *testing.T) {
 func writeFile(dir, name, content string) error {
 	return os.WriteFile(dir+"/"+name, []byte(content), 0644)
 }
+
+func TestExtractAPICalls_SourcePosition(t *testing.T) {
+	src := `package p
+import "context"
+type FooService struct { client interface{ Call(context.Context, string, any) (any, error) } }
+
+func (s *FooService) Get(ctx context.Context) (any, error) {
+	return s.client.Call(ctx, "foo.get_instance", nil)
+}
+`
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "foo_service.go", src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	methods := extractAPICalls(fset, f)
+	if len(methods) != 1 {
+		t.Fatalf("expected 1 method, got %d", len(methods))
+	}
+	if methods[0].File != "foo_service.go" || methods[0].Line != 5 {
+		t.Errorf("position = %s:%d, want foo_service.go:5", methods[0].File, methods[0].Line)
+	}
+}
+
+func TestScanGoMethods_RelativeFile(t *testing.T) {
+	dir := t.TempDir()
+	svcSrc := `package truenas
+import "context"
+type FooService struct { client interface{ Call(context.Context, string, any) (any, error) } }
+func (s *FooService) Get(ctx context.Context) (any, error) {
+	return s.client.Call(ctx, "foo.get_instance", nil)
+}
+`
+	if err := writeFile(dir, "foo_service.go", svcSrc); err != nil {
+		t.Fatal(err)
+	}
+
+	methods, err := scanGoMethods(dir)
+	if err != nil {
+		t.Fatalf("scanGoMethods error: %v", err)
+	}
+	if len(methods) != 1 || methods[0].File != "foo_service.go" || methods[0].Line != 4 {
+		t.Errorf("unexpected methods: %+v", methods)
+	}
+}
+
+func TestGoMethodLinks(t *testing.T) {
+	mapping := &apiMethodMapping{
+		goMethods: []goMethod{
+			{ServiceStruct: "FooService", GoMethodName: "Get", File: "foo_service.go", Line: 12},
+			{ServiceStruct: "FooService", GoMethodName: "List"},
+		},
+	}
+
+	if got, want := mapping.goMethodLinks(""), "[Get](foo_service.go#L12), List"; got != want {
+		t.Errorf("goMethodLinks = %q, want %q", got, want)
+	}
+	base := "https://example.com/blob/main/"
+	if got, want := mapping.goMethodLinks(base), "[Get]("+base+"foo_service.go#L12), List"; got != want {
+		t.Errorf("goMethodLinks = %q, want %q", got, want)
+	}
+}
+
+func TestRun_Formats(t *testing.T) {
+	dir := t.TempDir()
+	svcSrc := `package truenas
+import "context"
+type SysService struct { client interface{ Call(context.Context, string, any) (any, error) } }
+func (s *SysService) GetInfo(ctx context.Context) (any, error) {
+	return s.client.Call(ctx, "system.info", nil)
+}
+`
+	if err := writeFile(dir, "sys_service.go", svcSrc); err != nil {
+		t.Fatal(err)
+	}
+
+	jsonFile := dir + "/matrix.json"
+	if err := run(options{dir: dir, output: jsonFile, version: "25.04", format: "json"}); err != nil {
+		t.Fatalf("run json: %v", err)
+	}
+	rep, err := loadReport(jsonFile)
+	if err != nil {
+		t.Fatalf("loadReport: %v", err)
+	}
+	if rep.Version != "25.04" || rep.Summary.Implemented != 1 {
+		t.Errorf("unexpected report summary: %+v", rep.Summary)
+	}
+
+	csvFile := dir + "/matrix.csv"
+	if err := run(options{dir: dir, output: csvFile, version: "25.04", format: "csv"}); err != nil {
+		t.Fatalf("run csv: %v", err)
+	}
+	data, err := os.ReadFile(csvFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), "system.info,system,SysService,true,GetInfo,0,sys_service.go#L4") {
+		t.Errorf("expected system.info row in csv:\n%s", data)
+	}
+
+	if err := run(options{dir: dir, version: "25.04", format: "xml"}); err == nil {
+		t.Error("expected error for unknown format")
+	}
+}
+
+func TestRun_RequireTested(t *testing.T) {
+	dir := t.TempDir()
+	svcSrc := `package truenas
+import "context"
+type SysService struct { client interface{ Call(context.Context, string, any) (any, error) } }
+func (s *SysService) GetInfo(ctx context.Context) (any, error) {
+	return s.client.Call(ctx, "system.info", nil)
+}
+`
+	if err := writeFile(dir, "sys_service.go", svcSrc); err != nil {
+		t.Fatal(err)
+	}
+
+	err := run(options{dir: dir, output: dir + "/out.md", version: "25.04", requireTested: true})
+	if err == nil || !strings.Contains(err.Error(), "system.info is implemented by SysService.GetInfo but has no tests") {
+		t.Errorf("expected require-tested failure, got %v", err)
+	}
+
+	if err := run(options{dir: dir, output: dir + "/out.md", version: "25.04", baseline: dir + "/missing.json"}); err == nil {
+		t.Error("expected error for missing baseline")
+	}
+}
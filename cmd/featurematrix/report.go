@@ -0,0 +1,208 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/deevus/truenas-go/api"
+)
+
+// report is the machine-readable feature matrix written by -format json and
+// read back by -baseline.
+type report struct {
+	Version string         `json:"version"`
+	Summary reportSummary  `json:"summary"`
+	Methods []reportMethod `json:"methods"`
+}
+
+// reportSummary holds the totals shown in the markdown header.
+type reportSummary struct {
+	TotalAPI       int     `json:"total_api"`
+	Implemented    int     `json:"implemented"`
+	Tested         int     `json:"tested"`
+	ImplementedPct float64 `json:"implemented_pct"`
+	TestedPct      float64 `json:"tested_pct"` // of implemented
+}
+
+// reportMethod is one API method and the Go methods implementing it.
+type reportMethod struct {
+	APIMethod   string           `json:"api_method"`
+	Namespace   string           `json:"namespace"`
+	Implemented bool             `json:"implemented"`
+	Tests       int              `json:"tests"`
+	GoMethods   []reportGoMethod `json:"go_methods,omitempty"`
+}
+
+// reportGoMethod locates a Go method calling an API method.
+type reportGoMethod struct {
+	Service string `json:"service"`
+	Name    string `json:"name"`
+	File    string `json:"file,omitempty"`
+	Line    int    `json:"line,omitempty"`
+	Source  string `json:"source,omitempty"`
+}
+
+// buildReport computes per-method coverage for every API method of a version.
+func buildReport(version string, apiMethods map[string]api.MethodDef, goMethods []goMethod, testFuncs []string, sourceBase string) *report {
+	apiToGo := buildAPIMapping(goMethods)
+
+	names := make([]string, 0, len(apiMethods))
+	for name := range apiMethods {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	r := &report{Version: version, Methods: make([]reportMethod, 0, len(names))}
+	for _, name := range names {
+		m := reportMethod{APIMethod: name, Namespace: api.Namespace(name)}
+		if mapping, ok := apiToGo[name]; ok {
+			m.Implemented = true
+			m.Tests = mapping.totalTestCount(testFuncs)
+			for _, gm := range mapping.goMethods {
+				m.GoMethods = append(m.GoMethods, reportGoMethod{
+					Service: gm.ServiceStruct,
+					Name:    gm.GoMethodName,
+					File:    gm.File,
+					Line:    gm.Line,
+					Source:  sourceLink(sourceBase, gm),
+				})
+			}
+			r.Summary.Implemented++
+			if m.Tests > 0 {
+				r.Summary.Tested++
+			}
+		}
+		r.Methods = append(r.Methods, m)
+	}
+
+	r.Summary.TotalAPI = len(names)
+	r.Summary.ImplementedPct = pct(r.Summary.Implemented, r.Summary.TotalAPI)
+	r.Summary.TestedPct = pct(r.Summary.Tested, r.Summary.Implemented)
+	return r
+}
+
+// writeReportJSON writes the report as indented JSON.
+func writeReportJSON(w io.Writer, r *report) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// writeReportCSV writes one row per API method. Multiple Go methods are
+// joined with ";" in the go_methods and source columns.
+func writeReportCSV(w io.Writer, r *report) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"api_method", "namespace", "service", "implemented", "go_methods", "tests", "source"}); err != nil {
+		return err
+	}
+	for _, m := range r.Methods {
+		var service string
+		var goNames, sources []string
+		for _, gm := range m.GoMethods {
+			if service == "" {
+				service = gm.Service
+			}
+			goNames = append(goNames, gm.Name)
+			if gm.Source != "" {
+				sources = append(sources, gm.Source)
+			}
+		}
+		row := []string{
+			m.APIMethod,
+			m.Namespace,
+			service,
+			strconv.FormatBool(m.Implemented),
+			strings.Join(goNames, ";"),
+			strconv.Itoa(m.Tests),
+			strings.Join(sources, ";"),
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// loadReport reads a report written by -format json.
+func loadReport(path string) (*report, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading baseline: %w", err)
+	}
+	var r report
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, fmt.Errorf("parsing baseline %s: %w", path, err)
+	}
+	return &r, nil
+}
+
+// checkCoverage applies the -min-coverage, -require-tested and -baseline
+// gates, returning an error listing every failure.
+func checkCoverage(r, baseline *report, minCoverage float64, requireTested bool) error {
+	var failures []string
+
+	if minCoverage > 0 && r.Summary.ImplementedPct < minCoverage {
+		failures = append(failures, fmt.Sprintf("implemented %.1f%% of API methods, below -min-coverage %.1f%%", r.Summary.ImplementedPct, minCoverage))
+	}
+
+	if requireTested {
+		for _, m := range r.Methods {
+			if m.Implemented && m.Tests == 0 {
+				failures = append(failures, fmt.Sprintf("%s is implemented by %s but has no tests", m.APIMethod, goMethodList(m.GoMethods)))
+			}
+		}
+	}
+
+	if baseline != nil {
+		failures = append(failures, regressions(r, baseline)...)
+	}
+
+	if len(failures) == 0 {
+		return nil
+	}
+	return fmt.Errorf("coverage check failed:\n  %s", strings.Join(failures, "\n  "))
+}
+
+// regressions lists methods that lost their implementation or tests since
+// the baseline, and a drop in the share of implemented methods that are
+// tested. Methods no longer in the API are not regressions.
+func regressions(r, baseline *report) []string {
+	current := make(map[string]reportMethod, len(r.Methods))
+	for _, m := range r.Methods {
+		current[m.APIMethod] = m
+	}
+
+	var out []string
+	for _, old := range baseline.Methods {
+		m, ok := current[old.APIMethod]
+		if !ok {
+			continue
+		}
+		switch {
+		case old.Implemented && !m.Implemented:
+			out = append(out, fmt.Sprintf("%s was implemented in the baseline but is not anymore", old.APIMethod))
+		case old.Tests > 0 && m.Tests == 0:
+			out = append(out, fmt.Sprintf("%s was tested in the baseline but has no tests anymore", old.APIMethod))
+		}
+	}
+
+	if r.Summary.TestedPct < baseline.Summary.TestedPct {
+		out = append(out, fmt.Sprintf("tested share of implemented methods fell from %.1f%% to %.1f%%", baseline.Summary.TestedPct, r.Summary.TestedPct))
+	}
+	return out
+}
+
+func goMethodList(gms []reportGoMethod) string {
+	names := make([]string, len(gms))
+	for i, gm := range gms {
+		names[i] = gm.Service + "." + gm.Name
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/deevus/truenas-go/api"
+)
+
+func testReport() *report {
+	apiMethods := map[string]api.MethodDef{
+		"foo.create": {},
+		"foo.query":  {},
+		"foo.delete": {},
+		"bar.run":    {},
+	}
+	goMethods := []goMethod{
+		{ServiceStruct: "FooService", GoMethodName: "Create", APIMethod: "foo.create", File: "foo_service.go", Line: 10},
+		{ServiceStruct: "FooService", GoMethodName: "Get", APIMethod: "foo.query", File: "foo_service.go", Line: 20},
+		{ServiceStruct: "FooService", GoMethodName: "List", APIMethod: "foo.query", File: "foo_service.go", Line: 30},
+	}
+	testFuncs := []string{"TestFooService_Create", "TestFooService_Create_Error"}
+	return buildReport("25.04", apiMethods, goMethods, testFuncs, "")
+}
+
+func TestBuildReport(t *testing.T) {
+	r := testReport()
+
+	want := reportSummary{TotalAPI: 4, Implemented: 2, Tested: 1, ImplementedPct: 50, TestedPct: 50}
+	if r.Summary != want {
+		t.Errorf("Summary = %+v, want %+v", r.Summary, want)
+	}
+	if len(r.Methods) != 4 || r.Methods[0].APIMethod != "bar.run" {
+		t.Fatalf("expected 4 sorted methods, got %+v", r.Methods)
+	}
+
+	create := r.Methods[1]
+	if create.APIMethod != "foo.create" || !create.Implemented || create.Tests != 2 || create.Namespace != "foo" {
+		t.Errorf("unexpected foo.create: %+v", create)
+	}
+	if len(create.GoMethods) != 1 || create.GoMethods[0].Source != "foo_service.go#L10" {
+		t.Errorf("unexpected foo.create Go methods: %+v", create.GoMethods)
+	}
+
+	query := r.Methods[3]
+	if query.APIMethod != "foo.query" || len(query.GoMethods) != 2 || query.Tests != 0 {
+		t.Errorf("unexpected foo.query: %+v", query)
+	}
+}
+
+func TestBuildReport_SourceBase(t *testing.T) {
+	apiMethods := map[string]api.MethodDef{"foo.create": {}}
+	goMethods := []goMethod{{ServiceStruct: "FooService", GoMethodName: "Create", APIMethod: "foo.create", File: "foo_service.go", Line: 10}}
+
+	r := buildReport("25.04", apiMethods, goMethods, nil, "https://example.com/blob/main/")
+	if got := r.Methods[0].GoMethods[0].Source; got != "https://example.com/blob/main/foo_service.go#L10" {
+		t.Errorf("Source = %q", got)
+	}
+}
+
+func TestWriteReportJSON_RoundTrip(t *testing.T) {
+	r := testReport()
+
+	var buf bytes.Buffer
+	if err := writeReportJSON(&buf, r); err != nil {
+		t.Fatalf("writeReportJSON: %v", err)
+	}
+	if !strings.Contains(buf.String(), `"api_method": "foo.create"`) {
+		t.Errorf("expected snake_case keys:\n%s", buf.String())
+	}
+
+	path := filepath.Join(t.TempDir(), "baseline.json")
+	if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := loadReport(path)
+	if err != nil {
+		t.Fatalf("loadReport: %v", err)
+	}
+	if loaded.Summary != r.Summary || len(loaded.Methods) != len(r.Methods) {
+		t.Errorf("round trip mismatch: %+v", loaded.Summary)
+	}
+}
+
+func TestLoadReport_Invalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bad.json")
+	if err := os.WriteFile(path, []byte("not json"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := loadReport(path); err == nil {
+		t.Error("expected parse error")
+	}
+}
+
+func TestWriteReportCSV(t *testing.T) {
+	var buf bytes.Buffer
+	if err := writeReportCSV(&buf, testReport()); err != nil {
+		t.Fatalf("writeReportCSV: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	want := []string{
+		"api_method,namespace,service,implemented,go_methods,tests,source",
+		"bar.run,bar,,false,,0,",
+		"foo.create,foo,FooService,true,Create,2,foo_service.go#L10",
+		"foo.delete,foo,,false,,0,",
+		"foo.query,foo,FooService,true,Get;List,0,foo_service.go#L20;foo_service.go#L30",
+	}
+	if len(lines) != len(want) {
+		t.Fatalf("expected %d lines, got %d:\n%s", len(want), len(lines), buf.String())
+	}
+	for i := range want {
+		if lines[i] != want[i] {
+			t.Errorf("line %d = %q, want %q", i, lines[i], want[i])
+		}
+	}
+}
+
+func TestCheckCoverage_Pass(t *testing.T) {
+	r := testReport()
+	if err := checkCoverage(r, nil, 50, false); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := checkCoverage(r, r, 0, false); err != nil {
+		t.Errorf("unexpected error against identical baseline: %v", err)
+	}
+}
+
+func TestCheckCoverage_MinCoverage(t *testing.T) {
+	err := checkCoverage(testReport(), nil, 75, false)
+	if err == nil || !strings.Contains(err.Error(), "implemented 50.0% of API methods, below -min-coverage 75.0%") {
+		t.Errorf("expected min-coverage failure, got %v", err)
+	}
+}
+
+func TestCheckCoverage_RequireTested(t *testing.T) {
+	err := checkCoverage(testReport(), nil, 0, true)
+	if err == nil || !strings.Contains(err.Error(), "foo.query is implemented by FooService.Get, FooService.List but has no tests") {
+		t.Errorf("expected require-tested failure, got %v", err)
+	}
+	if strings.Contains(err.Error(), "foo.create") {
+		t.Errorf("tested method reported: %v", err)
+	}
+}
+
+func TestCheckCoverage_Baseline(t *testing.T) {
+	baseline := testReport()
+	baseline.Methods[2].Implemented = true // foo.delete
+	baseline.Methods[3].Tests = 1          // foo.query
+	baseline.Methods = append(baseline.Methods, reportMethod{APIMethod: "gone.method", Implemented: true, Tests: 1})
+	baseline.Summary.TestedPct = 75
+
+	err := checkCoverage(testReport(), baseline, 0, false)
+	if err == nil {
+		t.Fatal("expected baseline regressions")
+	}
+	msg := err.Error()
+	for _, want := range []string{
+		"foo.delete was implemented in the baseline but is not anymore",
+		"foo.query was tested in the baseline but has no tests anymore",
+		"tested share of implemented methods fell from 75.0% to 50.0%",
+	} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("expected %q in:\n%s", want, msg)
+		}
+	}
+	if strings.Contains(msg, "gone.method") {
+		t.Errorf("methods removed from the API should not regress:\n%s", msg)
+	}
+}
+
+func TestReport_JSONShape(t *testing.T) {
+	var buf bytes.Buffer
+	if err := writeReportJSON(&buf, testReport()); err != nil {
+		t.Fatal(err)
+	}
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(buf.Bytes(), &raw); err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"version", "summary", "methods"} {
+		if _, ok := raw[key]; !ok {
+			t.Errorf("missing top-level key %q", key)
+		}
+	}
+}
@@ -0,0 +1,266 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	truenas "github.com/deevus/truenas-go"
+)
+
+func appCommands() []*command {
+	return []*command{
+		{path: "app list", summary: "List apps", run: appList},
+		{path: "app get", args: "<name>", summary: "Show an app", run: appGet},
+		{path: "app create", args: "<name>", summary: "Create a custom app from a compose file", run: appCreate},
+		{path: "app update", args: "<name>", summary: "Replace a custom app's compose file", run: appUpdate},
+		{path: "app delete", args: "<name>", summary: "Delete an app", run: appAction("delete", (*truenas.AppService).DeleteApp)},
+		{path: "app start", args: "<name>", summary: "Start an app", run: appAction("start", (*truenas.AppService).StartApp)},
+		{path: "app stop", args: "<name>", summary: "Stop an app", run: appAction("stop", (*truenas.AppService).StopApp)},
+		{path: "app upgrade", args: "<name>", summary: "Upgrade an app to the latest version", run: appAction("upgrade", (*truenas.AppService).UpgradeApp)},
+		{path: "app redeploy", args: "<name>", summary: "Redeploy an app", run: appAction("redeploy", (*truenas.AppService).RedeployApp)},
+		{path: "app logs", args: "<name> [container]", summary: "Follow container logs", streaming: true, run: appLogs},
+	}
+}
+
+var appColumns = []column[truenas.App]{
+	{"NAME", func(a truenas.App) string { return a.Name }},
+	{"STATE", func(a truenas.App) string { return a.State }},
+	{"VERSION", func(a truenas.App) string { return strCell(a.HumanVersion) }},
+	{"UPGRADE", func(a truenas.App) string { return boolCell(a.UpgradeAvailable) }},
+	{"CONTAINERS", func(a truenas.App) string { return fmt.Sprint(a.ActiveWorkloads.Containers) }},
+	{"CUSTOM", func(a truenas.App) string { return boolCell(a.CustomApp) }},
+}
+
+func appService(ctx context.Context, e *env) (*truenas.AppService, error) {
+	c, err := e.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return truenas.NewAppService(c, c.Version()), nil
+}
+
+func appList(ctx context.Context, e *env, args []string) error {
+	if _, err := parseArgs(e.newFlagSet("app list"), args, "", 0, 0); err != nil {
+		return err
+	}
+	svc, err := appService(ctx, e)
+	if err != nil {
+		return err
+	}
+	apps, err := svc.ListApps(ctx)
+	if err != nil {
+		return err
+	}
+	return printList(e, apps, appColumns)
+}
+
+func appGet(ctx context.Context, e *env, args []string) error {
+	fs := e.newFlagSet("app get")
+	withConfig := fs.Bool("config", false, "include the app's configuration")
+	pos, err := parseArgs(fs, args, "<name>", 1, 1)
+	if err != nil {
+		return err
+	}
+	svc, err := appService(ctx, e)
+	if err != nil {
+		return err
+	}
+	get := svc.GetApp
+	if *withConfig {
+		get = svc.GetAppWithConfig
+	}
+	app, err := get(ctx, pos[0])
+	if err != nil {
+		return err
+	}
+	return printFound(e, app, "app", pos[0], appColumns)
+}
+
+func appCreate(ctx context.Context, e *env, args []string) error {
+	fs := e.newFlagSet("app create")
+	compose := fs.String("compose", "", "compose file for the custom app (- for stdin, required)")
+	pos, err := parseArgs(fs, args, "<name>", 1, 1)
+	if err != nil {
+		return err
+	}
+	config, err := readComposeFile(e, *compose)
+	if err != nil {
+		return err
+	}
+	svc, err := appService(ctx, e)
+	if err != nil {
+		return err
+	}
+	app, err := svc.CreateApp(ctx, truenas.CreateAppOpts{
+		Name:                pos[0],
+		CustomApp:           true,
+		CustomComposeConfig: config,
+	})
+	if err != nil {
+		return err
+	}
+	return printFound(e, app, "app", pos[0], appColumns)
+}
+
+func appUpdate(ctx context.Context, e *env, args []string) error {
+	fs := e.newFlagSet("app update")
+	compose := fs.String("compose", "", "new compose file (- for stdin, required)")
+	pos, err := parseArgs(fs, args, "<name>", 1, 1)
+	if err != nil {
+		return err
+	}
+	config, err := readComposeFile(e, *compose)
+	if err != nil {
+		return err
+	}
+	svc, err := appService(ctx, e)
+	if err != nil {
+		return err
+	}
+	app, err := svc.UpdateApp(ctx, pos[0], truenas.UpdateAppOpts{CustomComposeConfig: config})
+	if err != nil {
+		return err
+	}
+	return printFound(e, app, "app", pos[0], appColumns)
+}
+
+func readComposeFile(e *env, path string) (string, error) {
+	if path == "" {
+		return "", fmt.Errorf("-compose is required")
+	}
+	data, err := readInput(path, e.stdin)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// appAction builds a command calling an AppService method that takes only
+// the app name.
+func appAction(verb string, fn func(*truenas.AppService, context.Context, string) error) func(context.Context, *env, []string) error {
+	return func(ctx context.Context, e *env, args []string) error {
+		pos, err := parseArgs(e.newFlagSet("app "+verb), args, "<name>", 1, 1)
+		if err != nil {
+			return err
+		}
+		svc, err := appService(ctx, e)
+		if err != nil {
+			return err
+		}
+		return fn(svc, ctx, pos[0])
+	}
+}
+
+func appLogs(ctx context.Context, e *env, args []string) error {
+	fs := e.newFlagSet("app logs")
+	tail := fs.Int("tail", 100, "number of existing lines to show first")
+	timestamps := fs.Bool("timestamps", false, "prefix lines with their timestamp")
+	pos, err := parseArgs(fs, args, "<name> [container]", 1, 2)
+	if err != nil {
+		return err
+	}
+	svc, err := appService(ctx, e)
+	if err != nil {
+		return err
+	}
+
+	var container string
+	if len(pos) == 2 {
+		container = pos[1]
+	}
+	containerID, err := resolveContainer(ctx, svc, pos[0], container)
+	if err != nil {
+		return err
+	}
+
+	sub, err := svc.SubscribeContainerLogs(ctx, truenas.ContainerLogOpts{
+		AppName:     pos[0],
+		ContainerID: containerID,
+		TailLines:   *tail,
+	})
+	if err != nil {
+		return err
+	}
+	defer sub.Close()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case entry, ok := <-sub.C:
+			if !ok {
+				return nil
+			}
+			if err := writeLogEntry(e, entry, *timestamps); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// resolveContainer finds the container to follow: by ID, ID prefix or
+// compose service name, or the app's only container when none is given.
+func resolveContainer(ctx context.Context, svc *truenas.AppService, appName, container string) (string, error) {
+	app, err := svc.GetApp(ctx, appName)
+	if err != nil {
+		return "", err
+	}
+	if app == nil {
+		return "", fmt.Errorf("app %q not found", appName)
+	}
+
+	details := app.ActiveWorkloads.ContainerDetails
+	if container == "" {
+		switch len(details) {
+		case 0:
+			return "", fmt.Errorf("app %q has no running containers", appName)
+		case 1:
+			return details[0].ID, nil
+		}
+		names := make([]string, len(details))
+		for i, d := range details {
+			names[i] = d.ServiceName
+		}
+		return "", fmt.Errorf("app %q has %d containers, choose one of: %s", appName, len(details), strings.Join(names, ", "))
+	}
+
+	for _, d := range details {
+		if d.ID == container || d.ServiceName == container {
+			return d.ID, nil
+		}
+	}
+	for _, d := range details {
+		if strings.HasPrefix(d.ID, container) {
+			return d.ID, nil
+		}
+	}
+	// Let the middleware decide about containers it did not report.
+	return container, nil
+}
+
+// writeLogEntry prints a log line, or one JSON/YAML document per entry.
+func writeLogEntry(e *env, entry truenas.AppContainerLogEntry, timestamps bool) error {
+	switch e.format {
+	case formatJSON:
+		data, err := json.Marshal(entry)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(e.stdout, "%s\n", data)
+		return err
+	case formatYAML:
+		if _, err := fmt.Fprintln(e.stdout, "---"); err != nil {
+			return err
+		}
+		return e.printValue(entry)
+	}
+
+	line := strings.TrimRight(entry.Message, "\n")
+	if timestamps && entry.Timestamp != "" {
+		line = entry.Timestamp + " " + line
+	}
+	_, err := fmt.Fprintln(e.stdout, line)
+	return err
+}
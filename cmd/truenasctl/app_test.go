@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	truenas "github.com/deevus/truenas-go"
+	"github.com/deevus/truenas-go/client"
+)
+
+const appJSON = `[{"name": "web", "state": "RUNNING", "active_workloads": {"containers": 2, "container_details": [
+	{"id": "3f2a1c9e", "service_name": "nginx"},
+	{"id": "77b0d4aa", "service_name": "db"}
+]}}]`
+
+func TestResolveContainer(t *testing.T) {
+	mock := &client.MockClient{
+		VersionVal: truenas.Version{Major: 25, Minor: 4},
+		CallFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return json.RawMessage(appJSON), nil
+		},
+	}
+	svc := truenas.NewAppService(mock, mock.VersionVal)
+
+	tests := []struct {
+		container string
+		want      string
+		wantErr   string
+	}{
+		{"", "", "choose one of: nginx, db"},
+		{"db", "77b0d4aa", ""},
+		{"3f2a", "3f2a1c9e", ""},
+		{"77b0d4aa", "77b0d4aa", ""},
+		{"unknown", "unknown", ""},
+	}
+	for _, tt := range tests {
+		got, err := resolveContainer(context.Background(), svc, "web", tt.container)
+		if tt.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("%q: expected error containing %q, got %v", tt.container, tt.wantErr, err)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("%q: got %q, %v; want %q", tt.container, got, err, tt.want)
+		}
+	}
+}
+
+func TestRun_AppLogs(t *testing.T) {
+	var gotParams map[string]any
+	mock := &client.MockClient{
+		CallFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return json.RawMessage(appJSON), nil
+		},
+		SubscribeFunc: func(ctx context.Context, collection string, params any) (*truenas.Subscription[json.RawMessage], error) {
+			if collection != "app.container_log_follow" {
+				t.Errorf("unexpected collection %q", collection)
+			}
+			gotParams = params.(map[string]any)
+			ch := make(chan json.RawMessage, 2)
+			ch <- json.RawMessage(`{"timestamp": "2026-01-02T03:04:05Z", "data": "hello\n"}`)
+			ch <- json.RawMessage(`{"timestamp": "2026-01-02T03:04:06Z", "data": "world\n"}`)
+			close(ch)
+			return truenas.NewSubscription[json.RawMessage](ch, func() {}), nil
+		},
+	}
+
+	code, stdout, stderr := invoke(t, mock, "", "app", "logs", "web", "nginx", "-tail", "5", "-timestamps")
+	if code != 0 {
+		t.Fatalf("expected exit 0, got %d: %s", code, stderr)
+	}
+	if gotParams["container_id"] != "3f2a1c9e" || gotParams["tail_lines"] != 5 {
+		t.Errorf("unexpected params %v", gotParams)
+	}
+	if want := "2026-01-02T03:04:05Z hello\n2026-01-02T03:04:06Z world\n"; stdout != want {
+		t.Errorf("stdout = %q, want %q", stdout, want)
+	}
+
+	code, stdout, _ = invoke(t, mock, "", "app", "logs", "web", "db", "-o", "json")
+	if code != 0 {
+		t.Fatalf("expected exit 0, got %d", code)
+	}
+	if lines := strings.Split(strings.TrimSpace(stdout), "\n"); len(lines) != 2 || !strings.HasPrefix(lines[0], "{") {
+		t.Errorf("expected one JSON object per line, got %q", stdout)
+	}
+}
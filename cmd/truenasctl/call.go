@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/deevus/truenas-go/api"
+	"github.com/deevus/truenas-go/client"
+)
+
+func callCommands() []*command {
+	return []*command{
+		{path: "call", args: "<method> [params...]", summary: "Call any API method, like midclt call", run: callMethod},
+	}
+}
+
+// callMethod sends a raw JSON-RPC call. Each param is a JSON value, or a
+// plain string when it is not valid JSON, as with midclt; "-" reads one
+// param from stdin. Job methods are waited for unless -no-wait is given.
+func callMethod(ctx context.Context, e *env, args []string) error {
+	fs := e.newFlagSet("call")
+	noWait := fs.Bool("no-wait", false, "return the job ID instead of waiting for job methods")
+	noValidate := fs.Bool("no-validate", false, "send params without checking them against the API schema")
+	pos, err := parseArgs(fs, args, "<method> [params...]", 1, -1)
+	if err != nil {
+		return err
+	}
+	method := pos[0]
+
+	params, err := parseCallParams(pos[1:], e.stdin)
+	if err != nil {
+		return err
+	}
+
+	c, err := e.client(ctx)
+	if err != nil {
+		return err
+	}
+	version := catalogVersion(c)
+	if !*noValidate {
+		// Validate against the connected release's own catalog only.
+		c = client.NewValidatingClient(c, "")
+	}
+
+	call := c.Call
+	if isJob(version, method) && !*noWait {
+		call = c.CallAndWait
+	}
+	result, err := call(ctx, method, params)
+	if err != nil {
+		return err
+	}
+	return e.printRaw(result)
+}
+
+// parseCallParams converts command-line params to positional call params,
+// or nil when there are none.
+func parseCallParams(args []string, stdin io.Reader) (any, error) {
+	if len(args) == 0 {
+		return nil, nil
+	}
+	params := make([]any, len(args))
+	for i, arg := range args {
+		raw := []byte(arg)
+		if arg == "-" {
+			data, err := io.ReadAll(stdin)
+			if err != nil {
+				return nil, fmt.Errorf("read params from stdin: %w", err)
+			}
+			raw = data
+		}
+		var v any
+		if err := json.Unmarshal(raw, &v); err != nil {
+			if arg == "-" {
+				return nil, fmt.Errorf("parse params from stdin: %w", err)
+			}
+			v = arg
+		}
+		params[i] = v
+	}
+	return params, nil
+}
+
+// catalogVersion returns the embedded API catalog nearest to the connected
+// system, e.g. "25.04". It only decides which methods are jobs; params are
+// validated against an exact match.
+func catalogVersion(c client.Client) string {
+	v := c.Version()
+	return api.NearestVersion(fmt.Sprintf("%d.%d", v.Major, v.Minor))
+}
+
+// isJob reports whether the catalog marks method as a job. Methods missing
+// from the catalog are called without waiting.
+func isJob(version, method string) bool {
+	if version == "" {
+		return false
+	}
+	def, err := api.Method(version, method)
+	return err == nil && def.Job
+}
+
+// printRaw writes an untyped result as indented JSON, or as YAML with -o yaml.
+func (e *env) printRaw(result json.RawMessage) error {
+	if len(strings.TrimSpace(string(result))) == 0 {
+		result = json.RawMessage("null")
+	}
+	if e.format == formatYAML {
+		return e.printValue(result)
+	}
+	saved := e.format
+	e.format = formatJSON
+	defer func() { e.format = saved }()
+	return e.printValue(result)
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	truenas "github.com/deevus/truenas-go"
+	"github.com/deevus/truenas-go/client"
+)
+
+func TestParseCallParams(t *testing.T) {
+	got, err := parseCallParams([]string{`{"a": 1}`, "tank/data", "42", "-"}, strings.NewReader(`[true]`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []any{map[string]any{"a": float64(1)}, "tank/data", float64(42), []any{true}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %#v, want %#v", got, want)
+	}
+
+	if got, _ := parseCallParams(nil, nil); got != nil {
+		t.Errorf("expected nil params, got %#v", got)
+	}
+	if _, err := parseCallParams([]string{"-"}, strings.NewReader("{oops")); err == nil {
+		t.Error("expected error for invalid JSON on stdin")
+	}
+}
+
+func TestRun_Call(t *testing.T) {
+	var calls, waits []string
+	var gotParams any
+	mock := &client.MockClient{
+		CallFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			calls = append(calls, method)
+			gotParams = params
+			return json.RawMessage(`{"version":"25.04.1","hostname":"nas"}`), nil
+		},
+		CallAndWaitFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			waits = append(waits, method)
+			return json.RawMessage(`null`), nil
+		},
+	}
+
+	code, stdout, stderr := invoke(t, mock, "", "call", "system.info")
+	if code != 0 {
+		t.Fatalf("expected exit 0, got %d: %s", code, stderr)
+	}
+	if gotParams != nil {
+		t.Errorf("expected nil params, got %#v", gotParams)
+	}
+	if want := "{\n  \"version\": \"25.04.1\",\n  \"hostname\": \"nas\"\n}\n"; stdout != want {
+		t.Errorf("stdout = %q, want %q", stdout, want)
+	}
+
+	code, stdout, _ = invoke(t, mock, "", "call", "-o", "yaml", "system.info")
+	if code != 0 || stdout != "version: 25.04.1\nhostname: nas\n" {
+		t.Errorf("unexpected YAML output %d %q", code, stdout)
+	}
+
+	// Job methods are waited for, unless -no-wait.
+	calls, waits = nil, nil
+	if code, _, stderr := invoke(t, mock, "", "call", "app.start", "web"); code != 0 {
+		t.Fatalf("expected exit 0, got %d: %s", code, stderr)
+	}
+	if code, _, stderr := invoke(t, mock, "", "call", "app.start", "web", "-no-wait"); code != 0 {
+		t.Fatalf("expected exit 0, got %d: %s", code, stderr)
+	}
+	if !reflect.DeepEqual(waits, []string{"app.start"}) || !reflect.DeepEqual(calls, []string{"app.start"}) {
+		t.Errorf("expected one wait and one call, got waits %v calls %v", waits, calls)
+	}
+	if !reflect.DeepEqual(gotParams, []any{"web"}) {
+		t.Errorf("expected positional params, got %#v", gotParams)
+	}
+}
+
+func TestRun_CallValidation(t *testing.T) {
+	called := false
+	mock := &client.MockClient{
+		CallFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			called = true
+			return json.RawMessage(`{}`), nil
+		},
+	}
+	args := []string{"call", "pool.dataset.create", `{"name": 5}`}
+
+	code, _, stderr := invoke(t, mock, "", args...)
+	if code != 1 || called {
+		t.Errorf("expected local validation failure, got exit %d, called %v", code, called)
+	}
+	if !strings.Contains(stderr, "expected string, got integer") {
+		t.Errorf("unexpected stderr %q", stderr)
+	}
+
+	code, _, _ = invoke(t, mock, "", append(args, "-no-validate")...)
+	if code != 0 || !called {
+		t.Errorf("expected -no-validate to send the call, got exit %d, called %v", code, called)
+	}
+}
+
+func TestRun_CallNewerReleaseSkipsValidation(t *testing.T) {
+	called := false
+	mock := &client.MockClient{
+		VersionVal: truenas.Version{Major: 25, Minor: 10},
+		CallFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			called = true
+			return json.RawMessage(`{}`), nil
+		},
+	}
+	code, _, stderr := invoke(t, mock, "", "call", "pool.dataset.create", `{"name": "tank/a", "added_in_2510": true}`)
+	if code != 0 || !called {
+		t.Errorf("expected the call to reach the 25.10 server, got exit %d, stderr %q", code, stderr)
+	}
+}
+
+func TestRun_CallFlagLikeParams(t *testing.T) {
+	var gotParams any
+	mock := &client.MockClient{
+		CallFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			gotParams = params
+			return json.RawMessage(`1`), nil
+		},
+	}
+	if code, _, stderr := invoke(t, mock, "", "call", "cronjob.get_instance", "--", "-1"); code != 0 {
+		t.Fatalf("expected exit 0, got %d: %s", code, stderr)
+	}
+	if !reflect.DeepEqual(gotParams, []any{float64(-1)}) {
+		t.Errorf("expected params after -- to be positional, got %#v", gotParams)
+	}
+}
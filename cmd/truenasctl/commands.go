@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+// command is one "<resource> <verb>" entry point.
+type command struct {
+	path      string // e.g. "dataset list"
+	args      string // positional arguments for usage, e.g. "<id>"
+	summary   string
+	streaming bool // runs until interrupted (e.g. log following)
+	hidden    bool // omitted from usage (e.g. the completion helper)
+	run       func(ctx context.Context, e *env, args []string) error
+}
+
+// commands returns every command in usage order.
+func commands() []*command {
+	var all []*command
+	for _, group := range [][]*command{
+		systemCommands(),
+		poolCommands(),
+		datasetCommands(),
+		snapshotCommands(),
+		appCommands(),
+		vmCommands(),
+		cronCommands(),
+		callCommands(),
+		completionCommands(),
+	} {
+		all = append(all, group...)
+	}
+	return all
+}
+
+// findCommand matches the longest command path at the start of args and
+// returns the command with the remaining arguments.
+func findCommand(args []string) (*command, []string) {
+	byPath := make(map[string]*command)
+	for _, c := range commands() {
+		byPath[c.path] = c
+	}
+	for n := min(2, len(args)); n > 0; n-- {
+		if c, ok := byPath[strings.Join(args[:n], " ")]; ok {
+			return c, args[n:]
+		}
+	}
+	return nil, nil
+}
+
+func joinArgs(args []string, n int) string {
+	return strings.Join(args[:min(n, len(args))], " ")
+}
+
+// writeUsage prints the global usage with the command list.
+func writeUsage(w io.Writer, global *flag.FlagSet) {
+	fmt.Fprintf(w, "Usage: truenasctl [global flags] <resource> <verb> [args] [flags]\n\n")
+	fmt.Fprintf(w, "Commands:\n")
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, c := range commands() {
+		if c.hidden {
+			continue
+		}
+		fmt.Fprintf(tw, "  %s %s\t%s\n", c.path, c.args, c.summary)
+	}
+	tw.Flush()
+	fmt.Fprintf(w, "\nGlobal flags:\n")
+	out := global.Output()
+	global.SetOutput(w)
+	global.PrintDefaults()
+	global.SetOutput(out)
+}
+
+// newFlagSet returns the flag set of a command, including the output flags.
+func (e *env) newFlagSet(c string) *flag.FlagSet {
+	fs := flag.NewFlagSet(c, flag.ContinueOnError)
+	fs.SetOutput(e.stderr)
+	e.registerOutputFlags(fs)
+	return fs
+}
+
+// parseArgs parses flags that may appear before, between or after the
+// positional arguments, and checks the number of positionals is between
+// minArgs and maxArgs (-1 for no limit).
+func parseArgs(fs *flag.FlagSet, args []string, usage string, minArgs, maxArgs int) ([]string, error) {
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: truenasctl %s %s [flags]\n", fs.Name(), usage)
+		fs.PrintDefaults()
+	}
+
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, errUsage
+		}
+		rest := fs.Args()
+		if n := len(args) - len(rest); n > 0 && args[n-1] == "--" {
+			// Everything after "--" is positional, even if it looks like a flag.
+			positional = append(positional, rest...)
+			break
+		}
+		args = rest
+		if len(args) == 0 {
+			break
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+
+	if len(positional) < minArgs || (maxArgs >= 0 && len(positional) > maxArgs) {
+		fs.Usage()
+		return nil, errUsage
+	}
+	return positional, nil
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/deevus/truenas-go/api"
+)
+
+func completionCommands() []*command {
+	return []*command{
+		{path: "completion", args: "<bash|zsh|fish>", summary: "Print a shell completion script", run: completionScript},
+		{path: "__complete", hidden: true, run: completeWords},
+	}
+}
+
+// Completion scripts call "truenasctl __complete <words...>" with the words
+// after the program name, the last one being the word under the cursor.
+var completionScripts = map[string]string{
+	"bash": `_truenasctl() {
+	local IFS=$'\n'
+	COMPREPLY=($(truenasctl __complete "${COMP_WORDS[@]:1:COMP_CWORD}" 2>/dev/null))
+}
+complete -o default -F _truenasctl truenasctl
+`,
+	"zsh": `#compdef truenasctl
+_truenasctl() {
+	local -a candidates
+	candidates=(${(f)"$(truenasctl __complete "${(@)words[2,CURRENT]}" 2>/dev/null)"})
+	compadd -a candidates
+}
+compdef _truenasctl truenasctl
+`,
+	"fish": `complete -c truenasctl -f -a '(truenasctl __complete (commandline -opc)[2..-1] (commandline -ct) 2>/dev/null)'
+`,
+}
+
+func completionScript(ctx context.Context, e *env, args []string) error {
+	pos, err := parseArgs(e.newFlagSet("completion"), args, "<bash|zsh|fish>", 1, 1)
+	if err != nil {
+		return err
+	}
+	script, ok := completionScripts[pos[0]]
+	if !ok {
+		return fmt.Errorf("unsupported shell %q (want bash, zsh or fish)", pos[0])
+	}
+	_, err = fmt.Fprint(e.stdout, script)
+	return err
+}
+
+// completeWords prints one completion candidate per line. It never
+// connects, so method names come from the newest embedded API catalog.
+func completeWords(ctx context.Context, e *env, args []string) error {
+	for _, c := range completions(args) {
+		if _, err := fmt.Fprintln(e.stdout, c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// globalValueFlags are the global flags that consume the next word.
+var globalValueFlags = map[string]bool{"config": true, "profile": true, "timeout": true, "o": true}
+
+// completions returns the candidates for the last of words.
+func completions(words []string) []string {
+	if len(words) == 0 {
+		words = []string{""}
+	}
+	prefix := words[len(words)-1]
+	var pos []string
+	for i := 0; i < len(words)-1; i++ {
+		w := words[i]
+		if strings.HasPrefix(w, "-") {
+			name, _, hasValue := strings.Cut(strings.TrimLeft(w, "-"), "=")
+			if globalValueFlags[name] && !hasValue {
+				i++
+			}
+			continue
+		}
+		pos = append(pos, w)
+	}
+	if strings.HasPrefix(prefix, "-") {
+		return nil
+	}
+
+	switch {
+	case len(pos) == 0:
+		return matching(resources(), prefix)
+	case len(pos) == 1 && pos[0] == "call":
+		return matching(methodNames(), prefix)
+	case len(pos) == 1 && pos[0] == "completion":
+		return matching([]string{"bash", "fish", "zsh"}, prefix)
+	case len(pos) == 1:
+		return matching(verbs(pos[0]), prefix)
+	}
+	return nil
+}
+
+// resources returns the first words of the visible commands.
+func resources() []string {
+	seen := make(map[string]bool)
+	var out []string
+	for _, c := range commands() {
+		first, _, _ := strings.Cut(c.path, " ")
+		if !c.hidden && !seen[first] {
+			seen[first] = true
+			out = append(out, first)
+		}
+	}
+	return out
+}
+
+// verbs returns the second words of the commands under resource.
+func verbs(resource string) []string {
+	var out []string
+	for _, c := range commands() {
+		if r, verb, ok := strings.Cut(c.path, " "); ok && r == resource {
+			out = append(out, verb)
+		}
+	}
+	return out
+}
+
+// methodNames returns the sorted method names of the newest API catalog.
+func methodNames() []string {
+	methods, err := api.Methods(api.LatestVersion())
+	if err != nil {
+		return nil
+	}
+	names := make([]string, 0, len(methods))
+	for name := range methods {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func matching(candidates []string, prefix string) []string {
+	var out []string
+	for _, c := range candidates {
+		if strings.HasPrefix(c, prefix) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/deevus/truenas-go/api"
+	"github.com/deevus/truenas-go/client"
+)
+
+func TestCompletions(t *testing.T) {
+	tests := []struct {
+		words []string
+		want  []string
+	}{
+		{[]string{"da"}, []string{"dataset"}},
+		{[]string{"-profile", "prod", "sn"}, []string{"snapshot"}},
+		{[]string{"-o=json", "sn"}, []string{"snapshot"}},
+		{[]string{"cron", "r"}, []string{"run"}},
+		{[]string{"completion", ""}, []string{"bash", "fish", "zsh"}},
+		{[]string{"dataset", "get", ""}, nil},
+		{[]string{"dataset", "-"}, nil},
+		{[]string{"__"}, nil},
+	}
+	for _, tt := range tests {
+		if got := completions(tt.words); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("completions(%q) = %q, want %q", tt.words, got, tt.want)
+		}
+	}
+}
+
+func TestCompletions_Methods(t *testing.T) {
+	got := completions([]string{"call", "pool.dataset."})
+	if len(got) == 0 {
+		t.Fatal("expected method candidates")
+	}
+	for _, m := range got {
+		if !strings.HasPrefix(m, "pool.dataset.") {
+			t.Errorf("unexpected candidate %q", m)
+		}
+		if _, err := api.Method(api.LatestVersion(), m); err != nil {
+			t.Errorf("candidate %q not in catalog: %v", m, err)
+		}
+	}
+	if !slices.Contains(got, "pool.dataset.create") {
+		t.Errorf("expected pool.dataset.create in %q", got)
+	}
+}
+
+func TestRun_CompletionScript(t *testing.T) {
+	for shell := range completionScripts {
+		code, stdout, _ := invoke(t, &client.MockClient{}, "", "completion", shell)
+		if code != 0 || !strings.Contains(stdout, "truenasctl __complete") {
+			t.Errorf("%s: unexpected script (exit %d):\n%s", shell, code, stdout)
+		}
+	}
+	if code, _, _ := invoke(t, &client.MockClient{}, "", "completion", "tcsh"); code != 1 {
+		t.Errorf("expected exit 1 for an unsupported shell, got %d", code)
+	}
+}
+
+func TestRun_CompleteHidden(t *testing.T) {
+	_, stdout, _ := invoke(t, &client.MockClient{}, "")
+	if strings.Contains(stdout, "__complete") {
+		t.Error("expected __complete to be hidden from usage")
+	}
+	code, stdout, _ := invoke(t, &client.MockClient{}, "", "__complete", "call", "system.inf")
+	if code != 0 || stdout != "system.info\n" {
+		t.Errorf("unexpected completion output %d %q", code, stdout)
+	}
+}
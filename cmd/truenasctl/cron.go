@@ -0,0 +1,218 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+
+	truenas "github.com/deevus/truenas-go"
+)
+
+func cronCommands() []*command {
+	return []*command{
+		{path: "cron list", summary: "List cron jobs", run: cronList},
+		{path: "cron get", args: "<id>", summary: "Show a cron job", run: cronGet},
+		{path: "cron create", summary: "Create a cron job", run: cronCreate},
+		{path: "cron update", args: "<id>", summary: "Update a cron job", run: cronUpdate},
+		{path: "cron delete", args: "<id>", summary: "Delete a cron job", run: cronDelete},
+		{path: "cron run", args: "<id>", summary: "Run a cron job now and wait for it", run: cronRun},
+	}
+}
+
+var cronColumns = []column[truenas.CronJob]{
+	{"ID", func(j truenas.CronJob) string { return strconv.FormatInt(j.ID, 10) }},
+	{"USER", func(j truenas.CronJob) string { return j.User }},
+	{"SCHEDULE", func(j truenas.CronJob) string { return scheduleCell(j.Schedule) }},
+	{"ENABLED", func(j truenas.CronJob) string { return boolCell(j.Enabled) }},
+	{"DESCRIPTION", func(j truenas.CronJob) string { return strCell(j.Description) }},
+	{"COMMAND", func(j truenas.CronJob) string { return j.Command }},
+}
+
+func scheduleCell(s truenas.Schedule) string {
+	return fmt.Sprintf("%s %s %s %s %s", s.Minute, s.Hour, s.Dom, s.Month, s.Dow)
+}
+
+func cronService(ctx context.Context, e *env) (*truenas.CronService, error) {
+	c, err := e.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return truenas.NewCronService(c, c.Version()), nil
+}
+
+func cronList(ctx context.Context, e *env, args []string) error {
+	if _, err := parseArgs(e.newFlagSet("cron list"), args, "", 0, 0); err != nil {
+		return err
+	}
+	svc, err := cronService(ctx, e)
+	if err != nil {
+		return err
+	}
+	jobs, err := svc.List(ctx)
+	if err != nil {
+		return err
+	}
+	return printList(e, jobs, cronColumns)
+}
+
+func cronGet(ctx context.Context, e *env, args []string) error {
+	id, err := parseIDArgs(e.newFlagSet("cron get"), "cron job", args)
+	if err != nil {
+		return err
+	}
+	svc, err := cronService(ctx, e)
+	if err != nil {
+		return err
+	}
+	job, err := svc.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	return printFound(e, job, "cron job", strconv.FormatInt(id, 10), cronColumns)
+}
+
+// addCronFlags registers the flags shared by cron create and update.
+// Flags given on the command line override the spec file.
+func addCronFlags(fs *flag.FlagSet) (file *string) {
+	fs.String("user", "", "user to run the command as")
+	fs.String("command", "", "command to run")
+	fs.String("description", "", "description")
+	fs.String("schedule", "", `schedule as five cron fields, e.g. "0 3 * * *"`)
+	fs.Bool("enabled", true, "enable the job")
+	fs.Bool("capture-stdout", false, "mail the command's stdout")
+	fs.Bool("capture-stderr", false, "mail the command's stderr")
+	return fs.String("f", "", "read CreateCronJobOpts from a JSON or YAML file (- for stdin)")
+}
+
+// applyCronFlags copies the flags that were set onto opts.
+func applyCronFlags(fs *flag.FlagSet, opts *truenas.CreateCronJobOpts) error {
+	var err error
+	fs.Visit(func(f *flag.Flag) {
+		v := f.Value.String()
+		switch f.Name {
+		case "user":
+			opts.User = v
+		case "command":
+			opts.Command = v
+		case "description":
+			opts.Description = v
+		case "enabled":
+			opts.Enabled = v == "true"
+		case "capture-stdout":
+			opts.CaptureStdout = v == "true"
+		case "capture-stderr":
+			opts.CaptureStderr = v == "true"
+		case "schedule":
+			fields := strings.Fields(v)
+			if len(fields) != 5 {
+				err = fmt.Errorf("invalid schedule %q: want five fields", v)
+				return
+			}
+			opts.Schedule = truenas.Schedule{Minute: fields[0], Hour: fields[1], Dom: fields[2], Month: fields[3], Dow: fields[4]}
+		}
+	})
+	return err
+}
+
+func cronCreate(ctx context.Context, e *env, args []string) error {
+	fs := e.newFlagSet("cron create")
+	file := addCronFlags(fs)
+	if _, err := parseArgs(fs, args, "", 0, 0); err != nil {
+		return err
+	}
+
+	opts := truenas.CreateCronJobOpts{
+		User:     "root",
+		Enabled:  true,
+		Schedule: truenas.Schedule{Minute: "00", Hour: "*", Dom: "*", Month: "*", Dow: "*"},
+	}
+	if *file != "" {
+		if err := readSpec(*file, e.stdin, &opts); err != nil {
+			return err
+		}
+	}
+	if err := applyCronFlags(fs, &opts); err != nil {
+		return err
+	}
+	if opts.Command == "" {
+		return fmt.Errorf("command is required")
+	}
+
+	svc, err := cronService(ctx, e)
+	if err != nil {
+		return err
+	}
+	job, err := svc.Create(ctx, opts)
+	if err != nil {
+		return err
+	}
+	return printFound(e, job, "cron job", opts.Command, cronColumns)
+}
+
+func cronUpdate(ctx context.Context, e *env, args []string) error {
+	fs := e.newFlagSet("cron update")
+	file := addCronFlags(fs)
+	id, err := parseIDArgs(fs, "cron job", args)
+	if err != nil {
+		return err
+	}
+	svc, err := cronService(ctx, e)
+	if err != nil {
+		return err
+	}
+
+	// Updates replace every field, so start from the current job.
+	current, err := svc.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		return fmt.Errorf("cron job %d not found", id)
+	}
+	var opts truenas.UpdateCronJobOpts
+	if err := convert(*current, &opts); err != nil {
+		return err
+	}
+	if *file != "" {
+		if err := readSpec(*file, e.stdin, &opts); err != nil {
+			return err
+		}
+	}
+	if err := applyCronFlags(fs, &opts); err != nil {
+		return err
+	}
+
+	job, err := svc.Update(ctx, id, opts)
+	if err != nil {
+		return err
+	}
+	return printFound(e, job, "cron job", strconv.FormatInt(id, 10), cronColumns)
+}
+
+func cronDelete(ctx context.Context, e *env, args []string) error {
+	id, err := parseIDArgs(e.newFlagSet("cron delete"), "cron job", args)
+	if err != nil {
+		return err
+	}
+	svc, err := cronService(ctx, e)
+	if err != nil {
+		return err
+	}
+	return svc.Delete(ctx, id)
+}
+
+func cronRun(ctx context.Context, e *env, args []string) error {
+	fs := e.newFlagSet("cron run")
+	skipDisabled := fs.Bool("skip-disabled", false, "do nothing if the job is disabled")
+	id, err := parseIDArgs(fs, "cron job", args)
+	if err != nil {
+		return err
+	}
+	svc, err := cronService(ctx, e)
+	if err != nil {
+		return err
+	}
+	return svc.Run(ctx, id, *skipDisabled)
+}
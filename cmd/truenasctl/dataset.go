@@ -0,0 +1,178 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	truenas "github.com/deevus/truenas-go"
+)
+
+func datasetCommands() []*command {
+	return []*command{
+		{path: "dataset list", summary: "List datasets", run: datasetList},
+		{path: "dataset get", args: "<id>", summary: "Show a dataset", run: datasetGet},
+		{path: "dataset create", args: "<name>", summary: "Create a dataset", run: datasetCreate},
+		{path: "dataset update", args: "<id>", summary: "Update a dataset", run: datasetUpdate},
+		{path: "dataset delete", args: "<id>", summary: "Delete a dataset", run: datasetDelete},
+	}
+}
+
+var datasetColumns = []column[truenas.Dataset]{
+	{"NAME", func(d truenas.Dataset) string { return d.ID }},
+	{"USED", func(d truenas.Dataset) string { return bytesCell(d.Used) }},
+	{"AVAIL", func(d truenas.Dataset) string { return bytesCell(d.Available) }},
+	{"QUOTA", func(d truenas.Dataset) string { return bytesCell(d.Quota) }},
+	{"COMPRESSION", func(d truenas.Dataset) string { return strCell(d.Compression) }},
+	{"MOUNTPOINT", func(d truenas.Dataset) string { return strCell(d.Mountpoint) }},
+}
+
+func datasetService(ctx context.Context, e *env) (*truenas.DatasetService, error) {
+	c, err := e.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return truenas.NewDatasetService(c, c.Version()), nil
+}
+
+func datasetList(ctx context.Context, e *env, args []string) error {
+	if _, err := parseArgs(e.newFlagSet("dataset list"), args, "", 0, 0); err != nil {
+		return err
+	}
+	svc, err := datasetService(ctx, e)
+	if err != nil {
+		return err
+	}
+	datasets, err := svc.ListDatasets(ctx)
+	if err != nil {
+		return err
+	}
+	return printList(e, datasets, datasetColumns)
+}
+
+func datasetGet(ctx context.Context, e *env, args []string) error {
+	pos, err := parseArgs(e.newFlagSet("dataset get"), args, "<id>", 1, 1)
+	if err != nil {
+		return err
+	}
+	svc, err := datasetService(ctx, e)
+	if err != nil {
+		return err
+	}
+	ds, err := svc.GetDataset(ctx, pos[0])
+	if err != nil {
+		return err
+	}
+	return printFound(e, ds, "dataset", pos[0], datasetColumns)
+}
+
+func datasetCreate(ctx context.Context, e *env, args []string) error {
+	fs := e.newFlagSet("dataset create")
+	file := fs.String("f", "", "read CreateDatasetOpts from a JSON or YAML file (- for stdin)")
+	compression := fs.String("compression", "", "compression algorithm, e.g. lz4")
+	comments := fs.String("comments", "", "comments")
+	atime := fs.String("atime", "", "atime setting (ON or OFF)")
+	var quota, refquota sizeFlag
+	fs.Var(&quota, "quota", "quota, e.g. 100G")
+	fs.Var(&refquota, "refquota", "reference quota, e.g. 100G")
+	pos, err := parseArgs(fs, args, "<name>", 0, 1)
+	if err != nil {
+		return err
+	}
+
+	var opts truenas.CreateDatasetOpts
+	if *file != "" {
+		if err := readSpec(*file, e.stdin, &opts); err != nil {
+			return err
+		}
+	}
+	if len(pos) == 1 {
+		opts.Name = pos[0]
+	}
+	if opts.Name == "" {
+		return fmt.Errorf("dataset name is required")
+	}
+	setIfNotEmpty(&opts.Compression, *compression)
+	setIfNotEmpty(&opts.Comments, *comments)
+	setIfNotEmpty(&opts.Atime, *atime)
+	if quota.set {
+		opts.Quota = quota.value
+	}
+	if refquota.set {
+		opts.RefQuota = refquota.value
+	}
+
+	svc, err := datasetService(ctx, e)
+	if err != nil {
+		return err
+	}
+	ds, err := svc.CreateDataset(ctx, opts)
+	if err != nil {
+		return err
+	}
+	return printFound(e, ds, "dataset", opts.Name, datasetColumns)
+}
+
+func datasetUpdate(ctx context.Context, e *env, args []string) error {
+	fs := e.newFlagSet("dataset update")
+	file := fs.String("f", "", "read UpdateDatasetOpts from a JSON or YAML file (- for stdin)")
+	compression := fs.String("compression", "", "compression algorithm, e.g. lz4")
+	atime := fs.String("atime", "", "atime setting (ON or OFF)")
+	var comments optionalString
+	fs.Var(&comments, "comments", "comments")
+	var quota, refquota sizeFlag
+	fs.Var(&quota, "quota", "quota, e.g. 100G (0 to remove)")
+	fs.Var(&refquota, "refquota", "reference quota, e.g. 100G (0 to remove)")
+	pos, err := parseArgs(fs, args, "<id>", 1, 1)
+	if err != nil {
+		return err
+	}
+
+	var opts truenas.UpdateDatasetOpts
+	if *file != "" {
+		if err := readSpec(*file, e.stdin, &opts); err != nil {
+			return err
+		}
+	}
+	setIfNotEmpty(&opts.Compression, *compression)
+	setIfNotEmpty(&opts.Atime, *atime)
+	if p := comments.ptr(); p != nil {
+		opts.Comments = p
+	}
+	if p := quota.ptr(); p != nil {
+		opts.Quota = p
+	}
+	if p := refquota.ptr(); p != nil {
+		opts.RefQuota = p
+	}
+
+	svc, err := datasetService(ctx, e)
+	if err != nil {
+		return err
+	}
+	ds, err := svc.UpdateDataset(ctx, pos[0], opts)
+	if err != nil {
+		return err
+	}
+	return printFound(e, ds, "dataset", pos[0], datasetColumns)
+}
+
+func datasetDelete(ctx context.Context, e *env, args []string) error {
+	fs := e.newFlagSet("dataset delete")
+	recursive := fs.Bool("recursive", false, "also delete child datasets and snapshots")
+	pos, err := parseArgs(fs, args, "<id>", 1, 1)
+	if err != nil {
+		return err
+	}
+	svc, err := datasetService(ctx, e)
+	if err != nil {
+		return err
+	}
+	return svc.DeleteDataset(ctx, pos[0], *recursive)
+}
+
+// setIfNotEmpty overrides *dst with a flag value that was given.
+func setIfNotEmpty(dst *string, v string) {
+	if v != "" {
+		*dst = v
+	}
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	truenas "github.com/deevus/truenas-go"
+	"gopkg.in/yaml.v3"
+)
+
+// readSpec decodes a JSON or YAML file ("-" for stdin) into the options
+// struct v. Keys are the Go field names, matched case-insensitively, the
+// same shape -o json prints:
+//
+//	Name: tank/data
+//	Compression: lz4
+func readSpec(path string, stdin io.Reader, v any) error {
+	data, err := readInput(path, stdin)
+	if err != nil {
+		return err
+	}
+
+	// YAML is a superset of JSON, so decode generically and re-encode as
+	// JSON to reuse encoding/json's field matching.
+	var generic any
+	if err := yaml.Unmarshal(data, &generic); err != nil {
+		return fmt.Errorf("parse %s: %w", path, err)
+	}
+	js, err := json.Marshal(generic)
+	if err != nil {
+		return fmt.Errorf("parse %s: %w", path, err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(js))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		return fmt.Errorf("parse %s: %w", path, err)
+	}
+	return nil
+}
+
+// convert copies the fields of src into dst by name, e.g. a VM into the
+// UpdateVMOpts that would recreate it.
+func convert(src, dst any) error {
+	data, err := json.Marshal(src)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, dst)
+}
+
+// readInput reads a file, or stdin when path is "-".
+func readInput(path string, stdin io.Reader) ([]byte, error) {
+	if path == "-" {
+		return io.ReadAll(stdin)
+	}
+	return os.ReadFile(path)
+}
+
+// sizeFlag is a byte size flag accepting values like "10G" or "512MiB".
+type sizeFlag struct {
+	value int64
+	set   bool
+}
+
+func (f *sizeFlag) String() string {
+	if f == nil || !f.set {
+		return ""
+	}
+	return fmt.Sprint(f.value)
+}
+
+func (f *sizeFlag) Set(s string) error {
+	n, err := truenas.ParseSize(s)
+	if err != nil {
+		return err
+	}
+	f.value, f.set = n, true
+	return nil
+}
+
+// ptr returns a pointer to the value if the flag was set, or nil.
+func (f *sizeFlag) ptr() *int64 {
+	if !f.set {
+		return nil
+	}
+	v := f.value
+	return &v
+}
+
+// optionalString is a string flag that records whether it was set, for
+// update options where nil means "don't change".
+type optionalString struct {
+	value string
+	set   bool
+}
+
+func (f *optionalString) String() string {
+	if f == nil {
+		return ""
+	}
+	return f.value
+}
+
+func (f *optionalString) Set(s string) error {
+	f.value, f.set = s, true
+	return nil
+}
+
+func (f *optionalString) ptr() *string {
+	if !f.set {
+		return nil
+	}
+	v := f.value
+	return &v
+}
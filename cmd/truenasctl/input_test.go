@@ -0,0 +1,69 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	truenas "github.com/deevus/truenas-go"
+)
+
+func TestReadSpec(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"yaml", "Name: tank/data\ncompression: lz4\nQuota: 1024\n"},
+		{"json", `{"Name": "tank/data", "Compression": "lz4", "quota": 1024}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var opts truenas.CreateDatasetOpts
+			if err := readSpec("-", strings.NewReader(tt.input), &opts); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if opts.Name != "tank/data" || opts.Compression != "lz4" || opts.Quota != 1024 {
+				t.Errorf("unexpected opts: %+v", opts)
+			}
+		})
+	}
+}
+
+func TestReadSpec_File(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "spec.yaml")
+	if err := os.WriteFile(path, []byte("Name: tank/data\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	var opts truenas.CreateDatasetOpts
+	if err := readSpec(path, nil, &opts); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if opts.Name != "tank/data" {
+		t.Errorf("expected name from file, got %q", opts.Name)
+	}
+}
+
+func TestReadSpec_UnknownField(t *testing.T) {
+	var opts truenas.CreateDatasetOpts
+	err := readSpec("-", strings.NewReader("Name: x\nCompresion: lz4\n"), &opts)
+	if err == nil || !strings.Contains(err.Error(), "Compresion") {
+		t.Errorf("expected unknown field error, got %v", err)
+	}
+}
+
+func TestSizeFlag(t *testing.T) {
+	var f sizeFlag
+	if f.ptr() != nil {
+		t.Error("expected nil before Set")
+	}
+	if err := f.Set("2KiB"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p := f.ptr(); p == nil || *p != 2048 {
+		t.Errorf("expected 2048, got %v", p)
+	}
+	if err := f.Set("lots"); err == nil {
+		t.Error("expected error for invalid size")
+	}
+}
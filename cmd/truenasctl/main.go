@@ -0,0 +1,192 @@
+// Command truenasctl manages TrueNAS resources from the command line using
+// the typed services of this module.
+//
+//	truenasctl [global flags] <resource> <verb> [args] [flags]
+//
+//	truenasctl dataset list
+//	truenasctl -profile prod snapshot create tank/data nightly -o json
+//	truenasctl app create web -compose compose.yaml
+//	truenasctl app logs web 3f2a1c --tail 100
+//	truenasctl call pool.dataset.query '[["pool", "=", "tank"]]'
+//
+// Connection settings come from a client profile (see client.LoadConfig):
+// -config and -profile, or TRUENAS_CONFIG, TRUENAS_PROFILE and the other
+// TRUENAS_* environment variables. Output is a table by default, or JSON or
+// YAML with -o. Progress of long-running jobs is written to stderr unless
+// -quiet is set. With -dry-run, commands read the real system but only
+// print the changes they would make.
+//
+// call sends any API method over the profile's transport, waiting for job
+// methods and checking params against the embedded API schema. Shell
+// completion of commands and method names is available with
+// "truenasctl completion bash|zsh|fish".
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/deevus/truenas-go/client"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	os.Exit(run(ctx, os.Args[1:], os.Stdin, os.Stdout, os.Stderr, connectProfile))
+}
+
+// connectFunc returns a connected client for the given config file and
+// profile name.
+type connectFunc func(ctx context.Context, config, profile string) (client.Client, error)
+
+// connectProfile loads a profile and connects to it.
+func connectProfile(ctx context.Context, config, profile string) (client.Client, error) {
+	p, err := client.LoadConfig(config, profile)
+	if err != nil {
+		return nil, err
+	}
+	return client.New(ctx, p)
+}
+
+// errUsage reports invalid arguments; usage has already been printed.
+var errUsage = errors.New("usage")
+
+// run executes one invocation and returns the process exit code.
+func run(ctx context.Context, args []string, stdin io.Reader, stdout, stderr io.Writer, connect connectFunc) int {
+	e := &env{stdin: stdin, stdout: stdout, stderr: stderr, format: formatTable}
+
+	fs := flag.NewFlagSet("truenasctl", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() { writeUsage(stderr, fs) }
+	config := fs.String("config", "", "config file path (default: $TRUENAS_CONFIG or user config dir)")
+	profile := fs.String("profile", "", "profile name (default: $TRUENAS_PROFILE or default_profile)")
+	timeout := fs.Duration("timeout", 0, "overall timeout, e.g. 5m (default: none)")
+	dryRun := fs.Bool("dry-run", false, "print the changes a command would make instead of making them")
+	e.registerOutputFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+
+	if fs.NArg() == 0 || fs.Arg(0) == "help" {
+		writeUsage(stdout, fs)
+		return 0
+	}
+
+	cmd, rest := findCommand(fs.Args())
+	if cmd == nil {
+		fmt.Fprintf(stderr, "unknown command %q\n\n", joinArgs(fs.Args(), 2))
+		writeUsage(stderr, fs)
+		return 2
+	}
+
+	if *timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+	var preview *client.DryRunClient
+	e.connect = func(ctx context.Context) (client.Client, error) {
+		c, err := connect(ctx, *config, *profile)
+		if err != nil || !*dryRun {
+			return c, err
+		}
+		preview = client.NewDryRunClient(c)
+		return preview, nil
+	}
+	defer e.close()
+
+	// -quiet may still be set by the command's own flags, so it is checked
+	// when progress arrives.
+	progress := newProgressPrinter(stderr)
+	ctx = client.WithJobProgress(ctx, func(p client.JobProgress) {
+		if !e.quiet {
+			progress.report(p)
+		}
+	})
+
+	err := cmd.run(ctx, e, rest)
+	if preview != nil {
+		writeDryRun(stderr, preview.Calls())
+	}
+	switch {
+	case err == nil:
+		return 0
+	case errors.Is(err, errUsage):
+		return 2
+	case errors.Is(err, context.Canceled) && cmd.streaming:
+		// Interrupting a follow command is the normal way to stop it.
+		return 0
+	default:
+		fmt.Fprintf(stderr, "error: %v\n", err)
+		return 1
+	}
+}
+
+// writeDryRun lists the calls a -dry-run invocation intercepted.
+func writeDryRun(w io.Writer, calls []client.DryRunCall) {
+	if len(calls) == 0 {
+		fmt.Fprintln(w, "dry run: no changes")
+		return
+	}
+	for _, c := range calls {
+		params, err := json.Marshal(c.Params)
+		if err != nil {
+			params = []byte(fmt.Sprint(c.Params))
+		}
+		fmt.Fprintf(w, "dry run: %s %s\n", c.Method, params)
+	}
+}
+
+// env carries the per-invocation state shared by commands.
+type env struct {
+	stdin          io.Reader
+	stdout, stderr io.Writer
+	format         string
+	quiet          bool
+	connect        func(ctx context.Context) (client.Client, error)
+	c              client.Client
+}
+
+// registerOutputFlags adds the output flags accepted both globally and by
+// every command.
+func (e *env) registerOutputFlags(fs *flag.FlagSet) {
+	fs.Func("o", "output format: table, json or yaml (default table)", func(s string) error {
+		switch s {
+		case formatTable, formatJSON, formatYAML:
+			e.format = s
+			return nil
+		}
+		return fmt.Errorf("unknown output format %q", s)
+	})
+	fs.BoolVar(&e.quiet, "quiet", e.quiet, "do not report job progress on stderr")
+}
+
+// client connects on first use, so help and usage errors need no system.
+func (e *env) client(ctx context.Context) (client.Client, error) {
+	if e.c != nil {
+		return e.c, nil
+	}
+	c, err := e.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	e.c = c
+	return c, nil
+}
+
+func (e *env) close() {
+	if e.c != nil {
+		_ = e.c.Close()
+	}
+}
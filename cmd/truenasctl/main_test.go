@@ -0,0 +1,272 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	truenas "github.com/deevus/truenas-go"
+	"github.com/deevus/truenas-go/client"
+)
+
+const datasetsJSON = `[
+	{"id": "tank/data", "name": "tank/data", "pool": "tank", "type": "FILESYSTEM", "mountpoint": "/mnt/tank/data",
+	 "compression": {"value": "LZ4"}, "used": {"parsed": 2048}, "available": {"parsed": 1073741824}}
+]`
+
+// invoke runs truenasctl against mock and returns the exit code and output.
+func invoke(t *testing.T, mock *client.MockClient, stdin string, args ...string) (int, string, string) {
+	t.Helper()
+	if mock.VersionVal.Major == 0 {
+		mock.VersionVal = truenas.Version{Major: 25, Minor: 4}
+	}
+	connect := func(ctx context.Context, config, profile string) (client.Client, error) {
+		return mock, nil
+	}
+	var stdout, stderr bytes.Buffer
+	code := run(context.Background(), args, strings.NewReader(stdin), &stdout, &stderr, connect)
+	return code, stdout.String(), stderr.String()
+}
+
+func TestRun_Help(t *testing.T) {
+	code, stdout, _ := invoke(t, &client.MockClient{}, "")
+	if code != 0 {
+		t.Fatalf("expected exit 0, got %d", code)
+	}
+	for _, want := range []string{"dataset list", "app logs <name> [container]", "cron run <id>", "-profile"} {
+		if !strings.Contains(stdout, want) {
+			t.Errorf("usage missing %q:\n%s", want, stdout)
+		}
+	}
+}
+
+func TestRun_UnknownCommand(t *testing.T) {
+	connected := false
+	mock := &client.MockClient{ConnectFunc: func(ctx context.Context) error {
+		connected = true
+		return nil
+	}}
+	code, _, stderr := invoke(t, mock, "", "dataset", "frobnicate")
+	if code != 2 {
+		t.Errorf("expected exit 2, got %d", code)
+	}
+	if !strings.Contains(stderr, "unknown command") {
+		t.Errorf("expected unknown command error, got %q", stderr)
+	}
+	if connected {
+		t.Error("expected no connection for an unknown command")
+	}
+}
+
+func TestRun_DatasetList(t *testing.T) {
+	mock := &client.MockClient{
+		CallFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			if method != "pool.dataset.query" {
+				t.Errorf("unexpected method %q", method)
+			}
+			return json.RawMessage(datasetsJSON), nil
+		},
+	}
+
+	t.Run("table", func(t *testing.T) {
+		code, stdout, _ := invoke(t, mock, "", "dataset", "list")
+		if code != 0 {
+			t.Fatalf("expected exit 0, got %d", code)
+		}
+		lines := strings.Split(strings.TrimSpace(stdout), "\n")
+		if len(lines) != 2 {
+			t.Fatalf("expected header and one row, got %q", stdout)
+		}
+		if !strings.HasPrefix(lines[0], "NAME") || !strings.Contains(lines[1], "tank/data") || !strings.Contains(lines[1], "2.0 KiB") {
+			t.Errorf("unexpected table:\n%s", stdout)
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		code, stdout, _ := invoke(t, mock, "", "dataset", "list", "-o", "json")
+		if code != 0 {
+			t.Fatalf("expected exit 0, got %d", code)
+		}
+		var got []truenas.Dataset
+		if err := json.Unmarshal([]byte(stdout), &got); err != nil {
+			t.Fatalf("invalid JSON %q: %v", stdout, err)
+		}
+		if len(got) != 1 || got[0].ID != "tank/data" || got[0].Used != 2048 {
+			t.Errorf("unexpected datasets: %+v", got)
+		}
+	})
+
+	t.Run("global yaml", func(t *testing.T) {
+		code, stdout, _ := invoke(t, mock, "", "-o", "yaml", "dataset", "list")
+		if code != 0 {
+			t.Fatalf("expected exit 0, got %d", code)
+		}
+		if !strings.Contains(stdout, "- ID: tank/data\n") {
+			t.Errorf("unexpected YAML:\n%s", stdout)
+		}
+	})
+}
+
+func TestRun_GetNotFound(t *testing.T) {
+	mock := &client.MockClient{
+		CallFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return json.RawMessage(`[]`), nil
+		},
+	}
+	code, stdout, stderr := invoke(t, mock, "", "dataset", "get", "tank/missing")
+	if code != 1 {
+		t.Errorf("expected exit 1, got %d", code)
+	}
+	if stdout != "" {
+		t.Errorf("expected no output, got %q", stdout)
+	}
+	if !strings.Contains(stderr, `dataset "tank/missing" not found`) {
+		t.Errorf("unexpected stderr %q", stderr)
+	}
+}
+
+func TestRun_UsageErrors(t *testing.T) {
+	tests := [][]string{
+		{"dataset", "get"},
+		{"dataset", "get", "a", "b"},
+		{"dataset", "list", "-bogus"},
+		{"vm", "get", "abc"},
+		{"-o", "xml", "dataset", "list"},
+	}
+	for _, args := range tests {
+		code, _, _ := invoke(t, &client.MockClient{}, "", args...)
+		if code == 0 {
+			t.Errorf("%v: expected failure, got exit 0", args)
+		}
+	}
+	if code, _, _ := invoke(t, &client.MockClient{}, "", "dataset", "get"); code != 2 {
+		t.Errorf("expected exit 2 for missing argument, got %d", code)
+	}
+}
+
+func TestRun_CallError(t *testing.T) {
+	mock := &client.MockClient{
+		CallFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return nil, errors.New("connection refused")
+		},
+	}
+	code, _, stderr := invoke(t, mock, "", "dataset", "list")
+	if code != 1 {
+		t.Errorf("expected exit 1, got %d", code)
+	}
+	if !strings.Contains(stderr, "error: connection refused") {
+		t.Errorf("unexpected stderr %q", stderr)
+	}
+}
+
+func TestRun_DatasetCreate_FlagsAfterArgs(t *testing.T) {
+	var got map[string]any
+	mock := &client.MockClient{
+		CallFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			switch method {
+			case "pool.dataset.create":
+				got = params.(map[string]any)
+				return json.RawMessage(`{"id": "tank/new", "name": "tank/new"}`), nil
+			case "pool.dataset.query":
+				return json.RawMessage(`[{"id": "tank/new", "name": "tank/new"}]`), nil
+			}
+			t.Fatalf("unexpected method %q", method)
+			return nil, nil
+		},
+	}
+	code, _, stderr := invoke(t, mock, "", "dataset", "create", "tank/new", "-compression", "zstd", "-quota", "10GiB")
+	if code != 0 {
+		t.Fatalf("expected exit 0, got %d: %s", code, stderr)
+	}
+	if got["name"] != "tank/new" || got["compression"] != "ZSTD" && got["compression"] != "zstd" {
+		t.Errorf("unexpected params: %v", got)
+	}
+	if q, ok := got["quota"].(int64); !ok || q != 10*1024*1024*1024 {
+		t.Errorf("expected quota 10GiB, got %v", got["quota"])
+	}
+}
+
+func TestRun_CronUpdateKeepsFields(t *testing.T) {
+	var got map[string]any
+	mock := &client.MockClient{
+		CallFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			switch method {
+			case "cronjob.get_instance":
+				return json.RawMessage(`{"id": 3, "user": "root", "command": "backup.sh", "enabled": true,
+					"stdout": true, "stderr": false, "schedule": {"minute": "0", "hour": "3", "dom": "*", "month": "*", "dow": "*"}}`), nil
+			case "cronjob.update":
+				got = params.([]any)[1].(map[string]any)
+				return json.RawMessage(`{}`), nil
+			}
+			t.Fatalf("unexpected method %q", method)
+			return nil, nil
+		},
+	}
+	code, _, stderr := invoke(t, mock, "description: nightly\n", "cron", "update", "3", "-f", "-", "-schedule", "30 4 * * 1")
+	if code != 0 {
+		t.Fatalf("expected exit 0, got %d: %s", code, stderr)
+	}
+	if got["command"] != "backup.sh" || got["description"] != "nightly" || got["enabled"] != true {
+		t.Errorf("expected existing fields kept and spec applied, got %v", got)
+	}
+	if got["stderr"] != false {
+		t.Errorf("expected stderr capture kept, got %v", got["stderr"])
+	}
+	sched := got["schedule"].(map[string]any)
+	if sched["minute"] != "30" || sched["hour"] != "4" || sched["dow"] != "1" {
+		t.Errorf("unexpected schedule %v", sched)
+	}
+}
+
+func TestRun_JobProgress(t *testing.T) {
+	mock := &client.MockClient{
+		CallAndWaitFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			for _, pct := range []float64{10, 10, 100} {
+				client.ReportJobProgress(ctx, client.JobProgress{JobID: 7, Method: method, Percent: pct})
+			}
+			return json.RawMessage(`null`), nil
+		},
+	}
+
+	code, _, stderr := invoke(t, mock, "", "cron", "run", "3")
+	if code != 0 {
+		t.Fatalf("expected exit 0, got %d: %s", code, stderr)
+	}
+	if want := "[cronjob.run]  10%\n[cronjob.run] 100%\n"; stderr != want {
+		t.Errorf("stderr = %q, want %q", stderr, want)
+	}
+
+	code, _, stderr = invoke(t, mock, "", "cron", "run", "3", "-quiet")
+	if code != 0 || stderr != "" {
+		t.Errorf("expected no progress with -quiet, got %d %q", code, stderr)
+	}
+}
+
+func TestRun_DryRun(t *testing.T) {
+	mock := &client.MockClient{
+		CallFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			if method != "pool.dataset.query" {
+				t.Errorf("unexpected call %s reached the system", method)
+			}
+			return json.RawMessage(`[]`), nil
+		},
+	}
+	code, stdout, stderr := invoke(t, mock, "", "-dry-run", "dataset", "create", "tank/new", "-compression", "lz4")
+	if code != 0 {
+		t.Fatalf("expected exit 0, got %d: %s", code, stderr)
+	}
+	if !strings.Contains(stdout, "tank/new") {
+		t.Errorf("expected placeholder dataset in output, got %q", stdout)
+	}
+	if want := `dry run: pool.dataset.create {"compression":"lz4","name":"tank/new","type":"FILESYSTEM"}`; !strings.Contains(stderr, want) {
+		t.Errorf("stderr %q missing %q", stderr, want)
+	}
+
+	_, _, stderr = invoke(t, mock, "", "-dry-run", "dataset", "list")
+	if stderr != "dry run: no changes\n" {
+		t.Errorf("unexpected stderr %q", stderr)
+	}
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/dustin/go-humanize"
+	"gopkg.in/yaml.v3"
+)
+
+// Output formats accepted by -o.
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+	formatYAML  = "yaml"
+)
+
+// column renders one table column of a T.
+type column[T any] struct {
+	header string
+	value  func(T) string
+}
+
+// printList writes items as a table, or as a JSON/YAML array.
+func printList[T any](e *env, items []T, cols []column[T]) error {
+	if e.format != formatTable {
+		if items == nil {
+			items = []T{}
+		}
+		return e.printValue(items)
+	}
+
+	tw := tabwriter.NewWriter(e.stdout, 0, 0, 3, ' ', 0)
+	headers := make([]string, len(cols))
+	for i, c := range cols {
+		headers[i] = c.header
+	}
+	fmt.Fprintln(tw, strings.Join(headers, "\t"))
+	for _, item := range items {
+		values := make([]string, len(cols))
+		for i, c := range cols {
+			values[i] = c.value(item)
+		}
+		fmt.Fprintln(tw, strings.Join(values, "\t"))
+	}
+	return tw.Flush()
+}
+
+// printItem writes a single item as a one-row table, or as a JSON/YAML object.
+func printItem[T any](e *env, item T, cols []column[T]) error {
+	if e.format != formatTable {
+		return e.printValue(item)
+	}
+	return printList(e, []T{item}, cols)
+}
+
+// printValue writes v as JSON or YAML. YAML uses the same keys as JSON.
+func (e *env) printValue(v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	if e.format == formatJSON {
+		_, err = fmt.Fprintf(e.stdout, "%s\n", data)
+		return err
+	}
+	out, err := jsonToYAML(data)
+	if err != nil {
+		return err
+	}
+	_, err = e.stdout.Write(out)
+	return err
+}
+
+// jsonToYAML converts JSON to block-style YAML, keeping key order.
+func jsonToYAML(data []byte) ([]byte, error) {
+	// JSON is valid YAML; parsing it into a node keeps the field order that
+	// decoding into a map would lose.
+	var node yaml.Node
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		return nil, err
+	}
+	resetStyle(&node)
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&node); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// resetStyle clears the flow and quoting styles taken from the JSON input so
+// the encoder picks block style and quotes strings only where needed.
+func resetStyle(n *yaml.Node) {
+	n.Style = 0
+	for _, c := range n.Content {
+		resetStyle(c)
+	}
+}
+
+// Table cell helpers.
+
+func bytesCell(n int64) string {
+	if n <= 0 {
+		return "-"
+	}
+	return humanize.IBytes(uint64(n))
+}
+
+func boolCell(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
+
+func strCell(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+// printFound writes *item, or reports that the kind/id was not found.
+func printFound[T any](e *env, item *T, kind, id string, cols []column[T]) error {
+	if item == nil {
+		return fmt.Errorf("%s %q not found", kind, id)
+	}
+	return printItem(e, *item, cols)
+}
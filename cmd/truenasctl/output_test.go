@@ -0,0 +1,62 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestJSONToYAML(t *testing.T) {
+	got, err := jsonToYAML([]byte(`{"Name": "tank", "Size": 10, "Version": "25.04", "Tags": ["a", "b"], "Empty": []}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "Name: tank\nSize: 10\nVersion: \"25.04\"\nTags:\n  - a\n  - b\nEmpty: []\n"
+	if string(got) != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestPrintList(t *testing.T) {
+	type row struct {
+		Name string
+		Size int64
+	}
+	cols := []column[row]{
+		{"NAME", func(r row) string { return r.Name }},
+		{"SIZE", func(r row) string { return bytesCell(r.Size) }},
+	}
+
+	tests := []struct {
+		format string
+		items  []row
+		want   string
+	}{
+		{formatTable, []row{{"a", 1024}, {"bb", 0}}, "NAME   SIZE\na      1.0 KiB\nbb     -\n"},
+		{formatJSON, nil, "[]\n"},
+		{formatJSON, []row{{"a", 1}}, "[\n  {\n    \"Name\": \"a\",\n    \"Size\": 1\n  }\n]\n"},
+		{formatYAML, []row{{"a", 1}}, "- Name: a\n  Size: 1\n"},
+	}
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		e := &env{stdout: &buf, format: tt.format}
+		if err := printList(e, tt.items, cols); err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.format, err)
+		}
+		if buf.String() != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.format, buf.String(), tt.want)
+		}
+	}
+}
+
+func TestPrintFound_Nil(t *testing.T) {
+	var buf bytes.Buffer
+	e := &env{stdout: &buf, format: formatTable}
+	err := printFound[struct{}](e, nil, "pool", "tank", nil)
+	if err == nil || !strings.Contains(err.Error(), `pool "tank" not found`) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("expected no output, got %q", buf.String())
+	}
+}
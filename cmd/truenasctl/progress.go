@@ -0,0 +1,41 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/deevus/truenas-go/client"
+)
+
+// progressPrinter writes job progress lines, skipping repeats.
+type progressPrinter struct {
+	w    io.Writer
+	mu   sync.Mutex
+	last map[int64]client.JobProgress
+}
+
+func newProgressPrinter(w io.Writer) *progressPrinter {
+	return &progressPrinter{w: w, last: make(map[int64]client.JobProgress)}
+}
+
+// report is a client.JobProgressFunc.
+func (p *progressPrinter) report(jp client.JobProgress) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if prev, ok := p.last[jp.JobID]; ok && prev.Percent == jp.Percent && prev.Description == jp.Description {
+		return
+	}
+	p.last[jp.JobID] = jp
+
+	label := jp.Method
+	if label == "" {
+		label = fmt.Sprintf("job %d", jp.JobID)
+	}
+	if jp.Description != "" {
+		fmt.Fprintf(p.w, "[%s] %3.0f%% %s\n", label, jp.Percent, jp.Description)
+	} else {
+		fmt.Fprintf(p.w, "[%s] %3.0f%%\n", label, jp.Percent)
+	}
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+
+	truenas "github.com/deevus/truenas-go"
+)
+
+func snapshotCommands() []*command {
+	return []*command{
+		{path: "snapshot list", summary: "List snapshots", run: snapshotList},
+		{path: "snapshot get", args: "<id>", summary: "Show a snapshot", run: snapshotGet},
+		{path: "snapshot create", args: "<dataset> <name>", summary: "Create a snapshot", run: snapshotCreate},
+		{path: "snapshot delete", args: "<id>", summary: "Delete a snapshot", run: snapshotAction("delete", (*truenas.SnapshotService).Delete)},
+		{path: "snapshot rollback", args: "<id>", summary: "Roll a dataset back to a snapshot", run: snapshotAction("rollback", (*truenas.SnapshotService).Rollback)},
+		{path: "snapshot hold", args: "<id>", summary: "Place a hold on a snapshot", run: snapshotAction("hold", (*truenas.SnapshotService).Hold)},
+		{path: "snapshot release", args: "<id>", summary: "Release a snapshot hold", run: snapshotAction("release", (*truenas.SnapshotService).Release)},
+		{path: "snapshot clone", args: "<id> <dataset>", summary: "Clone a snapshot to a new dataset", run: snapshotClone},
+	}
+}
+
+var snapshotColumns = []column[truenas.Snapshot]{
+	{"NAME", func(s truenas.Snapshot) string { return s.ID }},
+	{"USED", func(s truenas.Snapshot) string { return bytesCell(s.Used) }},
+	{"REFER", func(s truenas.Snapshot) string { return bytesCell(s.Referenced) }},
+	{"HOLD", func(s truenas.Snapshot) string { return boolCell(s.HasHold) }},
+}
+
+func snapshotService(ctx context.Context, e *env) (*truenas.SnapshotService, error) {
+	c, err := e.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return truenas.NewSnapshotService(c, c.Version()), nil
+}
+
+func snapshotList(ctx context.Context, e *env, args []string) error {
+	fs := e.newFlagSet("snapshot list")
+	dataset := fs.String("dataset", "", "only list snapshots of this dataset")
+	if _, err := parseArgs(fs, args, "", 0, 0); err != nil {
+		return err
+	}
+	svc, err := snapshotService(ctx, e)
+	if err != nil {
+		return err
+	}
+	var filters [][]any
+	if *dataset != "" {
+		filters = [][]any{{"dataset", "=", *dataset}}
+	}
+	snaps, err := svc.Query(ctx, filters)
+	if err != nil {
+		return err
+	}
+	return printList(e, snaps, snapshotColumns)
+}
+
+func snapshotGet(ctx context.Context, e *env, args []string) error {
+	pos, err := parseArgs(e.newFlagSet("snapshot get"), args, "<id>", 1, 1)
+	if err != nil {
+		return err
+	}
+	svc, err := snapshotService(ctx, e)
+	if err != nil {
+		return err
+	}
+	snap, err := svc.Get(ctx, pos[0])
+	if err != nil {
+		return err
+	}
+	return printFound(e, snap, "snapshot", pos[0], snapshotColumns)
+}
+
+func snapshotCreate(ctx context.Context, e *env, args []string) error {
+	fs := e.newFlagSet("snapshot create")
+	recursive := fs.Bool("recursive", false, "also snapshot child datasets")
+	pos, err := parseArgs(fs, args, "<dataset> <name>", 2, 2)
+	if err != nil {
+		return err
+	}
+	svc, err := snapshotService(ctx, e)
+	if err != nil {
+		return err
+	}
+	snap, err := svc.Create(ctx, truenas.CreateSnapshotOpts{Dataset: pos[0], Name: pos[1], Recursive: *recursive})
+	if err != nil {
+		return err
+	}
+	return printFound(e, snap, "snapshot", pos[0]+"@"+pos[1], snapshotColumns)
+}
+
+// snapshotAction builds a command calling a SnapshotService method that
+// takes only the snapshot ID.
+func snapshotAction(verb string, fn func(*truenas.SnapshotService, context.Context, string) error) func(context.Context, *env, []string) error {
+	return func(ctx context.Context, e *env, args []string) error {
+		pos, err := parseArgs(e.newFlagSet("snapshot "+verb), args, "<id>", 1, 1)
+		if err != nil {
+			return err
+		}
+		svc, err := snapshotService(ctx, e)
+		if err != nil {
+			return err
+		}
+		return fn(svc, ctx, pos[0])
+	}
+}
+
+func snapshotClone(ctx context.Context, e *env, args []string) error {
+	pos, err := parseArgs(e.newFlagSet("snapshot clone"), args, "<id> <dataset>", 2, 2)
+	if err != nil {
+		return err
+	}
+	svc, err := snapshotService(ctx, e)
+	if err != nil {
+		return err
+	}
+	return svc.Clone(ctx, pos[0], pos[1])
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	truenas "github.com/deevus/truenas-go"
+)
+
+func systemCommands() []*command {
+	return []*command{
+		{path: "system info", summary: "Show system information", run: systemInfo},
+		{path: "system version", summary: "Show the TrueNAS version", run: systemVersion},
+	}
+}
+
+func poolCommands() []*command {
+	return []*command{
+		{path: "pool list", summary: "List storage pools", run: poolList},
+	}
+}
+
+var systemInfoColumns = []column[*truenas.SystemInfo]{
+	{"HOSTNAME", func(s *truenas.SystemInfo) string { return s.Hostname }},
+	{"MODEL", func(s *truenas.SystemInfo) string { return s.Model }},
+	{"CORES", func(s *truenas.SystemInfo) string { return fmt.Sprint(s.Cores) }},
+	{"ECC", func(s *truenas.SystemInfo) string { return boolCell(s.EccMemory) }},
+	{"UPTIME", func(s *truenas.SystemInfo) string { return strCell(s.Uptime) }},
+	{"LOAD", func(s *truenas.SystemInfo) string {
+		return fmt.Sprintf("%.2f %.2f %.2f", s.LoadAvg[0], s.LoadAvg[1], s.LoadAvg[2])
+	}},
+}
+
+var poolColumns = []column[truenas.Pool]{
+	{"NAME", func(p truenas.Pool) string { return p.Name }},
+	{"STATUS", func(p truenas.Pool) string { return p.Status }},
+	{"SIZE", func(p truenas.Pool) string { return bytesCell(p.Size) }},
+	{"ALLOCATED", func(p truenas.Pool) string { return bytesCell(p.Allocated) }},
+	{"FREE", func(p truenas.Pool) string { return bytesCell(p.Free) }},
+	{"PATH", func(p truenas.Pool) string { return p.Path }},
+}
+
+func systemInfo(ctx context.Context, e *env, args []string) error {
+	if _, err := parseArgs(e.newFlagSet("system info"), args, "", 0, 0); err != nil {
+		return err
+	}
+	c, err := e.client(ctx)
+	if err != nil {
+		return err
+	}
+	info, err := truenas.NewSystemService(c, c.Version()).GetInfo(ctx)
+	if err != nil {
+		return err
+	}
+	return printItem(e, info, systemInfoColumns)
+}
+
+func systemVersion(ctx context.Context, e *env, args []string) error {
+	if _, err := parseArgs(e.newFlagSet("system version"), args, "", 0, 0); err != nil {
+		return err
+	}
+	c, err := e.client(ctx)
+	if err != nil {
+		return err
+	}
+	version, err := truenas.NewSystemService(c, c.Version()).GetVersion(ctx)
+	if err != nil {
+		return err
+	}
+	if e.format != formatTable {
+		return e.printValue(map[string]string{"Version": version})
+	}
+	_, err = fmt.Fprintln(e.stdout, version)
+	return err
+}
+
+func poolList(ctx context.Context, e *env, args []string) error {
+	if _, err := parseArgs(e.newFlagSet("pool list"), args, "", 0, 0); err != nil {
+		return err
+	}
+	c, err := e.client(ctx)
+	if err != nil {
+		return err
+	}
+	pools, err := truenas.NewDatasetService(c, c.Version()).ListPools(ctx)
+	if err != nil {
+		return err
+	}
+	return printList(e, pools, poolColumns)
+}
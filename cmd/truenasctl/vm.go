@@ -0,0 +1,197 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strconv"
+
+	truenas "github.com/deevus/truenas-go"
+)
+
+func vmCommands() []*command {
+	return []*command{
+		{path: "vm get", args: "<id>", summary: "Show a VM", run: vmGet},
+		{path: "vm create", summary: "Create a VM from a spec file", run: vmCreate},
+		{path: "vm update", args: "<id>", summary: "Update a VM from a spec file", run: vmUpdate},
+		{path: "vm delete", args: "<id>", summary: "Delete a VM", run: vmDelete},
+		{path: "vm start", args: "<id>", summary: "Start a VM", run: vmStart},
+		{path: "vm stop", args: "<id>", summary: "Stop a VM", run: vmStop},
+		{path: "vm devices", args: "<id>", summary: "List a VM's devices", run: vmDevices},
+	}
+}
+
+var vmColumns = []column[truenas.VM]{
+	{"ID", func(v truenas.VM) string { return strconv.FormatInt(v.ID, 10) }},
+	{"NAME", func(v truenas.VM) string { return v.Name }},
+	{"STATE", func(v truenas.VM) string { return strCell(v.State) }},
+	{"VCPUS", func(v truenas.VM) string { return strconv.FormatInt(v.VCPUs, 10) }},
+	{"MEMORY", func(v truenas.VM) string { return bytesCell(v.Memory * 1024 * 1024) }}, // MiB
+	{"AUTOSTART", func(v truenas.VM) string { return boolCell(v.Autostart) }},
+}
+
+var vmDeviceColumns = []column[truenas.VMDevice]{
+	{"ID", func(d truenas.VMDevice) string { return strconv.FormatInt(d.ID, 10) }},
+	{"TYPE", func(d truenas.VMDevice) string { return string(d.DeviceType) }},
+	{"ORDER", func(d truenas.VMDevice) string { return strconv.FormatInt(d.Order, 10) }},
+}
+
+func vmService(ctx context.Context, e *env) (*truenas.VMService, error) {
+	c, err := e.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return truenas.NewVMService(c, c.Version()), nil
+}
+
+// parseID parses a numeric resource ID argument.
+func parseID(kind, s string) (int64, error) {
+	id, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s ID %q", kind, s)
+	}
+	return id, nil
+}
+
+// parseIDArgs parses a command whose only positional argument is a numeric ID.
+func parseIDArgs(fs *flag.FlagSet, kind string, args []string) (int64, error) {
+	pos, err := parseArgs(fs, args, "<id>", 1, 1)
+	if err != nil {
+		return 0, err
+	}
+	return parseID(kind, pos[0])
+}
+
+func vmGet(ctx context.Context, e *env, args []string) error {
+	id, err := parseIDArgs(e.newFlagSet("vm get"), "VM", args)
+	if err != nil {
+		return err
+	}
+	svc, err := vmService(ctx, e)
+	if err != nil {
+		return err
+	}
+	vm, err := svc.GetVM(ctx, id)
+	if err != nil {
+		return err
+	}
+	return printFound(e, vm, "VM", strconv.FormatInt(id, 10), vmColumns)
+}
+
+func vmCreate(ctx context.Context, e *env, args []string) error {
+	fs := e.newFlagSet("vm create")
+	file := fs.String("f", "", "CreateVMOpts as a JSON or YAML file (- for stdin, required)")
+	if _, err := parseArgs(fs, args, "", 0, 0); err != nil {
+		return err
+	}
+	if *file == "" {
+		return fmt.Errorf("-f is required")
+	}
+	var opts truenas.CreateVMOpts
+	if err := readSpec(*file, e.stdin, &opts); err != nil {
+		return err
+	}
+	svc, err := vmService(ctx, e)
+	if err != nil {
+		return err
+	}
+	vm, err := svc.CreateVM(ctx, opts)
+	if err != nil {
+		return err
+	}
+	return printFound(e, vm, "VM", opts.Name, vmColumns)
+}
+
+func vmUpdate(ctx context.Context, e *env, args []string) error {
+	fs := e.newFlagSet("vm update")
+	file := fs.String("f", "", "UpdateVMOpts as a JSON or YAML file (- for stdin, required)")
+	id, err := parseIDArgs(fs, "VM", args)
+	if err != nil {
+		return err
+	}
+	if *file == "" {
+		return fmt.Errorf("-f is required")
+	}
+	svc, err := vmService(ctx, e)
+	if err != nil {
+		return err
+	}
+
+	// Updates replace every field, so start from the current VM and apply
+	// only the fields given in the spec.
+	current, err := svc.GetVM(ctx, id)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		return fmt.Errorf("VM %d not found", id)
+	}
+	var opts truenas.UpdateVMOpts
+	if err := convert(*current, &opts); err != nil {
+		return err
+	}
+	if err := readSpec(*file, e.stdin, &opts); err != nil {
+		return err
+	}
+
+	vm, err := svc.UpdateVM(ctx, id, opts)
+	if err != nil {
+		return err
+	}
+	return printFound(e, vm, "VM", strconv.FormatInt(id, 10), vmColumns)
+}
+
+func vmDelete(ctx context.Context, e *env, args []string) error {
+	id, err := parseIDArgs(e.newFlagSet("vm delete"), "VM", args)
+	if err != nil {
+		return err
+	}
+	svc, err := vmService(ctx, e)
+	if err != nil {
+		return err
+	}
+	return svc.DeleteVM(ctx, id)
+}
+
+func vmStart(ctx context.Context, e *env, args []string) error {
+	id, err := parseIDArgs(e.newFlagSet("vm start"), "VM", args)
+	if err != nil {
+		return err
+	}
+	svc, err := vmService(ctx, e)
+	if err != nil {
+		return err
+	}
+	return svc.StartVM(ctx, id)
+}
+
+func vmStop(ctx context.Context, e *env, args []string) error {
+	fs := e.newFlagSet("vm stop")
+	force := fs.Bool("force", false, "power off immediately")
+	forceAfterTimeout := fs.Bool("force-after-timeout", false, "power off if the guest does not shut down in time")
+	id, err := parseIDArgs(fs, "VM", args)
+	if err != nil {
+		return err
+	}
+	svc, err := vmService(ctx, e)
+	if err != nil {
+		return err
+	}
+	return svc.StopVM(ctx, id, truenas.StopVMOpts{Force: *force, ForceAfterTimeout: *forceAfterTimeout})
+}
+
+func vmDevices(ctx context.Context, e *env, args []string) error {
+	id, err := parseIDArgs(e.newFlagSet("vm devices"), "VM", args)
+	if err != nil {
+		return err
+	}
+	svc, err := vmService(ctx, e)
+	if err != nil {
+		return err
+	}
+	devices, err := svc.ListDevices(ctx, id)
+	if err != nil {
+		return err
+	}
+	return printList(e, devices, vmDeviceColumns)
+}
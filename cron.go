@@ -11,3 +11,11 @@ type CronJobResponse struct {
 	Stderr      bool             `json:"stderr"`
 	Schedule    ScheduleResponse `json:"schedule"`
 }
+
+// WindowedScheduleResponse is a cron schedule restricted to a daily time
+// window.
+type WindowedScheduleResponse struct {
+	ScheduleResponse
+	Begin string `json:"begin"`
+	End   string `json:"end"`
+}
@@ -29,6 +29,14 @@ type Schedule struct {
 	Dow    string
 }
 
+// WindowedSchedule is a cron schedule that only fires between Begin and End
+// ("HH:MM") each day.
+type WindowedSchedule struct {
+	Schedule
+	Begin string
+	End   string
+}
+
 // CreateCronJobOpts contains options for creating a cron job.
 type CreateCronJobOpts struct {
 	User          string
@@ -58,7 +66,7 @@ func NewCronService(c AsyncCaller, v Version) *CronService {
 // Create creates a cron job and returns the full object.
 func (s *CronService) Create(ctx context.Context, opts CreateCronJobOpts) (*CronJob, error) {
 	params := optsToParams(opts)
-	result, err := s.client.Call(ctx, "cronjob.create", params)
+	result, err := callMethod(ctx, s.client, s.version, "cronjob.create", params)
 	if err != nil {
 		return nil, err
 	}
@@ -75,7 +83,7 @@ func (s *CronService) Create(ctx context.Context, opts CreateCronJobOpts) (*Cron
 
 // Get returns a cron job by ID, or nil if not found.
 func (s *CronService) Get(ctx context.Context, id int64) (*CronJob, error) {
-	result, err := s.client.Call(ctx, "cronjob.get_instance", id)
+	result, err := callMethod(ctx, s.client, s.version, "cronjob.get_instance", id)
 	if err != nil {
 		if isNotFoundError(err) {
 			return nil, nil
@@ -94,7 +102,7 @@ func (s *CronService) Get(ctx context.Context, id int64) (*CronJob, error) {
 
 // List returns all cron jobs.
 func (s *CronService) List(ctx context.Context) ([]CronJob, error) {
-	result, err := s.client.Call(ctx, "cronjob.query", nil)
+	result, err := callMethod(ctx, s.client, s.version, "cronjob.query", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -114,7 +122,7 @@ func (s *CronService) List(ctx context.Context) ([]CronJob, error) {
 // Update updates a cron job and returns the full object.
 func (s *CronService) Update(ctx context.Context, id int64, opts UpdateCronJobOpts) (*CronJob, error) {
 	params := optsToParams(opts)
-	_, err := s.client.Call(ctx, "cronjob.update", []any{id, params})
+	_, err := callMethod(ctx, s.client, s.version, "cronjob.update", []any{id, params})
 	if err != nil {
 		return nil, err
 	}
@@ -124,14 +132,14 @@ func (s *CronService) Update(ctx context.Context, id int64, opts UpdateCronJobOp
 
 // Delete deletes a cron job by ID.
 func (s *CronService) Delete(ctx context.Context, id int64) error {
-	_, err := s.client.Call(ctx, "cronjob.delete", id)
+	_, err := callMethod(ctx, s.client, s.version, "cronjob.delete", id)
 	return err
 }
 
 // Run triggers a cron job and waits for it to complete. When skipDisabled
 // is true, disabled jobs are skipped instead of being run.
 func (s *CronService) Run(ctx context.Context, id int64, skipDisabled bool) error {
-	_, err := s.client.CallAndWait(ctx, "cronjob.run", []any{id, skipDisabled})
+	_, err := callMethodAndWait(ctx, s.client, s.version, "cronjob.run", []any{id, skipDisabled})
 	return err
 }
 
@@ -145,13 +153,7 @@ func optsToParams(opts CreateCronJobOpts) map[string]any {
 		"enabled":     opts.Enabled,
 		"stdout":      !opts.CaptureStdout,
 		"stderr":      !opts.CaptureStderr,
-		"schedule": map[string]any{
-			"minute": opts.Schedule.Minute,
-			"hour":   opts.Schedule.Hour,
-			"dom":    opts.Schedule.Dom,
-			"month":  opts.Schedule.Month,
-			"dow":    opts.Schedule.Dow,
-		},
+		"schedule":    scheduleParams(opts.Schedule),
 	}
 }
 
@@ -166,12 +168,74 @@ func cronJobFromResponse(resp CronJobResponse) CronJob {
 		Enabled:       resp.Enabled,
 		CaptureStdout: !resp.Stdout,
 		CaptureStderr: !resp.Stderr,
-		Schedule: Schedule{
-			Minute: resp.Schedule.Minute,
-			Hour:   resp.Schedule.Hour,
-			Dom:    resp.Schedule.Dom,
-			Month:  resp.Schedule.Month,
-			Dow:    resp.Schedule.Dow,
-		},
+		Schedule:      scheduleFromResponse(resp.Schedule),
+	}
+}
+
+// setBool sets params[key] from v unless v is nil.
+func setBool(params map[string]any, key string, v *bool) {
+	if v != nil {
+		params[key] = *v
+	}
+}
+
+// setWindowedSchedule sets params[key] from v unless v is nil. A zero
+// schedule is sent as null.
+func setWindowedSchedule(params map[string]any, key string, v *WindowedSchedule) {
+	if v == nil {
+		return
+	}
+	if *v == (WindowedSchedule{}) {
+		params[key] = nil
+	} else {
+		params[key] = windowedScheduleParams(*v)
+	}
+}
+
+// windowedScheduleParams converts a WindowedSchedule to API parameters.
+// Begin and End are omitted when empty so the server defaults apply.
+func windowedScheduleParams(sched WindowedSchedule) map[string]any {
+	params := scheduleParams(sched.Schedule)
+	if sched.Begin != "" {
+		params["begin"] = sched.Begin
+	}
+	if sched.End != "" {
+		params["end"] = sched.End
+	}
+	return params
+}
+
+// scheduleParams converts a Schedule to API parameters.
+func scheduleParams(sched Schedule) map[string]any {
+	return map[string]any{
+		"minute": sched.Minute,
+		"hour":   sched.Hour,
+		"dom":    sched.Dom,
+		"month":  sched.Month,
+		"dow":    sched.Dow,
+	}
+}
+
+// scheduleFromResponse converts a wire-format ScheduleResponse to a Schedule.
+func scheduleFromResponse(resp ScheduleResponse) Schedule {
+	return Schedule{
+		Minute: resp.Minute,
+		Hour:   resp.Hour,
+		Dom:    resp.Dom,
+		Month:  resp.Month,
+		Dow:    resp.Dow,
+	}
+}
+
+// windowedScheduleFromResponse converts a nullable wire-format schedule to
+// a WindowedSchedule, or nil.
+func windowedScheduleFromResponse(resp *WindowedScheduleResponse) *WindowedSchedule {
+	if resp == nil {
+		return nil
+	}
+	return &WindowedSchedule{
+		Schedule: scheduleFromResponse(resp.ScheduleResponse),
+		Begin:    resp.Begin,
+		End:      resp.End,
 	}
 }
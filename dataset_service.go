@@ -19,6 +19,12 @@ type Dataset struct {
 	Atime       string
 	Used        int64
 	Available   int64
+	// CommentsInherited, CompressionInherited and AtimeInherited report
+	// whether the property is inherited or defaulted rather than set on the
+	// dataset itself.
+	CommentsInherited    bool
+	CompressionInherited bool
+	AtimeInherited       bool
 }
 
 // CreateDatasetOpts contains options for creating a filesystem dataset.
@@ -33,7 +39,9 @@ type CreateDatasetOpts struct {
 
 // UpdateDatasetOpts contains options for updating a filesystem dataset.
 // Pointer fields distinguish "don't change" (nil) from "set to zero/empty".
-// String fields use empty string to mean "don't change".
+// String fields use empty string to mean "don't change". Compression, Atime
+// and Comments accept "INHERIT" to clear the local value and inherit it from
+// the parent.
 type UpdateDatasetOpts struct {
 	Compression string // Empty = don't change
 	Quota       *int64
@@ -75,23 +83,15 @@ type UpdateZvolOpts struct {
 	Comments    *string
 }
 
-// Pool is the user-facing representation of a TrueNAS pool.
-type Pool struct {
-	ID        int64
-	Name      string
-	Path      string
-	Status    string
-	Size      int64
-	Allocated int64
-	Free      int64
-}
-
 // Int64Ptr returns a pointer to an int64. Helper for setting optional fields.
 func Int64Ptr(v int64) *int64 { return &v }
 
 // StringPtr returns a pointer to a string. Helper for setting optional fields.
 func StringPtr(v string) *string { return &v }
 
+// BoolPtr returns a pointer to a bool. Helper for setting optional fields.
+func BoolPtr(v bool) *bool { return &v }
+
 // DatasetService provides typed methods for the pool.dataset.* and pool.query API namespaces.
 type DatasetService struct {
 	client  Caller
@@ -106,7 +106,7 @@ func NewDatasetService(c Caller, v Version) *DatasetService {
 // CreateDataset creates a filesystem dataset and returns the full object.
 func (s *DatasetService) CreateDataset(ctx context.Context, opts CreateDatasetOpts) (*Dataset, error) {
 	params := datasetCreateParams(opts)
-	result, err := s.client.Call(ctx, "pool.dataset.create", params)
+	result, err := callMethod(ctx, s.client, s.version, "pool.dataset.create", params)
 	if err != nil {
 		return nil, err
 	}
@@ -122,7 +122,7 @@ func (s *DatasetService) CreateDataset(ctx context.Context, opts CreateDatasetOp
 // GetDataset returns a dataset by ID, or nil if not found.
 func (s *DatasetService) GetDataset(ctx context.Context, id string) (*Dataset, error) {
 	filter := [][]any{{"id", "=", id}}
-	result, err := s.client.Call(ctx, "pool.dataset.query", filter)
+	result, err := callMethod(ctx, s.client, s.version, "pool.dataset.query", filter)
 	if err != nil {
 		if isNotFoundError(err) {
 			return nil, nil
@@ -145,7 +145,7 @@ func (s *DatasetService) GetDataset(ctx context.Context, id string) (*Dataset, e
 
 // ListDatasets returns all filesystem datasets (type FILESYSTEM only).
 func (s *DatasetService) ListDatasets(ctx context.Context) ([]Dataset, error) {
-	result, err := s.client.Call(ctx, "pool.dataset.query", nil)
+	result, err := callMethod(ctx, s.client, s.version, "pool.dataset.query", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -170,7 +170,7 @@ func (s *DatasetService) ListDatasets(ctx context.Context) ([]Dataset, error) {
 // UpdateDataset updates a dataset and returns the full object.
 func (s *DatasetService) UpdateDataset(ctx context.Context, id string, opts UpdateDatasetOpts) (*Dataset, error) {
 	params := datasetUpdateParams(opts)
-	_, err := s.client.Call(ctx, "pool.dataset.update", []any{id, params})
+	_, err := callMethod(ctx, s.client, s.version, "pool.dataset.update", []any{id, params})
 	if err != nil {
 		return nil, err
 	}
@@ -186,14 +186,14 @@ func (s *DatasetService) DeleteDataset(ctx context.Context, id string, recursive
 	} else {
 		params = id
 	}
-	_, err := s.client.Call(ctx, "pool.dataset.delete", params)
+	_, err := callMethod(ctx, s.client, s.version, "pool.dataset.delete", params)
 	return err
 }
 
 // CreateZvol creates a zvol and returns the full object.
 func (s *DatasetService) CreateZvol(ctx context.Context, opts CreateZvolOpts) (*Zvol, error) {
 	params := zvolCreateParams(opts)
-	result, err := s.client.Call(ctx, "pool.dataset.create", params)
+	result, err := callMethod(ctx, s.client, s.version, "pool.dataset.create", params)
 	if err != nil {
 		return nil, err
 	}
@@ -209,7 +209,7 @@ func (s *DatasetService) CreateZvol(ctx context.Context, opts CreateZvolOpts) (*
 // GetZvol returns a zvol by ID, or nil if not found.
 func (s *DatasetService) GetZvol(ctx context.Context, id string) (*Zvol, error) {
 	filter := [][]any{{"id", "=", id}}
-	result, err := s.client.Call(ctx, "pool.dataset.query", filter)
+	result, err := callMethod(ctx, s.client, s.version, "pool.dataset.query", filter)
 	if err != nil {
 		if isNotFoundError(err) {
 			return nil, nil
@@ -233,7 +233,7 @@ func (s *DatasetService) GetZvol(ctx context.Context, id string) (*Zvol, error)
 // UpdateZvol updates a zvol and returns the full object.
 func (s *DatasetService) UpdateZvol(ctx context.Context, id string, opts UpdateZvolOpts) (*Zvol, error) {
 	params := zvolUpdateParams(opts)
-	_, err := s.client.Call(ctx, "pool.dataset.update", []any{id, params})
+	_, err := callMethod(ctx, s.client, s.version, "pool.dataset.update", []any{id, params})
 	if err != nil {
 		return nil, err
 	}
@@ -243,27 +243,17 @@ func (s *DatasetService) UpdateZvol(ctx context.Context, id string, opts UpdateZ
 
 // DeleteZvol deletes a zvol by ID.
 func (s *DatasetService) DeleteZvol(ctx context.Context, id string) error {
-	_, err := s.client.Call(ctx, "pool.dataset.delete", id)
+	_, err := callMethod(ctx, s.client, s.version, "pool.dataset.delete", id)
 	return err
 }
 
-// ListPools returns all pools.
+// ListPools returns all pools. PoolService.List returns the same pools.
 func (s *DatasetService) ListPools(ctx context.Context) ([]Pool, error) {
-	result, err := s.client.Call(ctx, "pool.query", nil)
+	result, err := callMethod(ctx, s.client, s.version, "pool.query", nil)
 	if err != nil {
 		return nil, err
 	}
-
-	var responses []PoolResponse
-	if err := json.Unmarshal(result, &responses); err != nil {
-		return nil, fmt.Errorf("parse query response: %w", err)
-	}
-
-	pools := make([]Pool, len(responses))
-	for i, resp := range responses {
-		pools[i] = poolFromResponse(resp)
-	}
-	return pools, nil
+	return parsePools(result)
 }
 
 // datasetFromResponse converts a wire-format DatasetResponse to a user-facing Dataset.
@@ -280,6 +270,10 @@ func datasetFromResponse(resp DatasetResponse) Dataset {
 		Atime:       resp.Atime.Value,
 		Used:        resp.Used.Parsed,
 		Available:   resp.Available.Parsed,
+
+		CommentsInherited:    resp.Comments.Inherited(),
+		CompressionInherited: resp.Compression.Inherited(),
+		AtimeInherited:       resp.Atime.Inherited(),
 	}
 }
 
@@ -297,19 +291,6 @@ func zvolFromResponse(resp DatasetResponse) Zvol {
 	}
 }
 
-// poolFromResponse converts a wire-format PoolResponse to a user-facing Pool.
-func poolFromResponse(resp PoolResponse) Pool {
-	return Pool{
-		ID:        resp.ID,
-		Name:      resp.Name,
-		Path:      resp.Path,
-		Status:    resp.Status,
-		Size:      resp.Size,
-		Allocated: resp.Allocated,
-		Free:      resp.Free,
-	}
-}
-
 // datasetCreateParams builds API parameters for pool.dataset.create (filesystem).
 func datasetCreateParams(opts CreateDatasetOpts) map[string]any {
 	params := map[string]any{
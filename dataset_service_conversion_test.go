@@ -404,3 +404,12 @@ func TestStringPtr(t *testing.T) {
 		t.Errorf("expected empty string, got %s", *e)
 	}
 }
+
+func TestBoolPtr(t *testing.T) {
+	if p := BoolPtr(true); !*p {
+		t.Error("expected true")
+	}
+	if p := BoolPtr(false); *p {
+		t.Error("expected false")
+	}
+}
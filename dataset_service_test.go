@@ -517,6 +517,36 @@ func TestDatasetService_GetDataset_UsageFields(t *testing.T) {
 	}
 }
 
+func TestDatasetService_GetDataset_PropertySources(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return json.RawMessage(`[{
+				"id": "pool1/ds1",
+				"name": "pool1/ds1",
+				"pool": "pool1",
+				"comments": {"value": "", "source": "DEFAULT"},
+				"compression": {"value": "LZ4", "source": "INHERITED"},
+				"atime": {"value": "OFF", "source": "LOCAL"}
+			}]`), nil
+		},
+	}
+
+	svc := NewDatasetService(mock, Version{})
+	ds, err := svc.GetDataset(context.Background(), "pool1/ds1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !ds.CommentsInherited {
+		t.Error("expected default comments to count as inherited")
+	}
+	if !ds.CompressionInherited {
+		t.Error("expected compression to be inherited")
+	}
+	if ds.AtimeInherited {
+		t.Error("expected locally set atime not to be inherited")
+	}
+}
+
 func TestDatasetService_UpdateDataset_CompressionAndAtime(t *testing.T) {
 	callCount := 0
 	mock := &mockCaller{
@@ -0,0 +1,92 @@
+package truenas
+
+// DiskType is the media type of a disk.
+type DiskType string
+
+const (
+	DiskHDD DiskType = "HDD"
+	DiskSSD DiskType = "SSD"
+)
+
+// DiskStandby is the idle time, in minutes, before a disk spins down.
+type DiskStandby string
+
+const (
+	DiskStandbyAlwaysOn DiskStandby = "ALWAYS ON"
+	DiskStandby5        DiskStandby = "5"
+	DiskStandby10       DiskStandby = "10"
+	DiskStandby20       DiskStandby = "20"
+	DiskStandby30       DiskStandby = "30"
+	DiskStandby60       DiskStandby = "60"
+	DiskStandby120      DiskStandby = "120"
+	DiskStandby180      DiskStandby = "180"
+	DiskStandby240      DiskStandby = "240"
+	DiskStandby300      DiskStandby = "300"
+	DiskStandby330      DiskStandby = "330"
+)
+
+// DiskAPM is a disk's Advanced Power Management level. Levels 1-127
+// permit spin-down; 128-254 do not.
+type DiskAPM string
+
+const (
+	DiskAPMDisabled DiskAPM = "DISABLED"
+	DiskAPM1        DiskAPM = "1"
+	DiskAPM64       DiskAPM = "64"
+	DiskAPM127      DiskAPM = "127"
+	DiskAPM128      DiskAPM = "128"
+	DiskAPM192      DiskAPM = "192"
+	DiskAPM254      DiskAPM = "254"
+)
+
+// DiskWipeMode is how thoroughly disk.wipe erases a disk.
+type DiskWipeMode string
+
+const (
+	// DiskWipeQuick erases the partition table and ZFS labels.
+	DiskWipeQuick DiskWipeMode = "QUICK"
+	// DiskWipeFull overwrites the whole disk with zeros.
+	DiskWipeFull DiskWipeMode = "FULL"
+	// DiskWipeFullRandom overwrites the whole disk with random data.
+	DiskWipeFullRandom DiskWipeMode = "FULL_RANDOM"
+)
+
+// DiskResponse represents a disk from the disk query API.
+type DiskResponse struct {
+	Identifier    string  `json:"identifier"`
+	Name          string  `json:"name"`
+	Subsystem     string  `json:"subsystem"`
+	Number        int64   `json:"number"`
+	Serial        string  `json:"serial"`
+	LunID         *string `json:"lunid"`
+	Size          int64   `json:"size"`
+	Description   string  `json:"description"`
+	TransferMode  string  `json:"transfermode"`
+	HDDStandby    string  `json:"hddstandby"`
+	ToggleSMART   bool    `json:"togglesmart"`
+	AdvPowerMgmt  string  `json:"advpowermgmt"`
+	SMARTOptions  string  `json:"smartoptions"`
+	ExpireTime    *string `json:"expiretime"`
+	Critical      *int64  `json:"critical"`
+	Difference    *int64  `json:"difference"`
+	Informational *int64  `json:"informational"`
+	Model         *string `json:"model"`
+	RotationRate  *int64  `json:"rotationrate"`
+	Type          *string `json:"type"`
+	ZFSGUID       *string `json:"zfs_guid"`
+	Bus           string  `json:"bus"`
+	Devname       string  `json:"devname"`
+	Pool          *string `json:"pool"` // Only with the "pools" query extra
+	SupportsSMART *bool   `json:"supports_smart"`
+	// ExportedZpool is set by disk.details on disks holding an exported
+	// pool.
+	ExportedZpool *string `json:"exported_zpool"`
+}
+
+// DiskTemperatureAggResponse represents one disk's entry from
+// disk.temperature_agg.
+type DiskTemperatureAggResponse struct {
+	Min *float64 `json:"min"`
+	Max *float64 `json:"max"`
+	Avg *float64 `json:"avg"`
+}
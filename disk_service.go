@@ -0,0 +1,284 @@
+package truenas
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Disk is the user-facing representation of a physical disk.
+type Disk struct {
+	// Identifier is the disk's stable ID, e.g. "{serial_lunid}8HG7MZJH_5000cca0bcd9a1f4".
+	Identifier string
+	Name       string // Device name, e.g. "sda"
+	Serial     string
+	Model      string
+	Size       int64
+	Type       DiskType
+	// RotationRate is the spindle speed in RPM, or 0 for SSDs and disks
+	// that do not report one.
+	RotationRate int64
+	Bus          string
+	Subsystem    string
+	Number       int64
+	LunID        string
+	Description  string
+	TransferMode string
+	// Pool is the imported pool the disk belongs to, or empty if none.
+	Pool string
+	// ExportedPool is an exported pool still on the disk. It is only
+	// reported by ListUnused.
+	ExportedPool string
+	// ZFSGUID is the disk's vdev GUID when it is part of a pool.
+	ZFSGUID       string
+	HDDStandby    DiskStandby
+	AdvPowerMgmt  DiskAPM
+	SMARTEnabled  bool
+	SMARTOptions  string
+	SupportsSMART bool
+	// Critical, Difference and Informational are the temperature alert
+	// thresholds in degrees Celsius; 0 when unset.
+	Critical      int64
+	Difference    int64
+	Informational int64
+}
+
+// UpdateDiskOpts contains options for updating a disk's settings. Nil
+// fields are left unchanged.
+type UpdateDiskOpts struct {
+	Description  *string
+	HDDStandby   DiskStandby // Empty = don't change
+	AdvPowerMgmt DiskAPM     // Empty = don't change
+	SMARTEnabled *bool
+	// SMARTOptions are extra smartctl arguments.
+	SMARTOptions *string
+	// Temperature alert thresholds in degrees Celsius. 0 clears a
+	// threshold.
+	Critical      *int64
+	Difference    *int64
+	Informational *int64
+	// Password unlocks a SED (self-encrypting drive).
+	Password *string
+}
+
+// DiskTemperatureAgg is a disk's temperature statistics over a period, in
+// degrees Celsius.
+type DiskTemperatureAgg struct {
+	Min float64
+	Max float64
+	Avg float64
+}
+
+// DiskService provides typed methods for the disk.* API namespace.
+type DiskService struct {
+	client  AsyncCaller
+	version Version
+}
+
+// NewDiskService creates a new DiskService.
+func NewDiskService(c AsyncCaller, v Version) *DiskService {
+	return &DiskService{client: c, version: v}
+}
+
+// diskQueryOptions asks disk queries to fill in each disk's pool.
+var diskQueryOptions = map[string]any{"extra": map[string]any{"pools": true}}
+
+// Get returns a disk by identifier, or nil if not found.
+func (s *DiskService) Get(ctx context.Context, identifier string) (*Disk, error) {
+	result, err := callMethod(ctx, s.client, s.version, "disk.get_instance", []any{identifier, diskQueryOptions})
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var resp DiskResponse
+	if err := json.Unmarshal(result, &resp); err != nil {
+		return nil, fmt.Errorf("parse get_instance response: %w", err)
+	}
+
+	disk := diskFromResponse(resp)
+	return &disk, nil
+}
+
+// GetByName returns a disk by device name (e.g. "sda"), or nil if not found.
+func (s *DiskService) GetByName(ctx context.Context, name string) (*Disk, error) {
+	filter := [][]any{{"name", "=", name}}
+	result, err := callMethod(ctx, s.client, s.version, "disk.query", []any{filter, diskQueryOptions})
+	if err != nil {
+		return nil, err
+	}
+
+	disks, err := parseDisks(result, "query")
+	if err != nil {
+		return nil, err
+	}
+	if len(disks) == 0 {
+		return nil, nil
+	}
+	return &disks[0], nil
+}
+
+// List returns all disks.
+func (s *DiskService) List(ctx context.Context) ([]Disk, error) {
+	result, err := callMethod(ctx, s.client, s.version, "disk.query", []any{[][]any{}, diskQueryOptions})
+	if err != nil {
+		return nil, err
+	}
+	return parseDisks(result, "query")
+}
+
+// ListUnused returns the disks that are not part of an imported pool, as
+// candidates for a new pool. Disks holding an exported pool are included
+// with ExportedPool set. Requires TrueNAS 25.04 or later.
+func (s *DiskService) ListUnused(ctx context.Context) ([]Disk, error) {
+	result, err := callMethod(ctx, s.client, s.version, "disk.details", map[string]any{"type": "UNUSED"})
+	if err != nil {
+		return nil, err
+	}
+	return parseDisks(result, "details")
+}
+
+// parseDisks parses a list of disks from a disk.query or disk.details
+// response.
+func parseDisks(result json.RawMessage, method string) ([]Disk, error) {
+	var responses []DiskResponse
+	if err := json.Unmarshal(result, &responses); err != nil {
+		return nil, fmt.Errorf("parse %s response: %w", method, err)
+	}
+
+	disks := make([]Disk, len(responses))
+	for i, resp := range responses {
+		disks[i] = diskFromResponse(resp)
+	}
+	return disks, nil
+}
+
+// Update updates a disk's settings and returns the full object.
+func (s *DiskService) Update(ctx context.Context, identifier string, opts UpdateDiskOpts) (*Disk, error) {
+	params := map[string]any{}
+	if opts.Description != nil {
+		params["description"] = *opts.Description
+	}
+	if opts.HDDStandby != "" {
+		params["hddstandby"] = string(opts.HDDStandby)
+	}
+	if opts.AdvPowerMgmt != "" {
+		params["advpowermgmt"] = string(opts.AdvPowerMgmt)
+	}
+	setBool(params, "togglesmart", opts.SMARTEnabled)
+	if opts.SMARTOptions != nil {
+		params["smartoptions"] = *opts.SMARTOptions
+	}
+	setNullableInt64(params, "critical", opts.Critical)
+	setNullableInt64(params, "difference", opts.Difference)
+	setNullableInt64(params, "informational", opts.Informational)
+	if opts.Password != nil {
+		params["passwd"] = *opts.Password
+	}
+
+	_, err := callMethod(ctx, s.client, s.version, "disk.update", []any{identifier, params})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Get(ctx, identifier)
+}
+
+// Wipe erases a disk, by device name, and waits for the job to complete.
+// Full wipes report progress to the callback attached to ctx with
+// client.WithJobProgress.
+func (s *DiskService) Wipe(ctx context.Context, dev string, mode DiskWipeMode) error {
+	_, err := callMethodAndWait(ctx, s.client, s.version, "disk.wipe", []any{dev, string(mode)})
+	return err
+}
+
+// Temperatures returns the current temperature, in degrees Celsius, of the
+// named disks, or of every disk if no names are given. Disks that do not
+// report a temperature are omitted.
+func (s *DiskService) Temperatures(ctx context.Context, names ...string) (map[string]int64, error) {
+	result, err := callMethod(ctx, s.client, s.version, "disk.temperatures", []any{nonNilStrings(names)})
+	if err != nil {
+		return nil, err
+	}
+
+	var resp map[string]*int64
+	if err := json.Unmarshal(result, &resp); err != nil {
+		return nil, fmt.Errorf("parse temperatures response: %w", err)
+	}
+
+	temps := make(map[string]int64, len(resp))
+	for name, temp := range resp {
+		if temp != nil {
+			temps[name] = *temp
+		}
+	}
+	return temps, nil
+}
+
+// TemperatureAgg returns the minimum, maximum and average temperature of
+// the named disks over the last days days. Disks without recorded
+// temperatures are omitted.
+func (s *DiskService) TemperatureAgg(ctx context.Context, names []string, days int64) (map[string]DiskTemperatureAgg, error) {
+	result, err := callMethod(ctx, s.client, s.version, "disk.temperature_agg", []any{nonNilStrings(names), days})
+	if err != nil {
+		return nil, err
+	}
+
+	var resp map[string]DiskTemperatureAggResponse
+	if err := json.Unmarshal(result, &resp); err != nil {
+		return nil, fmt.Errorf("parse temperature_agg response: %w", err)
+	}
+
+	aggs := make(map[string]DiskTemperatureAgg, len(resp))
+	for name, agg := range resp {
+		if agg.Min == nil && agg.Max == nil && agg.Avg == nil {
+			continue
+		}
+		aggs[name] = DiskTemperatureAgg{
+			Min: derefFloat64(agg.Min),
+			Max: derefFloat64(agg.Max),
+			Avg: derefFloat64(agg.Avg),
+		}
+	}
+	return aggs, nil
+}
+
+// derefFloat64 returns *p, or 0 if p is nil.
+func derefFloat64(p *float64) float64 {
+	if p == nil {
+		return 0
+	}
+	return *p
+}
+
+// diskFromResponse converts a wire-format DiskResponse to a user-facing Disk.
+func diskFromResponse(resp DiskResponse) Disk {
+	return Disk{
+		Identifier:    resp.Identifier,
+		Name:          resp.Name,
+		Serial:        resp.Serial,
+		Model:         derefString(resp.Model),
+		Size:          resp.Size,
+		Type:          DiskType(derefString(resp.Type)),
+		RotationRate:  derefInt64(resp.RotationRate),
+		Bus:           resp.Bus,
+		Subsystem:     resp.Subsystem,
+		Number:        resp.Number,
+		LunID:         derefString(resp.LunID),
+		Description:   resp.Description,
+		TransferMode:  resp.TransferMode,
+		Pool:          derefString(resp.Pool),
+		ExportedPool:  derefString(resp.ExportedZpool),
+		ZFSGUID:       derefString(resp.ZFSGUID),
+		HDDStandby:    DiskStandby(resp.HDDStandby),
+		AdvPowerMgmt:  DiskAPM(resp.AdvPowerMgmt),
+		SMARTEnabled:  resp.ToggleSMART,
+		SMARTOptions:  resp.SMARTOptions,
+		SupportsSMART: resp.SupportsSMART != nil && *resp.SupportsSMART,
+		Critical:      derefInt64(resp.Critical),
+		Difference:    derefInt64(resp.Difference),
+		Informational: derefInt64(resp.Informational),
+	}
+}
@@ -0,0 +1,87 @@
+package truenas
+
+import "context"
+
+// DiskServiceAPI defines the interface for disk operations.
+type DiskServiceAPI interface {
+	Get(ctx context.Context, identifier string) (*Disk, error)
+	GetByName(ctx context.Context, name string) (*Disk, error)
+	List(ctx context.Context) ([]Disk, error)
+	ListUnused(ctx context.Context) ([]Disk, error)
+	Update(ctx context.Context, identifier string, opts UpdateDiskOpts) (*Disk, error)
+	Wipe(ctx context.Context, dev string, mode DiskWipeMode) error
+	Temperatures(ctx context.Context, names ...string) (map[string]int64, error)
+	TemperatureAgg(ctx context.Context, names []string, days int64) (map[string]DiskTemperatureAgg, error)
+}
+
+// Compile-time checks.
+var _ DiskServiceAPI = (*DiskService)(nil)
+var _ DiskServiceAPI = (*MockDiskService)(nil)
+
+// MockDiskService is a test double for DiskServiceAPI.
+type MockDiskService struct {
+	GetFunc            func(ctx context.Context, identifier string) (*Disk, error)
+	GetByNameFunc      func(ctx context.Context, name string) (*Disk, error)
+	ListFunc           func(ctx context.Context) ([]Disk, error)
+	ListUnusedFunc     func(ctx context.Context) ([]Disk, error)
+	UpdateFunc         func(ctx context.Context, identifier string, opts UpdateDiskOpts) (*Disk, error)
+	WipeFunc           func(ctx context.Context, dev string, mode DiskWipeMode) error
+	TemperaturesFunc   func(ctx context.Context, names ...string) (map[string]int64, error)
+	TemperatureAggFunc func(ctx context.Context, names []string, days int64) (map[string]DiskTemperatureAgg, error)
+}
+
+func (m *MockDiskService) Get(ctx context.Context, identifier string) (*Disk, error) {
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, identifier)
+	}
+	return nil, nil
+}
+
+func (m *MockDiskService) GetByName(ctx context.Context, name string) (*Disk, error) {
+	if m.GetByNameFunc != nil {
+		return m.GetByNameFunc(ctx, name)
+	}
+	return nil, nil
+}
+
+func (m *MockDiskService) List(ctx context.Context) ([]Disk, error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockDiskService) ListUnused(ctx context.Context) ([]Disk, error) {
+	if m.ListUnusedFunc != nil {
+		return m.ListUnusedFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockDiskService) Update(ctx context.Context, identifier string, opts UpdateDiskOpts) (*Disk, error) {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, identifier, opts)
+	}
+	return nil, nil
+}
+
+func (m *MockDiskService) Wipe(ctx context.Context, dev string, mode DiskWipeMode) error {
+	if m.WipeFunc != nil {
+		return m.WipeFunc(ctx, dev, mode)
+	}
+	return nil
+}
+
+func (m *MockDiskService) Temperatures(ctx context.Context, names ...string) (map[string]int64, error) {
+	if m.TemperaturesFunc != nil {
+		return m.TemperaturesFunc(ctx, names...)
+	}
+	return nil, nil
+}
+
+func (m *MockDiskService) TemperatureAgg(ctx context.Context, names []string, days int64) (map[string]DiskTemperatureAgg, error) {
+	if m.TemperatureAggFunc != nil {
+		return m.TemperatureAggFunc(ctx, names, days)
+	}
+	return nil, nil
+}
@@ -0,0 +1,46 @@
+package truenas
+
+import (
+	"context"
+	"testing"
+)
+
+func TestMockDiskService_ImplementsInterface(t *testing.T) {
+	var _ DiskServiceAPI = (*DiskService)(nil)
+	var _ DiskServiceAPI = (*MockDiskService)(nil)
+}
+
+func TestMockDiskService_DefaultsToNil(t *testing.T) {
+	mock := &MockDiskService{}
+	ctx := context.Background()
+
+	disks, err := mock.ListUnused(ctx)
+	if err != nil {
+		t.Fatalf("expected nil error, got: %v", err)
+	}
+	if disks != nil {
+		t.Fatalf("expected nil result, got: %v", disks)
+	}
+
+	if err := mock.Wipe(ctx, "sda", DiskWipeQuick); err != nil {
+		t.Fatalf("expected nil error from Wipe, got: %v", err)
+	}
+}
+
+func TestMockDiskService_CallsFunc(t *testing.T) {
+	var gotNames []string
+	mock := &MockDiskService{
+		TemperaturesFunc: func(ctx context.Context, names ...string) (map[string]int64, error) {
+			gotNames = names
+			return map[string]int64{"sda": 34}, nil
+		},
+	}
+
+	temps, err := mock.Temperatures(context.Background(), "sda")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(gotNames) != 1 || gotNames[0] != "sda" || temps["sda"] != 34 {
+		t.Fatalf("unexpected call: names=%v temps=%v", gotNames, temps)
+	}
+}
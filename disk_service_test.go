@@ -0,0 +1,366 @@
+package truenas
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// sampleDiskJSON returns a single JSON object response for
+// disk.get_instance: a spinning disk in pool tank.
+func sampleDiskJSON() json.RawMessage {
+	return json.RawMessage(`{
+		"identifier": "{serial_lunid}8HG7MZJH_5000cca0bcd9a1f4",
+		"name": "sda",
+		"subsystem": "scsi",
+		"number": 2048,
+		"serial": "8HG7MZJH",
+		"lunid": "5000cca0bcd9a1f4",
+		"size": 12000138625024,
+		"description": "Bay 1",
+		"transfermode": "Auto",
+		"hddstandby": "ALWAYS ON",
+		"togglesmart": true,
+		"advpowermgmt": "DISABLED",
+		"smartoptions": "",
+		"expiretime": null,
+		"critical": 50,
+		"difference": null,
+		"informational": null,
+		"model": "HGST HUH721212ALE600",
+		"rotationrate": 7200,
+		"type": "HDD",
+		"zfs_guid": "212",
+		"bus": "ATA",
+		"devname": "sda",
+		"enclosure": null,
+		"pool": "tank",
+		"passwd": "",
+		"kmip_uid": null,
+		"supports_smart": true
+	}`)
+}
+
+func TestDiskService_Get(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				return sampleDiskJSON(), nil
+			},
+		},
+	}
+
+	svc := NewDiskService(mock, Version{})
+	disk, err := svc.Get(context.Background(), "{serial_lunid}8HG7MZJH_5000cca0bcd9a1f4")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if mock.calls[0].Method != "disk.get_instance" {
+		t.Fatalf("expected method disk.get_instance, got %s", mock.calls[0].Method)
+	}
+	wantParams := []any{"{serial_lunid}8HG7MZJH_5000cca0bcd9a1f4", map[string]any{"extra": map[string]any{"pools": true}}}
+	if !reflect.DeepEqual(mock.calls[0].Params, wantParams) {
+		t.Errorf("expected params %v, got %v", wantParams, mock.calls[0].Params)
+	}
+
+	want := &Disk{
+		Identifier:    "{serial_lunid}8HG7MZJH_5000cca0bcd9a1f4",
+		Name:          "sda",
+		Serial:        "8HG7MZJH",
+		Model:         "HGST HUH721212ALE600",
+		Size:          12000138625024,
+		Type:          DiskHDD,
+		RotationRate:  7200,
+		Bus:           "ATA",
+		Subsystem:     "scsi",
+		Number:        2048,
+		LunID:         "5000cca0bcd9a1f4",
+		Description:   "Bay 1",
+		TransferMode:  "Auto",
+		Pool:          "tank",
+		ZFSGUID:       "212",
+		HDDStandby:    DiskStandbyAlwaysOn,
+		AdvPowerMgmt:  DiskAPMDisabled,
+		SMARTEnabled:  true,
+		SupportsSMART: true,
+		Critical:      50,
+	}
+	if !reflect.DeepEqual(disk, want) {
+		t.Errorf("expected %+v, got %+v", want, disk)
+	}
+}
+
+func TestDiskService_Get_NotFound(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				return nil, errors.New("[ENOENT] None: Disk {serial}missing does not exist")
+			},
+		},
+	}
+
+	svc := NewDiskService(mock, Version{})
+	disk, err := svc.Get(context.Background(), "{serial}missing")
+	if err != nil || disk != nil {
+		t.Errorf("expected nil, nil, got %+v, %v", disk, err)
+	}
+}
+
+func TestDiskService_GetByName(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				return json.RawMessage(`[` + string(sampleDiskJSON()) + `]`), nil
+			},
+		},
+	}
+
+	svc := NewDiskService(mock, Version{})
+	disk, err := svc.GetByName(context.Background(), "sda")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if mock.calls[0].Method != "disk.query" {
+		t.Fatalf("expected method disk.query, got %s", mock.calls[0].Method)
+	}
+	wantParams := []any{[][]any{{"name", "=", "sda"}}, map[string]any{"extra": map[string]any{"pools": true}}}
+	if !reflect.DeepEqual(mock.calls[0].Params, wantParams) {
+		t.Errorf("expected params %v, got %v", wantParams, mock.calls[0].Params)
+	}
+	if disk == nil || disk.Serial != "8HG7MZJH" {
+		t.Errorf("unexpected disk: %+v", disk)
+	}
+}
+
+func TestDiskService_GetByName_NotFound(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				return json.RawMessage(`[]`), nil
+			},
+		},
+	}
+
+	svc := NewDiskService(mock, Version{})
+	disk, err := svc.GetByName(context.Background(), "sdz")
+	if err != nil || disk != nil {
+		t.Errorf("expected nil, nil, got %+v, %v", disk, err)
+	}
+}
+
+func TestDiskService_List(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				nvme := `{"identifier": "{serial}S4EVNX0N", "name": "nvme0n1", "subsystem": "nvme", "number": 66304,
+					"serial": "S4EVNX0N", "lunid": null, "size": 500107862016, "description": "", "transfermode": "Auto",
+					"hddstandby": "ALWAYS ON", "togglesmart": true, "advpowermgmt": "DISABLED", "smartoptions": "",
+					"expiretime": null, "critical": null, "difference": null, "informational": null,
+					"model": "Samsung SSD 970 EVO Plus 500GB", "rotationrate": null, "type": "SSD", "zfs_guid": null,
+					"bus": "NVME", "devname": "nvme0n1", "pool": null, "supports_smart": null}`
+				return json.RawMessage(`[` + string(sampleDiskJSON()) + `,` + nvme + `]`), nil
+			},
+		},
+	}
+
+	svc := NewDiskService(mock, Version{})
+	disks, err := svc.List(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	wantParams := []any{[][]any{}, map[string]any{"extra": map[string]any{"pools": true}}}
+	if mock.calls[0].Method != "disk.query" || !reflect.DeepEqual(mock.calls[0].Params, wantParams) {
+		t.Errorf("unexpected call: %+v", mock.calls[0])
+	}
+	if len(disks) != 2 {
+		t.Fatalf("expected 2 disks, got %d", len(disks))
+	}
+	nvme := disks[1]
+	if nvme.Type != DiskSSD || nvme.RotationRate != 0 || nvme.Pool != "" || nvme.ZFSGUID != "" ||
+		nvme.LunID != "" || nvme.SupportsSMART {
+		t.Errorf("unexpected disk: %+v", nvme)
+	}
+}
+
+func TestDiskService_ListUnused(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				return json.RawMessage(`[
+					{"identifier": "{serial}ZL2A", "name": "sdf", "serial": "ZL2A", "size": 4000787030016,
+						"type": "HDD", "rotationrate": 5400, "pool": null, "exported_zpool": null, "duplicate_serial": []},
+					{"identifier": "{serial}ZL2B", "name": "sdg", "serial": "ZL2B", "size": 4000787030016,
+						"type": "HDD", "rotationrate": 5400, "pool": null, "exported_zpool": "oldpool", "duplicate_serial": []}
+				]`), nil
+			},
+		},
+	}
+
+	svc := NewDiskService(mock, Version{})
+	disks, err := svc.ListUnused(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]any{"type": "UNUSED"}
+	if mock.calls[0].Method != "disk.details" || !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("unexpected call: %+v", mock.calls[0])
+	}
+	if len(disks) != 2 || disks[0].Name != "sdf" || disks[0].ExportedPool != "" || disks[1].ExportedPool != "oldpool" {
+		t.Errorf("unexpected disks: %+v", disks)
+	}
+}
+
+func TestDiskService_ListUnused_VersionBoundary(t *testing.T) {
+	tests := []struct {
+		version Version
+		wantErr bool
+	}{
+		{Version{Major: 24, Minor: 10, Patch: 2}, true},
+		{Version{Major: 25, Minor: 4}, false},
+		{Version{Major: 25, Minor: 10}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.version.String(), func(t *testing.T) {
+			mock := &mockAsyncCaller{
+				mockCaller: mockCaller{
+					callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+						return json.RawMessage(`[]`), nil
+					},
+				},
+			}
+			_, err := NewDiskService(mock, tt.version).ListUnused(context.Background())
+			if got := errors.Is(err, ErrUnsupportedOnVersion); got != tt.wantErr {
+				t.Fatalf("unsupported = %v, want %v (err: %v)", got, tt.wantErr, err)
+			}
+			if tt.wantErr && len(mock.calls) != 0 {
+				t.Errorf("expected no call on %s, got %+v", tt.version, mock.calls)
+			}
+		})
+	}
+}
+
+func TestDiskService_Update(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				return sampleDiskJSON(), nil
+			},
+		},
+	}
+
+	svc := NewDiskService(mock, Version{})
+	_, err := svc.Update(context.Background(), "{serial_lunid}8HG7MZJH_5000cca0bcd9a1f4", UpdateDiskOpts{
+		HDDStandby:   DiskStandby30,
+		AdvPowerMgmt: DiskAPM127,
+		SMARTEnabled: BoolPtr(false),
+		Critical:     Int64Ptr(0),
+		Difference:   Int64Ptr(5),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if mock.calls[0].Method != "disk.update" {
+		t.Fatalf("expected method disk.update, got %s", mock.calls[0].Method)
+	}
+	want := []any{"{serial_lunid}8HG7MZJH_5000cca0bcd9a1f4", map[string]any{
+		"hddstandby":   "30",
+		"advpowermgmt": "127",
+		"togglesmart":  false,
+		"critical":     nil,
+		"difference":   int64(5),
+	}}
+	if !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("expected params %v, got %v", want, mock.calls[0].Params)
+	}
+	if mock.calls[1].Method != "disk.get_instance" {
+		t.Errorf("expected re-read, got %s", mock.calls[1].Method)
+	}
+}
+
+func TestDiskService_Wipe(t *testing.T) {
+	mock := &mockAsyncCaller{}
+
+	svc := NewDiskService(mock, Version{})
+	if err := svc.Wipe(context.Background(), "sdf", DiskWipeQuick); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Verify CallAndWait was used (not Call)
+	want := []any{"sdf", "QUICK"}
+	if len(mock.calls) != 1 || mock.calls[0].Method != "disk.wipe" || !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("unexpected calls: %+v", mock.calls)
+	}
+}
+
+func TestDiskService_Wipe_Error(t *testing.T) {
+	mock := &mockAsyncCaller{
+		callAndWaitFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return nil, errors.New("[EBUSY] Disk sda is in use by pool tank")
+		},
+	}
+
+	svc := NewDiskService(mock, Version{})
+	if err := svc.Wipe(context.Background(), "sda", DiskWipeFull); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestDiskService_Temperatures(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				return json.RawMessage(`{"sda": 34, "sdb": null, "nvme0n1": 41}`), nil
+			},
+		},
+	}
+
+	svc := NewDiskService(mock, Version{})
+	temps, err := svc.Temperatures(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	wantParams := []any{[]string{}}
+	if mock.calls[0].Method != "disk.temperatures" || !reflect.DeepEqual(mock.calls[0].Params, wantParams) {
+		t.Errorf("unexpected call: %+v", mock.calls[0])
+	}
+	want := map[string]int64{"sda": 34, "nvme0n1": 41}
+	if !reflect.DeepEqual(temps, want) {
+		t.Errorf("expected %v, got %v", want, temps)
+	}
+}
+
+func TestDiskService_TemperatureAgg(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				return json.RawMessage(`{
+					"sda": {"min": 31.0, "max": 39.0, "avg": 34.25},
+					"sdb": {"min": null, "max": null, "avg": null}
+				}`), nil
+			},
+		},
+	}
+
+	svc := NewDiskService(mock, Version{})
+	aggs, err := svc.TemperatureAgg(context.Background(), []string{"sda", "sdb"}, 7)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	wantParams := []any{[]string{"sda", "sdb"}, int64(7)}
+	if mock.calls[0].Method != "disk.temperature_agg" || !reflect.DeepEqual(mock.calls[0].Params, wantParams) {
+		t.Errorf("unexpected call: %+v", mock.calls[0])
+	}
+	want := map[string]DiskTemperatureAgg{"sda": {Min: 31, Max: 39, Avg: 34.25}}
+	if !reflect.DeepEqual(aggs, want) {
+		t.Errorf("expected %v, got %v", want, aggs)
+	}
+}
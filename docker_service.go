@@ -39,7 +39,7 @@ func NewDockerService(c Caller, v Version) *DockerService {
 
 // GetStatus returns the current Docker runtime status.
 func (s *DockerService) GetStatus(ctx context.Context) (*DockerStatus, error) {
-	result, err := s.client.Call(ctx, "docker.status", nil)
+	result, err := callMethod(ctx, s.client, s.version, "docker.status", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -55,7 +55,7 @@ func (s *DockerService) GetStatus(ctx context.Context) (*DockerStatus, error) {
 
 // GetConfig returns the current Docker configuration.
 func (s *DockerService) GetConfig(ctx context.Context) (*DockerConfig, error) {
-	result, err := s.client.Call(ctx, "docker.config", nil)
+	result, err := callMethod(ctx, s.client, s.version, "docker.config", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -65,7 +65,7 @@ func (s *FilesystemService) WriteFile(ctx context.Context, path string, params W
 		},
 	}
 
-	_, err := s.client.Call(ctx, "filesystem.file_receive", apiParams)
+	_, err := callMethod(ctx, s.client, s.version, "filesystem.file_receive", apiParams)
 	if err != nil {
 		return fmt.Errorf("write file %q: %w", path, err)
 	}
@@ -75,7 +75,7 @@ func (s *FilesystemService) WriteFile(ctx context.Context, path string, params W
 // Stat returns filesystem stat information for the given path.
 // Mode is masked with 0o777 to strip file type bits.
 func (s *FilesystemService) Stat(ctx context.Context, path string) (*StatResult, error) {
-	result, err := s.client.Call(ctx, "filesystem.stat", path)
+	result, err := callMethod(ctx, s.client, s.version, "filesystem.stat", path)
 	if err != nil {
 		return nil, err
 	}
@@ -96,7 +96,7 @@ func (s *FilesystemService) Stat(ctx context.Context, path string) (*StatResult,
 // This is a job-based operation that blocks until complete.
 func (s *FilesystemService) SetPermissions(ctx context.Context, opts SetPermOpts) error {
 	params := buildSetPermParams(opts)
-	_, err := s.client.CallAndWait(ctx, "filesystem.setperm", params)
+	_, err := callMethodAndWait(ctx, s.client, s.version, "filesystem.setperm", params)
 	return err
 }
 
@@ -0,0 +1,272 @@
+// Package fleet runs operations across many TrueNAS systems at once.
+//
+// A Fleet holds a named client and its typed services per host, with labels
+// for selecting hosts. Each and Map fan out over the hosts with bounded
+// parallelism and report per-host failures in an *Error, so inventory
+// reports run across the fleet in one call:
+//
+//	f, err := fleet.Connect(ctx, profiles)
+//	full, err := fleet.Map(ctx, f.Select(map[string]string{"env": "prod"}),
+//		func(ctx context.Context, host *fleet.Host, svcs *fleet.Services) ([]truenas.Pool, error) {
+//			return svcs.Datasets.ListPools(ctx)
+//		})
+package fleet
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/deevus/truenas-go/client"
+)
+
+// DefaultParallelism is the number of hosts worked on at once when
+// Fleet.Parallelism is not set.
+const DefaultParallelism = 8
+
+// Host is one system of a fleet.
+type Host struct {
+	Name     string
+	Labels   map[string]string
+	Client   client.Client
+	Services *Services
+}
+
+// NewHost creates a host for a connected client, building its services for
+// the client's version.
+func NewHost(name string, c client.Client, labels map[string]string) *Host {
+	return &Host{
+		Name:     name,
+		Labels:   labels,
+		Client:   c,
+		Services: NewServices(c),
+	}
+}
+
+// Fleet is a set of hosts addressed by name.
+type Fleet struct {
+	// Parallelism bounds how many hosts Each and Map work on at once. If 0
+	// or less, DefaultParallelism is used.
+	Parallelism int
+
+	hosts map[string]*Host
+}
+
+// New creates a fleet of hosts. Host names must be unique.
+func New(hosts ...*Host) (*Fleet, error) {
+	f := &Fleet{hosts: make(map[string]*Host, len(hosts))}
+	for _, h := range hosts {
+		if err := f.Add(h); err != nil {
+			return nil, err
+		}
+	}
+	return f, nil
+}
+
+// Connect connects to each profile concurrently and returns a fleet of the
+// hosts that connected, named after their profiles and labelled with their
+// profile's labels. Hosts that failed to connect are reported in an *Error
+// alongside the fleet.
+func Connect(ctx context.Context, profiles []*client.Profile) (*Fleet, error) {
+	return connect(ctx, profiles, client.New)
+}
+
+func connect(ctx context.Context, profiles []*client.Profile, newClient func(context.Context, *client.Profile) (client.Client, error)) (*Fleet, error) {
+	byName := make(map[string]*client.Profile, len(profiles))
+	pending := make([]*Host, len(profiles))
+	for i, p := range profiles {
+		byName[p.Name] = p
+		pending[i] = &Host{Name: p.Name, Labels: p.Labels}
+	}
+	toConnect, err := New(pending...)
+	if err != nil {
+		return nil, err
+	}
+
+	var mu sync.Mutex
+	connected := &Fleet{hosts: make(map[string]*Host, len(profiles))}
+	err = toConnect.Each(ctx, func(ctx context.Context, host *Host, _ *Services) error {
+		c, err := newClient(ctx, byName[host.Name])
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		connected.hosts[host.Name] = NewHost(host.Name, c, host.Labels)
+		return nil
+	})
+	return connected, err
+}
+
+// Add adds a host to the fleet.
+func (f *Fleet) Add(h *Host) error {
+	if h.Name == "" {
+		return fmt.Errorf("host name is required")
+	}
+	if _, ok := f.hosts[h.Name]; ok {
+		return fmt.Errorf("host %q already in fleet", h.Name)
+	}
+	f.hosts[h.Name] = h
+	return nil
+}
+
+// Host returns the named host, or nil if it is not in the fleet.
+func (f *Fleet) Host(name string) *Host {
+	return f.hosts[name]
+}
+
+// Hosts returns the hosts sorted by name.
+func (f *Fleet) Hosts() []*Host {
+	hosts := make([]*Host, 0, len(f.hosts))
+	for _, h := range f.hosts {
+		hosts = append(hosts, h)
+	}
+	sort.Slice(hosts, func(i, j int) bool { return hosts[i].Name < hosts[j].Name })
+	return hosts
+}
+
+// Len returns the number of hosts.
+func (f *Fleet) Len() int {
+	return len(f.hosts)
+}
+
+// Select returns the hosts carrying every given label, e.g.
+// {"env": "prod"}. The returned fleet shares hosts with f.
+func (f *Fleet) Select(labels map[string]string) *Fleet {
+	return f.Filter(func(h *Host) bool {
+		for k, v := range labels {
+			if h.Labels[k] != v {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// Filter returns the hosts for which keep returns true. The returned fleet
+// shares hosts with f.
+func (f *Fleet) Filter(keep func(*Host) bool) *Fleet {
+	out := &Fleet{Parallelism: f.Parallelism, hosts: make(map[string]*Host)}
+	for name, h := range f.hosts {
+		if keep(h) {
+			out.hosts[name] = h
+		}
+	}
+	return out
+}
+
+// Each calls fn for every host, at most Parallelism at a time. A failure on
+// one host does not stop the others; the failures are returned together in
+// an *Error. Hosts not yet started when ctx is done fail with its error.
+func (f *Fleet) Each(ctx context.Context, fn func(ctx context.Context, host *Host, svcs *Services) error) error {
+	limit := f.Parallelism
+	if limit <= 0 {
+		limit = DefaultParallelism
+	}
+
+	var mu sync.Mutex
+	errs := make(map[string]error)
+	sem := make(chan struct{}, limit)
+	var wg sync.WaitGroup
+	for _, h := range f.Hosts() {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var err error
+			select {
+			case sem <- struct{}{}:
+				err = fn(ctx, h, h.Services)
+				<-sem
+			case <-ctx.Done():
+				err = ctx.Err()
+			}
+			if err != nil {
+				mu.Lock()
+				errs[h.Name] = err
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return &Error{Errors: errs}
+	}
+	return nil
+}
+
+// Map calls fn for every host like Each and returns the results of the
+// hosts that succeeded, by host name, along with an *Error for the others.
+func Map[T any](ctx context.Context, f *Fleet, fn func(ctx context.Context, host *Host, svcs *Services) (T, error)) (map[string]T, error) {
+	var mu sync.Mutex
+	results := make(map[string]T, f.Len())
+	err := f.Each(ctx, func(ctx context.Context, host *Host, svcs *Services) error {
+		v, err := fn(ctx, host, svcs)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		results[host.Name] = v
+		mu.Unlock()
+		return nil
+	})
+	return results, err
+}
+
+// Close closes every host's client.
+func (f *Fleet) Close() error {
+	errs := make(map[string]error)
+	for _, h := range f.Hosts() {
+		if h.Client == nil {
+			continue
+		}
+		if err := h.Client.Close(); err != nil {
+			errs[h.Name] = err
+		}
+	}
+	if len(errs) > 0 {
+		return &Error{Errors: errs}
+	}
+	return nil
+}
+
+// Error reports the hosts a fleet operation failed on.
+type Error struct {
+	Errors map[string]error // host name → error
+}
+
+// Hosts returns the names of the failed hosts, sorted.
+func (e *Error) Hosts() []string {
+	names := make([]string, 0, len(e.Errors))
+	for name := range e.Errors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Error lists each failed host with its error.
+func (e *Error) Error() string {
+	var b strings.Builder
+	if len(e.Errors) == 1 {
+		b.WriteString("1 host failed")
+	} else {
+		fmt.Fprintf(&b, "%d hosts failed", len(e.Errors))
+	}
+	for _, name := range e.Hosts() {
+		fmt.Fprintf(&b, "; %s: %v", name, e.Errors[name])
+	}
+	return b.String()
+}
+
+// Unwrap returns the per-host errors, so errors.Is and errors.As match any
+// of them.
+func (e *Error) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errors))
+	for _, name := range e.Hosts() {
+		errs = append(errs, e.Errors[name])
+	}
+	return errs
+}
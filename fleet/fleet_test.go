@@ -0,0 +1,250 @@
+package fleet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	truenas "github.com/deevus/truenas-go"
+	"github.com/deevus/truenas-go/client"
+)
+
+func newTestHost(name string, labels map[string]string, pools string) *Host {
+	return NewHost(name, &client.MockClient{
+		VersionVal: truenas.Version{Major: 25, Minor: 4},
+		CallFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			if pools == "" {
+				return nil, errors.New("connection refused")
+			}
+			return json.RawMessage(pools), nil
+		},
+	}, labels)
+}
+
+func TestNew_DuplicateHost(t *testing.T) {
+	_, err := New(newTestHost("nas1", nil, ""), newTestHost("nas1", nil, ""))
+	if err == nil || !strings.Contains(err.Error(), `"nas1" already in fleet`) {
+		t.Errorf("err = %v, want duplicate host error", err)
+	}
+	if _, err := New(&Host{}); err == nil {
+		t.Error("expected error for a host without a name")
+	}
+}
+
+func TestFleet_Select(t *testing.T) {
+	f, err := New(
+		newTestHost("nas3", map[string]string{"env": "prod", "site": "ams"}, ""),
+		newTestHost("nas1", map[string]string{"env": "prod", "site": "lon"}, ""),
+		newTestHost("nas2", map[string]string{"env": "dev", "site": "ams"}, ""),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		labels map[string]string
+		want   []string
+	}{
+		{nil, []string{"nas1", "nas2", "nas3"}},
+		{map[string]string{"env": "prod"}, []string{"nas1", "nas3"}},
+		{map[string]string{"env": "prod", "site": "ams"}, []string{"nas3"}},
+		{map[string]string{"env": "staging"}, nil},
+	}
+	for _, tt := range tests {
+		var got []string
+		for _, h := range f.Select(tt.labels).Hosts() {
+			got = append(got, h.Name)
+		}
+		if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+			t.Errorf("Select(%v) = %v, want %v", tt.labels, got, tt.want)
+		}
+	}
+	if f.Host("nas2") == nil || f.Host("nas9") != nil {
+		t.Error("Host lookup returned the wrong hosts")
+	}
+}
+
+func TestMap_PoolsOverThreshold(t *testing.T) {
+	f, err := New(
+		newTestHost("nas1", nil, `[{"id": 1, "name": "tank", "size": 100, "allocated": 85, "free": 15}]`),
+		newTestHost("nas2", nil, `[{"id": 1, "name": "tank", "size": 100, "allocated": 20, "free": 80}]`),
+		newTestHost("nas3", nil, ""),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	full, err := Map(context.Background(), f, func(ctx context.Context, host *Host, svcs *Services) ([]string, error) {
+		pools, err := svcs.Datasets.ListPools(ctx)
+		if err != nil {
+			return nil, err
+		}
+		var names []string
+		for _, p := range pools {
+			if p.Allocated*100 > p.Size*80 {
+				names = append(names, p.Name)
+			}
+		}
+		return names, nil
+	})
+
+	var fleetErr *Error
+	if !errors.As(err, &fleetErr) {
+		t.Fatalf("err = %v, want *Error", err)
+	}
+	if hosts := fleetErr.Hosts(); len(hosts) != 1 || hosts[0] != "nas3" {
+		t.Errorf("failed hosts = %v, want [nas3]", hosts)
+	}
+	if !strings.Contains(err.Error(), "1 host failed; nas3: ") {
+		t.Errorf("err = %q", err)
+	}
+	if len(full) != 2 || len(full["nas1"]) != 1 || full["nas1"][0] != "tank" || len(full["nas2"]) != 0 {
+		t.Errorf("results = %v, want tank on nas1 only", full)
+	}
+}
+
+func TestEach_Parallelism(t *testing.T) {
+	var hosts []*Host
+	for _, name := range []string{"a", "b", "c", "d", "e", "f", "g"} {
+		hosts = append(hosts, newTestHost(name, nil, "[]"))
+	}
+	f, _ := New(hosts...)
+	f.Parallelism = 2
+
+	var running, peak, calls atomic.Int32
+	err := f.Each(context.Background(), func(ctx context.Context, host *Host, svcs *Services) error {
+		calls.Add(1)
+		n := running.Add(1)
+		defer running.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls.Load() != 7 {
+		t.Errorf("fn called %d times, want 7", calls.Load())
+	}
+	if p := peak.Load(); p > 2 {
+		t.Errorf("peak parallelism = %d, want at most 2", p)
+	}
+}
+
+func TestEach_ContextCanceled(t *testing.T) {
+	f, _ := New(newTestHost("nas1", nil, "[]"), newTestHost("nas2", nil, "[]"))
+	f.Parallelism = 1
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := f.Each(ctx, func(ctx context.Context, host *Host, svcs *Services) error {
+		return ctx.Err()
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("err = %v, want context.Canceled", err)
+	}
+	var fleetErr *Error
+	if !errors.As(err, &fleetErr) || len(fleetErr.Errors) != 2 {
+		t.Errorf("err = %v, want both hosts failed", err)
+	}
+}
+
+func TestConnect(t *testing.T) {
+	profiles := []*client.Profile{
+		{Name: "nas1", Host: "nas1.local", Labels: map[string]string{"env": "prod"}},
+		{Name: "nas2", Host: "nas2.local"},
+	}
+	newClient := func(ctx context.Context, p *client.Profile) (client.Client, error) {
+		if p.Name == "nas2" {
+			return nil, errors.New("dial tcp: no route to host")
+		}
+		return &client.MockClient{VersionVal: truenas.Version{Major: 25, Minor: 4}}, nil
+	}
+
+	f, err := connect(context.Background(), profiles, newClient)
+	if err == nil || !strings.Contains(err.Error(), "nas2: dial tcp: no route to host") {
+		t.Errorf("err = %v, want nas2 to fail", err)
+	}
+	if f.Len() != 1 {
+		t.Fatalf("got %d hosts, want 1", f.Len())
+	}
+	h := f.Host("nas1")
+	if h == nil || h.Labels["env"] != "prod" || h.Services == nil || h.Services.Datasets == nil {
+		t.Errorf("nas1 = %+v, want a connected host with services", h)
+	}
+
+	if _, err := connect(context.Background(), append(profiles, profiles[0]), newClient); err == nil {
+		t.Error("expected error for duplicate profile names")
+	}
+}
+
+func TestNewServices_SetsEveryService(t *testing.T) {
+	svcs := reflect.ValueOf(*NewServices(&client.MockClient{VersionVal: truenas.Version{Major: 25, Minor: 4}}))
+	for i := range svcs.NumField() {
+		if svcs.Field(i).IsNil() {
+			t.Errorf("NewServices left %s unset", svcs.Type().Field(i).Name)
+		}
+	}
+}
+
+func TestMap_UnhealthyPools(t *testing.T) {
+	f, err := New(
+		newTestHost("nas1", nil, `[{"id": 1, "name": "tank", "status": "DEGRADED", "healthy": false}]`),
+		newTestHost("nas2", nil, `[{"id": 1, "name": "tank", "status": "ONLINE", "healthy": true}]`),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	unhealthy, err := Map(context.Background(), f, func(ctx context.Context, host *Host, svcs *Services) ([]string, error) {
+		pools, err := svcs.Pools.List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		var names []string
+		for _, p := range pools {
+			if !p.Healthy {
+				names = append(names, p.Name+" "+p.Status)
+			}
+		}
+		return names, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(unhealthy["nas1"]) != 1 || unhealthy["nas1"][0] != "tank DEGRADED" || len(unhealthy["nas2"]) != 0 {
+		t.Errorf("results = %v, want degraded tank on nas1 only", unhealthy)
+	}
+}
+
+func TestFleet_Close(t *testing.T) {
+	closed := 0
+	mock := func(err error) *client.MockClient {
+		return &client.MockClient{CloseFunc: func() error {
+			closed++
+			return err
+		}}
+	}
+	f, _ := New(
+		&Host{Name: "nas1", Client: mock(nil)},
+		&Host{Name: "nas2", Client: mock(errors.New("already closed"))},
+	)
+
+	err := f.Close()
+	if closed != 2 {
+		t.Errorf("closed %d clients, want 2", closed)
+	}
+	if err == nil || err.Error() != "1 host failed; nas2: already closed" {
+		t.Errorf("err = %v", err)
+	}
+}
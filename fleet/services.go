@@ -0,0 +1,66 @@
+package fleet
+
+import (
+	truenas "github.com/deevus/truenas-go"
+	"github.com/deevus/truenas-go/client"
+)
+
+// Services are the typed services of one host. The fields are interfaces so
+// tests can substitute mocks.
+type Services struct {
+	Apps          truenas.AppServiceAPI
+	CloudSync     truenas.CloudSyncServiceAPI
+	Cron          truenas.CronServiceAPI
+	Datasets      truenas.DatasetServiceAPI
+	Disks         truenas.DiskServiceAPI
+	Docker        truenas.DockerServiceAPI
+	Filesystem    truenas.FilesystemServiceAPI
+	Groups        truenas.GroupServiceAPI
+	Interfaces    truenas.InterfaceServiceAPI
+	ISCSI         truenas.ISCSIServiceAPI
+	Network       truenas.NetworkServiceAPI
+	NFS           truenas.NFSServiceAPI
+	Pools         truenas.PoolServiceAPI
+	Replication   truenas.ReplicationServiceAPI
+	Reporting     truenas.ReportingServiceAPI
+	RsyncTasks    truenas.RsyncTaskServiceAPI
+	Scrub         truenas.ScrubServiceAPI
+	SMB           truenas.SMBServiceAPI
+	Snapshots     truenas.SnapshotServiceAPI
+	SnapshotTasks truenas.SnapshotTaskServiceAPI
+	System        truenas.SystemServiceAPI
+	Users         truenas.UserServiceAPI
+	Virt          truenas.VirtServiceAPI
+	VMs           truenas.VMServiceAPI
+}
+
+// NewServices builds every service for a connected client's version.
+func NewServices(c client.Client) *Services {
+	v := c.Version()
+	return &Services{
+		Apps:          truenas.NewAppService(c, v),
+		CloudSync:     truenas.NewCloudSyncService(c, v),
+		Cron:          truenas.NewCronService(c, v),
+		Datasets:      truenas.NewDatasetService(c, v),
+		Disks:         truenas.NewDiskService(c, v),
+		Docker:        truenas.NewDockerService(c, v),
+		Filesystem:    truenas.NewFilesystemService(c, v),
+		Groups:        truenas.NewGroupService(c, v),
+		Interfaces:    truenas.NewInterfaceService(c, v),
+		ISCSI:         truenas.NewISCSIService(c, v),
+		Network:       truenas.NewNetworkService(c, v),
+		NFS:           truenas.NewNFSService(c, v),
+		Pools:         truenas.NewPoolService(c, v),
+		Replication:   truenas.NewReplicationService(c, v),
+		Reporting:     truenas.NewReportingService(c, v),
+		RsyncTasks:    truenas.NewRsyncTaskService(c, v),
+		Scrub:         truenas.NewScrubService(c, v),
+		SMB:           truenas.NewSMBService(c, v),
+		Snapshots:     truenas.NewSnapshotService(c, v),
+		SnapshotTasks: truenas.NewSnapshotTaskService(c, v),
+		System:        truenas.NewSystemService(c, v),
+		Users:         truenas.NewUserService(c, v),
+		Virt:          truenas.NewVirtService(c, v),
+		VMs:           truenas.NewVMService(c, v),
+	}
+}
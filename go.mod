@@ -4,10 +4,12 @@ go 1.25.0
 
 require (
 	al.essio.dev/pkg/shellescape v1.6.0
+	github.com/BurntSushi/toml v1.6.0
 	github.com/dustin/go-humanize v1.0.1
 	github.com/gorilla/websocket v1.5.3
 	golang.org/x/crypto v0.48.0
 	golang.org/x/time v0.14.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require golang.org/x/sys v0.41.0 // indirect
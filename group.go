@@ -0,0 +1,14 @@
+package truenas
+
+// GroupResponse represents a group from the TrueNAS API.
+type GroupResponse struct {
+	ID                   int64    `json:"id"`
+	GID                  int64    `json:"gid"`
+	Name                 string   `json:"name"`
+	Builtin              bool     `json:"builtin"`
+	Local                bool     `json:"local"`
+	SMB                  bool     `json:"smb"`
+	SudoCommands         []string `json:"sudo_commands"`
+	SudoCommandsNoPasswd []string `json:"sudo_commands_nopasswd"`
+	Users                []int64  `json:"users"`
+}
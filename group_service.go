@@ -0,0 +1,266 @@
+package truenas
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Group is the user-facing representation of a TrueNAS group.
+type Group struct {
+	ID                   int64
+	GID                  int64
+	Name                 string
+	Builtin              bool
+	Local                bool
+	SMB                  bool
+	SudoCommands         []string
+	SudoCommandsNoPasswd []string
+	// Users are the ids (not the UIDs) of the member users.
+	Users []int64
+}
+
+// CreateGroupOpts contains options for creating a group.
+type CreateGroupOpts struct {
+	GID                  *int64 // Nil = next available GID
+	Name                 string
+	SMB                  bool
+	SudoCommands         []string
+	SudoCommandsNoPasswd []string
+	Users                []int64 // Member user ids
+}
+
+// UpdateGroupOpts contains options for updating a group.
+// Pointer fields distinguish "don't change" (nil) from "set to zero/empty".
+// Slice fields use nil to mean "don't change"; an empty, non-nil slice
+// clears the list.
+type UpdateGroupOpts struct {
+	Name                 string // Empty = don't change
+	SMB                  *bool
+	SudoCommands         []string
+	SudoCommandsNoPasswd []string
+	Users                []int64
+}
+
+// GroupService provides typed methods for the group.* API namespace.
+type GroupService struct {
+	client  Caller
+	version Version
+}
+
+// NewGroupService creates a new GroupService.
+func NewGroupService(c Caller, v Version) *GroupService {
+	return &GroupService{client: c, version: v}
+}
+
+// Create creates a group and returns the full object.
+func (s *GroupService) Create(ctx context.Context, opts CreateGroupOpts) (*Group, error) {
+	params := groupCreateParams(opts)
+	result, err := callMethod(ctx, s.client, s.version, "group.create", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var id int64
+	if err := json.Unmarshal(result, &id); err != nil {
+		return nil, fmt.Errorf("parse create response: %w", err)
+	}
+
+	return s.Get(ctx, id)
+}
+
+// Get returns a group by ID, or nil if not found.
+func (s *GroupService) Get(ctx context.Context, id int64) (*Group, error) {
+	result, err := callMethod(ctx, s.client, s.version, "group.get_instance", id)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var resp GroupResponse
+	if err := json.Unmarshal(result, &resp); err != nil {
+		return nil, fmt.Errorf("parse get_instance response: %w", err)
+	}
+
+	group := groupFromResponse(resp)
+	return &group, nil
+}
+
+// GetByName returns a group by name, or nil if not found.
+func (s *GroupService) GetByName(ctx context.Context, name string) (*Group, error) {
+	groups, err := s.query(ctx, [][]any{{"name", "=", name}})
+	if err != nil {
+		return nil, err
+	}
+	if len(groups) == 0 {
+		return nil, nil
+	}
+	return &groups[0], nil
+}
+
+// List returns the local groups, including built-in groups. Directory
+// service groups are not included.
+func (s *GroupService) List(ctx context.Context) ([]Group, error) {
+	return s.query(ctx, [][]any{{"local", "=", true}})
+}
+
+func (s *GroupService) query(ctx context.Context, filter [][]any) ([]Group, error) {
+	result, err := callMethod(ctx, s.client, s.version, "group.query", filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var responses []GroupResponse
+	if err := json.Unmarshal(result, &responses); err != nil {
+		return nil, fmt.Errorf("parse query response: %w", err)
+	}
+
+	groups := make([]Group, len(responses))
+	for i, resp := range responses {
+		groups[i] = groupFromResponse(resp)
+	}
+	return groups, nil
+}
+
+// Update updates a group and returns the full object.
+func (s *GroupService) Update(ctx context.Context, id int64, opts UpdateGroupOpts) (*Group, error) {
+	_, err := callMethod(ctx, s.client, s.version, "group.update", []any{id, groupUpdateParams(opts)})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Get(ctx, id)
+}
+
+// Delete deletes a group by ID. When deleteUsers is true, the users whose
+// primary group it is are deleted too.
+func (s *GroupService) Delete(ctx context.Context, id int64, deleteUsers bool) error {
+	_, err := callMethod(ctx, s.client, s.version, "group.delete", []any{id, map[string]any{"delete_users": deleteUsers}})
+	return err
+}
+
+// NextGID returns the next free GID.
+func (s *GroupService) NextGID(ctx context.Context) (int64, error) {
+	result, err := callMethod(ctx, s.client, s.version, "group.get_next_gid", nil)
+	if err != nil {
+		return 0, err
+	}
+
+	var gid int64
+	if err := json.Unmarshal(result, &gid); err != nil {
+		return 0, fmt.Errorf("parse get_next_gid response: %w", err)
+	}
+	return gid, nil
+}
+
+// AddMembers adds users, by user id, to a group and returns the updated
+// group. Users already in the group are skipped.
+func (s *GroupService) AddMembers(ctx context.Context, id int64, userIDs ...int64) (*Group, error) {
+	group, err := s.mustGet(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	members := make(map[int64]bool, len(group.Users))
+	for _, u := range group.Users {
+		members[u] = true
+	}
+	users := append([]int64{}, group.Users...)
+	for _, u := range userIDs {
+		if !members[u] {
+			members[u] = true
+			users = append(users, u)
+		}
+	}
+	if len(users) == len(group.Users) {
+		return group, nil
+	}
+	return s.Update(ctx, id, UpdateGroupOpts{Users: users})
+}
+
+// RemoveMembers removes users, by user id, from a group and returns the
+// updated group. Users not in the group are skipped.
+func (s *GroupService) RemoveMembers(ctx context.Context, id int64, userIDs ...int64) (*Group, error) {
+	group, err := s.mustGet(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	remove := make(map[int64]bool, len(userIDs))
+	for _, u := range userIDs {
+		remove[u] = true
+	}
+	users := []int64{}
+	for _, u := range group.Users {
+		if !remove[u] {
+			users = append(users, u)
+		}
+	}
+	if len(users) == len(group.Users) {
+		return group, nil
+	}
+	return s.Update(ctx, id, UpdateGroupOpts{Users: users})
+}
+
+// mustGet returns a group by ID, or an error if not found.
+func (s *GroupService) mustGet(ctx context.Context, id int64) (*Group, error) {
+	group, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if group == nil {
+		return nil, fmt.Errorf("group %d not found", id)
+	}
+	return group, nil
+}
+
+// groupFromResponse converts a wire-format GroupResponse to a user-facing Group.
+func groupFromResponse(resp GroupResponse) Group {
+	return Group{
+		ID:                   resp.ID,
+		GID:                  resp.GID,
+		Name:                 resp.Name,
+		Builtin:              resp.Builtin,
+		Local:                resp.Local,
+		SMB:                  resp.SMB,
+		SudoCommands:         resp.SudoCommands,
+		SudoCommandsNoPasswd: resp.SudoCommandsNoPasswd,
+		Users:                resp.Users,
+	}
+}
+
+// groupCreateParams builds API parameters for group.create.
+func groupCreateParams(opts CreateGroupOpts) map[string]any {
+	params := map[string]any{
+		"name":                   opts.Name,
+		"smb":                    opts.SMB,
+		"sudo_commands":          nonNilStrings(opts.SudoCommands),
+		"sudo_commands_nopasswd": nonNilStrings(opts.SudoCommandsNoPasswd),
+		"users":                  nonNilInt64s(opts.Users),
+	}
+	if opts.GID != nil {
+		params["gid"] = *opts.GID
+	}
+	return params
+}
+
+// groupUpdateParams builds API parameters for group.update.
+func groupUpdateParams(opts UpdateGroupOpts) map[string]any {
+	params := map[string]any{}
+	if opts.Name != "" {
+		params["name"] = opts.Name
+	}
+	if opts.SMB != nil {
+		params["smb"] = *opts.SMB
+	}
+	if opts.SudoCommands != nil {
+		params["sudo_commands"] = opts.SudoCommands
+	}
+	if opts.SudoCommandsNoPasswd != nil {
+		params["sudo_commands_nopasswd"] = opts.SudoCommandsNoPasswd
+	}
+	if opts.Users != nil {
+		params["users"] = opts.Users
+	}
+	return params
+}
@@ -0,0 +1,46 @@
+package truenas
+
+import (
+	"context"
+	"testing"
+)
+
+func TestMockGroupService_ImplementsInterface(t *testing.T) {
+	var _ GroupServiceAPI = (*GroupService)(nil)
+	var _ GroupServiceAPI = (*MockGroupService)(nil)
+}
+
+func TestMockGroupService_DefaultsToNil(t *testing.T) {
+	mock := &MockGroupService{}
+	ctx := context.Background()
+
+	group, err := mock.GetByName(ctx, "apps")
+	if err != nil {
+		t.Fatalf("expected nil error, got: %v", err)
+	}
+	if group != nil {
+		t.Fatalf("expected nil result, got: %v", group)
+	}
+
+	if err := mock.Delete(ctx, 1, false); err != nil {
+		t.Fatalf("expected nil error from Delete, got: %v", err)
+	}
+}
+
+func TestMockGroupService_CallsFunc(t *testing.T) {
+	var got []int64
+	mock := &MockGroupService{
+		AddMembersFunc: func(ctx context.Context, id int64, userIDs ...int64) (*Group, error) {
+			got = userIDs
+			return &Group{ID: id, Users: userIDs}, nil
+		},
+	}
+
+	group, err := mock.AddMembers(context.Background(), 7, 1, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 2 || group.ID != 7 {
+		t.Fatalf("expected AddMembersFunc called with 2 users, got %v", got)
+	}
+}
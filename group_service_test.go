@@ -0,0 +1,215 @@
+package truenas
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// sampleGroupJSON returns a single JSON object response for group.get_instance.
+func sampleGroupJSON(users string) json.RawMessage {
+	return json.RawMessage(`{
+		"id": 41,
+		"gid": 3001,
+		"name": "apps",
+		"builtin": false,
+		"local": true,
+		"smb": false,
+		"sudo_commands": [],
+		"sudo_commands_nopasswd": [],
+		"users": ` + users + `
+	}`)
+}
+
+func TestGroupService_Create(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			if method == "group.create" {
+				return json.RawMessage(`41`), nil
+			}
+			return sampleGroupJSON(`[70]`), nil
+		},
+	}
+
+	svc := NewGroupService(mock, Version{})
+	group, err := svc.Create(context.Background(), CreateGroupOpts{
+		GID:   Int64Ptr(3001),
+		Name:  "apps",
+		Users: []int64{70},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]any{
+		"gid":                    int64(3001),
+		"name":                   "apps",
+		"smb":                    false,
+		"sudo_commands":          []string{},
+		"sudo_commands_nopasswd": []string{},
+		"users":                  []int64{70},
+	}
+	if !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("expected params %v, got %v", want, mock.calls[0].Params)
+	}
+	if mock.calls[1].Method != "group.get_instance" || mock.calls[1].Params != int64(41) {
+		t.Errorf("expected re-read of group 41, got %s %v", mock.calls[1].Method, mock.calls[1].Params)
+	}
+	if group.ID != 41 || group.GID != 3001 || group.Name != "apps" || !group.Local {
+		t.Errorf("unexpected group: %+v", group)
+	}
+}
+
+func TestGroupService_Create_ParseError(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return json.RawMessage(`"not an id"`), nil
+		},
+	}
+
+	svc := NewGroupService(mock, Version{})
+	if _, err := svc.Create(context.Background(), CreateGroupOpts{Name: "apps"}); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestGroupService_Get_NotFound(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return nil, errors.New("[ENOENT] None: Group 99 does not exist")
+		},
+	}
+
+	svc := NewGroupService(mock, Version{})
+	group, err := svc.Get(context.Background(), 99)
+	if err != nil || group != nil {
+		t.Errorf("expected nil, nil, got %+v, %v", group, err)
+	}
+}
+
+func TestGroupService_GetByName_And_List(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			if method != "group.query" {
+				t.Errorf("expected method group.query, got %s", method)
+			}
+			return json.RawMessage(`[` + string(sampleGroupJSON(`[]`)) + `]`), nil
+		},
+	}
+
+	svc := NewGroupService(mock, Version{})
+	group, err := svc.GetByName(context.Background(), "apps")
+	if err != nil || group == nil || group.Name != "apps" {
+		t.Errorf("unexpected result: %+v, %v", group, err)
+	}
+	groups, err := svc.List(context.Background())
+	if err != nil || len(groups) != 1 {
+		t.Errorf("unexpected result: %+v, %v", groups, err)
+	}
+
+	if want := [][]any{{"name", "=", "apps"}}; !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("expected filter %v, got %v", want, mock.calls[0].Params)
+	}
+	if want := [][]any{{"local", "=", true}}; !reflect.DeepEqual(mock.calls[1].Params, want) {
+		t.Errorf("expected filter %v, got %v", want, mock.calls[1].Params)
+	}
+}
+
+func TestGroupService_Update(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return sampleGroupJSON(`[]`), nil
+		},
+	}
+
+	svc := NewGroupService(mock, Version{})
+	if _, err := svc.Update(context.Background(), 41, UpdateGroupOpts{Name: "apps2", SMB: BoolPtr(true)}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []any{int64(41), map[string]any{"name": "apps2", "smb": true}}
+	if mock.calls[0].Method != "group.update" || !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("unexpected call: %s %v", mock.calls[0].Method, mock.calls[0].Params)
+	}
+}
+
+func TestGroupService_Delete(t *testing.T) {
+	mock := &mockCaller{}
+
+	svc := NewGroupService(mock, Version{})
+	if err := svc.Delete(context.Background(), 41, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []any{int64(41), map[string]any{"delete_users": false}}
+	if mock.calls[0].Method != "group.delete" || !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("unexpected call: %s %v", mock.calls[0].Method, mock.calls[0].Params)
+	}
+}
+
+func TestGroupService_NextGID(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			if method != "group.get_next_gid" {
+				t.Errorf("expected method group.get_next_gid, got %s", method)
+			}
+			return json.RawMessage(`3002`), nil
+		},
+	}
+
+	svc := NewGroupService(mock, Version{})
+	gid, err := svc.NextGID(context.Background())
+	if err != nil || gid != 3002 {
+		t.Errorf("expected 3002, got %d, %v", gid, err)
+	}
+}
+
+func TestGroupService_Members(t *testing.T) {
+	var updates [][]int64
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			if method == "group.update" {
+				users := params.([]any)[1].(map[string]any)["users"].([]int64)
+				updates = append(updates, users)
+				return nil, nil
+			}
+			return sampleGroupJSON(`[70, 71]`), nil
+		},
+	}
+
+	svc := NewGroupService(mock, Version{})
+	ctx := context.Background()
+	if _, err := svc.AddMembers(ctx, 41, 71, 72, 72); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := svc.AddMembers(ctx, 41, 70); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := svc.RemoveMembers(ctx, 41, 70, 71); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := svc.RemoveMembers(ctx, 41, 99); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Adding existing members and removing non-members change nothing.
+	want := [][]int64{{70, 71, 72}, {}}
+	if !reflect.DeepEqual(updates, want) {
+		t.Errorf("expected updates %v, got %v", want, updates)
+	}
+}
+
+func TestGroupService_Members_GroupNotFound(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return nil, errors.New("[ENOENT] None: Group 99 does not exist")
+		},
+	}
+
+	svc := NewGroupService(mock, Version{})
+	if _, err := svc.RemoveMembers(context.Background(), 99, 1); err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -45,7 +45,7 @@ func NewInterfaceService(c Caller, v Version) *InterfaceService {
 
 // List returns all network interfaces.
 func (s *InterfaceService) List(ctx context.Context) ([]NetworkInterface, error) {
-	result, err := s.client.Call(ctx, "interface.query", nil)
+	result, err := callMethod(ctx, s.client, s.version, "interface.query", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -65,7 +65,7 @@ func (s *InterfaceService) List(ctx context.Context) ([]NetworkInterface, error)
 // Get returns a network interface by ID, or nil if not found.
 func (s *InterfaceService) Get(ctx context.Context, id string) (*NetworkInterface, error) {
 	filter := [][]any{{"id", "=", id}}
-	result, err := s.client.Call(ctx, "interface.query", filter)
+	result, err := callMethod(ctx, s.client, s.version, "interface.query", filter)
 	if err != nil {
 		return nil, err
 	}
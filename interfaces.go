@@ -81,3 +81,11 @@ type SubscribeCaller interface {
 	AsyncCaller
 	Subscribe(ctx context.Context, collection string, params any) (*Subscription[json.RawMessage], error)
 }
+
+// EventSubscriber adds subscriptions that deliver whole collection_update
+// notifications, as used by Watch.
+// Only WebSocket transport supports this; SSH returns ErrUnsupportedOperation.
+type EventSubscriber interface {
+	SubscribeCaller
+	SubscribeEvents(ctx context.Context, collection string, params any) (*Subscription[json.RawMessage], error)
+}
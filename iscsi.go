@@ -0,0 +1,130 @@
+package truenas
+
+import "encoding/json"
+
+// ISCSIAuthMethod is the CHAP authentication an iSCSI target group or
+// discovery requires.
+type ISCSIAuthMethod string
+
+const (
+	ISCSIAuthNone       ISCSIAuthMethod = "NONE"
+	ISCSIAuthCHAP       ISCSIAuthMethod = "CHAP"
+	ISCSIAuthCHAPMutual ISCSIAuthMethod = "CHAP_MUTUAL"
+)
+
+// ISCSITargetMode is the transport an iSCSI target is exported over.
+type ISCSITargetMode string
+
+const (
+	ISCSITargetModeISCSI ISCSITargetMode = "ISCSI"
+	ISCSITargetModeFC    ISCSITargetMode = "FC"
+	ISCSITargetModeBoth  ISCSITargetMode = "BOTH"
+)
+
+// ISCSIExtentType is the kind of storage backing an iSCSI extent.
+type ISCSIExtentType string
+
+const (
+	ISCSIExtentTypeDisk ISCSIExtentType = "DISK" // A zvol
+	ISCSIExtentTypeFile ISCSIExtentType = "FILE"
+)
+
+// ISCSIGlobalConfigResponse represents the global iSCSI configuration.
+type ISCSIGlobalConfigResponse struct {
+	ID                 int64    `json:"id"`
+	Basename           string   `json:"basename"`
+	ISNSServers        []string `json:"isns_servers"`
+	ListenPort         int64    `json:"listen_port"`
+	PoolAvailThreshold *int64   `json:"pool_avail_threshold"`
+	ALUA               bool     `json:"alua"`
+	ISER               bool     `json:"iser"`
+}
+
+// ISCSIPortalResponse represents an iSCSI portal from the iscsi.portal query
+// API.
+type ISCSIPortalResponse struct {
+	ID      int64                       `json:"id"`
+	Listen  []ISCSIPortalListenResponse `json:"listen"`
+	Tag     int64                       `json:"tag"`
+	Comment string                      `json:"comment"`
+}
+
+// ISCSIPortalListenResponse is an address an iSCSI portal listens on.
+type ISCSIPortalListenResponse struct {
+	IP   string `json:"ip"`
+	Port int64  `json:"port"`
+}
+
+// ISCSIInitiatorResponse represents an iSCSI initiator group from the
+// iscsi.initiator query API.
+type ISCSIInitiatorResponse struct {
+	ID         int64    `json:"id"`
+	Initiators []string `json:"initiators"`
+	Comment    string   `json:"comment"`
+}
+
+// ISCSIAuthResponse represents an iSCSI CHAP credential from the iscsi.auth
+// query API.
+type ISCSIAuthResponse struct {
+	ID            int64  `json:"id"`
+	Tag           int64  `json:"tag"`
+	User          string `json:"user"`
+	Secret        string `json:"secret"`
+	PeerUser      string `json:"peeruser"`
+	PeerSecret    string `json:"peersecret"`
+	DiscoveryAuth string `json:"discovery_auth"`
+}
+
+// ISCSITargetResponse represents an iSCSI target from the iscsi.target query
+// API.
+type ISCSITargetResponse struct {
+	ID           int64                      `json:"id"`
+	Name         string                     `json:"name"`
+	Alias        *string                    `json:"alias"`
+	Mode         string                     `json:"mode"`
+	Groups       []ISCSITargetGroupResponse `json:"groups"`
+	AuthNetworks []string                   `json:"auth_networks"`
+	RelTgtID     int64                      `json:"rel_tgt_id"`
+}
+
+// ISCSITargetGroupResponse ties an iSCSI target to a portal, and optionally
+// an initiator group and CHAP credential.
+type ISCSITargetGroupResponse struct {
+	Portal     int64  `json:"portal"`
+	Initiator  *int64 `json:"initiator"`
+	AuthMethod string `json:"authmethod"`
+	Auth       *int64 `json:"auth"`
+}
+
+// ISCSIExtentResponse represents an iSCSI extent from the iscsi.extent query
+// API.
+type ISCSIExtentResponse struct {
+	ID             int64       `json:"id"`
+	Name           string      `json:"name"`
+	Type           string      `json:"type"`
+	Disk           *string     `json:"disk"`
+	Serial         *string     `json:"serial"`
+	Path           *string     `json:"path"`
+	Filesize       json.Number `json:"filesize"` // Sent as a string or an integer
+	Blocksize      int64       `json:"blocksize"`
+	PBlocksize     bool        `json:"pblocksize"`
+	AvailThreshold *int64      `json:"avail_threshold"`
+	Comment        string      `json:"comment"`
+	NAA            string      `json:"naa"`
+	InsecureTPC    bool        `json:"insecure_tpc"`
+	Xen            bool        `json:"xen"`
+	RPM            string      `json:"rpm"`
+	RO             bool        `json:"ro"`
+	Enabled        bool        `json:"enabled"`
+	Vendor         string      `json:"vendor"`
+	Locked         *bool       `json:"locked"`
+}
+
+// ISCSITargetExtentResponse represents an association of an extent with a
+// target from the iscsi.targetextent query API.
+type ISCSITargetExtentResponse struct {
+	ID     int64 `json:"id"`
+	Target int64 `json:"target"`
+	LunID  int64 `json:"lunid"`
+	Extent int64 `json:"extent"`
+}
@@ -0,0 +1,1090 @@
+package truenas
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ISCSIGlobalConfig is the user-facing representation of the global iSCSI
+// configuration.
+type ISCSIGlobalConfig struct {
+	Basename           string // IQN prefix of target names
+	ISNSServers        []string
+	ListenPort         int64
+	PoolAvailThreshold int64 // Percent; 0 = unset
+	ALUA               bool
+	ISER               bool
+}
+
+// UpdateISCSIGlobalConfigOpts contains options for updating the global iSCSI
+// configuration.
+// Pointer fields distinguish "don't change" (nil) from "set to zero/empty".
+// Slice fields use nil to mean "don't change".
+type UpdateISCSIGlobalConfigOpts struct {
+	Basename           string // Empty = don't change
+	ISNSServers        []string
+	ListenPort         *int64
+	PoolAvailThreshold *int64 // 0 = unset
+	ALUA               *bool
+	ISER               *bool
+}
+
+// ISCSIPortal is the user-facing representation of an iSCSI portal.
+type ISCSIPortal struct {
+	ID      int64
+	Tag     int64
+	Comment string
+	Listen  []ISCSIPortalListen
+}
+
+// ISCSIPortalListen is an address an iSCSI portal listens on.
+type ISCSIPortalListen struct {
+	IP   string
+	Port int64
+}
+
+// CreateISCSIPortalOpts contains options for creating an iSCSI portal.
+type CreateISCSIPortalOpts struct {
+	Listen  []string // IP addresses; the port is the global listen port
+	Comment string
+}
+
+// UpdateISCSIPortalOpts contains options for updating an iSCSI portal.
+// Listen uses nil to mean "don't change".
+type UpdateISCSIPortalOpts struct {
+	Listen  []string
+	Comment *string
+}
+
+// ISCSIInitiator is the user-facing representation of an iSCSI initiator
+// group, which restricts the initiators allowed to connect to a target.
+type ISCSIInitiator struct {
+	ID         int64
+	Initiators []string // Initiator IQNs; empty = all initiators
+	Comment    string
+}
+
+// CreateISCSIInitiatorOpts contains options for creating an iSCSI initiator
+// group.
+type CreateISCSIInitiatorOpts struct {
+	Initiators []string
+	Comment    string
+}
+
+// UpdateISCSIInitiatorOpts contains options for updating an iSCSI initiator
+// group. Initiators uses nil to mean "don't change"; an empty, non-nil slice
+// allows all initiators.
+type UpdateISCSIInitiatorOpts struct {
+	Initiators []string
+	Comment    *string
+}
+
+// ISCSIAuth is the user-facing representation of an iSCSI CHAP credential.
+// Credentials sharing a Tag form an authorized access group.
+type ISCSIAuth struct {
+	ID            int64
+	Tag           int64
+	User          string
+	Secret        string
+	PeerUser      string // Mutual CHAP user; empty when unset
+	PeerSecret    string
+	DiscoveryAuth ISCSIAuthMethod
+}
+
+// CreateISCSIAuthOpts contains options for creating an iSCSI CHAP
+// credential.
+type CreateISCSIAuthOpts struct {
+	Tag           int64
+	User          string
+	Secret        string // 12 to 16 characters
+	PeerUser      string
+	PeerSecret    string
+	DiscoveryAuth ISCSIAuthMethod // Empty = NONE
+}
+
+// UpdateISCSIAuthOpts contains options for updating an iSCSI CHAP
+// credential.
+// Pointer fields distinguish "don't change" (nil) from "set to zero/empty".
+// String fields use empty string to mean "don't change".
+type UpdateISCSIAuthOpts struct {
+	Tag           *int64
+	User          string
+	Secret        string
+	PeerUser      *string
+	PeerSecret    *string
+	DiscoveryAuth ISCSIAuthMethod
+}
+
+// ISCSITarget is the user-facing representation of an iSCSI target.
+type ISCSITarget struct {
+	ID           int64
+	Name         string // Appended to the global basename to form the IQN
+	Alias        string
+	Mode         ISCSITargetMode
+	Groups       []ISCSITargetGroup
+	AuthNetworks []string
+	RelTgtID     int64
+}
+
+// ISCSITargetGroup exposes a target on a portal, optionally restricted to
+// an initiator group and protected by CHAP.
+type ISCSITargetGroup struct {
+	Portal     int64
+	Initiator  *int64 // Initiator group ID; nil = any initiator
+	AuthMethod ISCSIAuthMethod
+	Auth       *int64 // CHAP credential tag; nil = none
+}
+
+// CreateISCSITargetOpts contains options for creating an iSCSI target.
+type CreateISCSITargetOpts struct {
+	Name         string
+	Alias        string
+	Mode         ISCSITargetMode // Empty = ISCSI
+	Groups       []ISCSITargetGroup
+	AuthNetworks []string
+}
+
+// UpdateISCSITargetOpts contains options for updating an iSCSI target.
+// Slice fields use nil to mean "don't change"; an empty, non-nil slice
+// clears the list.
+type UpdateISCSITargetOpts struct {
+	Name         string  // Empty = don't change
+	Alias        *string // Empty string = unset
+	Mode         ISCSITargetMode
+	Groups       []ISCSITargetGroup
+	AuthNetworks []string
+}
+
+// ISCSIExtent is the user-facing representation of an iSCSI extent, the
+// storage behind a LUN.
+type ISCSIExtent struct {
+	ID             int64
+	Name           string
+	Type           ISCSIExtentType
+	Disk           string // "zvol/<zvol ID>" for DISK extents
+	Path           string // Backing file for FILE extents
+	Filesize       int64  // Bytes, for FILE extents
+	Serial         string
+	NAA            string
+	Blocksize      int64
+	PBlocksize     bool  // Hide the physical block size from initiators
+	AvailThreshold int64 // Percent; 0 = unset
+	Comment        string
+	InsecureTPC    bool
+	Xen            bool
+	RPM            string
+	ReadOnly       bool
+	Enabled        bool
+	Vendor         string
+	Locked         bool
+}
+
+// CreateISCSIExtentOpts contains options for creating an iSCSI extent.
+// Pointer fields use the server default when nil. Bool fields are only sent
+// when true, and other fields when non-zero.
+type CreateISCSIExtentOpts struct {
+	Name           string
+	Type           ISCSIExtentType // Empty = DISK
+	Disk           string          // "zvol/<zvol ID>", for DISK extents
+	Path           string          // For FILE extents
+	Filesize       int64           // For FILE extents
+	Serial         string
+	Blocksize      int64 // 512, 1024, 2048 or 4096
+	PBlocksize     bool
+	AvailThreshold int64
+	Comment        string
+	InsecureTPC    *bool
+	Xen            bool
+	RPM            string
+	ReadOnly       bool
+	Enabled        *bool
+}
+
+// UpdateISCSIExtentOpts contains options for updating an iSCSI extent.
+// Pointer fields distinguish "don't change" (nil) from "set to zero/empty".
+// String fields use empty string to mean "don't change".
+type UpdateISCSIExtentOpts struct {
+	Name           string
+	Disk           string
+	Path           string
+	Filesize       *int64
+	Serial         string
+	Blocksize      *int64
+	PBlocksize     *bool
+	AvailThreshold *int64 // 0 = unset
+	Comment        *string
+	InsecureTPC    *bool
+	Xen            *bool
+	RPM            string
+	ReadOnly       *bool
+	Enabled        *bool
+}
+
+// ISCSITargetExtent is the user-facing representation of an extent
+// associated with a target as a LUN.
+type ISCSITargetExtent struct {
+	ID     int64
+	Target int64
+	Extent int64
+	LunID  int64
+}
+
+// CreateISCSITargetExtentOpts contains options for associating an extent
+// with a target.
+type CreateISCSITargetExtentOpts struct {
+	Target int64
+	Extent int64
+	LunID  *int64 // Nil = next free LUN
+}
+
+// UpdateISCSITargetExtentOpts contains options for updating a target/extent
+// association. Nil fields are not changed.
+type UpdateISCSITargetExtentOpts struct {
+	Target *int64
+	Extent *int64
+	LunID  *int64
+}
+
+// ExposeZvolOpts contains options for ISCSIService.ExposeZvol.
+type ExposeZvolOpts struct {
+	// Name is used for both the target and the extent. Empty = derived
+	// from the zvol ID, e.g. "tank-vols-db" for "tank/vols/db".
+	Name  string
+	Alias string
+	// PortalID is the portal to expose the target on. When 0 the target is
+	// created without groups and is not reachable until one is added.
+	PortalID     int64
+	InitiatorID  *int64 // Initiator group; nil = any initiator
+	AuthMethod   ISCSIAuthMethod
+	AuthTag      *int64 // CHAP credential tag; used with AuthMethod
+	AuthNetworks []string
+	LunID        *int64 // Nil = next free LUN
+	Blocksize    int64  // Empty = server default
+	ReadOnly     bool
+	Comment      string // Extent comment
+}
+
+// ISCSIExposure is the result of ISCSIService.ExposeZvol.
+type ISCSIExposure struct {
+	Target       ISCSITarget
+	Extent       ISCSIExtent
+	TargetExtent ISCSITargetExtent
+}
+
+// ISCSIService provides typed methods for the iscsi.* API namespace.
+type ISCSIService struct {
+	client  Caller
+	version Version
+}
+
+// NewISCSIService creates a new ISCSIService.
+func NewISCSIService(c Caller, v Version) *ISCSIService {
+	return &ISCSIService{client: c, version: v}
+}
+
+// GetGlobalConfig returns the global iSCSI configuration.
+func (s *ISCSIService) GetGlobalConfig(ctx context.Context) (*ISCSIGlobalConfig, error) {
+	result, err := callMethod(ctx, s.client, s.version, "iscsi.global.config", nil)
+	if err != nil {
+		return nil, err
+	}
+	return parseISCSIResult(result, "config", iscsiGlobalConfigFromResponse)
+}
+
+// UpdateGlobalConfig updates the global iSCSI configuration and returns it.
+func (s *ISCSIService) UpdateGlobalConfig(ctx context.Context, opts UpdateISCSIGlobalConfigOpts) (*ISCSIGlobalConfig, error) {
+	result, err := callMethod(ctx, s.client, s.version, "iscsi.global.update", iscsiGlobalConfigUpdateParams(opts))
+	if err != nil {
+		return nil, err
+	}
+	return parseISCSIResult(result, "update", iscsiGlobalConfigFromResponse)
+}
+
+// CreatePortal creates an iSCSI portal and returns it.
+func (s *ISCSIService) CreatePortal(ctx context.Context, opts CreateISCSIPortalOpts) (*ISCSIPortal, error) {
+	params := map[string]any{"listen": iscsiPortalListenParams(opts.Listen)}
+	if opts.Comment != "" {
+		params["comment"] = opts.Comment
+	}
+	result, err := callMethod(ctx, s.client, s.version, "iscsi.portal.create", params)
+	if err != nil {
+		return nil, err
+	}
+	return parseISCSIResult(result, "create", iscsiPortalFromResponse)
+}
+
+// GetPortal returns an iSCSI portal by ID, or nil if not found.
+func (s *ISCSIService) GetPortal(ctx context.Context, id int64) (*ISCSIPortal, error) {
+	result, err := callMethod(ctx, s.client, s.version, "iscsi.portal.get_instance", id)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return parseISCSIResult(result, "get_instance", iscsiPortalFromResponse)
+}
+
+// ListPortals returns all iSCSI portals.
+func (s *ISCSIService) ListPortals(ctx context.Context) ([]ISCSIPortal, error) {
+	result, err := callMethod(ctx, s.client, s.version, "iscsi.portal.query", nil)
+	if err != nil {
+		return nil, err
+	}
+	return parseISCSIResults(result, iscsiPortalFromResponse)
+}
+
+// UpdatePortal updates an iSCSI portal and returns it.
+func (s *ISCSIService) UpdatePortal(ctx context.Context, id int64, opts UpdateISCSIPortalOpts) (*ISCSIPortal, error) {
+	params := map[string]any{}
+	if opts.Listen != nil {
+		params["listen"] = iscsiPortalListenParams(opts.Listen)
+	}
+	if opts.Comment != nil {
+		params["comment"] = *opts.Comment
+	}
+	result, err := callMethod(ctx, s.client, s.version, "iscsi.portal.update", []any{id, params})
+	if err != nil {
+		return nil, err
+	}
+	return parseISCSIResult(result, "update", iscsiPortalFromResponse)
+}
+
+// DeletePortal deletes an iSCSI portal by ID.
+func (s *ISCSIService) DeletePortal(ctx context.Context, id int64) error {
+	_, err := callMethod(ctx, s.client, s.version, "iscsi.portal.delete", id)
+	return err
+}
+
+// CreateInitiator creates an iSCSI initiator group and returns it.
+func (s *ISCSIService) CreateInitiator(ctx context.Context, opts CreateISCSIInitiatorOpts) (*ISCSIInitiator, error) {
+	params := map[string]any{"initiators": nonNilStrings(opts.Initiators)}
+	if opts.Comment != "" {
+		params["comment"] = opts.Comment
+	}
+	result, err := callMethod(ctx, s.client, s.version, "iscsi.initiator.create", params)
+	if err != nil {
+		return nil, err
+	}
+	return parseISCSIResult(result, "create", iscsiInitiatorFromResponse)
+}
+
+// GetInitiator returns an iSCSI initiator group by ID, or nil if not found.
+func (s *ISCSIService) GetInitiator(ctx context.Context, id int64) (*ISCSIInitiator, error) {
+	result, err := callMethod(ctx, s.client, s.version, "iscsi.initiator.get_instance", id)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return parseISCSIResult(result, "get_instance", iscsiInitiatorFromResponse)
+}
+
+// ListInitiators returns all iSCSI initiator groups.
+func (s *ISCSIService) ListInitiators(ctx context.Context) ([]ISCSIInitiator, error) {
+	result, err := callMethod(ctx, s.client, s.version, "iscsi.initiator.query", nil)
+	if err != nil {
+		return nil, err
+	}
+	return parseISCSIResults(result, iscsiInitiatorFromResponse)
+}
+
+// UpdateInitiator updates an iSCSI initiator group and returns it.
+func (s *ISCSIService) UpdateInitiator(ctx context.Context, id int64, opts UpdateISCSIInitiatorOpts) (*ISCSIInitiator, error) {
+	params := map[string]any{}
+	if opts.Initiators != nil {
+		params["initiators"] = opts.Initiators
+	}
+	if opts.Comment != nil {
+		params["comment"] = *opts.Comment
+	}
+	result, err := callMethod(ctx, s.client, s.version, "iscsi.initiator.update", []any{id, params})
+	if err != nil {
+		return nil, err
+	}
+	return parseISCSIResult(result, "update", iscsiInitiatorFromResponse)
+}
+
+// DeleteInitiator deletes an iSCSI initiator group by ID.
+func (s *ISCSIService) DeleteInitiator(ctx context.Context, id int64) error {
+	_, err := callMethod(ctx, s.client, s.version, "iscsi.initiator.delete", id)
+	return err
+}
+
+// CreateAuth creates an iSCSI CHAP credential and returns it.
+func (s *ISCSIService) CreateAuth(ctx context.Context, opts CreateISCSIAuthOpts) (*ISCSIAuth, error) {
+	params := map[string]any{
+		"tag":    opts.Tag,
+		"user":   opts.User,
+		"secret": opts.Secret,
+	}
+	if opts.PeerUser != "" {
+		params["peeruser"] = opts.PeerUser
+	}
+	if opts.PeerSecret != "" {
+		params["peersecret"] = opts.PeerSecret
+	}
+	if opts.DiscoveryAuth != "" {
+		params["discovery_auth"] = string(opts.DiscoveryAuth)
+	}
+	result, err := callMethod(ctx, s.client, s.version, "iscsi.auth.create", params)
+	if err != nil {
+		return nil, err
+	}
+	return parseISCSIResult(result, "create", iscsiAuthFromResponse)
+}
+
+// GetAuth returns an iSCSI CHAP credential by ID, or nil if not found.
+func (s *ISCSIService) GetAuth(ctx context.Context, id int64) (*ISCSIAuth, error) {
+	result, err := callMethod(ctx, s.client, s.version, "iscsi.auth.get_instance", id)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return parseISCSIResult(result, "get_instance", iscsiAuthFromResponse)
+}
+
+// ListAuths returns all iSCSI CHAP credentials.
+func (s *ISCSIService) ListAuths(ctx context.Context) ([]ISCSIAuth, error) {
+	result, err := callMethod(ctx, s.client, s.version, "iscsi.auth.query", nil)
+	if err != nil {
+		return nil, err
+	}
+	return parseISCSIResults(result, iscsiAuthFromResponse)
+}
+
+// UpdateAuth updates an iSCSI CHAP credential and returns it.
+func (s *ISCSIService) UpdateAuth(ctx context.Context, id int64, opts UpdateISCSIAuthOpts) (*ISCSIAuth, error) {
+	params := map[string]any{}
+	if opts.Tag != nil {
+		params["tag"] = *opts.Tag
+	}
+	if opts.User != "" {
+		params["user"] = opts.User
+	}
+	if opts.Secret != "" {
+		params["secret"] = opts.Secret
+	}
+	if opts.PeerUser != nil {
+		params["peeruser"] = *opts.PeerUser
+	}
+	if opts.PeerSecret != nil {
+		params["peersecret"] = *opts.PeerSecret
+	}
+	if opts.DiscoveryAuth != "" {
+		params["discovery_auth"] = string(opts.DiscoveryAuth)
+	}
+	result, err := callMethod(ctx, s.client, s.version, "iscsi.auth.update", []any{id, params})
+	if err != nil {
+		return nil, err
+	}
+	return parseISCSIResult(result, "update", iscsiAuthFromResponse)
+}
+
+// DeleteAuth deletes an iSCSI CHAP credential by ID.
+func (s *ISCSIService) DeleteAuth(ctx context.Context, id int64) error {
+	_, err := callMethod(ctx, s.client, s.version, "iscsi.auth.delete", id)
+	return err
+}
+
+// CreateTarget creates an iSCSI target and returns it.
+func (s *ISCSIService) CreateTarget(ctx context.Context, opts CreateISCSITargetOpts) (*ISCSITarget, error) {
+	params := iscsiTargetCreateParams(opts)
+	result, err := callMethod(ctx, s.client, s.version, "iscsi.target.create", params)
+	if err != nil {
+		return nil, err
+	}
+	return parseISCSIResult(result, "create", iscsiTargetFromResponse)
+}
+
+// GetTarget returns an iSCSI target by ID, or nil if not found.
+func (s *ISCSIService) GetTarget(ctx context.Context, id int64) (*ISCSITarget, error) {
+	result, err := callMethod(ctx, s.client, s.version, "iscsi.target.get_instance", id)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return parseISCSIResult(result, "get_instance", iscsiTargetFromResponse)
+}
+
+// ListTargets returns all iSCSI targets.
+func (s *ISCSIService) ListTargets(ctx context.Context) ([]ISCSITarget, error) {
+	result, err := callMethod(ctx, s.client, s.version, "iscsi.target.query", nil)
+	if err != nil {
+		return nil, err
+	}
+	return parseISCSIResults(result, iscsiTargetFromResponse)
+}
+
+// UpdateTarget updates an iSCSI target and returns it.
+func (s *ISCSIService) UpdateTarget(ctx context.Context, id int64, opts UpdateISCSITargetOpts) (*ISCSITarget, error) {
+	params := iscsiTargetUpdateParams(opts)
+	result, err := callMethod(ctx, s.client, s.version, "iscsi.target.update", []any{id, params})
+	if err != nil {
+		return nil, err
+	}
+	return parseISCSIResult(result, "update", iscsiTargetFromResponse)
+}
+
+// DeleteTarget deletes an iSCSI target by ID. Force deletes it even while
+// initiators are connected, and deleteExtents also deletes the extents
+// associated with it.
+func (s *ISCSIService) DeleteTarget(ctx context.Context, id int64, force, deleteExtents bool) error {
+	_, err := callMethod(ctx, s.client, s.version, "iscsi.target.delete", []any{id, force, deleteExtents})
+	return err
+}
+
+// CreateExtent creates an iSCSI extent and returns it.
+func (s *ISCSIService) CreateExtent(ctx context.Context, opts CreateISCSIExtentOpts) (*ISCSIExtent, error) {
+	params := iscsiExtentCreateParams(opts)
+	result, err := callMethod(ctx, s.client, s.version, "iscsi.extent.create", params)
+	if err != nil {
+		return nil, err
+	}
+	return parseISCSIResult(result, "create", iscsiExtentFromResponse)
+}
+
+// GetExtent returns an iSCSI extent by ID, or nil if not found.
+func (s *ISCSIService) GetExtent(ctx context.Context, id int64) (*ISCSIExtent, error) {
+	result, err := callMethod(ctx, s.client, s.version, "iscsi.extent.get_instance", id)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return parseISCSIResult(result, "get_instance", iscsiExtentFromResponse)
+}
+
+// ListExtents returns all iSCSI extents.
+func (s *ISCSIService) ListExtents(ctx context.Context) ([]ISCSIExtent, error) {
+	result, err := callMethod(ctx, s.client, s.version, "iscsi.extent.query", nil)
+	if err != nil {
+		return nil, err
+	}
+	return parseISCSIResults(result, iscsiExtentFromResponse)
+}
+
+// UpdateExtent updates an iSCSI extent and returns it.
+func (s *ISCSIService) UpdateExtent(ctx context.Context, id int64, opts UpdateISCSIExtentOpts) (*ISCSIExtent, error) {
+	params := iscsiExtentUpdateParams(opts)
+	result, err := callMethod(ctx, s.client, s.version, "iscsi.extent.update", []any{id, params})
+	if err != nil {
+		return nil, err
+	}
+	return parseISCSIResult(result, "update", iscsiExtentFromResponse)
+}
+
+// DeleteExtent deletes an iSCSI extent by ID. Remove also deletes the
+// backing file of a FILE extent, and force deletes the extent even while
+// it is in use.
+func (s *ISCSIService) DeleteExtent(ctx context.Context, id int64, remove, force bool) error {
+	_, err := callMethod(ctx, s.client, s.version, "iscsi.extent.delete", []any{id, remove, force})
+	return err
+}
+
+// CreateTargetExtent associates an extent with a target and returns the
+// association.
+func (s *ISCSIService) CreateTargetExtent(ctx context.Context, opts CreateISCSITargetExtentOpts) (*ISCSITargetExtent, error) {
+	params := map[string]any{
+		"target": opts.Target,
+		"extent": opts.Extent,
+	}
+	if opts.LunID != nil {
+		params["lunid"] = *opts.LunID
+	}
+	result, err := callMethod(ctx, s.client, s.version, "iscsi.targetextent.create", params)
+	if err != nil {
+		return nil, err
+	}
+	return parseISCSIResult(result, "create", iscsiTargetExtentFromResponse)
+}
+
+// GetTargetExtent returns a target/extent association by ID, or nil if not
+// found.
+func (s *ISCSIService) GetTargetExtent(ctx context.Context, id int64) (*ISCSITargetExtent, error) {
+	result, err := callMethod(ctx, s.client, s.version, "iscsi.targetextent.get_instance", id)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return parseISCSIResult(result, "get_instance", iscsiTargetExtentFromResponse)
+}
+
+// ListTargetExtents returns all target/extent associations.
+func (s *ISCSIService) ListTargetExtents(ctx context.Context) ([]ISCSITargetExtent, error) {
+	result, err := callMethod(ctx, s.client, s.version, "iscsi.targetextent.query", nil)
+	if err != nil {
+		return nil, err
+	}
+	return parseISCSIResults(result, iscsiTargetExtentFromResponse)
+}
+
+// UpdateTargetExtent updates a target/extent association and returns it.
+func (s *ISCSIService) UpdateTargetExtent(ctx context.Context, id int64, opts UpdateISCSITargetExtentOpts) (*ISCSITargetExtent, error) {
+	params := map[string]any{}
+	if opts.Target != nil {
+		params["target"] = *opts.Target
+	}
+	if opts.Extent != nil {
+		params["extent"] = *opts.Extent
+	}
+	if opts.LunID != nil {
+		params["lunid"] = *opts.LunID
+	}
+	result, err := callMethod(ctx, s.client, s.version, "iscsi.targetextent.update", []any{id, params})
+	if err != nil {
+		return nil, err
+	}
+	return parseISCSIResult(result, "update", iscsiTargetExtentFromResponse)
+}
+
+// DeleteTargetExtent deletes a target/extent association by ID. Force
+// deletes it even while the LUN is in use.
+func (s *ISCSIService) DeleteTargetExtent(ctx context.Context, id int64, force bool) error {
+	_, err := callMethod(ctx, s.client, s.version, "iscsi.targetextent.delete", []any{id, force})
+	return err
+}
+
+// ExposeZvol exports a zvol over iSCSI by creating a DISK extent for it, a
+// target, and the association between them. If a step fails, whatever was
+// already created is deleted again; the zvol itself is never touched.
+func (s *ISCSIService) ExposeZvol(ctx context.Context, zvolID string, opts ExposeZvolOpts) (*ISCSIExposure, error) {
+	name := opts.Name
+	if name == "" {
+		name = iscsiNameFromZvolID(zvolID)
+	}
+
+	var undo []func(context.Context) error
+	fail := func(step string, err error) (*ISCSIExposure, error) {
+		err = fmt.Errorf("expose zvol %s: %s: %w", zvolID, step, err)
+		cleanupCtx := context.WithoutCancel(ctx)
+		var errs []error
+		for i := len(undo) - 1; i >= 0; i-- {
+			if uerr := undo[i](cleanupCtx); uerr != nil {
+				errs = append(errs, uerr)
+			}
+		}
+		if len(errs) > 0 {
+			return nil, fmt.Errorf("%w; cleanup incomplete: %w", err, errors.Join(errs...))
+		}
+		return nil, err
+	}
+
+	extent, err := s.CreateExtent(ctx, CreateISCSIExtentOpts{
+		Name:      name,
+		Type:      ISCSIExtentTypeDisk,
+		Disk:      "zvol/" + zvolID,
+		Blocksize: opts.Blocksize,
+		Comment:   opts.Comment,
+		ReadOnly:  opts.ReadOnly,
+	})
+	if err != nil {
+		return fail("create extent", err)
+	}
+	undo = append(undo, func(ctx context.Context) error {
+		if err := s.DeleteExtent(ctx, extent.ID, false, true); err != nil {
+			return fmt.Errorf("delete extent %d: %w", extent.ID, err)
+		}
+		return nil
+	})
+
+	targetOpts := CreateISCSITargetOpts{
+		Name:         name,
+		Alias:        opts.Alias,
+		AuthNetworks: opts.AuthNetworks,
+	}
+	if opts.PortalID != 0 {
+		targetOpts.Groups = []ISCSITargetGroup{{
+			Portal:     opts.PortalID,
+			Initiator:  opts.InitiatorID,
+			AuthMethod: opts.AuthMethod,
+			Auth:       opts.AuthTag,
+		}}
+	}
+	target, err := s.CreateTarget(ctx, targetOpts)
+	if err != nil {
+		return fail("create target", err)
+	}
+	undo = append(undo, func(ctx context.Context) error {
+		if err := s.DeleteTarget(ctx, target.ID, true, false); err != nil {
+			return fmt.Errorf("delete target %d: %w", target.ID, err)
+		}
+		return nil
+	})
+
+	assoc, err := s.CreateTargetExtent(ctx, CreateISCSITargetExtentOpts{
+		Target: target.ID,
+		Extent: extent.ID,
+		LunID:  opts.LunID,
+	})
+	if err != nil {
+		return fail("create target extent", err)
+	}
+
+	return &ISCSIExposure{Target: *target, Extent: *extent, TargetExtent: *assoc}, nil
+}
+
+// iscsiNameFromZvolID derives a target name from a zvol ID. Target names
+// may only contain lowercase letters, digits, '.', '-' and ':'.
+func iscsiNameFromZvolID(zvolID string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '.', r == '-', r == ':':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r + ('a' - 'A')
+		default:
+			return '-'
+		}
+	}, zvolID)
+}
+
+// parseISCSIResult parses a single iSCSI object response and converts it.
+func parseISCSIResult[R, T any](result json.RawMessage, method string, convert func(R) T) (*T, error) {
+	var resp R
+	if err := json.Unmarshal(result, &resp); err != nil {
+		return nil, fmt.Errorf("parse %s response: %w", method, err)
+	}
+
+	v := convert(resp)
+	return &v, nil
+}
+
+// parseISCSIResults parses an iSCSI query response and converts each entry.
+func parseISCSIResults[R, T any](result json.RawMessage, convert func(R) T) ([]T, error) {
+	var responses []R
+	if err := json.Unmarshal(result, &responses); err != nil {
+		return nil, fmt.Errorf("parse query response: %w", err)
+	}
+
+	out := make([]T, len(responses))
+	for i, resp := range responses {
+		out[i] = convert(resp)
+	}
+	return out, nil
+}
+
+// iscsiGlobalConfigFromResponse converts a wire-format
+// ISCSIGlobalConfigResponse to a user-facing ISCSIGlobalConfig.
+func iscsiGlobalConfigFromResponse(resp ISCSIGlobalConfigResponse) ISCSIGlobalConfig {
+	config := ISCSIGlobalConfig{
+		Basename:    resp.Basename,
+		ISNSServers: resp.ISNSServers,
+		ListenPort:  resp.ListenPort,
+		ALUA:        resp.ALUA,
+		ISER:        resp.ISER,
+	}
+	if resp.PoolAvailThreshold != nil {
+		config.PoolAvailThreshold = *resp.PoolAvailThreshold
+	}
+	return config
+}
+
+// iscsiPortalFromResponse converts a wire-format ISCSIPortalResponse to a
+// user-facing ISCSIPortal.
+func iscsiPortalFromResponse(resp ISCSIPortalResponse) ISCSIPortal {
+	portal := ISCSIPortal{
+		ID:      resp.ID,
+		Tag:     resp.Tag,
+		Comment: resp.Comment,
+		Listen:  make([]ISCSIPortalListen, len(resp.Listen)),
+	}
+	for i, l := range resp.Listen {
+		portal.Listen[i] = ISCSIPortalListen{IP: l.IP, Port: l.Port}
+	}
+	return portal
+}
+
+// iscsiInitiatorFromResponse converts a wire-format ISCSIInitiatorResponse
+// to a user-facing ISCSIInitiator.
+func iscsiInitiatorFromResponse(resp ISCSIInitiatorResponse) ISCSIInitiator {
+	return ISCSIInitiator{
+		ID:         resp.ID,
+		Initiators: resp.Initiators,
+		Comment:    resp.Comment,
+	}
+}
+
+// iscsiAuthFromResponse converts a wire-format ISCSIAuthResponse to a
+// user-facing ISCSIAuth.
+func iscsiAuthFromResponse(resp ISCSIAuthResponse) ISCSIAuth {
+	return ISCSIAuth{
+		ID:            resp.ID,
+		Tag:           resp.Tag,
+		User:          resp.User,
+		Secret:        resp.Secret,
+		PeerUser:      resp.PeerUser,
+		PeerSecret:    resp.PeerSecret,
+		DiscoveryAuth: ISCSIAuthMethod(resp.DiscoveryAuth),
+	}
+}
+
+// iscsiTargetFromResponse converts a wire-format ISCSITargetResponse to a
+// user-facing ISCSITarget.
+func iscsiTargetFromResponse(resp ISCSITargetResponse) ISCSITarget {
+	target := ISCSITarget{
+		ID:           resp.ID,
+		Name:         resp.Name,
+		Mode:         ISCSITargetMode(resp.Mode),
+		AuthNetworks: resp.AuthNetworks,
+		RelTgtID:     resp.RelTgtID,
+		Groups:       make([]ISCSITargetGroup, len(resp.Groups)),
+	}
+	if resp.Alias != nil {
+		target.Alias = *resp.Alias
+	}
+	for i, g := range resp.Groups {
+		target.Groups[i] = ISCSITargetGroup{
+			Portal:     g.Portal,
+			Initiator:  g.Initiator,
+			AuthMethod: ISCSIAuthMethod(g.AuthMethod),
+			Auth:       g.Auth,
+		}
+	}
+	return target
+}
+
+// iscsiExtentFromResponse converts a wire-format ISCSIExtentResponse to a
+// user-facing ISCSIExtent.
+func iscsiExtentFromResponse(resp ISCSIExtentResponse) ISCSIExtent {
+	extent := ISCSIExtent{
+		ID:          resp.ID,
+		Name:        resp.Name,
+		Type:        ISCSIExtentType(resp.Type),
+		NAA:         resp.NAA,
+		Blocksize:   resp.Blocksize,
+		PBlocksize:  resp.PBlocksize,
+		Comment:     resp.Comment,
+		InsecureTPC: resp.InsecureTPC,
+		Xen:         resp.Xen,
+		RPM:         resp.RPM,
+		ReadOnly:    resp.RO,
+		Enabled:     resp.Enabled,
+		Vendor:      resp.Vendor,
+	}
+	if resp.Disk != nil {
+		extent.Disk = *resp.Disk
+	}
+	if resp.Path != nil {
+		extent.Path = *resp.Path
+	}
+	if resp.Serial != nil {
+		extent.Serial = *resp.Serial
+	}
+	if size, err := resp.Filesize.Int64(); err == nil {
+		extent.Filesize = size
+	}
+	if resp.AvailThreshold != nil {
+		extent.AvailThreshold = *resp.AvailThreshold
+	}
+	if resp.Locked != nil {
+		extent.Locked = *resp.Locked
+	}
+	return extent
+}
+
+// iscsiTargetExtentFromResponse converts a wire-format
+// ISCSITargetExtentResponse to a user-facing ISCSITargetExtent.
+func iscsiTargetExtentFromResponse(resp ISCSITargetExtentResponse) ISCSITargetExtent {
+	return ISCSITargetExtent{
+		ID:     resp.ID,
+		Target: resp.Target,
+		Extent: resp.Extent,
+		LunID:  resp.LunID,
+	}
+}
+
+// iscsiGlobalConfigUpdateParams builds API parameters for
+// iscsi.global.update.
+func iscsiGlobalConfigUpdateParams(opts UpdateISCSIGlobalConfigOpts) map[string]any {
+	params := map[string]any{}
+	if opts.Basename != "" {
+		params["basename"] = opts.Basename
+	}
+	if opts.ISNSServers != nil {
+		params["isns_servers"] = opts.ISNSServers
+	}
+	if opts.ListenPort != nil {
+		params["listen_port"] = *opts.ListenPort
+	}
+	setNullableInt64(params, "pool_avail_threshold", opts.PoolAvailThreshold)
+	if opts.ALUA != nil {
+		params["alua"] = *opts.ALUA
+	}
+	if opts.ISER != nil {
+		params["iser"] = *opts.ISER
+	}
+	return params
+}
+
+// iscsiPortalListenParams builds the listen list of a portal.
+func iscsiPortalListenParams(ips []string) []map[string]any {
+	listen := make([]map[string]any, len(ips))
+	for i, ip := range ips {
+		listen[i] = map[string]any{"ip": ip}
+	}
+	return listen
+}
+
+// iscsiTargetCreateParams builds API parameters for iscsi.target.create.
+func iscsiTargetCreateParams(opts CreateISCSITargetOpts) map[string]any {
+	params := map[string]any{
+		"name":   opts.Name,
+		"groups": iscsiTargetGroupParams(opts.Groups),
+	}
+	if opts.Alias != "" {
+		params["alias"] = opts.Alias
+	}
+	if opts.Mode != "" {
+		params["mode"] = string(opts.Mode)
+	}
+	if len(opts.AuthNetworks) > 0 {
+		params["auth_networks"] = opts.AuthNetworks
+	}
+	return params
+}
+
+// iscsiTargetUpdateParams builds API parameters for iscsi.target.update.
+func iscsiTargetUpdateParams(opts UpdateISCSITargetOpts) map[string]any {
+	params := map[string]any{}
+	if opts.Name != "" {
+		params["name"] = opts.Name
+	}
+	setNullableString(params, "alias", opts.Alias)
+	if opts.Mode != "" {
+		params["mode"] = string(opts.Mode)
+	}
+	if opts.Groups != nil {
+		params["groups"] = iscsiTargetGroupParams(opts.Groups)
+	}
+	if opts.AuthNetworks != nil {
+		params["auth_networks"] = opts.AuthNetworks
+	}
+	return params
+}
+
+// iscsiTargetGroupParams builds the groups list of a target.
+func iscsiTargetGroupParams(groups []ISCSITargetGroup) []map[string]any {
+	out := make([]map[string]any, len(groups))
+	for i, g := range groups {
+		group := map[string]any{"portal": g.Portal}
+		if g.Initiator != nil {
+			group["initiator"] = *g.Initiator
+		}
+		if g.AuthMethod != "" {
+			group["authmethod"] = string(g.AuthMethod)
+		}
+		if g.Auth != nil {
+			group["auth"] = *g.Auth
+		}
+		out[i] = group
+	}
+	return out
+}
+
+// iscsiExtentCreateParams builds API parameters for iscsi.extent.create.
+func iscsiExtentCreateParams(opts CreateISCSIExtentOpts) map[string]any {
+	params := map[string]any{
+		"name": opts.Name,
+	}
+	if opts.Type != "" {
+		params["type"] = string(opts.Type)
+	}
+	if opts.Disk != "" {
+		params["disk"] = opts.Disk
+	}
+	if opts.Path != "" {
+		params["path"] = opts.Path
+	}
+	if opts.Filesize != 0 {
+		params["filesize"] = opts.Filesize
+	}
+	if opts.Serial != "" {
+		params["serial"] = opts.Serial
+	}
+	if opts.Blocksize != 0 {
+		params["blocksize"] = opts.Blocksize
+	}
+	if opts.PBlocksize {
+		params["pblocksize"] = true
+	}
+	if opts.AvailThreshold != 0 {
+		params["avail_threshold"] = opts.AvailThreshold
+	}
+	if opts.Comment != "" {
+		params["comment"] = opts.Comment
+	}
+	if opts.InsecureTPC != nil {
+		params["insecure_tpc"] = *opts.InsecureTPC
+	}
+	if opts.Xen {
+		params["xen"] = true
+	}
+	if opts.RPM != "" {
+		params["rpm"] = opts.RPM
+	}
+	if opts.ReadOnly {
+		params["ro"] = true
+	}
+	if opts.Enabled != nil {
+		params["enabled"] = *opts.Enabled
+	}
+	return params
+}
+
+// iscsiExtentUpdateParams builds API parameters for iscsi.extent.update.
+func iscsiExtentUpdateParams(opts UpdateISCSIExtentOpts) map[string]any {
+	params := map[string]any{}
+	if opts.Name != "" {
+		params["name"] = opts.Name
+	}
+	if opts.Disk != "" {
+		params["disk"] = opts.Disk
+	}
+	if opts.Path != "" {
+		params["path"] = opts.Path
+	}
+	if opts.Filesize != nil {
+		params["filesize"] = *opts.Filesize
+	}
+	if opts.Serial != "" {
+		params["serial"] = opts.Serial
+	}
+	if opts.Blocksize != nil {
+		params["blocksize"] = *opts.Blocksize
+	}
+	if opts.PBlocksize != nil {
+		params["pblocksize"] = *opts.PBlocksize
+	}
+	setNullableInt64(params, "avail_threshold", opts.AvailThreshold)
+	if opts.Comment != nil {
+		params["comment"] = *opts.Comment
+	}
+	if opts.InsecureTPC != nil {
+		params["insecure_tpc"] = *opts.InsecureTPC
+	}
+	if opts.Xen != nil {
+		params["xen"] = *opts.Xen
+	}
+	if opts.RPM != "" {
+		params["rpm"] = opts.RPM
+	}
+	if opts.ReadOnly != nil {
+		params["ro"] = *opts.ReadOnly
+	}
+	if opts.Enabled != nil {
+		params["enabled"] = *opts.Enabled
+	}
+	return params
+}
@@ -0,0 +1,312 @@
+package truenas
+
+import "context"
+
+// ISCSIServiceAPI defines the interface for iSCSI block storage operations.
+type ISCSIServiceAPI interface {
+	GetGlobalConfig(ctx context.Context) (*ISCSIGlobalConfig, error)
+	UpdateGlobalConfig(ctx context.Context, opts UpdateISCSIGlobalConfigOpts) (*ISCSIGlobalConfig, error)
+	CreatePortal(ctx context.Context, opts CreateISCSIPortalOpts) (*ISCSIPortal, error)
+	GetPortal(ctx context.Context, id int64) (*ISCSIPortal, error)
+	ListPortals(ctx context.Context) ([]ISCSIPortal, error)
+	UpdatePortal(ctx context.Context, id int64, opts UpdateISCSIPortalOpts) (*ISCSIPortal, error)
+	DeletePortal(ctx context.Context, id int64) error
+	CreateInitiator(ctx context.Context, opts CreateISCSIInitiatorOpts) (*ISCSIInitiator, error)
+	GetInitiator(ctx context.Context, id int64) (*ISCSIInitiator, error)
+	ListInitiators(ctx context.Context) ([]ISCSIInitiator, error)
+	UpdateInitiator(ctx context.Context, id int64, opts UpdateISCSIInitiatorOpts) (*ISCSIInitiator, error)
+	DeleteInitiator(ctx context.Context, id int64) error
+	CreateAuth(ctx context.Context, opts CreateISCSIAuthOpts) (*ISCSIAuth, error)
+	GetAuth(ctx context.Context, id int64) (*ISCSIAuth, error)
+	ListAuths(ctx context.Context) ([]ISCSIAuth, error)
+	UpdateAuth(ctx context.Context, id int64, opts UpdateISCSIAuthOpts) (*ISCSIAuth, error)
+	DeleteAuth(ctx context.Context, id int64) error
+	CreateTarget(ctx context.Context, opts CreateISCSITargetOpts) (*ISCSITarget, error)
+	GetTarget(ctx context.Context, id int64) (*ISCSITarget, error)
+	ListTargets(ctx context.Context) ([]ISCSITarget, error)
+	UpdateTarget(ctx context.Context, id int64, opts UpdateISCSITargetOpts) (*ISCSITarget, error)
+	DeleteTarget(ctx context.Context, id int64, force, deleteExtents bool) error
+	CreateExtent(ctx context.Context, opts CreateISCSIExtentOpts) (*ISCSIExtent, error)
+	GetExtent(ctx context.Context, id int64) (*ISCSIExtent, error)
+	ListExtents(ctx context.Context) ([]ISCSIExtent, error)
+	UpdateExtent(ctx context.Context, id int64, opts UpdateISCSIExtentOpts) (*ISCSIExtent, error)
+	DeleteExtent(ctx context.Context, id int64, remove, force bool) error
+	CreateTargetExtent(ctx context.Context, opts CreateISCSITargetExtentOpts) (*ISCSITargetExtent, error)
+	GetTargetExtent(ctx context.Context, id int64) (*ISCSITargetExtent, error)
+	ListTargetExtents(ctx context.Context) ([]ISCSITargetExtent, error)
+	UpdateTargetExtent(ctx context.Context, id int64, opts UpdateISCSITargetExtentOpts) (*ISCSITargetExtent, error)
+	DeleteTargetExtent(ctx context.Context, id int64, force bool) error
+	ExposeZvol(ctx context.Context, zvolID string, opts ExposeZvolOpts) (*ISCSIExposure, error)
+}
+
+// Compile-time checks.
+var _ ISCSIServiceAPI = (*ISCSIService)(nil)
+var _ ISCSIServiceAPI = (*MockISCSIService)(nil)
+
+// MockISCSIService is a test double for ISCSIServiceAPI.
+type MockISCSIService struct {
+	GetGlobalConfigFunc    func(ctx context.Context) (*ISCSIGlobalConfig, error)
+	UpdateGlobalConfigFunc func(ctx context.Context, opts UpdateISCSIGlobalConfigOpts) (*ISCSIGlobalConfig, error)
+	CreatePortalFunc       func(ctx context.Context, opts CreateISCSIPortalOpts) (*ISCSIPortal, error)
+	GetPortalFunc          func(ctx context.Context, id int64) (*ISCSIPortal, error)
+	ListPortalsFunc        func(ctx context.Context) ([]ISCSIPortal, error)
+	UpdatePortalFunc       func(ctx context.Context, id int64, opts UpdateISCSIPortalOpts) (*ISCSIPortal, error)
+	DeletePortalFunc       func(ctx context.Context, id int64) error
+	CreateInitiatorFunc    func(ctx context.Context, opts CreateISCSIInitiatorOpts) (*ISCSIInitiator, error)
+	GetInitiatorFunc       func(ctx context.Context, id int64) (*ISCSIInitiator, error)
+	ListInitiatorsFunc     func(ctx context.Context) ([]ISCSIInitiator, error)
+	UpdateInitiatorFunc    func(ctx context.Context, id int64, opts UpdateISCSIInitiatorOpts) (*ISCSIInitiator, error)
+	DeleteInitiatorFunc    func(ctx context.Context, id int64) error
+	CreateAuthFunc         func(ctx context.Context, opts CreateISCSIAuthOpts) (*ISCSIAuth, error)
+	GetAuthFunc            func(ctx context.Context, id int64) (*ISCSIAuth, error)
+	ListAuthsFunc          func(ctx context.Context) ([]ISCSIAuth, error)
+	UpdateAuthFunc         func(ctx context.Context, id int64, opts UpdateISCSIAuthOpts) (*ISCSIAuth, error)
+	DeleteAuthFunc         func(ctx context.Context, id int64) error
+	CreateTargetFunc       func(ctx context.Context, opts CreateISCSITargetOpts) (*ISCSITarget, error)
+	GetTargetFunc          func(ctx context.Context, id int64) (*ISCSITarget, error)
+	ListTargetsFunc        func(ctx context.Context) ([]ISCSITarget, error)
+	UpdateTargetFunc       func(ctx context.Context, id int64, opts UpdateISCSITargetOpts) (*ISCSITarget, error)
+	DeleteTargetFunc       func(ctx context.Context, id int64, force, deleteExtents bool) error
+	CreateExtentFunc       func(ctx context.Context, opts CreateISCSIExtentOpts) (*ISCSIExtent, error)
+	GetExtentFunc          func(ctx context.Context, id int64) (*ISCSIExtent, error)
+	ListExtentsFunc        func(ctx context.Context) ([]ISCSIExtent, error)
+	UpdateExtentFunc       func(ctx context.Context, id int64, opts UpdateISCSIExtentOpts) (*ISCSIExtent, error)
+	DeleteExtentFunc       func(ctx context.Context, id int64, remove, force bool) error
+	CreateTargetExtentFunc func(ctx context.Context, opts CreateISCSITargetExtentOpts) (*ISCSITargetExtent, error)
+	GetTargetExtentFunc    func(ctx context.Context, id int64) (*ISCSITargetExtent, error)
+	ListTargetExtentsFunc  func(ctx context.Context) ([]ISCSITargetExtent, error)
+	UpdateTargetExtentFunc func(ctx context.Context, id int64, opts UpdateISCSITargetExtentOpts) (*ISCSITargetExtent, error)
+	DeleteTargetExtentFunc func(ctx context.Context, id int64, force bool) error
+	ExposeZvolFunc         func(ctx context.Context, zvolID string, opts ExposeZvolOpts) (*ISCSIExposure, error)
+}
+
+func (m *MockISCSIService) GetGlobalConfig(ctx context.Context) (*ISCSIGlobalConfig, error) {
+	if m.GetGlobalConfigFunc != nil {
+		return m.GetGlobalConfigFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockISCSIService) UpdateGlobalConfig(ctx context.Context, opts UpdateISCSIGlobalConfigOpts) (*ISCSIGlobalConfig, error) {
+	if m.UpdateGlobalConfigFunc != nil {
+		return m.UpdateGlobalConfigFunc(ctx, opts)
+	}
+	return nil, nil
+}
+
+func (m *MockISCSIService) CreatePortal(ctx context.Context, opts CreateISCSIPortalOpts) (*ISCSIPortal, error) {
+	if m.CreatePortalFunc != nil {
+		return m.CreatePortalFunc(ctx, opts)
+	}
+	return nil, nil
+}
+
+func (m *MockISCSIService) GetPortal(ctx context.Context, id int64) (*ISCSIPortal, error) {
+	if m.GetPortalFunc != nil {
+		return m.GetPortalFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockISCSIService) ListPortals(ctx context.Context) ([]ISCSIPortal, error) {
+	if m.ListPortalsFunc != nil {
+		return m.ListPortalsFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockISCSIService) UpdatePortal(ctx context.Context, id int64, opts UpdateISCSIPortalOpts) (*ISCSIPortal, error) {
+	if m.UpdatePortalFunc != nil {
+		return m.UpdatePortalFunc(ctx, id, opts)
+	}
+	return nil, nil
+}
+
+func (m *MockISCSIService) DeletePortal(ctx context.Context, id int64) error {
+	if m.DeletePortalFunc != nil {
+		return m.DeletePortalFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockISCSIService) CreateInitiator(ctx context.Context, opts CreateISCSIInitiatorOpts) (*ISCSIInitiator, error) {
+	if m.CreateInitiatorFunc != nil {
+		return m.CreateInitiatorFunc(ctx, opts)
+	}
+	return nil, nil
+}
+
+func (m *MockISCSIService) GetInitiator(ctx context.Context, id int64) (*ISCSIInitiator, error) {
+	if m.GetInitiatorFunc != nil {
+		return m.GetInitiatorFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockISCSIService) ListInitiators(ctx context.Context) ([]ISCSIInitiator, error) {
+	if m.ListInitiatorsFunc != nil {
+		return m.ListInitiatorsFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockISCSIService) UpdateInitiator(ctx context.Context, id int64, opts UpdateISCSIInitiatorOpts) (*ISCSIInitiator, error) {
+	if m.UpdateInitiatorFunc != nil {
+		return m.UpdateInitiatorFunc(ctx, id, opts)
+	}
+	return nil, nil
+}
+
+func (m *MockISCSIService) DeleteInitiator(ctx context.Context, id int64) error {
+	if m.DeleteInitiatorFunc != nil {
+		return m.DeleteInitiatorFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockISCSIService) CreateAuth(ctx context.Context, opts CreateISCSIAuthOpts) (*ISCSIAuth, error) {
+	if m.CreateAuthFunc != nil {
+		return m.CreateAuthFunc(ctx, opts)
+	}
+	return nil, nil
+}
+
+func (m *MockISCSIService) GetAuth(ctx context.Context, id int64) (*ISCSIAuth, error) {
+	if m.GetAuthFunc != nil {
+		return m.GetAuthFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockISCSIService) ListAuths(ctx context.Context) ([]ISCSIAuth, error) {
+	if m.ListAuthsFunc != nil {
+		return m.ListAuthsFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockISCSIService) UpdateAuth(ctx context.Context, id int64, opts UpdateISCSIAuthOpts) (*ISCSIAuth, error) {
+	if m.UpdateAuthFunc != nil {
+		return m.UpdateAuthFunc(ctx, id, opts)
+	}
+	return nil, nil
+}
+
+func (m *MockISCSIService) DeleteAuth(ctx context.Context, id int64) error {
+	if m.DeleteAuthFunc != nil {
+		return m.DeleteAuthFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockISCSIService) CreateTarget(ctx context.Context, opts CreateISCSITargetOpts) (*ISCSITarget, error) {
+	if m.CreateTargetFunc != nil {
+		return m.CreateTargetFunc(ctx, opts)
+	}
+	return nil, nil
+}
+
+func (m *MockISCSIService) GetTarget(ctx context.Context, id int64) (*ISCSITarget, error) {
+	if m.GetTargetFunc != nil {
+		return m.GetTargetFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockISCSIService) ListTargets(ctx context.Context) ([]ISCSITarget, error) {
+	if m.ListTargetsFunc != nil {
+		return m.ListTargetsFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockISCSIService) UpdateTarget(ctx context.Context, id int64, opts UpdateISCSITargetOpts) (*ISCSITarget, error) {
+	if m.UpdateTargetFunc != nil {
+		return m.UpdateTargetFunc(ctx, id, opts)
+	}
+	return nil, nil
+}
+
+func (m *MockISCSIService) DeleteTarget(ctx context.Context, id int64, force, deleteExtents bool) error {
+	if m.DeleteTargetFunc != nil {
+		return m.DeleteTargetFunc(ctx, id, force, deleteExtents)
+	}
+	return nil
+}
+
+func (m *MockISCSIService) CreateExtent(ctx context.Context, opts CreateISCSIExtentOpts) (*ISCSIExtent, error) {
+	if m.CreateExtentFunc != nil {
+		return m.CreateExtentFunc(ctx, opts)
+	}
+	return nil, nil
+}
+
+func (m *MockISCSIService) GetExtent(ctx context.Context, id int64) (*ISCSIExtent, error) {
+	if m.GetExtentFunc != nil {
+		return m.GetExtentFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockISCSIService) ListExtents(ctx context.Context) ([]ISCSIExtent, error) {
+	if m.ListExtentsFunc != nil {
+		return m.ListExtentsFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockISCSIService) UpdateExtent(ctx context.Context, id int64, opts UpdateISCSIExtentOpts) (*ISCSIExtent, error) {
+	if m.UpdateExtentFunc != nil {
+		return m.UpdateExtentFunc(ctx, id, opts)
+	}
+	return nil, nil
+}
+
+func (m *MockISCSIService) DeleteExtent(ctx context.Context, id int64, remove, force bool) error {
+	if m.DeleteExtentFunc != nil {
+		return m.DeleteExtentFunc(ctx, id, remove, force)
+	}
+	return nil
+}
+
+func (m *MockISCSIService) CreateTargetExtent(ctx context.Context, opts CreateISCSITargetExtentOpts) (*ISCSITargetExtent, error) {
+	if m.CreateTargetExtentFunc != nil {
+		return m.CreateTargetExtentFunc(ctx, opts)
+	}
+	return nil, nil
+}
+
+func (m *MockISCSIService) GetTargetExtent(ctx context.Context, id int64) (*ISCSITargetExtent, error) {
+	if m.GetTargetExtentFunc != nil {
+		return m.GetTargetExtentFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockISCSIService) ListTargetExtents(ctx context.Context) ([]ISCSITargetExtent, error) {
+	if m.ListTargetExtentsFunc != nil {
+		return m.ListTargetExtentsFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockISCSIService) UpdateTargetExtent(ctx context.Context, id int64, opts UpdateISCSITargetExtentOpts) (*ISCSITargetExtent, error) {
+	if m.UpdateTargetExtentFunc != nil {
+		return m.UpdateTargetExtentFunc(ctx, id, opts)
+	}
+	return nil, nil
+}
+
+func (m *MockISCSIService) DeleteTargetExtent(ctx context.Context, id int64, force bool) error {
+	if m.DeleteTargetExtentFunc != nil {
+		return m.DeleteTargetExtentFunc(ctx, id, force)
+	}
+	return nil
+}
+
+func (m *MockISCSIService) ExposeZvol(ctx context.Context, zvolID string, opts ExposeZvolOpts) (*ISCSIExposure, error) {
+	if m.ExposeZvolFunc != nil {
+		return m.ExposeZvolFunc(ctx, zvolID, opts)
+	}
+	return nil, nil
+}
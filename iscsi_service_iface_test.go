@@ -0,0 +1,50 @@
+package truenas
+
+import (
+	"context"
+	"testing"
+)
+
+func TestMockISCSIService_ImplementsInterface(t *testing.T) {
+	var _ ISCSIServiceAPI = (*ISCSIService)(nil)
+	var _ ISCSIServiceAPI = (*MockISCSIService)(nil)
+}
+
+func TestMockISCSIService_DefaultsToNil(t *testing.T) {
+	mock := &MockISCSIService{}
+	ctx := context.Background()
+
+	target, err := mock.GetTarget(ctx, 1)
+	if err != nil {
+		t.Fatalf("expected nil error, got: %v", err)
+	}
+	if target != nil {
+		t.Fatalf("expected nil result, got: %v", target)
+	}
+
+	exposure, err := mock.ExposeZvol(ctx, "tank/vol", ExposeZvolOpts{})
+	if err != nil || exposure != nil {
+		t.Fatalf("expected nil, nil from ExposeZvol, got: %v, %v", exposure, err)
+	}
+}
+
+func TestMockISCSIService_CallsFunc(t *testing.T) {
+	called := false
+	mock := &MockISCSIService{
+		ExposeZvolFunc: func(ctx context.Context, zvolID string, opts ExposeZvolOpts) (*ISCSIExposure, error) {
+			called = true
+			return &ISCSIExposure{Extent: ISCSIExtent{Disk: "zvol/" + zvolID}}, nil
+		},
+	}
+
+	exposure, err := mock.ExposeZvol(context.Background(), "tank/vol", ExposeZvolOpts{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !called {
+		t.Fatal("expected ExposeZvolFunc to be called")
+	}
+	if exposure.Extent.Disk != "zvol/tank/vol" {
+		t.Fatalf("unexpected exposure: %+v", exposure)
+	}
+}
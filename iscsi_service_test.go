@@ -0,0 +1,692 @@
+package truenas
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// sampleISCSIExtentJSON returns a JSON response for a DISK extent.
+func sampleISCSIExtentJSON() json.RawMessage {
+	return json.RawMessage(`{
+		"id": 7,
+		"name": "tank-vols-db",
+		"type": "DISK",
+		"disk": "zvol/tank/vols/db",
+		"serial": "ac1f6b3e2d4c",
+		"path": "zvol/tank/vols/db",
+		"filesize": "0",
+		"blocksize": 512,
+		"pblocksize": false,
+		"avail_threshold": null,
+		"comment": "",
+		"naa": "0x6589cfc000000a1b2c3d4e5f60718293",
+		"insecure_tpc": true,
+		"xen": false,
+		"rpm": "SSD",
+		"ro": false,
+		"enabled": true,
+		"vendor": "TrueNAS",
+		"locked": false
+	}`)
+}
+
+// sampleISCSITargetJSON returns a JSON response for a target exposed on
+// portal 1.
+func sampleISCSITargetJSON() json.RawMessage {
+	return json.RawMessage(`{
+		"id": 5,
+		"name": "tank-vols-db",
+		"alias": null,
+		"mode": "ISCSI",
+		"groups": [{"portal": 1, "initiator": 2, "authmethod": "CHAP", "auth": 1}],
+		"auth_networks": [],
+		"rel_tgt_id": 5,
+		"iscsi_parameters": null
+	}`)
+}
+
+func sampleISCSITargetExtentJSON() json.RawMessage {
+	return json.RawMessage(`{"id": 9, "target": 5, "lunid": 0, "extent": 7}`)
+}
+
+func TestISCSIService_GetGlobalConfig(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			if method != "iscsi.global.config" {
+				t.Errorf("expected method iscsi.global.config, got %s", method)
+			}
+			return json.RawMessage(`{"id": 1, "basename": "iqn.2005-10.org.freenas.ctl", "isns_servers": [], "listen_port": 3260, "pool_avail_threshold": 80, "alua": false, "iser": false}`), nil
+		},
+	}
+
+	svc := NewISCSIService(mock, Version{})
+	config, err := svc.GetGlobalConfig(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if config.Basename != "iqn.2005-10.org.freenas.ctl" || config.ListenPort != 3260 || config.PoolAvailThreshold != 80 {
+		t.Errorf("unexpected config: %+v", config)
+	}
+}
+
+func TestISCSIService_UpdateGlobalConfig(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return json.RawMessage(`{"id": 1, "basename": "iqn.2024-01.com.example", "isns_servers": [], "listen_port": 3260, "pool_avail_threshold": null, "alua": false, "iser": false}`), nil
+		},
+	}
+
+	svc := NewISCSIService(mock, Version{})
+	_, err := svc.UpdateGlobalConfig(context.Background(), UpdateISCSIGlobalConfigOpts{
+		Basename:           "iqn.2024-01.com.example",
+		PoolAvailThreshold: Int64Ptr(0),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]any{"basename": "iqn.2024-01.com.example", "pool_avail_threshold": nil}
+	if mock.calls[0].Method != "iscsi.global.update" || !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("unexpected call: %s %v", mock.calls[0].Method, mock.calls[0].Params)
+	}
+}
+
+func TestISCSIService_CreatePortal(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return json.RawMessage(`{"id": 1, "listen": [{"ip": "0.0.0.0", "port": 3260}], "tag": 1, "comment": "all"}`), nil
+		},
+	}
+
+	svc := NewISCSIService(mock, Version{})
+	portal, err := svc.CreatePortal(context.Background(), CreateISCSIPortalOpts{Listen: []string{"0.0.0.0"}, Comment: "all"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]any{"listen": []map[string]any{{"ip": "0.0.0.0"}}, "comment": "all"}
+	if mock.calls[0].Method != "iscsi.portal.create" || !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("unexpected call: %s %v", mock.calls[0].Method, mock.calls[0].Params)
+	}
+	if portal.Tag != 1 || len(portal.Listen) != 1 || portal.Listen[0].Port != 3260 {
+		t.Errorf("unexpected portal: %+v", portal)
+	}
+}
+
+func TestISCSIService_CreateInitiator(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return json.RawMessage(`{"id": 2, "initiators": [], "comment": ""}`), nil
+		},
+	}
+
+	svc := NewISCSIService(mock, Version{})
+	if _, err := svc.CreateInitiator(context.Background(), CreateISCSIInitiatorOpts{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]any{"initiators": []string{}}
+	if mock.calls[0].Method != "iscsi.initiator.create" || !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("unexpected call: %s %v", mock.calls[0].Method, mock.calls[0].Params)
+	}
+}
+
+func TestISCSIService_CreateAuth(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return json.RawMessage(`{"id": 1, "tag": 1, "user": "k8s", "secret": "secretsecret", "peeruser": "", "peersecret": "", "discovery_auth": "CHAP"}`), nil
+		},
+	}
+
+	svc := NewISCSIService(mock, Version{})
+	auth, err := svc.CreateAuth(context.Background(), CreateISCSIAuthOpts{
+		Tag:           1,
+		User:          "k8s",
+		Secret:        "secretsecret",
+		DiscoveryAuth: ISCSIAuthCHAP,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]any{"tag": int64(1), "user": "k8s", "secret": "secretsecret", "discovery_auth": "CHAP"}
+	if mock.calls[0].Method != "iscsi.auth.create" || !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("unexpected call: %s %v", mock.calls[0].Method, mock.calls[0].Params)
+	}
+	if auth.DiscoveryAuth != ISCSIAuthCHAP || auth.PeerUser != "" {
+		t.Errorf("unexpected auth: %+v", auth)
+	}
+}
+
+func TestISCSIService_CreateTarget(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return sampleISCSITargetJSON(), nil
+		},
+	}
+
+	svc := NewISCSIService(mock, Version{})
+	target, err := svc.CreateTarget(context.Background(), CreateISCSITargetOpts{
+		Name: "tank-vols-db",
+		Groups: []ISCSITargetGroup{
+			{Portal: 1, Initiator: Int64Ptr(2), AuthMethod: ISCSIAuthCHAP, Auth: Int64Ptr(1)},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]any{
+		"name":   "tank-vols-db",
+		"groups": []map[string]any{{"portal": int64(1), "initiator": int64(2), "authmethod": "CHAP", "auth": int64(1)}},
+	}
+	if mock.calls[0].Method != "iscsi.target.create" || !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("unexpected call: %s %v", mock.calls[0].Method, mock.calls[0].Params)
+	}
+	if target.ID != 5 || target.Alias != "" || target.Mode != ISCSITargetModeISCSI {
+		t.Errorf("unexpected target: %+v", target)
+	}
+	if len(target.Groups) != 1 || *target.Groups[0].Initiator != 2 || target.Groups[0].AuthMethod != ISCSIAuthCHAP {
+		t.Errorf("unexpected groups: %+v", target.Groups)
+	}
+}
+
+func TestISCSIService_UpdateTarget(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return sampleISCSITargetJSON(), nil
+		},
+	}
+
+	svc := NewISCSIService(mock, Version{})
+	_, err := svc.UpdateTarget(context.Background(), 5, UpdateISCSITargetOpts{
+		Alias:  StringPtr(""),
+		Groups: []ISCSITargetGroup{},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []any{int64(5), map[string]any{"alias": nil, "groups": []map[string]any{}}}
+	if mock.calls[0].Method != "iscsi.target.update" || !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("unexpected call: %s %v", mock.calls[0].Method, mock.calls[0].Params)
+	}
+}
+
+func TestISCSIService_DeleteTarget(t *testing.T) {
+	mock := &mockCaller{}
+
+	svc := NewISCSIService(mock, Version{})
+	if err := svc.DeleteTarget(context.Background(), 5, true, true); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []any{int64(5), true, true}
+	if mock.calls[0].Method != "iscsi.target.delete" || !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("unexpected call: %s %v", mock.calls[0].Method, mock.calls[0].Params)
+	}
+}
+
+func TestISCSIService_GetExtent(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			if method != "iscsi.extent.get_instance" {
+				t.Errorf("expected method iscsi.extent.get_instance, got %s", method)
+			}
+			return sampleISCSIExtentJSON(), nil
+		},
+	}
+
+	svc := NewISCSIService(mock, Version{})
+	extent, err := svc.GetExtent(context.Background(), 7)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if extent.Type != ISCSIExtentTypeDisk || extent.Disk != "zvol/tank/vols/db" || extent.Blocksize != 512 {
+		t.Errorf("unexpected extent: %+v", extent)
+	}
+	if extent.Filesize != 0 || extent.AvailThreshold != 0 || !extent.InsecureTPC || extent.Vendor != "TrueNAS" {
+		t.Errorf("unexpected extent: %+v", extent)
+	}
+}
+
+func TestISCSIService_GetExtent_FilesizeAsNumber(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return json.RawMessage(`{"id": 8, "name": "file", "type": "FILE", "disk": null, "path": "/mnt/tank/lun0", "filesize": 10737418240, "locked": null}`), nil
+		},
+	}
+
+	svc := NewISCSIService(mock, Version{})
+	extent, err := svc.GetExtent(context.Background(), 8)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if extent.Filesize != 10737418240 || extent.Path != "/mnt/tank/lun0" || extent.Locked {
+		t.Errorf("unexpected extent: %+v", extent)
+	}
+}
+
+func TestISCSIService_GetTarget_NotFound(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return nil, errors.New("[ENOENT] None: iSCSI target 9 does not exist")
+		},
+	}
+
+	svc := NewISCSIService(mock, Version{})
+	target, err := svc.GetTarget(context.Background(), 9)
+	if err != nil || target != nil {
+		t.Errorf("expected nil, nil, got %+v, %v", target, err)
+	}
+}
+
+func TestISCSIService_ListTargetExtents(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			if method != "iscsi.targetextent.query" {
+				t.Errorf("expected method iscsi.targetextent.query, got %s", method)
+			}
+			return json.RawMessage(`[` + string(sampleISCSITargetExtentJSON()) + `]`), nil
+		},
+	}
+
+	svc := NewISCSIService(mock, Version{})
+	assocs, err := svc.ListTargetExtents(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []ISCSITargetExtent{{ID: 9, Target: 5, Extent: 7, LunID: 0}}
+	if !reflect.DeepEqual(assocs, want) {
+		t.Errorf("expected %+v, got %+v", want, assocs)
+	}
+}
+
+func TestISCSIService_ListExtents_ParseError(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return json.RawMessage(`not json`), nil
+		},
+	}
+
+	svc := NewISCSIService(mock, Version{})
+	if _, err := svc.ListExtents(context.Background()); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+// exposeZvolCaller answers the calls ExposeZvol makes, failing failMethod
+// and any method in failCleanup.
+func exposeZvolCaller(failMethod string, failCleanup ...string) *mockCaller {
+	return &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			if method == failMethod {
+				return nil, errors.New("[EINVAL] " + method + ": boom")
+			}
+			for _, m := range failCleanup {
+				if method == m {
+					return nil, errors.New("[EBUSY] " + method + ": busy")
+				}
+			}
+			switch method {
+			case "iscsi.extent.create":
+				return sampleISCSIExtentJSON(), nil
+			case "iscsi.target.create":
+				return sampleISCSITargetJSON(), nil
+			case "iscsi.targetextent.create":
+				return sampleISCSITargetExtentJSON(), nil
+			}
+			return json.RawMessage(`true`), nil
+		},
+	}
+}
+
+func TestISCSIService_ExposeZvol(t *testing.T) {
+	mock := exposeZvolCaller("")
+
+	svc := NewISCSIService(mock, Version{})
+	exposure, err := svc.ExposeZvol(context.Background(), "tank/vols/db", ExposeZvolOpts{
+		PortalID:    1,
+		InitiatorID: Int64Ptr(2),
+		AuthMethod:  ISCSIAuthCHAP,
+		AuthTag:     Int64Ptr(1),
+		LunID:       Int64Ptr(0),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(mock.calls) != 3 {
+		t.Fatalf("expected 3 calls, got %d", len(mock.calls))
+	}
+	wantExtent := map[string]any{"name": "tank-vols-db", "type": "DISK", "disk": "zvol/tank/vols/db"}
+	if mock.calls[0].Method != "iscsi.extent.create" || !reflect.DeepEqual(mock.calls[0].Params, wantExtent) {
+		t.Errorf("unexpected extent call: %s %v", mock.calls[0].Method, mock.calls[0].Params)
+	}
+	wantTarget := map[string]any{
+		"name":   "tank-vols-db",
+		"groups": []map[string]any{{"portal": int64(1), "initiator": int64(2), "authmethod": "CHAP", "auth": int64(1)}},
+	}
+	if mock.calls[1].Method != "iscsi.target.create" || !reflect.DeepEqual(mock.calls[1].Params, wantTarget) {
+		t.Errorf("unexpected target call: %s %v", mock.calls[1].Method, mock.calls[1].Params)
+	}
+	wantAssoc := map[string]any{"target": int64(5), "extent": int64(7), "lunid": int64(0)}
+	if mock.calls[2].Method != "iscsi.targetextent.create" || !reflect.DeepEqual(mock.calls[2].Params, wantAssoc) {
+		t.Errorf("unexpected targetextent call: %s %v", mock.calls[2].Method, mock.calls[2].Params)
+	}
+
+	if exposure.Target.ID != 5 || exposure.Extent.ID != 7 || exposure.TargetExtent.ID != 9 {
+		t.Errorf("unexpected exposure: %+v", exposure)
+	}
+}
+
+func TestISCSIService_ExposeZvol_RollsBack(t *testing.T) {
+	mock := exposeZvolCaller("iscsi.targetextent.create")
+
+	svc := NewISCSIService(mock, Version{})
+	_, err := svc.ExposeZvol(context.Background(), "tank/vols/db", ExposeZvolOpts{Name: "db"})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if !strings.Contains(err.Error(), "expose zvol tank/vols/db: create target extent:") {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	var methods []string
+	for _, c := range mock.calls {
+		methods = append(methods, c.Method)
+	}
+	want := []string{
+		"iscsi.extent.create",
+		"iscsi.target.create",
+		"iscsi.targetextent.create",
+		"iscsi.target.delete",
+		"iscsi.extent.delete",
+	}
+	if !reflect.DeepEqual(methods, want) {
+		t.Fatalf("expected calls %v, got %v", want, methods)
+	}
+	if !reflect.DeepEqual(mock.calls[3].Params, []any{int64(5), true, false}) {
+		t.Errorf("unexpected target delete params: %v", mock.calls[3].Params)
+	}
+	// The zvol must survive: remove=false.
+	if !reflect.DeepEqual(mock.calls[4].Params, []any{int64(7), false, true}) {
+		t.Errorf("unexpected extent delete params: %v", mock.calls[4].Params)
+	}
+}
+
+func TestISCSIService_ExposeZvol_CleanupIncomplete(t *testing.T) {
+	mock := exposeZvolCaller("iscsi.target.create", "iscsi.extent.delete")
+
+	svc := NewISCSIService(mock, Version{})
+	_, err := svc.ExposeZvol(context.Background(), "tank/vols/db", ExposeZvolOpts{})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if !strings.Contains(err.Error(), "create target:") || !strings.Contains(err.Error(), "cleanup incomplete: delete extent 7:") {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if len(mock.calls) != 3 {
+		t.Errorf("expected 3 calls, got %d", len(mock.calls))
+	}
+}
+
+func TestISCSINameFromZvolID(t *testing.T) {
+	tests := map[string]string{
+		"tank/vols/db":     "tank-vols-db",
+		"Tank/VM_Disks/a1": "tank-vm-disks-a1",
+		"tank/k8s.pv-01":   "tank-k8s.pv-01",
+	}
+	for in, want := range tests {
+		if got := iscsiNameFromZvolID(in); got != want {
+			t.Errorf("iscsiNameFromZvolID(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+// iscsiCaller returns a mockCaller that answers every call with result.
+func iscsiCaller(result string) *mockCaller {
+	return &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return json.RawMessage(result), nil
+		},
+	}
+}
+
+// assertISCSICall checks the only call made to mock.
+func assertISCSICall(t *testing.T, mock *mockCaller, method string, params any) {
+	t.Helper()
+	if len(mock.calls) != 1 {
+		t.Fatalf("expected 1 call, got %d", len(mock.calls))
+	}
+	if mock.calls[0].Method != method || !reflect.DeepEqual(mock.calls[0].Params, params) {
+		t.Errorf("expected %s %v, got %s %v", method, params, mock.calls[0].Method, mock.calls[0].Params)
+	}
+}
+
+const sampleISCSIPortalJSON = `{"id": 1, "listen": [{"ip": "10.0.0.5", "port": 3260}], "tag": 1, "comment": ""}`
+
+func TestISCSIService_GetPortal(t *testing.T) {
+	mock := iscsiCaller(sampleISCSIPortalJSON)
+	portal, err := NewISCSIService(mock, Version{}).GetPortal(context.Background(), 1)
+	if err != nil || portal.Listen[0].IP != "10.0.0.5" {
+		t.Fatalf("unexpected result: %+v, %v", portal, err)
+	}
+	assertISCSICall(t, mock, "iscsi.portal.get_instance", int64(1))
+}
+
+func TestISCSIService_ListPortals(t *testing.T) {
+	mock := iscsiCaller(`[` + sampleISCSIPortalJSON + `]`)
+	portals, err := NewISCSIService(mock, Version{}).ListPortals(context.Background())
+	if err != nil || len(portals) != 1 {
+		t.Fatalf("unexpected result: %+v, %v", portals, err)
+	}
+	assertISCSICall(t, mock, "iscsi.portal.query", nil)
+}
+
+func TestISCSIService_UpdatePortal(t *testing.T) {
+	mock := iscsiCaller(sampleISCSIPortalJSON)
+	_, err := NewISCSIService(mock, Version{}).UpdatePortal(context.Background(), 1, UpdateISCSIPortalOpts{
+		Listen: []string{"10.0.0.5"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertISCSICall(t, mock, "iscsi.portal.update", []any{int64(1), map[string]any{
+		"listen": []map[string]any{{"ip": "10.0.0.5"}},
+	}})
+}
+
+func TestISCSIService_DeletePortal(t *testing.T) {
+	mock := iscsiCaller(`true`)
+	if err := NewISCSIService(mock, Version{}).DeletePortal(context.Background(), 1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertISCSICall(t, mock, "iscsi.portal.delete", int64(1))
+}
+
+const sampleISCSIInitiatorJSON = `{"id": 2, "initiators": ["iqn.1993-08.org.debian:01:k8s-node1"], "comment": "k8s"}`
+
+func TestISCSIService_GetInitiator(t *testing.T) {
+	mock := iscsiCaller(sampleISCSIInitiatorJSON)
+	initiator, err := NewISCSIService(mock, Version{}).GetInitiator(context.Background(), 2)
+	if err != nil || initiator.Comment != "k8s" || len(initiator.Initiators) != 1 {
+		t.Fatalf("unexpected result: %+v, %v", initiator, err)
+	}
+	assertISCSICall(t, mock, "iscsi.initiator.get_instance", int64(2))
+}
+
+func TestISCSIService_ListInitiators(t *testing.T) {
+	mock := iscsiCaller(`[` + sampleISCSIInitiatorJSON + `]`)
+	initiators, err := NewISCSIService(mock, Version{}).ListInitiators(context.Background())
+	if err != nil || len(initiators) != 1 {
+		t.Fatalf("unexpected result: %+v, %v", initiators, err)
+	}
+	assertISCSICall(t, mock, "iscsi.initiator.query", nil)
+}
+
+func TestISCSIService_UpdateInitiator(t *testing.T) {
+	mock := iscsiCaller(sampleISCSIInitiatorJSON)
+	_, err := NewISCSIService(mock, Version{}).UpdateInitiator(context.Background(), 2, UpdateISCSIInitiatorOpts{
+		Initiators: []string{},
+		Comment:    StringPtr("any"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertISCSICall(t, mock, "iscsi.initiator.update", []any{int64(2), map[string]any{"initiators": []string{}, "comment": "any"}})
+}
+
+func TestISCSIService_DeleteInitiator(t *testing.T) {
+	mock := iscsiCaller(`true`)
+	if err := NewISCSIService(mock, Version{}).DeleteInitiator(context.Background(), 2); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertISCSICall(t, mock, "iscsi.initiator.delete", int64(2))
+}
+
+const sampleISCSIAuthJSON = `{"id": 1, "tag": 1, "user": "k8s", "secret": "secretsecret", "peeruser": "nas", "peersecret": "peersecret12", "discovery_auth": "CHAP_MUTUAL"}`
+
+func TestISCSIService_GetAuth(t *testing.T) {
+	mock := iscsiCaller(sampleISCSIAuthJSON)
+	auth, err := NewISCSIService(mock, Version{}).GetAuth(context.Background(), 1)
+	if err != nil || auth.PeerUser != "nas" || auth.DiscoveryAuth != ISCSIAuthCHAPMutual {
+		t.Fatalf("unexpected result: %+v, %v", auth, err)
+	}
+	assertISCSICall(t, mock, "iscsi.auth.get_instance", int64(1))
+}
+
+func TestISCSIService_ListAuths(t *testing.T) {
+	mock := iscsiCaller(`[` + sampleISCSIAuthJSON + `]`)
+	auths, err := NewISCSIService(mock, Version{}).ListAuths(context.Background())
+	if err != nil || len(auths) != 1 {
+		t.Fatalf("unexpected result: %+v, %v", auths, err)
+	}
+	assertISCSICall(t, mock, "iscsi.auth.query", nil)
+}
+
+func TestISCSIService_UpdateAuth(t *testing.T) {
+	mock := iscsiCaller(sampleISCSIAuthJSON)
+	_, err := NewISCSIService(mock, Version{}).UpdateAuth(context.Background(), 1, UpdateISCSIAuthOpts{
+		Secret:     "newsecret123",
+		PeerUser:   StringPtr(""),
+		PeerSecret: StringPtr(""),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertISCSICall(t, mock, "iscsi.auth.update", []any{int64(1), map[string]any{
+		"secret":     "newsecret123",
+		"peeruser":   "",
+		"peersecret": "",
+	}})
+}
+
+func TestISCSIService_DeleteAuth(t *testing.T) {
+	mock := iscsiCaller(`true`)
+	if err := NewISCSIService(mock, Version{}).DeleteAuth(context.Background(), 1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertISCSICall(t, mock, "iscsi.auth.delete", int64(1))
+}
+
+func TestISCSIService_ListTargets(t *testing.T) {
+	mock := iscsiCaller(`[` + string(sampleISCSITargetJSON()) + `]`)
+	targets, err := NewISCSIService(mock, Version{}).ListTargets(context.Background())
+	if err != nil || len(targets) != 1 || targets[0].Name != "tank-vols-db" {
+		t.Fatalf("unexpected result: %+v, %v", targets, err)
+	}
+	assertISCSICall(t, mock, "iscsi.target.query", nil)
+}
+
+func TestISCSIService_CreateExtent(t *testing.T) {
+	mock := iscsiCaller(`{"id": 8, "name": "lun0", "type": "FILE", "path": "/mnt/tank/lun0", "filesize": "10737418240", "blocksize": 4096}`)
+	extent, err := NewISCSIService(mock, Version{}).CreateExtent(context.Background(), CreateISCSIExtentOpts{
+		Name:        "lun0",
+		Type:        ISCSIExtentTypeFile,
+		Path:        "/mnt/tank/lun0",
+		Filesize:    10737418240,
+		Blocksize:   4096,
+		InsecureTPC: BoolPtr(false),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertISCSICall(t, mock, "iscsi.extent.create", map[string]any{
+		"name":         "lun0",
+		"type":         "FILE",
+		"path":         "/mnt/tank/lun0",
+		"filesize":     int64(10737418240),
+		"blocksize":    int64(4096),
+		"insecure_tpc": false,
+	})
+	if extent.Filesize != 10737418240 || extent.Type != ISCSIExtentTypeFile {
+		t.Errorf("unexpected extent: %+v", extent)
+	}
+}
+
+func TestISCSIService_UpdateExtent(t *testing.T) {
+	mock := iscsiCaller(string(sampleISCSIExtentJSON()))
+	_, err := NewISCSIService(mock, Version{}).UpdateExtent(context.Background(), 7, UpdateISCSIExtentOpts{
+		ReadOnly:       BoolPtr(true),
+		AvailThreshold: Int64Ptr(90),
+		RPM:            "7200",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertISCSICall(t, mock, "iscsi.extent.update", []any{int64(7), map[string]any{
+		"ro":              true,
+		"avail_threshold": int64(90),
+		"rpm":             "7200",
+	}})
+}
+
+func TestISCSIService_DeleteExtent(t *testing.T) {
+	mock := iscsiCaller(`true`)
+	if err := NewISCSIService(mock, Version{}).DeleteExtent(context.Background(), 8, true, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertISCSICall(t, mock, "iscsi.extent.delete", []any{int64(8), true, false})
+}
+
+func TestISCSIService_CreateTargetExtent(t *testing.T) {
+	mock := iscsiCaller(string(sampleISCSITargetExtentJSON()))
+	assoc, err := NewISCSIService(mock, Version{}).CreateTargetExtent(context.Background(), CreateISCSITargetExtentOpts{
+		Target: 5,
+		Extent: 7,
+	})
+	if err != nil || assoc.ID != 9 {
+		t.Fatalf("unexpected result: %+v, %v", assoc, err)
+	}
+	assertISCSICall(t, mock, "iscsi.targetextent.create", map[string]any{"target": int64(5), "extent": int64(7)})
+}
+
+func TestISCSIService_GetTargetExtent(t *testing.T) {
+	mock := iscsiCaller(string(sampleISCSITargetExtentJSON()))
+	assoc, err := NewISCSIService(mock, Version{}).GetTargetExtent(context.Background(), 9)
+	if err != nil || assoc.Target != 5 || assoc.Extent != 7 {
+		t.Fatalf("unexpected result: %+v, %v", assoc, err)
+	}
+	assertISCSICall(t, mock, "iscsi.targetextent.get_instance", int64(9))
+}
+
+func TestISCSIService_UpdateTargetExtent(t *testing.T) {
+	mock := iscsiCaller(string(sampleISCSITargetExtentJSON()))
+	if _, err := NewISCSIService(mock, Version{}).UpdateTargetExtent(context.Background(), 9, UpdateISCSITargetExtentOpts{LunID: Int64Ptr(3)}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertISCSICall(t, mock, "iscsi.targetextent.update", []any{int64(9), map[string]any{"lunid": int64(3)}})
+}
+
+func TestISCSIService_DeleteTargetExtent(t *testing.T) {
+	mock := iscsiCaller(`true`)
+	if err := NewISCSIService(mock, Version{}).DeleteTargetExtent(context.Background(), 9, true); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertISCSICall(t, mock, "iscsi.targetextent.delete", []any{int64(9), true})
+}
@@ -0,0 +1,138 @@
+package truenas
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// JobState is the lifecycle state of a middleware job.
+type JobState string
+
+const (
+	JobStateWaiting JobState = "WAITING"
+	JobStateRunning JobState = "RUNNING"
+	JobStateSuccess JobState = "SUCCESS"
+	JobStateFailed  JobState = "FAILED"
+	JobStateAborted JobState = "ABORTED"
+)
+
+// JobResponse represents a job from the core.get_jobs API.
+type JobResponse struct {
+	ID          int64               `json:"id"`
+	Method      string              `json:"method"`
+	Description *string             `json:"description"`
+	Abortable   bool                `json:"abortable"`
+	Progress    JobProgressResponse `json:"progress"`
+	State       string              `json:"state"`
+	Error       *string             `json:"error"`
+	Result      json.RawMessage     `json:"result"`
+	LogsExcerpt *string             `json:"logs_excerpt"`
+}
+
+// JobProgressResponse is the progress a job last reported.
+type JobProgressResponse struct {
+	Percent     *float64 `json:"percent"`
+	Description *string  `json:"description"`
+}
+
+// JobInfo is a snapshot of a job's state.
+type JobInfo struct {
+	ID          int64
+	Method      string
+	Description string
+	Abortable   bool
+	State       JobState
+	Percent     float64 // Last reported progress, 0-100
+	Progress    string  // Last reported progress description
+	Error       string
+	Result      json.RawMessage
+	LogsExcerpt string
+}
+
+// Done reports whether the job has finished, successfully or not.
+func (j *JobInfo) Done() bool {
+	switch j.State {
+	case JobStateSuccess, JobStateFailed, JobStateAborted:
+		return true
+	}
+	return false
+}
+
+// Job is a handle to a job started without waiting for it to finish.
+type Job struct {
+	ID      int64
+	client  AsyncCaller
+	version Version
+}
+
+// newJob builds a handle from the job ID returned when a job method is
+// called without waiting.
+func newJob(c AsyncCaller, v Version, result json.RawMessage) (*Job, error) {
+	var id int64
+	if err := json.Unmarshal(result, &id); err != nil {
+		return nil, fmt.Errorf("parse job ID: %w", err)
+	}
+	return &Job{ID: id, client: c, version: v}, nil
+}
+
+// Wait blocks until the job finishes and returns its result. A failed or
+// aborted job is returned as an error.
+func (j *Job) Wait(ctx context.Context) (json.RawMessage, error) {
+	return callMethodAndWait(ctx, j.client, j.version, "core.job_wait", j.ID)
+}
+
+// Status returns the job's current state, or nil if the server no longer
+// tracks it.
+func (j *Job) Status(ctx context.Context) (*JobInfo, error) {
+	filter := [][]any{{"id", "=", j.ID}}
+	result, err := callMethod(ctx, j.client, j.version, "core.get_jobs", []any{filter})
+	if err != nil {
+		return nil, err
+	}
+
+	var jobs []JobResponse
+	if err := json.Unmarshal(result, &jobs); err != nil {
+		return nil, fmt.Errorf("parse get_jobs response: %w", err)
+	}
+	if len(jobs) == 0 {
+		return nil, nil
+	}
+
+	info := jobInfoFromResponse(jobs[0])
+	return &info, nil
+}
+
+// Abort asks the server to abort the job.
+func (j *Job) Abort(ctx context.Context) error {
+	_, err := callMethod(ctx, j.client, j.version, "core.job_abort", j.ID)
+	return err
+}
+
+// jobInfoFromResponse converts a wire-format JobResponse to a user-facing
+// JobInfo.
+func jobInfoFromResponse(resp JobResponse) JobInfo {
+	info := JobInfo{
+		ID:        resp.ID,
+		Method:    resp.Method,
+		Abortable: resp.Abortable,
+		State:     JobState(resp.State),
+		Result:    resp.Result,
+	}
+	if resp.Description != nil {
+		info.Description = *resp.Description
+	}
+	if resp.Progress.Percent != nil {
+		info.Percent = *resp.Progress.Percent
+	}
+	if resp.Progress.Description != nil {
+		info.Progress = *resp.Progress.Description
+	}
+	if resp.Error != nil {
+		info.Error = *resp.Error
+	}
+	if resp.LogsExcerpt != nil {
+		info.LogsExcerpt = *resp.LogsExcerpt
+	}
+	return info
+}
@@ -0,0 +1,122 @@
+package truenas
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestJob_Wait(t *testing.T) {
+	mock := &mockAsyncCaller{
+		callAndWaitFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return json.RawMessage(`{"ok": true}`), nil
+		},
+	}
+
+	job := &Job{ID: 42, client: mock}
+	result, err := job.Wait(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if mock.calls[0].Method != "core.job_wait" || mock.calls[0].Params != int64(42) {
+		t.Errorf("unexpected call: %+v", mock.calls[0])
+	}
+	if string(result) != `{"ok": true}` {
+		t.Errorf("unexpected result: %s", result)
+	}
+}
+
+func TestJob_Wait_Error(t *testing.T) {
+	mock := &mockAsyncCaller{
+		callAndWaitFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return nil, errors.New("job failed: target dataset does not exist")
+		},
+	}
+
+	job := &Job{ID: 42, client: mock}
+	if _, err := job.Wait(context.Background()); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestJob_Status(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				return json.RawMessage(`[{
+					"id": 42,
+					"method": "replication.run",
+					"description": null,
+					"abortable": true,
+					"progress": {"percent": 37, "description": "Sending tank/data@auto-2025-10-09_09-00", "extra": null},
+					"state": "RUNNING",
+					"error": null,
+					"result": null,
+					"logs_excerpt": null
+				}]`), nil
+			},
+		},
+	}
+
+	job := &Job{ID: 42, client: mock}
+	info, err := job.Status(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if mock.calls[0].Method != "core.get_jobs" {
+		t.Fatalf("expected method core.get_jobs, got %s", mock.calls[0].Method)
+	}
+	if want := []any{[][]any{{"id", "=", int64(42)}}}; !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("expected params %v, got %v", want, mock.calls[0].Params)
+	}
+	if info.State != JobStateRunning || info.Percent != 37 || info.Method != "replication.run" || !info.Abortable {
+		t.Errorf("unexpected info: %+v", info)
+	}
+	if info.Done() {
+		t.Error("expected running job not to be done")
+	}
+}
+
+func TestJob_Status_NotFound(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				return json.RawMessage(`[]`), nil
+			},
+		},
+	}
+
+	job := &Job{ID: 42, client: mock}
+	info, err := job.Status(context.Background())
+	if err != nil || info != nil {
+		t.Errorf("expected nil, nil, got %+v, %v", info, err)
+	}
+}
+
+func TestJob_Abort(t *testing.T) {
+	mock := &mockAsyncCaller{}
+
+	job := &Job{ID: 42, client: mock}
+	if err := job.Abort(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if mock.calls[0].Method != "core.job_abort" || mock.calls[0].Params != int64(42) {
+		t.Errorf("unexpected call: %+v", mock.calls[0])
+	}
+}
+
+func TestJobInfo_Done(t *testing.T) {
+	for state, want := range map[JobState]bool{
+		JobStateWaiting: false,
+		JobStateRunning: false,
+		JobStateSuccess: true,
+		JobStateFailed:  true,
+		JobStateAborted: true,
+	} {
+		if got := (&JobInfo{State: state}).Done(); got != want {
+			t.Errorf("Done() for %s = %v, want %v", state, got, want)
+		}
+	}
+}
@@ -0,0 +1,404 @@
+package truenas
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// ErrUnsupportedOnVersion is returned when an operation has no API method on
+// the connected TrueNAS version.
+var ErrUnsupportedOnVersion = errors.New("not supported on this TrueNAS version")
+
+// MethodSpec describes the API method implementing a logical operation on a
+// range of TrueNAS versions.
+type MethodSpec struct {
+	Method     string  // API method name, e.g. "pool.snapshot.create"
+	MinVersion Version // Inclusive lower bound; zero means no lower bound
+	MaxVersion Version // Exclusive upper bound; zero means no upper bound
+	// Params adapts the parameters built by the service to the shape this
+	// method expects. Nil passes them through unchanged.
+	Params func(params any) any
+}
+
+// supports reports whether the spec applies to version v.
+func (m MethodSpec) supports(v Version) bool {
+	if !m.MinVersion.IsZero() && v.Compare(m.MinVersion) < 0 {
+		return false
+	}
+	if !m.MaxVersion.IsZero() && v.Compare(m.MaxVersion) >= 0 {
+		return false
+	}
+	return true
+}
+
+// shapeParams applies the spec's parameter adapter, if any.
+func (m MethodSpec) shapeParams(params any) any {
+	if m.Params == nil {
+		return params
+	}
+	return m.Params(params)
+}
+
+// Version bounds referenced by the registry.
+var (
+	version2410 = Version{Major: 24, Minor: 10}
+	version2504 = Version{Major: 25, Minor: 4}
+	version2510 = Version{Major: 25, Minor: 10}
+)
+
+// methodRegistry maps each logical operation to the API methods implementing
+// it, in order of preference. Operations are keyed by their 25.04 method name.
+// Adding support for a new release means adding or bounding entries here.
+var methodRegistry = map[string][]MethodSpec{
+	// AppService
+	"app.available_space":      since("app.available_space", version2410),
+	"app.container_log_follow": since("app.container_log_follow", version2410),
+	"app.create":               since("app.create", version2410),
+	"app.delete":               since("app.delete", version2410),
+	"app.image.query":          since("app.image.query", version2410),
+	"app.query":                since("app.query", version2410),
+	"app.redeploy":             since("app.redeploy", version2410),
+	"app.registry.create":      since("app.registry.create", version2410),
+	"app.registry.delete":      since("app.registry.delete", version2410),
+	"app.registry.query":       since("app.registry.query", version2410),
+	"app.registry.update":      since("app.registry.update", version2410),
+	"app.start":                since("app.start", version2410),
+	"app.stats":                since("app.stats", version2410),
+	"app.stop":                 since("app.stop", version2410),
+	"app.update":               since("app.update", version2410),
+	"app.upgrade":              since("app.upgrade", version2410),
+	"app.upgrade_summary":      since("app.upgrade_summary", version2410),
+
+	// CloudSyncService
+	"cloudsync.create":             method("cloudsync.create"),
+	"cloudsync.credentials.create": method("cloudsync.credentials.create"),
+	"cloudsync.credentials.delete": method("cloudsync.credentials.delete"),
+	"cloudsync.credentials.query":  method("cloudsync.credentials.query"),
+	"cloudsync.credentials.update": method("cloudsync.credentials.update"),
+	"cloudsync.delete":             method("cloudsync.delete"),
+	"cloudsync.query":              method("cloudsync.query"),
+	"cloudsync.sync":               method("cloudsync.sync"),
+	"cloudsync.update":             method("cloudsync.update"),
+
+	// CronService
+	"cronjob.create":       method("cronjob.create"),
+	"cronjob.delete":       method("cronjob.delete"),
+	"cronjob.get_instance": method("cronjob.get_instance"),
+	"cronjob.query":        method("cronjob.query"),
+	"cronjob.run":          method("cronjob.run"),
+	"cronjob.update":       method("cronjob.update"),
+
+	// DatasetService
+	"pool.dataset.create": method("pool.dataset.create"),
+	"pool.dataset.delete": method("pool.dataset.delete"),
+	"pool.dataset.query":  method("pool.dataset.query"),
+	"pool.dataset.update": method("pool.dataset.update"),
+	"pool.query":          method("pool.query"),
+
+	// DiskService: 24.x lists unused disks with disk.get_unused, whose
+	// params and response differ.
+	"disk.details":         since("disk.details", version2504),
+	"disk.get_instance":    method("disk.get_instance"),
+	"disk.query":           method("disk.query"),
+	"disk.temperature_agg": method("disk.temperature_agg"),
+	"disk.temperatures":    method("disk.temperatures"),
+	"disk.update":          method("disk.update"),
+	"disk.wipe":            method("disk.wipe"),
+
+	// DockerService
+	"docker.config": since("docker.config", version2410),
+	"docker.status": since("docker.status", version2410),
+
+	// FilesystemService
+	"filesystem.file_receive": method("filesystem.file_receive"),
+	"filesystem.setperm":      method("filesystem.setperm"),
+	"filesystem.stat":         method("filesystem.stat"),
+
+	// GroupService
+	"group.create":       method("group.create"),
+	"group.delete":       method("group.delete"),
+	"group.get_instance": method("group.get_instance"),
+	"group.get_next_gid": method("group.get_next_gid"),
+	"group.query":        method("group.query"),
+	"group.update":       method("group.update"),
+
+	// InterfaceService
+	"interface.query": method("interface.query"),
+
+	// ISCSIService
+	"iscsi.auth.create":               method("iscsi.auth.create"),
+	"iscsi.auth.delete":               method("iscsi.auth.delete"),
+	"iscsi.auth.get_instance":         method("iscsi.auth.get_instance"),
+	"iscsi.auth.query":                method("iscsi.auth.query"),
+	"iscsi.auth.update":               method("iscsi.auth.update"),
+	"iscsi.extent.create":             method("iscsi.extent.create"),
+	"iscsi.extent.delete":             method("iscsi.extent.delete"),
+	"iscsi.extent.get_instance":       method("iscsi.extent.get_instance"),
+	"iscsi.extent.query":              method("iscsi.extent.query"),
+	"iscsi.extent.update":             method("iscsi.extent.update"),
+	"iscsi.global.config":             method("iscsi.global.config"),
+	"iscsi.global.update":             method("iscsi.global.update"),
+	"iscsi.initiator.create":          method("iscsi.initiator.create"),
+	"iscsi.initiator.delete":          method("iscsi.initiator.delete"),
+	"iscsi.initiator.get_instance":    method("iscsi.initiator.get_instance"),
+	"iscsi.initiator.query":           method("iscsi.initiator.query"),
+	"iscsi.initiator.update":          method("iscsi.initiator.update"),
+	"iscsi.portal.create":             method("iscsi.portal.create"),
+	"iscsi.portal.delete":             method("iscsi.portal.delete"),
+	"iscsi.portal.get_instance":       method("iscsi.portal.get_instance"),
+	"iscsi.portal.query":              method("iscsi.portal.query"),
+	"iscsi.portal.update":             method("iscsi.portal.update"),
+	"iscsi.target.create":             method("iscsi.target.create"),
+	"iscsi.target.delete":             method("iscsi.target.delete"),
+	"iscsi.target.get_instance":       method("iscsi.target.get_instance"),
+	"iscsi.target.query":              method("iscsi.target.query"),
+	"iscsi.target.update":             method("iscsi.target.update"),
+	"iscsi.targetextent.create":       method("iscsi.targetextent.create"),
+	"iscsi.targetextent.delete":       method("iscsi.targetextent.delete"),
+	"iscsi.targetextent.get_instance": method("iscsi.targetextent.get_instance"),
+	"iscsi.targetextent.query":        method("iscsi.targetextent.query"),
+	"iscsi.targetextent.update":       method("iscsi.targetextent.update"),
+
+	// Job
+	"core.get_jobs":  method("core.get_jobs"),
+	"core.job_abort": method("core.job_abort"),
+	"core.job_wait":  method("core.job_wait"),
+
+	// NetworkService
+	"network.general.summary": method("network.general.summary"),
+
+	// NFSService
+	"nfs.config":               method("nfs.config"),
+	"nfs.get_nfs3_clients":     method("nfs.get_nfs3_clients"),
+	"nfs.get_nfs4_clients":     method("nfs.get_nfs4_clients"),
+	"nfs.update":               method("nfs.update"),
+	"sharing.nfs.create":       method("sharing.nfs.create"),
+	"sharing.nfs.delete":       method("sharing.nfs.delete"),
+	"sharing.nfs.get_instance": method("sharing.nfs.get_instance"),
+	"sharing.nfs.query":        method("sharing.nfs.query"),
+	"sharing.nfs.update":       method("sharing.nfs.update"),
+
+	// PoolService (pool.query is shared with DatasetService above)
+	"pool.attach":       method("pool.attach"),
+	"pool.create":       method("pool.create"),
+	"pool.detach":       method("pool.detach"),
+	"pool.expand":       method("pool.expand"),
+	"pool.export":       method("pool.export"),
+	"pool.get_instance": method("pool.get_instance"),
+	"pool.import_find":  method("pool.import_find"),
+	"pool.import_pool":  method("pool.import_pool"),
+	"pool.offline":      method("pool.offline"),
+	"pool.online":       method("pool.online"),
+	"pool.remove":       method("pool.remove"),
+	"pool.replace":      method("pool.replace"),
+
+	// ReplicationService
+	"replication.config.config":                   method("replication.config.config"),
+	"replication.config.update":                   method("replication.config.update"),
+	"replication.count_eligible_manual_snapshots": method("replication.count_eligible_manual_snapshots"),
+	"replication.create":                          method("replication.create"),
+	"replication.delete":                          method("replication.delete"),
+	"replication.get_instance":                    method("replication.get_instance"),
+	"replication.list_datasets":                   method("replication.list_datasets"),
+	"replication.list_naming_schemas":             method("replication.list_naming_schemas"),
+	"replication.query":                           method("replication.query"),
+	"replication.run":                             method("replication.run"),
+	"replication.target_unmatched_snapshots":      method("replication.target_unmatched_snapshots"),
+	"replication.update":                          method("replication.update"),
+
+	// ReportingService
+	"reporting.netdata_get_data": method("reporting.netdata_get_data"),
+	"reporting.netdata_graphs":   method("reporting.netdata_graphs"),
+	"reporting.realtime":         method("reporting.realtime"),
+
+	// RsyncTaskService
+	"rsynctask.create":       method("rsynctask.create"),
+	"rsynctask.delete":       method("rsynctask.delete"),
+	"rsynctask.get_instance": method("rsynctask.get_instance"),
+	"rsynctask.query":        method("rsynctask.query"),
+	"rsynctask.run":          method("rsynctask.run"),
+	"rsynctask.update":       method("rsynctask.update"),
+
+	// ScrubService
+	"pool.resilver.config":    method("pool.resilver.config"),
+	"pool.resilver.update":    method("pool.resilver.update"),
+	"pool.scrub.create":       method("pool.scrub.create"),
+	"pool.scrub.delete":       method("pool.scrub.delete"),
+	"pool.scrub.get_instance": method("pool.scrub.get_instance"),
+	"pool.scrub.query":        method("pool.scrub.query"),
+	"pool.scrub.run":          method("pool.scrub.run"),
+	"pool.scrub.scrub":        method("pool.scrub.scrub"),
+	"pool.scrub.update":       method("pool.scrub.update"),
+
+	// SMBService
+	"sharing.smb.create":       method("sharing.smb.create"),
+	"sharing.smb.delete":       method("sharing.smb.delete"),
+	"sharing.smb.get_instance": method("sharing.smb.get_instance"),
+	"sharing.smb.getacl":       method("sharing.smb.getacl"),
+	"sharing.smb.presets":      method("sharing.smb.presets"),
+	"sharing.smb.query":        method("sharing.smb.query"),
+	"sharing.smb.setacl":       method("sharing.smb.setacl"),
+	"sharing.smb.update":       method("sharing.smb.update"),
+	"smb.config":               method("smb.config"),
+	"smb.update":               method("smb.update"),
+
+	// SnapshotService: zfs.snapshot.* was renamed to pool.snapshot.* in 25.10.
+	"zfs.snapshot.clone":  renamed("zfs.snapshot.clone", "pool.snapshot.clone", version2510),
+	"zfs.snapshot.create": renamed("zfs.snapshot.create", "pool.snapshot.create", version2510),
+	"zfs.snapshot.delete": renamed("zfs.snapshot.delete", "pool.snapshot.delete", version2510),
+	"zfs.snapshot.hold":   renamed("zfs.snapshot.hold", "pool.snapshot.hold", version2510),
+	"zfs.snapshot.query": {
+		{Method: "zfs.snapshot.query", MaxVersion: version2510},
+		{Method: "pool.snapshot.query", MinVersion: version2510, Params: snapshotQueryParams},
+	},
+	"zfs.snapshot.release":  renamed("zfs.snapshot.release", "pool.snapshot.release", version2510),
+	"zfs.snapshot.rollback": renamed("zfs.snapshot.rollback", "pool.snapshot.rollback", version2510),
+
+	// SnapshotTaskService
+	"pool.snapshottask.create":                           method("pool.snapshottask.create"),
+	"pool.snapshottask.delete":                           method("pool.snapshottask.delete"),
+	"pool.snapshottask.delete_will_change_retention_for": method("pool.snapshottask.delete_will_change_retention_for"),
+	"pool.snapshottask.get_instance":                     method("pool.snapshottask.get_instance"),
+	"pool.snapshottask.max_count":                        method("pool.snapshottask.max_count"),
+	"pool.snapshottask.max_total_count":                  method("pool.snapshottask.max_total_count"),
+	"pool.snapshottask.query":                            method("pool.snapshottask.query"),
+	"pool.snapshottask.run":                              method("pool.snapshottask.run"),
+	"pool.snapshottask.update":                           method("pool.snapshottask.update"),
+	"pool.snapshottask.update_will_change_retention_for": method("pool.snapshottask.update_will_change_retention_for"),
+
+	// SystemService
+	"system.info":    method("system.info"),
+	"system.version": method("system.version"),
+
+	// UserService
+	"user.create":        method("user.create"),
+	"user.delete":        method("user.delete"),
+	"user.get_instance":  method("user.get_instance"),
+	"user.get_next_uid":  method("user.get_next_uid"),
+	"user.query":         method("user.query"),
+	"user.set_password":  method("user.set_password"),
+	"user.shell_choices": method("user.shell_choices"),
+	"user.update":        method("user.update"),
+
+	// VirtService
+	"virt.global.config":          since("virt.global.config", version2504),
+	"virt.global.update":          since("virt.global.update", version2504),
+	"virt.instance.create":        since("virt.instance.create", version2504),
+	"virt.instance.delete":        since("virt.instance.delete", version2504),
+	"virt.instance.device_add":    since("virt.instance.device_add", version2504),
+	"virt.instance.device_delete": since("virt.instance.device_delete", version2504),
+	"virt.instance.device_list":   since("virt.instance.device_list", version2504),
+	"virt.instance.get_instance":  since("virt.instance.get_instance", version2504),
+	"virt.instance.query":         since("virt.instance.query", version2504),
+	"virt.instance.start":         since("virt.instance.start", version2504),
+	"virt.instance.stop":          since("virt.instance.stop", version2504),
+	"virt.instance.update":        since("virt.instance.update", version2504),
+
+	// VMService
+	"vm.create":        method("vm.create"),
+	"vm.delete":        method("vm.delete"),
+	"vm.device.create": method("vm.device.create"),
+	"vm.device.delete": method("vm.device.delete"),
+	"vm.device.query":  method("vm.device.query"),
+	"vm.device.update": method("vm.device.update"),
+	"vm.get_instance":  method("vm.get_instance"),
+	"vm.start":         method("vm.start"),
+	"vm.stop":          method("vm.stop"),
+	"vm.update":        method("vm.update"),
+}
+
+// method declares an operation available on all versions.
+func method(name string) []MethodSpec {
+	return []MethodSpec{{Method: name}}
+}
+
+// since declares an operation introduced in version min.
+func since(name string, min Version) []MethodSpec {
+	return []MethodSpec{{Method: name, MinVersion: min}}
+}
+
+// renamed declares an operation whose method changed name in version at.
+func renamed(before, after string, at Version) []MethodSpec {
+	return []MethodSpec{
+		{Method: before, MaxVersion: at},
+		{Method: after, MinVersion: at},
+	}
+}
+
+// ResolveMethod returns the API method implementing op on version v.
+// A zero Version (unknown) resolves to the first declared method.
+// Operations without a declaration resolve to themselves.
+// Returns an error wrapping ErrUnsupportedOnVersion if no declared method
+// covers v.
+func ResolveMethod(v Version, op string) (MethodSpec, error) {
+	specs, ok := methodRegistry[op]
+	if !ok {
+		return MethodSpec{Method: op}, nil
+	}
+	if v.IsZero() {
+		return specs[0], nil
+	}
+	for _, spec := range specs {
+		if spec.supports(v) {
+			return spec, nil
+		}
+	}
+	label := v.Raw
+	if label == "" {
+		label = v.String()
+	}
+	return MethodSpec{}, fmt.Errorf("%s: %w (%s)", op, ErrUnsupportedOnVersion, label)
+}
+
+// RegisteredOperations returns the sorted names of all registered operations.
+func RegisteredOperations() []string {
+	ops := make([]string, 0, len(methodRegistry))
+	for op := range methodRegistry {
+		ops = append(ops, op)
+	}
+	sort.Strings(ops)
+	return ops
+}
+
+// OperationMethods returns the methods declared for op across all versions,
+// or nil if op is not registered.
+func OperationMethods(op string) []MethodSpec {
+	specs := methodRegistry[op]
+	if specs == nil {
+		return nil
+	}
+	out := make([]MethodSpec, len(specs))
+	copy(out, specs)
+	return out
+}
+
+// callMethod resolves op for version v and calls it.
+func callMethod(ctx context.Context, c Caller, v Version, op string, params any) (json.RawMessage, error) {
+	spec, err := ResolveMethod(v, op)
+	if err != nil {
+		return nil, err
+	}
+	return c.Call(ctx, spec.Method, spec.shapeParams(params))
+}
+
+// callMethodAndWait resolves op for version v and calls it, waiting for the
+// resulting job to complete.
+func callMethodAndWait(ctx context.Context, c AsyncCaller, v Version, op string, params any) (json.RawMessage, error) {
+	spec, err := ResolveMethod(v, op)
+	if err != nil {
+		return nil, err
+	}
+	return c.CallAndWait(ctx, spec.Method, spec.shapeParams(params))
+}
+
+// subscribeMethod resolves op for version v and subscribes to it.
+func subscribeMethod(ctx context.Context, c SubscribeCaller, v Version, op string, params any) (*Subscription[json.RawMessage], error) {
+	spec, err := ResolveMethod(v, op)
+	if err != nil {
+		return nil, err
+	}
+	return c.Subscribe(ctx, spec.Method, spec.shapeParams(params))
+}
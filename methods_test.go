@@ -0,0 +1,211 @@
+package truenas
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/deevus/truenas-go/api"
+)
+
+func TestResolveMethod(t *testing.T) {
+	tests := []struct {
+		name    string
+		version Version
+		op      string
+		want    string
+	}{
+		{
+			name:    "24.10 uses zfs.snapshot",
+			version: Version{Major: 24, Minor: 10},
+			op:      "zfs.snapshot.create",
+			want:    "zfs.snapshot.create",
+		},
+		{
+			name:    "25.04 uses zfs.snapshot",
+			version: Version{Major: 25, Minor: 4, Patch: 2},
+			op:      "zfs.snapshot.query",
+			want:    "zfs.snapshot.query",
+		},
+		{
+			name:    "25.10 uses pool.snapshot",
+			version: Version{Major: 25, Minor: 10},
+			op:      "zfs.snapshot.create",
+			want:    "pool.snapshot.create",
+		},
+		{
+			name:    "26.04 uses pool.snapshot",
+			version: Version{Major: 26, Minor: 4},
+			op:      "zfs.snapshot.release",
+			want:    "pool.snapshot.release",
+		},
+		{
+			name:    "unknown version uses first declared method",
+			version: Version{},
+			op:      "zfs.snapshot.hold",
+			want:    "zfs.snapshot.hold",
+		},
+		{
+			name:    "unbounded method",
+			version: Version{Major: 24, Minor: 4},
+			op:      "cronjob.create",
+			want:    "cronjob.create",
+		},
+		{
+			name:    "unregistered operation resolves to itself",
+			version: Version{Major: 25, Minor: 4},
+			op:      "core.ping",
+			want:    "core.ping",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec, err := ResolveMethod(tt.version, tt.op)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if spec.Method != tt.want {
+				t.Errorf("ResolveMethod() = %q, want %q", spec.Method, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveMethod_UnsupportedOnVersion(t *testing.T) {
+	_, err := ResolveMethod(Version{Major: 24, Minor: 10, Raw: "TrueNAS-SCALE-24.10.2"}, "virt.instance.query")
+	if !errors.Is(err, ErrUnsupportedOnVersion) {
+		t.Fatalf("expected ErrUnsupportedOnVersion, got %v", err)
+	}
+	want := "virt.instance.query: not supported on this TrueNAS version (TrueNAS-SCALE-24.10.2)"
+	if err.Error() != want {
+		t.Errorf("error = %q, want %q", err.Error(), want)
+	}
+}
+
+func TestMethodSpec_Supports(t *testing.T) {
+	spec := MethodSpec{
+		Method:     "x.y",
+		MinVersion: Version{Major: 25, Minor: 4},
+		MaxVersion: Version{Major: 25, Minor: 10},
+	}
+	tests := []struct {
+		version Version
+		want    bool
+	}{
+		{Version{Major: 24, Minor: 10, Patch: 2}, false},
+		{Version{Major: 25, Minor: 4}, true},
+		{Version{Major: 25, Minor: 4, Patch: 2, Build: 4}, true},
+		{Version{Major: 25, Minor: 10}, false},
+		{Version{Major: 25, Minor: 10, Patch: 1}, false},
+	}
+	for _, tt := range tests {
+		if got := spec.supports(tt.version); got != tt.want {
+			t.Errorf("supports(%s) = %v, want %v", tt.version, got, tt.want)
+		}
+	}
+}
+
+func TestRegisteredOperations(t *testing.T) {
+	ops := RegisteredOperations()
+	if !slices.IsSorted(ops) {
+		t.Error("expected sorted operations")
+	}
+	if !slices.Contains(ops, "zfs.snapshot.create") {
+		t.Error("expected zfs.snapshot.create to be registered")
+	}
+}
+
+func TestOperationMethods(t *testing.T) {
+	specs := OperationMethods("zfs.snapshot.delete")
+	if len(specs) != 2 {
+		t.Fatalf("expected 2 specs, got %d", len(specs))
+	}
+	if specs[0].Method != "zfs.snapshot.delete" || specs[1].Method != "pool.snapshot.delete" {
+		t.Errorf("unexpected methods: %q, %q", specs[0].Method, specs[1].Method)
+	}
+
+	specs[0].Method = "mutated"
+	if OperationMethods("zfs.snapshot.delete")[0].Method != "zfs.snapshot.delete" {
+		t.Error("expected OperationMethods to return a copy")
+	}
+
+	if got := OperationMethods("nope.nope"); got != nil {
+		t.Errorf("expected nil for unregistered operation, got %v", got)
+	}
+}
+
+// TestMethodRegistry_MatchesAPI checks that every registered method valid on
+// 25.04 exists in the embedded 25.04 API.
+func TestMethodRegistry_MatchesAPI(t *testing.T) {
+	methods, err := api.Methods("25.04")
+	if err != nil {
+		t.Fatalf("load api methods: %v", err)
+	}
+	// Event sources and private methods are not part of the method catalog.
+	skip := map[string]bool{
+		"app.container_log_follow": true,
+		"app.stats":                true,
+		"filesystem.file_receive":  true,
+		"reporting.realtime":       true,
+	}
+	v := Version{Major: 25, Minor: 4}
+	for _, op := range RegisteredOperations() {
+		if skip[op] {
+			continue
+		}
+		spec, err := ResolveMethod(v, op)
+		if err != nil {
+			t.Errorf("%s: %v", op, err)
+			continue
+		}
+		if _, ok := methods[spec.Method]; !ok {
+			t.Errorf("%s: method %q not in 25.04 API", op, spec.Method)
+		}
+	}
+}
+
+func TestCallMethod_ShapesParams(t *testing.T) {
+	methodRegistry["test.shape"] = []MethodSpec{{
+		Method: "test.shaped",
+		Params: func(params any) any { return []any{params, "extra"} },
+	}}
+	t.Cleanup(func() { delete(methodRegistry, "test.shape") })
+
+	var gotMethod string
+	var gotParams any
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			gotMethod = method
+			gotParams = params
+			return nil, nil
+		},
+	}
+
+	if _, err := callMethod(context.Background(), mock, Version{}, "test.shape", "x"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotMethod != "test.shaped" {
+		t.Errorf("method = %q, want test.shaped", gotMethod)
+	}
+	if p, ok := gotParams.([]any); !ok || len(p) != 2 || p[0] != "x" || p[1] != "extra" {
+		t.Errorf("params = %#v, want []any{\"x\", \"extra\"}", gotParams)
+	}
+}
+
+func TestCallMethod_UnsupportedSkipsCall(t *testing.T) {
+	mock := &mockAsyncCaller{}
+	v := Version{Major: 24, Minor: 4}
+
+	if _, err := callMethod(context.Background(), mock, v, "app.query", nil); !errors.Is(err, ErrUnsupportedOnVersion) {
+		t.Errorf("callMethod: expected ErrUnsupportedOnVersion, got %v", err)
+	}
+	if _, err := callMethodAndWait(context.Background(), mock, v, "app.start", "x"); !errors.Is(err, ErrUnsupportedOnVersion) {
+		t.Errorf("callMethodAndWait: expected ErrUnsupportedOnVersion, got %v", err)
+	}
+	if len(mock.calls) != 0 {
+		t.Errorf("expected no API calls for unsupported operation, got %d", len(mock.calls))
+	}
+}
@@ -138,3 +138,17 @@ func (m *mockSubscribeCaller) Subscribe(ctx context.Context, collection string,
 	}
 	return nil, nil
 }
+
+// mockEventSubscriber is a test double for the EventSubscriber interface.
+type mockEventSubscriber struct {
+	mockSubscribeCaller
+	subscribeEventsFunc func(ctx context.Context, collection string, params any) (*Subscription[json.RawMessage], error)
+}
+
+func (m *mockEventSubscriber) SubscribeEvents(ctx context.Context, collection string, params any) (*Subscription[json.RawMessage], error) {
+	m.calls = append(m.calls, mockCall{Method: "SubscribeEvents", Params: collection})
+	if m.subscribeEventsFunc != nil {
+		return m.subscribeEventsFunc(ctx, collection, params)
+	}
+	return nil, nil
+}
@@ -33,7 +33,7 @@ func NewNetworkService(c Caller, v Version) *NetworkService {
 // GetSummary returns general network information including default routes,
 // nameservers, and per-interface IP addresses.
 func (s *NetworkService) GetSummary(ctx context.Context) (*NetworkSummary, error) {
-	result, err := s.client.Call(ctx, "network.general.summary", nil)
+	result, err := callMethod(ctx, s.client, s.version, "network.general.summary", nil)
 	if err != nil {
 		return nil, err
 	}
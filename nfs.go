@@ -0,0 +1,74 @@
+package truenas
+
+// NFSSecurity is a security flavor an NFS share can be mounted with.
+type NFSSecurity string
+
+const (
+	NFSSecuritySys   NFSSecurity = "SYS"
+	NFSSecurityKRB5  NFSSecurity = "KRB5"
+	NFSSecurityKRB5I NFSSecurity = "KRB5I"
+	NFSSecurityKRB5P NFSSecurity = "KRB5P"
+)
+
+// NFSProtocol is an NFS protocol version the server can offer.
+type NFSProtocol string
+
+const (
+	NFSProtocolV3 NFSProtocol = "NFSV3"
+	NFSProtocolV4 NFSProtocol = "NFSV4"
+)
+
+// NFSShareResponse represents an NFS share from the sharing.nfs query API.
+type NFSShareResponse struct {
+	ID              int64    `json:"id"`
+	Path            string   `json:"path"`
+	Aliases         []string `json:"aliases"`
+	Comment         string   `json:"comment"`
+	Networks        []string `json:"networks"`
+	Hosts           []string `json:"hosts"`
+	RO              bool     `json:"ro"`
+	MaprootUser     *string  `json:"maproot_user"`
+	MaprootGroup    *string  `json:"maproot_group"`
+	MapallUser      *string  `json:"mapall_user"`
+	MapallGroup     *string  `json:"mapall_group"`
+	Security        []string `json:"security"`
+	Enabled         bool     `json:"enabled"`
+	Locked          bool     `json:"locked"`
+	ExposeSnapshots bool     `json:"expose_snapshots"`
+}
+
+// NFSConfigResponse represents the NFS service configuration.
+type NFSConfigResponse struct {
+	ID              int64    `json:"id"`
+	Servers         *int64   `json:"servers"`
+	AllowNonroot    bool     `json:"allow_nonroot"`
+	Protocols       []string `json:"protocols"`
+	V4Krb           bool     `json:"v4_krb"`
+	V4Domain        string   `json:"v4_domain"`
+	BindIP          []string `json:"bindip"`
+	MountdPort      *int64   `json:"mountd_port"`
+	RpcstatdPort    *int64   `json:"rpcstatd_port"`
+	RpclockdPort    *int64   `json:"rpclockd_port"`
+	MountdLog       bool     `json:"mountd_log"`
+	StatdLockdLog   bool     `json:"statd_lockd_log"`
+	V4KrbEnabled    bool     `json:"v4_krb_enabled"`
+	UserdManageGids bool     `json:"userd_manage_gids"`
+	KeytabHasNFSSPN bool     `json:"keytab_has_nfs_spn"`
+	ManagedNfsd     bool     `json:"managed_nfsd"`
+	RDMA            bool     `json:"rdma"`
+}
+
+// NFS3ClientResponse represents one entry of nfs.get_nfs3_clients, read from
+// the server's rmtab.
+type NFS3ClientResponse struct {
+	IP     string `json:"ip"`
+	Export string `json:"export"`
+}
+
+// NFS4ClientResponse represents one entry of nfs.get_nfs4_clients, read from
+// the kernel's nfsd client table.
+type NFS4ClientResponse struct {
+	ID    string         `json:"id"`
+	Info  map[string]any `json:"info"`
+	State map[string]any `json:"state"`
+}
@@ -0,0 +1,537 @@
+package truenas
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// NFSShare is the user-facing representation of a TrueNAS NFS share.
+type NFSShare struct {
+	ID       int64
+	Path     string
+	Comment  string
+	Networks []string // Allowed networks in CIDR notation; empty = all
+	Hosts    []string // Allowed IPs or hostnames; empty = all
+	ReadOnly bool
+	// MaprootUser and MaprootGroup map the client's root user to a local
+	// user and group. Empty when unset.
+	MaprootUser  string
+	MaprootGroup string
+	// MapallUser and MapallGroup map every client user to a local user and
+	// group. Empty when unset.
+	MapallUser      string
+	MapallGroup     string
+	Security        []NFSSecurity
+	Enabled         bool
+	ExposeSnapshots bool
+	Locked          bool // The share's dataset is locked
+}
+
+// CreateNFSShareOpts contains options for creating an NFS share.
+// Pointer fields use the server default when nil. Bool fields are only sent
+// when true, and string fields when non-empty.
+type CreateNFSShareOpts struct {
+	Path            string
+	Comment         string
+	Networks        []string
+	Hosts           []string
+	ReadOnly        bool
+	MaprootUser     string
+	MaprootGroup    string
+	MapallUser      string
+	MapallGroup     string
+	Security        []NFSSecurity
+	Enabled         *bool
+	ExposeSnapshots bool
+}
+
+// UpdateNFSShareOpts contains options for updating an NFS share.
+// Pointer fields distinguish "don't change" (nil) from "set to zero/empty".
+// Slice fields use nil to mean "don't change"; an empty, non-nil slice
+// clears the list.
+type UpdateNFSShareOpts struct {
+	Path            string // Empty = don't change
+	Comment         *string
+	Networks        []string
+	Hosts           []string
+	ReadOnly        *bool
+	MaprootUser     *string // Empty string = unset
+	MaprootGroup    *string // Empty string = unset
+	MapallUser      *string // Empty string = unset
+	MapallGroup     *string // Empty string = unset
+	Security        []NFSSecurity
+	Enabled         *bool
+	ExposeSnapshots *bool
+}
+
+// NFSConfig is the user-facing representation of the NFS service
+// configuration.
+type NFSConfig struct {
+	// Servers is the number of nfsd threads; 0 when managed by the server.
+	Servers         int64
+	ManagedServers  bool
+	AllowNonroot    bool
+	Protocols       []NFSProtocol
+	V4Kerberos      bool
+	V4Domain        string
+	BindIP          []string
+	MountdPort      int64 // 0 = unset
+	RPCStatdPort    int64 // 0 = unset
+	RPCLockdPort    int64 // 0 = unset
+	MountdLog       bool
+	StatdLockdLog   bool
+	UserdManageGIDs bool
+	RDMA            bool
+	// V4KerberosEnabled reports whether NFSv4 Kerberos is in effect, which
+	// is also the case when the keytab has an NFS SPN.
+	V4KerberosEnabled bool
+	KeytabHasNFSSPN   bool
+}
+
+// UpdateNFSConfigOpts contains options for updating the NFS service
+// configuration.
+// Pointer fields distinguish "don't change" (nil) from "set to zero/empty".
+// Slice fields use nil to mean "don't change".
+type UpdateNFSConfigOpts struct {
+	Servers         *int64 // 0 = let the server manage the count
+	AllowNonroot    *bool
+	Protocols       []NFSProtocol
+	V4Kerberos      *bool
+	V4Domain        *string
+	BindIP          []string
+	MountdPort      *int64 // 0 = unset
+	RPCStatdPort    *int64 // 0 = unset
+	RPCLockdPort    *int64 // 0 = unset
+	MountdLog       *bool
+	StatdLockdLog   *bool
+	UserdManageGIDs *bool
+	RDMA            *bool
+}
+
+// NFS3Client is an NFSv3 mount recorded in the server's rmtab. Entries may
+// be stale, since NFSv3 clients are not required to report unmounts.
+type NFS3Client struct {
+	IP     string
+	Export string
+}
+
+// NFS4Client is a client connected over NFSv4.
+type NFS4Client struct {
+	ID      string
+	Address string // Client address and port, from Info
+	// Info and State hold the kernel's client and open-state details as
+	// reported by the server.
+	Info  map[string]any
+	State map[string]any
+}
+
+// NFSService provides typed methods for the sharing.nfs.* and nfs.* API
+// namespaces.
+type NFSService struct {
+	client  Caller
+	version Version
+}
+
+// NewNFSService creates a new NFSService.
+func NewNFSService(c Caller, v Version) *NFSService {
+	return &NFSService{client: c, version: v}
+}
+
+// CreateShare creates an NFS share and returns the full object.
+func (s *NFSService) CreateShare(ctx context.Context, opts CreateNFSShareOpts) (*NFSShare, error) {
+	params := nfsShareCreateParams(opts)
+	result, err := callMethod(ctx, s.client, s.version, "sharing.nfs.create", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var createResp struct {
+		ID int64 `json:"id"`
+	}
+	if err := json.Unmarshal(result, &createResp); err != nil {
+		return nil, fmt.Errorf("parse create response: %w", err)
+	}
+
+	return s.GetShare(ctx, createResp.ID)
+}
+
+// GetShare returns an NFS share by ID, or nil if not found.
+func (s *NFSService) GetShare(ctx context.Context, id int64) (*NFSShare, error) {
+	result, err := callMethod(ctx, s.client, s.version, "sharing.nfs.get_instance", id)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var resp NFSShareResponse
+	if err := json.Unmarshal(result, &resp); err != nil {
+		return nil, fmt.Errorf("parse get_instance response: %w", err)
+	}
+
+	share := nfsShareFromResponse(resp)
+	return &share, nil
+}
+
+// GetShareByPath returns the NFS share of a path, or nil if not found.
+func (s *NFSService) GetShareByPath(ctx context.Context, path string) (*NFSShare, error) {
+	filter := [][]any{{"path", "=", path}}
+	result, err := callMethod(ctx, s.client, s.version, "sharing.nfs.query", filter)
+	if err != nil {
+		return nil, err
+	}
+
+	shares, err := parseNFSShares(result)
+	if err != nil {
+		return nil, err
+	}
+	if len(shares) == 0 {
+		return nil, nil
+	}
+	return &shares[0], nil
+}
+
+// ListShares returns all NFS shares.
+func (s *NFSService) ListShares(ctx context.Context) ([]NFSShare, error) {
+	result, err := callMethod(ctx, s.client, s.version, "sharing.nfs.query", nil)
+	if err != nil {
+		return nil, err
+	}
+	return parseNFSShares(result)
+}
+
+// parseNFSShares parses a sharing.nfs.query response.
+func parseNFSShares(result json.RawMessage) ([]NFSShare, error) {
+	var responses []NFSShareResponse
+	if err := json.Unmarshal(result, &responses); err != nil {
+		return nil, fmt.Errorf("parse query response: %w", err)
+	}
+
+	shares := make([]NFSShare, len(responses))
+	for i, resp := range responses {
+		shares[i] = nfsShareFromResponse(resp)
+	}
+	return shares, nil
+}
+
+// UpdateShare updates an NFS share and returns the full object.
+func (s *NFSService) UpdateShare(ctx context.Context, id int64, opts UpdateNFSShareOpts) (*NFSShare, error) {
+	params := nfsShareUpdateParams(opts)
+	_, err := callMethod(ctx, s.client, s.version, "sharing.nfs.update", []any{id, params})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetShare(ctx, id)
+}
+
+// DeleteShare deletes an NFS share by ID. The exported path is not touched.
+func (s *NFSService) DeleteShare(ctx context.Context, id int64) error {
+	_, err := callMethod(ctx, s.client, s.version, "sharing.nfs.delete", id)
+	return err
+}
+
+// GetConfig returns the NFS service configuration.
+func (s *NFSService) GetConfig(ctx context.Context) (*NFSConfig, error) {
+	result, err := callMethod(ctx, s.client, s.version, "nfs.config", nil)
+	if err != nil {
+		return nil, err
+	}
+	return parseNFSConfig(result, "config")
+}
+
+// UpdateConfig updates the NFS service configuration and returns it.
+func (s *NFSService) UpdateConfig(ctx context.Context, opts UpdateNFSConfigOpts) (*NFSConfig, error) {
+	result, err := callMethod(ctx, s.client, s.version, "nfs.update", nfsConfigUpdateParams(opts))
+	if err != nil {
+		return nil, err
+	}
+	return parseNFSConfig(result, "update")
+}
+
+// ListNFS3Clients returns the NFSv3 mounts recorded by the server.
+func (s *NFSService) ListNFS3Clients(ctx context.Context) ([]NFS3Client, error) {
+	result, err := callMethod(ctx, s.client, s.version, "nfs.get_nfs3_clients", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responses []NFS3ClientResponse
+	if err := json.Unmarshal(result, &responses); err != nil {
+		return nil, fmt.Errorf("parse get_nfs3_clients response: %w", err)
+	}
+
+	clients := make([]NFS3Client, len(responses))
+	for i, resp := range responses {
+		clients[i] = NFS3Client{IP: resp.IP, Export: resp.Export}
+	}
+	return clients, nil
+}
+
+// ListNFS4Clients returns the clients connected over NFSv4.
+func (s *NFSService) ListNFS4Clients(ctx context.Context) ([]NFS4Client, error) {
+	result, err := callMethod(ctx, s.client, s.version, "nfs.get_nfs4_clients", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responses []NFS4ClientResponse
+	if err := json.Unmarshal(result, &responses); err != nil {
+		return nil, fmt.Errorf("parse get_nfs4_clients response: %w", err)
+	}
+
+	clients := make([]NFS4Client, len(responses))
+	for i, resp := range responses {
+		clients[i] = nfs4ClientFromResponse(resp)
+	}
+	return clients, nil
+}
+
+func parseNFSConfig(result json.RawMessage, method string) (*NFSConfig, error) {
+	var resp NFSConfigResponse
+	if err := json.Unmarshal(result, &resp); err != nil {
+		return nil, fmt.Errorf("parse %s response: %w", method, err)
+	}
+
+	config := nfsConfigFromResponse(resp)
+	return &config, nil
+}
+
+// nfsShareFromResponse converts a wire-format NFSShareResponse to a
+// user-facing NFSShare.
+func nfsShareFromResponse(resp NFSShareResponse) NFSShare {
+	share := NFSShare{
+		ID:              resp.ID,
+		Path:            resp.Path,
+		Comment:         resp.Comment,
+		Networks:        resp.Networks,
+		Hosts:           resp.Hosts,
+		ReadOnly:        resp.RO,
+		Enabled:         resp.Enabled,
+		ExposeSnapshots: resp.ExposeSnapshots,
+		Locked:          resp.Locked,
+	}
+	if resp.MaprootUser != nil {
+		share.MaprootUser = *resp.MaprootUser
+	}
+	if resp.MaprootGroup != nil {
+		share.MaprootGroup = *resp.MaprootGroup
+	}
+	if resp.MapallUser != nil {
+		share.MapallUser = *resp.MapallUser
+	}
+	if resp.MapallGroup != nil {
+		share.MapallGroup = *resp.MapallGroup
+	}
+	if len(resp.Security) > 0 {
+		share.Security = make([]NFSSecurity, len(resp.Security))
+		for i, sec := range resp.Security {
+			share.Security[i] = NFSSecurity(sec)
+		}
+	}
+	return share
+}
+
+// nfsConfigFromResponse converts a wire-format NFSConfigResponse to a
+// user-facing NFSConfig.
+func nfsConfigFromResponse(resp NFSConfigResponse) NFSConfig {
+	config := NFSConfig{
+		ManagedServers:    resp.ManagedNfsd,
+		AllowNonroot:      resp.AllowNonroot,
+		V4Kerberos:        resp.V4Krb,
+		V4Domain:          resp.V4Domain,
+		BindIP:            resp.BindIP,
+		MountdLog:         resp.MountdLog,
+		StatdLockdLog:     resp.StatdLockdLog,
+		UserdManageGIDs:   resp.UserdManageGids,
+		RDMA:              resp.RDMA,
+		V4KerberosEnabled: resp.V4KrbEnabled,
+		KeytabHasNFSSPN:   resp.KeytabHasNFSSPN,
+	}
+	if resp.Servers != nil {
+		config.Servers = *resp.Servers
+	}
+	if resp.MountdPort != nil {
+		config.MountdPort = *resp.MountdPort
+	}
+	if resp.RpcstatdPort != nil {
+		config.RPCStatdPort = *resp.RpcstatdPort
+	}
+	if resp.RpclockdPort != nil {
+		config.RPCLockdPort = *resp.RpclockdPort
+	}
+	if len(resp.Protocols) > 0 {
+		config.Protocols = make([]NFSProtocol, len(resp.Protocols))
+		for i, p := range resp.Protocols {
+			config.Protocols[i] = NFSProtocol(p)
+		}
+	}
+	return config
+}
+
+// nfs4ClientFromResponse converts a wire-format NFS4ClientResponse to a
+// user-facing NFS4Client.
+func nfs4ClientFromResponse(resp NFS4ClientResponse) NFS4Client {
+	client := NFS4Client{
+		ID:    resp.ID,
+		Info:  resp.Info,
+		State: resp.State,
+	}
+	if addr, ok := resp.Info["address"].(string); ok {
+		client.Address = addr
+	}
+	return client
+}
+
+// nfsShareCreateParams builds API parameters for sharing.nfs.create.
+func nfsShareCreateParams(opts CreateNFSShareOpts) map[string]any {
+	params := map[string]any{
+		"path": opts.Path,
+	}
+	if opts.Comment != "" {
+		params["comment"] = opts.Comment
+	}
+	if len(opts.Networks) > 0 {
+		params["networks"] = opts.Networks
+	}
+	if len(opts.Hosts) > 0 {
+		params["hosts"] = opts.Hosts
+	}
+	if opts.ReadOnly {
+		params["ro"] = true
+	}
+	if opts.MaprootUser != "" {
+		params["maproot_user"] = opts.MaprootUser
+	}
+	if opts.MaprootGroup != "" {
+		params["maproot_group"] = opts.MaprootGroup
+	}
+	if opts.MapallUser != "" {
+		params["mapall_user"] = opts.MapallUser
+	}
+	if opts.MapallGroup != "" {
+		params["mapall_group"] = opts.MapallGroup
+	}
+	if len(opts.Security) > 0 {
+		params["security"] = nfsSecurityParams(opts.Security)
+	}
+	if opts.Enabled != nil {
+		params["enabled"] = *opts.Enabled
+	}
+	if opts.ExposeSnapshots {
+		params["expose_snapshots"] = true
+	}
+	return params
+}
+
+// nfsShareUpdateParams builds API parameters for sharing.nfs.update.
+func nfsShareUpdateParams(opts UpdateNFSShareOpts) map[string]any {
+	params := map[string]any{}
+	if opts.Path != "" {
+		params["path"] = opts.Path
+	}
+	if opts.Comment != nil {
+		params["comment"] = *opts.Comment
+	}
+	if opts.Networks != nil {
+		params["networks"] = opts.Networks
+	}
+	if opts.Hosts != nil {
+		params["hosts"] = opts.Hosts
+	}
+	if opts.ReadOnly != nil {
+		params["ro"] = *opts.ReadOnly
+	}
+	setNullableString(params, "maproot_user", opts.MaprootUser)
+	setNullableString(params, "maproot_group", opts.MaprootGroup)
+	setNullableString(params, "mapall_user", opts.MapallUser)
+	setNullableString(params, "mapall_group", opts.MapallGroup)
+	if opts.Security != nil {
+		params["security"] = nfsSecurityParams(opts.Security)
+	}
+	if opts.Enabled != nil {
+		params["enabled"] = *opts.Enabled
+	}
+	if opts.ExposeSnapshots != nil {
+		params["expose_snapshots"] = *opts.ExposeSnapshots
+	}
+	return params
+}
+
+// nfsConfigUpdateParams builds API parameters for nfs.update.
+func nfsConfigUpdateParams(opts UpdateNFSConfigOpts) map[string]any {
+	params := map[string]any{}
+	setNullableInt64(params, "servers", opts.Servers)
+	if opts.AllowNonroot != nil {
+		params["allow_nonroot"] = *opts.AllowNonroot
+	}
+	if opts.Protocols != nil {
+		protocols := make([]string, len(opts.Protocols))
+		for i, p := range opts.Protocols {
+			protocols[i] = string(p)
+		}
+		params["protocols"] = protocols
+	}
+	if opts.V4Kerberos != nil {
+		params["v4_krb"] = *opts.V4Kerberos
+	}
+	if opts.V4Domain != nil {
+		params["v4_domain"] = *opts.V4Domain
+	}
+	if opts.BindIP != nil {
+		params["bindip"] = opts.BindIP
+	}
+	setNullableInt64(params, "mountd_port", opts.MountdPort)
+	setNullableInt64(params, "rpcstatd_port", opts.RPCStatdPort)
+	setNullableInt64(params, "rpclockd_port", opts.RPCLockdPort)
+	if opts.MountdLog != nil {
+		params["mountd_log"] = *opts.MountdLog
+	}
+	if opts.StatdLockdLog != nil {
+		params["statd_lockd_log"] = *opts.StatdLockdLog
+	}
+	if opts.UserdManageGIDs != nil {
+		params["userd_manage_gids"] = *opts.UserdManageGIDs
+	}
+	if opts.RDMA != nil {
+		params["rdma"] = *opts.RDMA
+	}
+	return params
+}
+
+// nfsSecurityParams converts security flavors to their API form.
+func nfsSecurityParams(security []NFSSecurity) []string {
+	out := make([]string, len(security))
+	for i, sec := range security {
+		out[i] = string(sec)
+	}
+	return out
+}
+
+// setNullableString sets params[key] from v unless v is nil. An empty string
+// is sent as null.
+func setNullableString[S ~string](params map[string]any, key string, v *S) {
+	if v == nil {
+		return
+	}
+	if *v == "" {
+		params[key] = nil
+	} else {
+		params[key] = string(*v)
+	}
+}
+
+// setNullableInt64 sets params[key] from v unless v is nil. Zero is sent as
+// null.
+func setNullableInt64(params map[string]any, key string, v *int64) {
+	if v == nil {
+		return
+	}
+	if *v == 0 {
+		params[key] = nil
+	} else {
+		params[key] = *v
+	}
+}
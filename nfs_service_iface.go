@@ -0,0 +1,105 @@
+package truenas
+
+import "context"
+
+// NFSServiceAPI defines the interface for NFS share and service operations.
+type NFSServiceAPI interface {
+	CreateShare(ctx context.Context, opts CreateNFSShareOpts) (*NFSShare, error)
+	GetShare(ctx context.Context, id int64) (*NFSShare, error)
+	GetShareByPath(ctx context.Context, path string) (*NFSShare, error)
+	ListShares(ctx context.Context) ([]NFSShare, error)
+	UpdateShare(ctx context.Context, id int64, opts UpdateNFSShareOpts) (*NFSShare, error)
+	DeleteShare(ctx context.Context, id int64) error
+	GetConfig(ctx context.Context) (*NFSConfig, error)
+	UpdateConfig(ctx context.Context, opts UpdateNFSConfigOpts) (*NFSConfig, error)
+	ListNFS3Clients(ctx context.Context) ([]NFS3Client, error)
+	ListNFS4Clients(ctx context.Context) ([]NFS4Client, error)
+}
+
+// Compile-time checks.
+var _ NFSServiceAPI = (*NFSService)(nil)
+var _ NFSServiceAPI = (*MockNFSService)(nil)
+
+// MockNFSService is a test double for NFSServiceAPI.
+type MockNFSService struct {
+	CreateShareFunc     func(ctx context.Context, opts CreateNFSShareOpts) (*NFSShare, error)
+	GetShareFunc        func(ctx context.Context, id int64) (*NFSShare, error)
+	GetShareByPathFunc  func(ctx context.Context, path string) (*NFSShare, error)
+	ListSharesFunc      func(ctx context.Context) ([]NFSShare, error)
+	UpdateShareFunc     func(ctx context.Context, id int64, opts UpdateNFSShareOpts) (*NFSShare, error)
+	DeleteShareFunc     func(ctx context.Context, id int64) error
+	GetConfigFunc       func(ctx context.Context) (*NFSConfig, error)
+	UpdateConfigFunc    func(ctx context.Context, opts UpdateNFSConfigOpts) (*NFSConfig, error)
+	ListNFS3ClientsFunc func(ctx context.Context) ([]NFS3Client, error)
+	ListNFS4ClientsFunc func(ctx context.Context) ([]NFS4Client, error)
+}
+
+func (m *MockNFSService) CreateShare(ctx context.Context, opts CreateNFSShareOpts) (*NFSShare, error) {
+	if m.CreateShareFunc != nil {
+		return m.CreateShareFunc(ctx, opts)
+	}
+	return nil, nil
+}
+
+func (m *MockNFSService) GetShare(ctx context.Context, id int64) (*NFSShare, error) {
+	if m.GetShareFunc != nil {
+		return m.GetShareFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockNFSService) GetShareByPath(ctx context.Context, path string) (*NFSShare, error) {
+	if m.GetShareByPathFunc != nil {
+		return m.GetShareByPathFunc(ctx, path)
+	}
+	return nil, nil
+}
+
+func (m *MockNFSService) ListShares(ctx context.Context) ([]NFSShare, error) {
+	if m.ListSharesFunc != nil {
+		return m.ListSharesFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockNFSService) UpdateShare(ctx context.Context, id int64, opts UpdateNFSShareOpts) (*NFSShare, error) {
+	if m.UpdateShareFunc != nil {
+		return m.UpdateShareFunc(ctx, id, opts)
+	}
+	return nil, nil
+}
+
+func (m *MockNFSService) DeleteShare(ctx context.Context, id int64) error {
+	if m.DeleteShareFunc != nil {
+		return m.DeleteShareFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockNFSService) GetConfig(ctx context.Context) (*NFSConfig, error) {
+	if m.GetConfigFunc != nil {
+		return m.GetConfigFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockNFSService) UpdateConfig(ctx context.Context, opts UpdateNFSConfigOpts) (*NFSConfig, error) {
+	if m.UpdateConfigFunc != nil {
+		return m.UpdateConfigFunc(ctx, opts)
+	}
+	return nil, nil
+}
+
+func (m *MockNFSService) ListNFS3Clients(ctx context.Context) ([]NFS3Client, error) {
+	if m.ListNFS3ClientsFunc != nil {
+		return m.ListNFS3ClientsFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockNFSService) ListNFS4Clients(ctx context.Context) ([]NFS4Client, error) {
+	if m.ListNFS4ClientsFunc != nil {
+		return m.ListNFS4ClientsFunc(ctx)
+	}
+	return nil, nil
+}
@@ -0,0 +1,50 @@
+package truenas
+
+import (
+	"context"
+	"testing"
+)
+
+func TestMockNFSService_ImplementsInterface(t *testing.T) {
+	var _ NFSServiceAPI = (*NFSService)(nil)
+	var _ NFSServiceAPI = (*MockNFSService)(nil)
+}
+
+func TestMockNFSService_DefaultsToNil(t *testing.T) {
+	mock := &MockNFSService{}
+	ctx := context.Background()
+
+	share, err := mock.GetShare(ctx, 1)
+	if err != nil {
+		t.Fatalf("expected nil error, got: %v", err)
+	}
+	if share != nil {
+		t.Fatalf("expected nil result, got: %v", share)
+	}
+
+	clients, err := mock.ListNFS4Clients(ctx)
+	if err != nil || clients != nil {
+		t.Fatalf("expected nil, nil from ListNFS4Clients, got: %v, %v", clients, err)
+	}
+}
+
+func TestMockNFSService_CallsFunc(t *testing.T) {
+	called := false
+	mock := &MockNFSService{
+		ListNFS3ClientsFunc: func(ctx context.Context) ([]NFS3Client, error) {
+			called = true
+			return []NFS3Client{{IP: "10.0.3.7", Export: "/mnt/tank/k8s"}}, nil
+		},
+	}
+
+	clients, err := mock.ListNFS3Clients(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !called {
+		t.Fatal("expected ListNFS3ClientsFunc to be called")
+	}
+	if len(clients) != 1 || clients[0].IP != "10.0.3.7" {
+		t.Fatalf("unexpected clients: %+v", clients)
+	}
+}
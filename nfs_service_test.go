@@ -0,0 +1,316 @@
+package truenas
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// sampleNFSShareJSON returns a single JSON object response for
+// sharing.nfs.get_instance.
+func sampleNFSShareJSON() json.RawMessage {
+	return json.RawMessage(`{
+		"id": 4,
+		"path": "/mnt/tank/k8s",
+		"aliases": [],
+		"comment": "Kubernetes volumes",
+		"networks": ["10.0.0.0/16"],
+		"hosts": [],
+		"ro": false,
+		"maproot_user": "root",
+		"maproot_group": "wheel",
+		"mapall_user": null,
+		"mapall_group": null,
+		"security": ["SYS", "KRB5P"],
+		"enabled": true,
+		"locked": false,
+		"expose_snapshots": true
+	}`)
+}
+
+func TestNFSService_CreateShare(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return sampleNFSShareJSON(), nil
+		},
+	}
+
+	svc := NewNFSService(mock, Version{})
+	share, err := svc.CreateShare(context.Background(), CreateNFSShareOpts{
+		Path:            "/mnt/tank/k8s",
+		Comment:         "Kubernetes volumes",
+		Networks:        []string{"10.0.0.0/16"},
+		MaprootUser:     "root",
+		MaprootGroup:    "wheel",
+		Security:        []NFSSecurity{NFSSecuritySys, NFSSecurityKRB5P},
+		Enabled:         BoolPtr(true),
+		ExposeSnapshots: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if mock.calls[0].Method != "sharing.nfs.create" {
+		t.Fatalf("expected method sharing.nfs.create, got %s", mock.calls[0].Method)
+	}
+	want := map[string]any{
+		"path":             "/mnt/tank/k8s",
+		"comment":          "Kubernetes volumes",
+		"networks":         []string{"10.0.0.0/16"},
+		"maproot_user":     "root",
+		"maproot_group":    "wheel",
+		"security":         []string{"SYS", "KRB5P"},
+		"enabled":          true,
+		"expose_snapshots": true,
+	}
+	if !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("expected params %v, got %v", want, mock.calls[0].Params)
+	}
+	if mock.calls[1].Method != "sharing.nfs.get_instance" || mock.calls[1].Params != int64(4) {
+		t.Errorf("expected re-read of share 4, got %s %v", mock.calls[1].Method, mock.calls[1].Params)
+	}
+
+	if share.ID != 4 || share.MaprootUser != "root" || share.MapallUser != "" || !share.ExposeSnapshots {
+		t.Errorf("unexpected share: %+v", share)
+	}
+	if !reflect.DeepEqual(share.Security, []NFSSecurity{NFSSecuritySys, NFSSecurityKRB5P}) {
+		t.Errorf("unexpected security: %v", share.Security)
+	}
+}
+
+func TestNFSService_CreateShare_Error(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return nil, errors.New("[EEXIST] sharingnfs_create.path: Export already exists")
+		},
+	}
+
+	svc := NewNFSService(mock, Version{})
+	if _, err := svc.CreateShare(context.Background(), CreateNFSShareOpts{Path: "/mnt/tank/k8s"}); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestNFSService_GetShare_NotFound(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return nil, errors.New("[ENOENT] None: NFS share 9 does not exist")
+		},
+	}
+
+	svc := NewNFSService(mock, Version{})
+	share, err := svc.GetShare(context.Background(), 9)
+	if err != nil || share != nil {
+		t.Errorf("expected nil, nil, got %+v, %v", share, err)
+	}
+}
+
+func TestNFSService_ListShares_And_GetShareByPath(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			if method != "sharing.nfs.query" {
+				t.Errorf("expected method sharing.nfs.query, got %s", method)
+			}
+			return json.RawMessage(`[` + string(sampleNFSShareJSON()) + `]`), nil
+		},
+	}
+
+	svc := NewNFSService(mock, Version{})
+	shares, err := svc.ListShares(context.Background())
+	if err != nil || len(shares) != 1 || shares[0].Path != "/mnt/tank/k8s" {
+		t.Errorf("unexpected result: %+v, %v", shares, err)
+	}
+
+	share, err := svc.GetShareByPath(context.Background(), "/mnt/tank/k8s")
+	if err != nil || share == nil || share.ID != 4 {
+		t.Errorf("unexpected result: %+v, %v", share, err)
+	}
+	if want := [][]any{{"path", "=", "/mnt/tank/k8s"}}; !reflect.DeepEqual(mock.calls[1].Params, want) {
+		t.Errorf("expected filter %v, got %v", want, mock.calls[1].Params)
+	}
+}
+
+func TestNFSService_UpdateShare(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return sampleNFSShareJSON(), nil
+		},
+	}
+
+	svc := NewNFSService(mock, Version{})
+	_, err := svc.UpdateShare(context.Background(), 4, UpdateNFSShareOpts{
+		ReadOnly:    BoolPtr(true),
+		Hosts:       []string{},
+		MaprootUser: StringPtr(""),
+		MapallUser:  StringPtr("nobody"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []any{int64(4), map[string]any{
+		"ro":           true,
+		"hosts":        []string{},
+		"maproot_user": nil,
+		"mapall_user":  "nobody",
+	}}
+	if mock.calls[0].Method != "sharing.nfs.update" || !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("unexpected call: %s %v", mock.calls[0].Method, mock.calls[0].Params)
+	}
+}
+
+func TestNFSService_DeleteShare(t *testing.T) {
+	mock := &mockCaller{}
+
+	svc := NewNFSService(mock, Version{})
+	if err := svc.DeleteShare(context.Background(), 4); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if mock.calls[0].Method != "sharing.nfs.delete" || mock.calls[0].Params != int64(4) {
+		t.Errorf("unexpected call: %s %v", mock.calls[0].Method, mock.calls[0].Params)
+	}
+}
+
+// sampleNFSConfigJSON returns a JSON response for nfs.config.
+func sampleNFSConfigJSON() json.RawMessage {
+	return json.RawMessage(`{
+		"id": 1,
+		"servers": null,
+		"allow_nonroot": false,
+		"protocols": ["NFSV3", "NFSV4"],
+		"v4_krb": false,
+		"v4_domain": "example.com",
+		"bindip": [],
+		"mountd_port": 618,
+		"rpcstatd_port": null,
+		"rpclockd_port": null,
+		"mountd_log": false,
+		"statd_lockd_log": false,
+		"v4_krb_enabled": false,
+		"userd_manage_gids": false,
+		"keytab_has_nfs_spn": false,
+		"managed_nfsd": true,
+		"rdma": false
+	}`)
+}
+
+func TestNFSService_GetConfig(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			if method != "nfs.config" {
+				t.Errorf("expected method nfs.config, got %s", method)
+			}
+			return sampleNFSConfigJSON(), nil
+		},
+	}
+
+	svc := NewNFSService(mock, Version{})
+	config, err := svc.GetConfig(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if config.Servers != 0 || !config.ManagedServers || config.MountdPort != 618 || config.RPCStatdPort != 0 {
+		t.Errorf("unexpected config: %+v", config)
+	}
+	if !reflect.DeepEqual(config.Protocols, []NFSProtocol{NFSProtocolV3, NFSProtocolV4}) || config.V4Domain != "example.com" {
+		t.Errorf("unexpected config: %+v", config)
+	}
+}
+
+func TestNFSService_UpdateConfig(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return sampleNFSConfigJSON(), nil
+		},
+	}
+
+	svc := NewNFSService(mock, Version{})
+	config, err := svc.UpdateConfig(context.Background(), UpdateNFSConfigOpts{
+		Servers:    Int64Ptr(0),
+		Protocols:  []NFSProtocol{NFSProtocolV4},
+		V4Kerberos: BoolPtr(true),
+		V4Domain:   StringPtr("example.com"),
+		MountdPort: Int64Ptr(618),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]any{
+		"servers":     nil,
+		"protocols":   []string{"NFSV4"},
+		"v4_krb":      true,
+		"v4_domain":   "example.com",
+		"mountd_port": int64(618),
+	}
+	if mock.calls[0].Method != "nfs.update" || !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("unexpected call: %s %v", mock.calls[0].Method, mock.calls[0].Params)
+	}
+	// nfs.update returns the new configuration, so no re-read is needed.
+	if len(mock.calls) != 1 || config.V4Domain != "example.com" {
+		t.Errorf("expected a single call returning the config, got %d calls, %+v", len(mock.calls), config)
+	}
+}
+
+func TestNFSService_ListNFS3Clients(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			if method != "nfs.get_nfs3_clients" {
+				t.Errorf("expected method nfs.get_nfs3_clients, got %s", method)
+			}
+			return json.RawMessage(`[{"ip": "10.0.3.7", "export": "/mnt/tank/k8s"}]`), nil
+		},
+	}
+
+	svc := NewNFSService(mock, Version{})
+	clients, err := svc.ListNFS3Clients(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []NFS3Client{{IP: "10.0.3.7", Export: "/mnt/tank/k8s"}}
+	if !reflect.DeepEqual(clients, want) {
+		t.Errorf("expected %+v, got %+v", want, clients)
+	}
+}
+
+func TestNFSService_ListNFS4Clients(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			if method != "nfs.get_nfs4_clients" {
+				t.Errorf("expected method nfs.get_nfs4_clients, got %s", method)
+			}
+			return json.RawMessage(`[{
+				"id": "12",
+				"info": {"clientid": 16045219541785346, "address": "10.0.3.8:883", "status": "confirmed", "minor version": 2},
+				"state": {}
+			}]`), nil
+		},
+	}
+
+	svc := NewNFSService(mock, Version{})
+	clients, err := svc.ListNFS4Clients(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(clients) != 1 || clients[0].ID != "12" || clients[0].Address != "10.0.3.8:883" {
+		t.Fatalf("unexpected clients: %+v", clients)
+	}
+	if clients[0].Info["status"] != "confirmed" {
+		t.Errorf("expected raw info to be kept, got %v", clients[0].Info)
+	}
+}
+
+func TestNFSService_ListNFS4Clients_ParseError(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return json.RawMessage(`not json`), nil
+		},
+	}
+
+	svc := NewNFSService(mock, Version{})
+	if _, err := svc.ListNFS4Clients(context.Background()); err == nil {
+		t.Fatal("expected error")
+	}
+}
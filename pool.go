@@ -0,0 +1,123 @@
+package truenas
+
+// VdevType is the layout of a vdev. The server reports single-disk vdevs
+// and the leaves of redundant vdevs as VdevDisk.
+type VdevType string
+
+const (
+	VdevStripe VdevType = "STRIPE"
+	VdevMirror VdevType = "MIRROR"
+	VdevRAIDZ1 VdevType = "RAIDZ1"
+	VdevRAIDZ2 VdevType = "RAIDZ2"
+	VdevRAIDZ3 VdevType = "RAIDZ3"
+	VdevDRAID1 VdevType = "DRAID1"
+	VdevDRAID2 VdevType = "DRAID2"
+	VdevDRAID3 VdevType = "DRAID3"
+	VdevDisk   VdevType = "DISK"
+)
+
+// PoolDeduplication is the deduplication setting of a new pool's root
+// dataset.
+type PoolDeduplication string
+
+const (
+	PoolDedupOn     PoolDeduplication = "ON"
+	PoolDedupVerify PoolDeduplication = "VERIFY"
+	PoolDedupOff    PoolDeduplication = "OFF"
+)
+
+// ScanFunction is the kind of scan a pool runs.
+type ScanFunction string
+
+const (
+	ScanScrub    ScanFunction = "SCRUB"
+	ScanResilver ScanFunction = "RESILVER"
+)
+
+// ScanState is the state of a pool's most recent scan.
+type ScanState string
+
+const (
+	ScanScanning ScanState = "SCANNING"
+	ScanFinished ScanState = "FINISHED"
+	ScanCanceled ScanState = "CANCELED"
+)
+
+// PoolResponse represents a pool from the pool.query API.
+type PoolResponse struct {
+	ID            int64                `json:"id"`
+	Name          string               `json:"name"`
+	GUID          string               `json:"guid"`
+	Path          string               `json:"path"`
+	Status        string               `json:"status"`
+	Healthy       bool                 `json:"healthy"`
+	Warning       bool                 `json:"warning"`
+	StatusCode    *string              `json:"status_code"`
+	StatusDetail  *string              `json:"status_detail"`
+	IsUpgraded    bool                 `json:"is_upgraded"`
+	Size          int64                `json:"size"`
+	Allocated     int64                `json:"allocated"`
+	Free          int64                `json:"free"`
+	Freeing       int64                `json:"freeing"`
+	Fragmentation *string              `json:"fragmentation"`
+	Autotrim      PropertyValue        `json:"autotrim"`
+	Scan          PoolScanResponse     `json:"scan"`
+	Topology      PoolTopologyResponse `json:"topology"`
+}
+
+// PoolScanResponse represents the most recent scrub or resilver of a pool.
+// Every field is null when the pool has never been scanned.
+type PoolScanResponse struct {
+	Function       *string       `json:"function"`
+	State          *string       `json:"state"`
+	StartTime      *DateResponse `json:"start_time"`
+	EndTime        *DateResponse `json:"end_time"`
+	Percentage     *float64      `json:"percentage"`
+	BytesToProcess *int64        `json:"bytes_to_process"`
+	BytesProcessed *int64        `json:"bytes_processed"`
+	BytesIssued    *int64        `json:"bytes_issued"`
+	Pause          *DateResponse `json:"pause"` // Set while a scrub is paused
+	Errors         *int64        `json:"errors"`
+	TotalSecsLeft  *int64        `json:"total_secs_left"`
+}
+
+// PoolTopologyResponse represents the vdev classes of a pool.
+type PoolTopologyResponse struct {
+	Data    []VdevResponse `json:"data"`
+	Log     []VdevResponse `json:"log"`
+	Cache   []VdevResponse `json:"cache"`
+	Spare   []VdevResponse `json:"spare"`
+	Special []VdevResponse `json:"special"`
+	Dedup   []VdevResponse `json:"dedup"`
+}
+
+// VdevResponse represents a vdev, or a disk within one, in a pool's
+// topology.
+type VdevResponse struct {
+	Name     string            `json:"name"`
+	Type     string            `json:"type"`
+	Path     *string           `json:"path"`
+	GUID     string            `json:"guid"`
+	Status   string            `json:"status"`
+	Disk     *string           `json:"disk"`
+	Stats    VdevStatsResponse `json:"stats"`
+	Children []VdevResponse    `json:"children"`
+}
+
+// VdevStatsResponse represents the I/O error counters and capacity of a
+// vdev.
+type VdevStatsResponse struct {
+	ReadErrors     int64 `json:"read_errors"`
+	WriteErrors    int64 `json:"write_errors"`
+	ChecksumErrors int64 `json:"checksum_errors"`
+	Size           int64 `json:"size"`
+	Allocated      int64 `json:"allocated"`
+}
+
+// ImportablePoolResponse represents a pool from the pool.import_find API.
+type ImportablePoolResponse struct {
+	Name     string `json:"name"`
+	GUID     string `json:"guid"`
+	Status   string `json:"status"`
+	Hostname string `json:"hostname"`
+}
@@ -0,0 +1,682 @@
+package truenas
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Pool is the user-facing representation of a TrueNAS pool.
+type Pool struct {
+	ID     int64
+	Name   string
+	GUID   string
+	Path   string
+	Status string // ONLINE, DEGRADED, FAULTED, OFFLINE, UNAVAIL or REMOVED
+	// Healthy is false when any vdev is degraded or has I/O errors.
+	Healthy bool
+	// Warning is set for conditions that need attention but do not affect
+	// the data, such as features that are not enabled yet.
+	Warning      bool
+	StatusCode   string // ZFS status code, e.g. "FEAT_DISABLED"; empty when healthy
+	StatusDetail string
+	IsUpgraded   bool
+	Size         int64
+	Allocated    int64
+	Free         int64
+	Freeing      int64
+	// Fragmentation is the free-space fragmentation in percent.
+	Fragmentation int64
+	Autotrim      bool
+	// Scan is the pool's most recent scrub or resilver, or nil if it has
+	// never been scanned.
+	Scan     *PoolScan
+	Topology PoolTopology
+}
+
+// PoolScan is the progress or outcome of a pool's scrub or resilver.
+type PoolScan struct {
+	Function       ScanFunction
+	State          ScanState
+	StartTime      time.Time
+	EndTime        time.Time // Zero while scanning
+	Percentage     float64
+	BytesToProcess int64
+	BytesProcessed int64
+	BytesIssued    int64
+	// Paused is set while a scrub is paused; PausedAt is when it was paused.
+	Paused   bool
+	PausedAt time.Time
+	Errors   int64
+	// TimeLeft is the server's estimate of the time to completion, or 0 when
+	// it has none.
+	TimeLeft time.Duration
+}
+
+// Done reports whether the scan has finished or been canceled.
+func (s PoolScan) Done() bool {
+	return s.State == ScanFinished || s.State == ScanCanceled
+}
+
+// PoolTopology is the vdev layout of a pool, by vdev class.
+type PoolTopology struct {
+	Data    []Vdev
+	Log     []Vdev
+	Cache   []Vdev
+	Spare   []Vdev
+	Special []Vdev
+	Dedup   []Vdev
+}
+
+// Vdev is a vdev in a pool's topology. Disks are leaf vdevs of type
+// VdevDisk with no children.
+type Vdev struct {
+	Name           string
+	Type           VdevType
+	GUID           string
+	Path           string // Device path; disks only
+	Disk           string // Disk name, e.g. "sda"; disks only
+	Status         string
+	ReadErrors     int64
+	WriteErrors    int64
+	ChecksumErrors int64
+	Size           int64
+	Allocated      int64
+	Children       []Vdev
+}
+
+// Disks returns the leaf disks of every vdev in the topology, in the order
+// the server reports them.
+func (t PoolTopology) Disks() []Vdev {
+	var disks []Vdev
+	for _, class := range [][]Vdev{t.Data, t.Log, t.Cache, t.Spare, t.Special, t.Dedup} {
+		for _, vdev := range class {
+			disks = appendLeafVdevs(disks, vdev)
+		}
+	}
+	return disks
+}
+
+func appendLeafVdevs(disks []Vdev, vdev Vdev) []Vdev {
+	if len(vdev.Children) == 0 {
+		return append(disks, vdev)
+	}
+	for _, child := range vdev.Children {
+		disks = appendLeafVdevs(disks, child)
+	}
+	return disks
+}
+
+// VdevSpec describes a vdev to create. Use Stripe, Mirror, RAIDZ1, RAIDZ2,
+// RAIDZ3 or DRAID to build one.
+type VdevSpec struct {
+	Type  VdevType
+	Disks []string // Disk names, e.g. "sda"
+	// DRAIDDataDisks and DRAIDSpareDisks size a dRAID vdev's redundancy
+	// groups and distributed spares. Zero = server default.
+	DRAIDDataDisks  int64
+	DRAIDSpareDisks int64
+}
+
+// Stripe returns a vdev spec with no redundancy.
+func Stripe(disks ...string) VdevSpec { return VdevSpec{Type: VdevStripe, Disks: disks} }
+
+// Mirror returns a mirrored vdev spec.
+func Mirror(disks ...string) VdevSpec { return VdevSpec{Type: VdevMirror, Disks: disks} }
+
+// RAIDZ1 returns a single-parity RAIDZ vdev spec.
+func RAIDZ1(disks ...string) VdevSpec { return VdevSpec{Type: VdevRAIDZ1, Disks: disks} }
+
+// RAIDZ2 returns a double-parity RAIDZ vdev spec.
+func RAIDZ2(disks ...string) VdevSpec { return VdevSpec{Type: VdevRAIDZ2, Disks: disks} }
+
+// RAIDZ3 returns a triple-parity RAIDZ vdev spec.
+func RAIDZ3(disks ...string) VdevSpec { return VdevSpec{Type: VdevRAIDZ3, Disks: disks} }
+
+// DRAID returns a dRAID vdev spec with the given parity (1-3). dataDisks
+// and spareDisks may be 0 to let the server choose.
+func DRAID(parity int, dataDisks, spareDisks int64, disks ...string) VdevSpec {
+	return VdevSpec{
+		Type:            VdevType("DRAID" + strconv.Itoa(parity)),
+		Disks:           disks,
+		DRAIDDataDisks:  dataDisks,
+		DRAIDSpareDisks: spareDisks,
+	}
+}
+
+// PoolTopologySpec is the vdev layout of a pool to create. Data is
+// required; Special and Dedup accept Stripe or Mirror vdevs, Log accepts
+// Stripe or Mirror, and Cache accepts only Stripe.
+type PoolTopologySpec struct {
+	Data    []VdevSpec
+	Special []VdevSpec
+	Dedup   []VdevSpec
+	Log     []VdevSpec
+	Cache   []VdevSpec
+	Spares  []string // Hot spare disk names
+}
+
+// PoolEncryptionOpts configures encryption of a new pool's root dataset.
+// Set exactly one of GenerateKey, Passphrase or Key.
+type PoolEncryptionOpts struct {
+	GenerateKey bool
+	Passphrase  string
+	Key         string // Hex-encoded
+	Algorithm   string // Default: AES-256-GCM
+	PBKDF2Iters int64  // Default: 350000; passphrase only
+}
+
+// CreatePoolOpts contains options for creating a pool.
+type CreatePoolOpts struct {
+	Name     string
+	Topology PoolTopologySpec
+	// Encryption, when non-nil, encrypts the pool's root dataset.
+	Encryption    *PoolEncryptionOpts
+	Deduplication PoolDeduplication // Empty = server default (inherit)
+	Checksum      string            // Empty = server default, e.g. "SHA256"
+	// DedupTableQuota caps the dedup table size in bytes. 0 = automatic.
+	DedupTableQuota       int64
+	AllowDuplicateSerials bool
+}
+
+// AttachPoolDiskOpts contains options for attaching a disk to a vdev,
+// turning a single disk into a mirror or widening a mirror or RAIDZ vdev.
+type AttachPoolDiskOpts struct {
+	TargetVdev            string // GUID of the vdev to attach to
+	NewDisk               string // Disk name, e.g. "sdc"
+	AllowDuplicateSerials bool
+}
+
+// ReplacePoolDiskOpts contains options for replacing a disk in a pool.
+type ReplacePoolDiskOpts struct {
+	Label string // GUID or device name of the disk being replaced
+	Disk  string // Identifier of the replacement (Disk.Identifier), not its name
+	Force bool
+	// PreserveSettings copies power management and S.M.A.R.T. settings of
+	// the replaced disk to the new disk.
+	PreserveSettings    *bool // Default: true
+	PreserveDescription *bool // Default: true
+}
+
+// ExportPoolOpts contains options for exporting a pool.
+type ExportPoolOpts struct {
+	// Cascade deletes the shares, tasks and other attachments of the pool.
+	Cascade bool
+	// RestartServices restarts services that have files open on the pool.
+	RestartServices bool
+	// Destroy destroys the pool and its data instead of exporting it.
+	Destroy bool
+}
+
+// ImportablePool is a pool found by ImportFind.
+type ImportablePool struct {
+	Name     string
+	GUID     string
+	Status   string
+	Hostname string // Host that last had the pool imported
+}
+
+// ImportPoolOpts contains options for importing a pool.
+type ImportPoolOpts struct {
+	GUID string
+	Name string // Empty = keep the pool's name
+	// EnableAttachments re-enables the attachments that were disabled when
+	// the pool was exported.
+	EnableAttachments bool
+}
+
+// PoolService provides typed methods for the pool.* API namespace.
+type PoolService struct {
+	client  AsyncCaller
+	version Version
+}
+
+// NewPoolService creates a new PoolService.
+func NewPoolService(c AsyncCaller, v Version) *PoolService {
+	return &PoolService{client: c, version: v}
+}
+
+// Create creates a pool, waits for the job to complete and returns the full
+// object.
+func (s *PoolService) Create(ctx context.Context, opts CreatePoolOpts) (*Pool, error) {
+	params := poolCreateParams(opts)
+	result, err := callMethodAndWait(ctx, s.client, s.version, "pool.create", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var createResp struct {
+		ID int64 `json:"id"`
+	}
+	if err := json.Unmarshal(result, &createResp); err != nil {
+		return nil, fmt.Errorf("parse create response: %w", err)
+	}
+
+	return s.Get(ctx, createResp.ID)
+}
+
+// Get returns a pool by ID, or nil if not found.
+func (s *PoolService) Get(ctx context.Context, id int64) (*Pool, error) {
+	result, err := callMethod(ctx, s.client, s.version, "pool.get_instance", id)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var resp PoolResponse
+	if err := json.Unmarshal(result, &resp); err != nil {
+		return nil, fmt.Errorf("parse get_instance response: %w", err)
+	}
+
+	pool := poolFromResponse(resp)
+	return &pool, nil
+}
+
+// GetByName returns a pool by name, or nil if not found.
+func (s *PoolService) GetByName(ctx context.Context, name string) (*Pool, error) {
+	filter := [][]any{{"name", "=", name}}
+	result, err := callMethod(ctx, s.client, s.version, "pool.query", filter)
+	if err != nil {
+		return nil, err
+	}
+
+	pools, err := parsePools(result)
+	if err != nil {
+		return nil, err
+	}
+	if len(pools) == 0 {
+		return nil, nil
+	}
+	return &pools[0], nil
+}
+
+// List returns all pools.
+func (s *PoolService) List(ctx context.Context) ([]Pool, error) {
+	result, err := callMethod(ctx, s.client, s.version, "pool.query", nil)
+	if err != nil {
+		return nil, err
+	}
+	return parsePools(result)
+}
+
+// parsePools parses a pool.query response.
+func parsePools(result json.RawMessage) ([]Pool, error) {
+	var responses []PoolResponse
+	if err := json.Unmarshal(result, &responses); err != nil {
+		return nil, fmt.Errorf("parse query response: %w", err)
+	}
+
+	pools := make([]Pool, len(responses))
+	for i, resp := range responses {
+		pools[i] = poolFromResponse(resp)
+	}
+	return pools, nil
+}
+
+// Attach attaches a disk to a vdev of a pool and waits for the job to
+// complete. The pool resilvers onto the new disk in the background.
+func (s *PoolService) Attach(ctx context.Context, id int64, opts AttachPoolDiskOpts) error {
+	params := map[string]any{
+		"target_vdev": opts.TargetVdev,
+		"new_disk":    opts.NewDisk,
+	}
+	if opts.AllowDuplicateSerials {
+		params["allow_duplicate_serials"] = true
+	}
+	_, err := callMethodAndWait(ctx, s.client, s.version, "pool.attach", []any{id, params})
+	return err
+}
+
+// Replace replaces a disk in a pool and waits for the job to complete. The
+// pool resilvers onto the new disk in the background.
+func (s *PoolService) Replace(ctx context.Context, id int64, opts ReplacePoolDiskOpts) error {
+	params := map[string]any{
+		"label": opts.Label,
+		"disk":  opts.Disk,
+	}
+	if opts.Force {
+		params["force"] = true
+	}
+	setBool(params, "preserve_settings", opts.PreserveSettings)
+	setBool(params, "preserve_description", opts.PreserveDescription)
+	_, err := callMethodAndWait(ctx, s.client, s.version, "pool.replace", []any{id, params})
+	return err
+}
+
+// Detach detaches a disk from a mirror, optionally wiping it.
+func (s *PoolService) Detach(ctx context.Context, id int64, label string, wipe bool) error {
+	params := map[string]any{"label": label}
+	if wipe {
+		params["wipe"] = true
+	}
+	_, err := callMethod(ctx, s.client, s.version, "pool.detach", []any{id, params})
+	return err
+}
+
+// Remove removes a vdev or disk from a pool and waits for the job to
+// complete. label is the GUID or device name.
+func (s *PoolService) Remove(ctx context.Context, id int64, label string) error {
+	params := map[string]any{"label": label}
+	_, err := callMethodAndWait(ctx, s.client, s.version, "pool.remove", []any{id, params})
+	return err
+}
+
+// Offline takes a disk of a pool offline. label is the GUID or device name.
+func (s *PoolService) Offline(ctx context.Context, id int64, label string) error {
+	params := map[string]any{"label": label}
+	_, err := callMethod(ctx, s.client, s.version, "pool.offline", []any{id, params})
+	return err
+}
+
+// Online brings an offline disk of a pool back online. label is the GUID
+// or device name.
+func (s *PoolService) Online(ctx context.Context, id int64, label string) error {
+	params := map[string]any{"label": label}
+	_, err := callMethod(ctx, s.client, s.version, "pool.online", []any{id, params})
+	return err
+}
+
+// Expand grows a pool to use the full size of its disks after they were
+// replaced with larger ones, and waits for the job to complete.
+func (s *PoolService) Expand(ctx context.Context, id int64) error {
+	_, err := callMethodAndWait(ctx, s.client, s.version, "pool.expand", id)
+	return err
+}
+
+// Export exports (or, with Destroy, destroys) a pool and waits for the job
+// to complete.
+func (s *PoolService) Export(ctx context.Context, id int64, opts ExportPoolOpts) error {
+	params := map[string]any{}
+	if opts.Cascade {
+		params["cascade"] = true
+	}
+	if opts.RestartServices {
+		params["restart_services"] = true
+	}
+	if opts.Destroy {
+		params["destroy"] = true
+	}
+	_, err := callMethodAndWait(ctx, s.client, s.version, "pool.export", []any{id, params})
+	return err
+}
+
+// ImportFind returns the pools on attached disks that can be imported.
+func (s *PoolService) ImportFind(ctx context.Context) ([]ImportablePool, error) {
+	result, err := callMethodAndWait(ctx, s.client, s.version, "pool.import_find", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responses []ImportablePoolResponse
+	if err := json.Unmarshal(result, &responses); err != nil {
+		return nil, fmt.Errorf("parse import_find response: %w", err)
+	}
+
+	pools := make([]ImportablePool, len(responses))
+	for i, resp := range responses {
+		pools[i] = ImportablePool{
+			Name:     resp.Name,
+			GUID:     resp.GUID,
+			Status:   resp.Status,
+			Hostname: resp.Hostname,
+		}
+	}
+	return pools, nil
+}
+
+// Import imports a pool found by ImportFind and waits for the job to
+// complete.
+func (s *PoolService) Import(ctx context.Context, opts ImportPoolOpts) error {
+	params := map[string]any{"guid": opts.GUID}
+	if opts.Name != "" {
+		params["name"] = opts.Name
+	}
+	if opts.EnableAttachments {
+		params["enable_attachments"] = true
+	}
+	_, err := callMethodAndWait(ctx, s.client, s.version, "pool.import_pool", params)
+	return err
+}
+
+// defaultScanPollInterval is how often WatchScan polls when no interval is
+// given.
+const defaultScanPollInterval = 5 * time.Second
+
+// WatchScan polls a pool's scan status every interval (5 seconds if zero)
+// and delivers it on the subscription until the scan finishes or is
+// canceled; the final status is delivered before the channel closes. A
+// paused scrub keeps the watch open. The channel also closes if the pool
+// disappears, a poll fails, or the subscription is closed.
+//
+// It returns an error if the pool does not exist or has never been scanned.
+// Unlike event subscriptions, WatchScan works over any transport.
+func (s *PoolService) WatchScan(ctx context.Context, id int64, interval time.Duration) (*Subscription[PoolScan], error) {
+	pool, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if pool == nil {
+		return nil, fmt.Errorf("pool %d not found", id)
+	}
+	if pool.Scan == nil {
+		return nil, fmt.Errorf("pool %q has never been scanned", pool.Name)
+	}
+	if interval <= 0 {
+		interval = defaultScanPollInterval
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	ch := make(chan PoolScan, 1)
+	go func() {
+		defer close(ch)
+		scan := *pool.Scan
+		for {
+			select {
+			case ch <- scan:
+			case <-ctx.Done():
+				return
+			}
+			if scan.Done() {
+				return
+			}
+
+			select {
+			case <-time.After(interval):
+			case <-ctx.Done():
+				return
+			}
+
+			pool, err := s.Get(ctx, id)
+			if err != nil || pool == nil || pool.Scan == nil {
+				return
+			}
+			scan = *pool.Scan
+		}
+	}()
+
+	return &Subscription[PoolScan]{
+		C:      ch,
+		cancel: cancel,
+	}, nil
+}
+
+// poolCreateParams converts CreatePoolOpts to API parameters.
+func poolCreateParams(opts CreatePoolOpts) map[string]any {
+	params := map[string]any{
+		"name":     opts.Name,
+		"topology": poolTopologyParams(opts.Topology),
+	}
+	if opts.Encryption != nil {
+		params["encryption"] = true
+		params["encryption_options"] = poolEncryptionParams(*opts.Encryption)
+	}
+	if opts.Deduplication != "" {
+		params["deduplication"] = string(opts.Deduplication)
+	}
+	if opts.Checksum != "" {
+		params["checksum"] = opts.Checksum
+	}
+	if opts.DedupTableQuota != 0 {
+		params["dedup_table_quota"] = "CUSTOM"
+		params["dedup_table_quota_value"] = opts.DedupTableQuota
+	}
+	if opts.AllowDuplicateSerials {
+		params["allow_duplicate_serials"] = true
+	}
+	return params
+}
+
+// poolTopologyParams converts a PoolTopologySpec to API parameters,
+// omitting empty vdev classes.
+func poolTopologyParams(spec PoolTopologySpec) map[string]any {
+	params := map[string]any{
+		"data": vdevSpecParams(spec.Data),
+	}
+	for key, vdevs := range map[string][]VdevSpec{
+		"special": spec.Special,
+		"dedup":   spec.Dedup,
+		"log":     spec.Log,
+		"cache":   spec.Cache,
+	} {
+		if len(vdevs) > 0 {
+			params[key] = vdevSpecParams(vdevs)
+		}
+	}
+	if len(spec.Spares) > 0 {
+		params["spares"] = spec.Spares
+	}
+	return params
+}
+
+func vdevSpecParams(vdevs []VdevSpec) []map[string]any {
+	params := make([]map[string]any, len(vdevs))
+	for i, vdev := range vdevs {
+		p := map[string]any{
+			"type":  string(vdev.Type),
+			"disks": nonNilStrings(vdev.Disks),
+		}
+		if vdev.DRAIDDataDisks != 0 {
+			p["draid_data_disks"] = vdev.DRAIDDataDisks
+		}
+		if vdev.DRAIDSpareDisks != 0 {
+			p["draid_spare_disks"] = vdev.DRAIDSpareDisks
+		}
+		params[i] = p
+	}
+	return params
+}
+
+func poolEncryptionParams(opts PoolEncryptionOpts) map[string]any {
+	params := map[string]any{}
+	if opts.GenerateKey {
+		params["generate_key"] = true
+	}
+	if opts.Passphrase != "" {
+		params["passphrase"] = opts.Passphrase
+	}
+	if opts.Key != "" {
+		params["key"] = opts.Key
+	}
+	if opts.Algorithm != "" {
+		params["algorithm"] = opts.Algorithm
+	}
+	if opts.PBKDF2Iters != 0 {
+		params["pbkdf2iters"] = opts.PBKDF2Iters
+	}
+	return params
+}
+
+// poolFromResponse converts a wire-format PoolResponse to a user-facing Pool.
+func poolFromResponse(resp PoolResponse) Pool {
+	pool := Pool{
+		ID:           resp.ID,
+		Name:         resp.Name,
+		GUID:         resp.GUID,
+		Path:         resp.Path,
+		Status:       resp.Status,
+		Healthy:      resp.Healthy,
+		Warning:      resp.Warning,
+		StatusCode:   derefString(resp.StatusCode),
+		StatusDetail: derefString(resp.StatusDetail),
+		IsUpgraded:   resp.IsUpgraded,
+		Size:         resp.Size,
+		Allocated:    resp.Allocated,
+		Free:         resp.Free,
+		Freeing:      resp.Freeing,
+		Autotrim:     resp.Autotrim.Value == "on",
+		Topology: PoolTopology{
+			Data:    vdevsFromResponse(resp.Topology.Data),
+			Log:     vdevsFromResponse(resp.Topology.Log),
+			Cache:   vdevsFromResponse(resp.Topology.Cache),
+			Spare:   vdevsFromResponse(resp.Topology.Spare),
+			Special: vdevsFromResponse(resp.Topology.Special),
+			Dedup:   vdevsFromResponse(resp.Topology.Dedup),
+		},
+	}
+	if resp.Fragmentation != nil {
+		pool.Fragmentation, _ = strconv.ParseInt(*resp.Fragmentation, 10, 64)
+	}
+	if resp.Scan.Function != nil {
+		scan := poolScanFromResponse(resp.Scan)
+		pool.Scan = &scan
+	}
+	return pool
+}
+
+// poolScanFromResponse converts a wire-format PoolScanResponse to a
+// user-facing PoolScan.
+func poolScanFromResponse(resp PoolScanResponse) PoolScan {
+	scan := PoolScan{
+		Function:       ScanFunction(derefString(resp.Function)),
+		State:          ScanState(derefString(resp.State)),
+		BytesToProcess: derefInt64(resp.BytesToProcess),
+		BytesProcessed: derefInt64(resp.BytesProcessed),
+		BytesIssued:    derefInt64(resp.BytesIssued),
+		Errors:         derefInt64(resp.Errors),
+		TimeLeft:       time.Duration(derefInt64(resp.TotalSecsLeft)) * time.Second,
+	}
+	if resp.StartTime != nil {
+		scan.StartTime = time.UnixMilli(resp.StartTime.Date)
+	}
+	if resp.EndTime != nil {
+		scan.EndTime = time.UnixMilli(resp.EndTime.Date)
+	}
+	if resp.Percentage != nil {
+		scan.Percentage = *resp.Percentage
+	}
+	if resp.Pause != nil {
+		scan.Paused = true
+		scan.PausedAt = time.UnixMilli(resp.Pause.Date)
+	}
+	return scan
+}
+
+// vdevsFromResponse converts wire-format vdevs, recursively, to user-facing
+// Vdevs.
+func vdevsFromResponse(responses []VdevResponse) []Vdev {
+	if len(responses) == 0 {
+		return nil
+	}
+	vdevs := make([]Vdev, len(responses))
+	for i, resp := range responses {
+		vdevs[i] = Vdev{
+			Name:           resp.Name,
+			Type:           VdevType(resp.Type),
+			GUID:           resp.GUID,
+			Path:           derefString(resp.Path),
+			Disk:           derefString(resp.Disk),
+			Status:         resp.Status,
+			ReadErrors:     resp.Stats.ReadErrors,
+			WriteErrors:    resp.Stats.WriteErrors,
+			ChecksumErrors: resp.Stats.ChecksumErrors,
+			Size:           resp.Stats.Size,
+			Allocated:      resp.Stats.Allocated,
+			Children:       vdevsFromResponse(resp.Children),
+		}
+	}
+	return vdevs
+}
@@ -0,0 +1,153 @@
+package truenas
+
+import (
+	"context"
+	"time"
+)
+
+// PoolServiceAPI defines the interface for pool operations.
+type PoolServiceAPI interface {
+	Create(ctx context.Context, opts CreatePoolOpts) (*Pool, error)
+	Get(ctx context.Context, id int64) (*Pool, error)
+	GetByName(ctx context.Context, name string) (*Pool, error)
+	List(ctx context.Context) ([]Pool, error)
+	Attach(ctx context.Context, id int64, opts AttachPoolDiskOpts) error
+	Replace(ctx context.Context, id int64, opts ReplacePoolDiskOpts) error
+	Detach(ctx context.Context, id int64, label string, wipe bool) error
+	Remove(ctx context.Context, id int64, label string) error
+	Offline(ctx context.Context, id int64, label string) error
+	Online(ctx context.Context, id int64, label string) error
+	Expand(ctx context.Context, id int64) error
+	Export(ctx context.Context, id int64, opts ExportPoolOpts) error
+	ImportFind(ctx context.Context) ([]ImportablePool, error)
+	Import(ctx context.Context, opts ImportPoolOpts) error
+	WatchScan(ctx context.Context, id int64, interval time.Duration) (*Subscription[PoolScan], error)
+}
+
+// Compile-time checks.
+var _ PoolServiceAPI = (*PoolService)(nil)
+var _ PoolServiceAPI = (*MockPoolService)(nil)
+
+// MockPoolService is a test double for PoolServiceAPI.
+type MockPoolService struct {
+	CreateFunc     func(ctx context.Context, opts CreatePoolOpts) (*Pool, error)
+	GetFunc        func(ctx context.Context, id int64) (*Pool, error)
+	GetByNameFunc  func(ctx context.Context, name string) (*Pool, error)
+	ListFunc       func(ctx context.Context) ([]Pool, error)
+	AttachFunc     func(ctx context.Context, id int64, opts AttachPoolDiskOpts) error
+	ReplaceFunc    func(ctx context.Context, id int64, opts ReplacePoolDiskOpts) error
+	DetachFunc     func(ctx context.Context, id int64, label string, wipe bool) error
+	RemoveFunc     func(ctx context.Context, id int64, label string) error
+	OfflineFunc    func(ctx context.Context, id int64, label string) error
+	OnlineFunc     func(ctx context.Context, id int64, label string) error
+	ExpandFunc     func(ctx context.Context, id int64) error
+	ExportFunc     func(ctx context.Context, id int64, opts ExportPoolOpts) error
+	ImportFindFunc func(ctx context.Context) ([]ImportablePool, error)
+	ImportFunc     func(ctx context.Context, opts ImportPoolOpts) error
+	WatchScanFunc  func(ctx context.Context, id int64, interval time.Duration) (*Subscription[PoolScan], error)
+}
+
+func (m *MockPoolService) Create(ctx context.Context, opts CreatePoolOpts) (*Pool, error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, opts)
+	}
+	return nil, nil
+}
+
+func (m *MockPoolService) Get(ctx context.Context, id int64) (*Pool, error) {
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockPoolService) GetByName(ctx context.Context, name string) (*Pool, error) {
+	if m.GetByNameFunc != nil {
+		return m.GetByNameFunc(ctx, name)
+	}
+	return nil, nil
+}
+
+func (m *MockPoolService) List(ctx context.Context) ([]Pool, error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockPoolService) Attach(ctx context.Context, id int64, opts AttachPoolDiskOpts) error {
+	if m.AttachFunc != nil {
+		return m.AttachFunc(ctx, id, opts)
+	}
+	return nil
+}
+
+func (m *MockPoolService) Replace(ctx context.Context, id int64, opts ReplacePoolDiskOpts) error {
+	if m.ReplaceFunc != nil {
+		return m.ReplaceFunc(ctx, id, opts)
+	}
+	return nil
+}
+
+func (m *MockPoolService) Detach(ctx context.Context, id int64, label string, wipe bool) error {
+	if m.DetachFunc != nil {
+		return m.DetachFunc(ctx, id, label, wipe)
+	}
+	return nil
+}
+
+func (m *MockPoolService) Remove(ctx context.Context, id int64, label string) error {
+	if m.RemoveFunc != nil {
+		return m.RemoveFunc(ctx, id, label)
+	}
+	return nil
+}
+
+func (m *MockPoolService) Offline(ctx context.Context, id int64, label string) error {
+	if m.OfflineFunc != nil {
+		return m.OfflineFunc(ctx, id, label)
+	}
+	return nil
+}
+
+func (m *MockPoolService) Online(ctx context.Context, id int64, label string) error {
+	if m.OnlineFunc != nil {
+		return m.OnlineFunc(ctx, id, label)
+	}
+	return nil
+}
+
+func (m *MockPoolService) Expand(ctx context.Context, id int64) error {
+	if m.ExpandFunc != nil {
+		return m.ExpandFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockPoolService) Export(ctx context.Context, id int64, opts ExportPoolOpts) error {
+	if m.ExportFunc != nil {
+		return m.ExportFunc(ctx, id, opts)
+	}
+	return nil
+}
+
+func (m *MockPoolService) ImportFind(ctx context.Context) ([]ImportablePool, error) {
+	if m.ImportFindFunc != nil {
+		return m.ImportFindFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockPoolService) Import(ctx context.Context, opts ImportPoolOpts) error {
+	if m.ImportFunc != nil {
+		return m.ImportFunc(ctx, opts)
+	}
+	return nil
+}
+
+func (m *MockPoolService) WatchScan(ctx context.Context, id int64, interval time.Duration) (*Subscription[PoolScan], error) {
+	if m.WatchScanFunc != nil {
+		return m.WatchScanFunc(ctx, id, interval)
+	}
+	return nil, nil
+}
@@ -0,0 +1,45 @@
+package truenas
+
+import (
+	"context"
+	"testing"
+)
+
+func TestMockPoolService_ImplementsInterface(t *testing.T) {
+	var _ PoolServiceAPI = (*PoolService)(nil)
+	var _ PoolServiceAPI = (*MockPoolService)(nil)
+}
+
+func TestMockPoolService_DefaultsToNil(t *testing.T) {
+	mock := &MockPoolService{}
+	ctx := context.Background()
+
+	pool, err := mock.GetByName(ctx, "tank")
+	if err != nil {
+		t.Fatalf("expected nil error, got: %v", err)
+	}
+	if pool != nil {
+		t.Fatalf("expected nil result, got: %v", pool)
+	}
+
+	if err := mock.Offline(ctx, 1, "sda"); err != nil {
+		t.Fatalf("expected nil error from Offline, got: %v", err)
+	}
+}
+
+func TestMockPoolService_CallsFunc(t *testing.T) {
+	var gotLabel string
+	mock := &MockPoolService{
+		ReplaceFunc: func(ctx context.Context, id int64, opts ReplacePoolDiskOpts) error {
+			gotLabel = opts.Label
+			return nil
+		},
+	}
+
+	if err := mock.Replace(context.Background(), 1, ReplacePoolDiskOpts{Label: "1234", Disk: "{serial}ZL2C"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotLabel != "1234" {
+		t.Fatalf("expected ReplaceFunc to receive label 1234, got %q", gotLabel)
+	}
+}
@@ -0,0 +1,689 @@
+package truenas
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// samplePoolJSON returns a single JSON object response for
+// pool.get_instance: a degraded RAIDZ2 pool with a mirrored log and a
+// spare.
+func samplePoolJSON() json.RawMessage {
+	return json.RawMessage(`{
+		"id": 1,
+		"name": "tank",
+		"guid": "1234567890",
+		"path": "/mnt/tank",
+		"status": "DEGRADED",
+		"scan": {},
+		"expand": null,
+		"is_upgraded": true,
+		"healthy": false,
+		"warning": false,
+		"status_code": "CORRUPT_DATA",
+		"status_detail": "One or more devices has experienced an error resulting in data corruption.",
+		"size": 3985729650688,
+		"allocated": 1099511627776,
+		"free": 2886218022912,
+		"freeing": 0,
+		"dedup_table_size": 0,
+		"dedup_table_quota": "auto",
+		"fragmentation": "3",
+		"size_str": "3985729650688",
+		"allocated_str": "1099511627776",
+		"free_str": "2886218022912",
+		"freeing_str": "0",
+		"autotrim": {"parsed": "on", "rawvalue": "on", "source": "LOCAL", "value": "on"},
+		"topology": {
+			"data": [{
+				"name": "raidz2-0",
+				"type": "RAIDZ2",
+				"path": null,
+				"guid": "111",
+				"status": "DEGRADED",
+				"stats": {"read_errors": 0, "write_errors": 0, "checksum_errors": 0, "size": 3985729650688, "allocated": 1099511627776},
+				"children": [
+					{"name": "sda1", "type": "DISK", "path": "/dev/sda1", "guid": "211", "status": "ONLINE", "disk": "sda",
+						"stats": {"read_errors": 0, "write_errors": 0, "checksum_errors": 0, "size": 0, "allocated": 0}, "children": []},
+					{"name": "sdb1", "type": "DISK", "path": "/dev/sdb1", "guid": "212", "status": "FAULTED", "disk": "sdb",
+						"stats": {"read_errors": 3, "write_errors": 1, "checksum_errors": 12, "size": 0, "allocated": 0}, "children": []},
+					{"name": "sdc1", "type": "DISK", "path": "/dev/sdc1", "guid": "213", "status": "ONLINE", "disk": "sdc",
+						"stats": {"read_errors": 0, "write_errors": 0, "checksum_errors": 0, "size": 0, "allocated": 0}, "children": []},
+					{"name": "sdd1", "type": "DISK", "path": "/dev/sdd1", "guid": "214", "status": "ONLINE", "disk": "sdd",
+						"stats": {"read_errors": 0, "write_errors": 0, "checksum_errors": 0, "size": 0, "allocated": 0}, "children": []}
+				]
+			}],
+			"log": [{
+				"name": "mirror-1",
+				"type": "MIRROR",
+				"path": null,
+				"guid": "121",
+				"status": "ONLINE",
+				"stats": {"read_errors": 0, "write_errors": 0, "checksum_errors": 0, "size": 0, "allocated": 0},
+				"children": [
+					{"name": "nvme0n1p1", "type": "DISK", "path": "/dev/nvme0n1p1", "guid": "221", "status": "ONLINE", "disk": "nvme0n1",
+						"stats": {"read_errors": 0, "write_errors": 0, "checksum_errors": 0, "size": 0, "allocated": 0}, "children": []},
+					{"name": "nvme1n1p1", "type": "DISK", "path": "/dev/nvme1n1p1", "guid": "222", "status": "ONLINE", "disk": "nvme1n1",
+						"stats": {"read_errors": 0, "write_errors": 0, "checksum_errors": 0, "size": 0, "allocated": 0}, "children": []}
+				]
+			}],
+			"cache": [],
+			"spare": [
+				{"name": "sde1", "type": "DISK", "path": "/dev/sde1", "guid": "231", "status": "AVAIL", "disk": "sde",
+					"stats": {"read_errors": 0, "write_errors": 0, "checksum_errors": 0, "size": 0, "allocated": 0}, "children": []}
+			],
+			"special": [],
+			"dedup": []
+		}
+	}`)
+}
+
+func TestPoolService_Create(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				return samplePoolJSON(), nil
+			},
+		},
+		callAndWaitFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return samplePoolJSON(), nil
+		},
+	}
+
+	svc := NewPoolService(mock, Version{})
+	pool, err := svc.Create(context.Background(), CreatePoolOpts{
+		Name: "tank",
+		Topology: PoolTopologySpec{
+			Data:   []VdevSpec{RAIDZ2("sda", "sdb", "sdc", "sdd")},
+			Log:    []VdevSpec{Mirror("nvme0n1", "nvme1n1")},
+			Spares: []string{"sde"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if mock.calls[0].Method != "pool.create" {
+		t.Fatalf("expected method pool.create, got %s", mock.calls[0].Method)
+	}
+	want := map[string]any{
+		"name": "tank",
+		"topology": map[string]any{
+			"data":   []map[string]any{{"type": "RAIDZ2", "disks": []string{"sda", "sdb", "sdc", "sdd"}}},
+			"log":    []map[string]any{{"type": "MIRROR", "disks": []string{"nvme0n1", "nvme1n1"}}},
+			"spares": []string{"sde"},
+		},
+	}
+	if !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("expected params %v, got %v", want, mock.calls[0].Params)
+	}
+	if mock.calls[1].Method != "pool.get_instance" || mock.calls[1].Params != int64(1) {
+		t.Errorf("expected re-read of pool 1, got %s %v", mock.calls[1].Method, mock.calls[1].Params)
+	}
+
+	if pool.ID != 1 || pool.Name != "tank" || pool.GUID != "1234567890" || pool.Status != "DEGRADED" {
+		t.Errorf("unexpected pool: %+v", pool)
+	}
+	if pool.Healthy || pool.StatusCode != "CORRUPT_DATA" || pool.StatusDetail == "" {
+		t.Errorf("unexpected health: healthy=%v code=%q detail=%q", pool.Healthy, pool.StatusCode, pool.StatusDetail)
+	}
+	if pool.Fragmentation != 3 || !pool.Autotrim || !pool.IsUpgraded || pool.Free != 2886218022912 {
+		t.Errorf("unexpected properties: %+v", pool)
+	}
+
+	data := pool.Topology.Data
+	if len(data) != 1 || data[0].Type != VdevRAIDZ2 || data[0].Status != "DEGRADED" || len(data[0].Children) != 4 {
+		t.Fatalf("unexpected data vdevs: %+v", data)
+	}
+	faulted := data[0].Children[1]
+	if faulted.Type != VdevDisk || faulted.Disk != "sdb" || faulted.Path != "/dev/sdb1" || faulted.GUID != "212" ||
+		faulted.ReadErrors != 3 || faulted.WriteErrors != 1 || faulted.ChecksumErrors != 12 {
+		t.Errorf("unexpected faulted disk: %+v", faulted)
+	}
+	if len(pool.Topology.Log) != 1 || pool.Topology.Log[0].Type != VdevMirror {
+		t.Errorf("unexpected log vdevs: %+v", pool.Topology.Log)
+	}
+	if len(pool.Topology.Spare) != 1 || pool.Topology.Spare[0].Status != "AVAIL" {
+		t.Errorf("unexpected spares: %+v", pool.Topology.Spare)
+	}
+	if pool.Topology.Cache != nil || pool.Topology.Special != nil || pool.Topology.Dedup != nil {
+		t.Errorf("expected empty classes to be nil: %+v", pool.Topology)
+	}
+}
+
+func TestPoolService_Create_AllOptions(t *testing.T) {
+	mock := &mockAsyncCaller{
+		callAndWaitFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return json.RawMessage(`{"id": 2}`), nil
+		},
+	}
+
+	svc := NewPoolService(mock, Version{})
+	_, _ = svc.Create(context.Background(), CreatePoolOpts{
+		Name: "vault",
+		Topology: PoolTopologySpec{
+			Data:    []VdevSpec{DRAID(2, 8, 1, "sda", "sdb", "sdc", "sdd", "sde", "sdf", "sdg", "sdh", "sdi", "sdj", "sdk")},
+			Special: []VdevSpec{Mirror("nvme0n1", "nvme1n1")},
+			Dedup:   []VdevSpec{Mirror("nvme2n1", "nvme3n1")},
+			Cache:   []VdevSpec{Stripe("nvme4n1")},
+		},
+		Encryption:            &PoolEncryptionOpts{Passphrase: "hunter22", PBKDF2Iters: 500000},
+		Deduplication:         PoolDedupVerify,
+		Checksum:              "BLAKE3",
+		DedupTableQuota:       1 << 30,
+		AllowDuplicateSerials: true,
+	})
+
+	want := map[string]any{
+		"name": "vault",
+		"topology": map[string]any{
+			"data": []map[string]any{{
+				"type":              "DRAID2",
+				"disks":             []string{"sda", "sdb", "sdc", "sdd", "sde", "sdf", "sdg", "sdh", "sdi", "sdj", "sdk"},
+				"draid_data_disks":  int64(8),
+				"draid_spare_disks": int64(1),
+			}},
+			"special": []map[string]any{{"type": "MIRROR", "disks": []string{"nvme0n1", "nvme1n1"}}},
+			"dedup":   []map[string]any{{"type": "MIRROR", "disks": []string{"nvme2n1", "nvme3n1"}}},
+			"cache":   []map[string]any{{"type": "STRIPE", "disks": []string{"nvme4n1"}}},
+		},
+		"encryption":              true,
+		"encryption_options":      map[string]any{"passphrase": "hunter22", "pbkdf2iters": int64(500000)},
+		"deduplication":           "VERIFY",
+		"checksum":                "BLAKE3",
+		"dedup_table_quota":       "CUSTOM",
+		"dedup_table_quota_value": int64(1 << 30),
+		"allow_duplicate_serials": true,
+	}
+	if !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("expected params %v, got %v", want, mock.calls[0].Params)
+	}
+}
+
+func TestPoolService_Create_Error(t *testing.T) {
+	mock := &mockAsyncCaller{
+		callAndWaitFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return nil, errors.New("[EINVAL] pool_create.topology.data.0.disks: RAIDZ2 requires at least 4 disks")
+		},
+	}
+
+	svc := NewPoolService(mock, Version{})
+	pool, err := svc.Create(context.Background(), CreatePoolOpts{
+		Name:     "tank",
+		Topology: PoolTopologySpec{Data: []VdevSpec{RAIDZ2("sda", "sdb")}},
+	})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if pool != nil {
+		t.Errorf("expected nil pool, got %+v", pool)
+	}
+}
+
+func TestPoolService_Get_NotFound(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				return nil, errors.New("[ENOENT] None: Pool 9 does not exist")
+			},
+		},
+	}
+
+	svc := NewPoolService(mock, Version{})
+	pool, err := svc.Get(context.Background(), 9)
+	if err != nil || pool != nil {
+		t.Errorf("expected nil, nil, got %+v, %v", pool, err)
+	}
+}
+
+func TestPoolService_GetByName(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				return json.RawMessage(`[` + string(samplePoolJSON()) + `]`), nil
+			},
+		},
+	}
+
+	svc := NewPoolService(mock, Version{})
+	pool, err := svc.GetByName(context.Background(), "tank")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if mock.calls[0].Method != "pool.query" {
+		t.Fatalf("expected method pool.query, got %s", mock.calls[0].Method)
+	}
+	wantFilter := [][]any{{"name", "=", "tank"}}
+	if !reflect.DeepEqual(mock.calls[0].Params, wantFilter) {
+		t.Errorf("expected filter %v, got %v", wantFilter, mock.calls[0].Params)
+	}
+	if pool == nil || pool.ID != 1 {
+		t.Errorf("unexpected pool: %+v", pool)
+	}
+}
+
+func TestPoolService_GetByName_NotFound(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				return json.RawMessage(`[]`), nil
+			},
+		},
+	}
+
+	svc := NewPoolService(mock, Version{})
+	pool, err := svc.GetByName(context.Background(), "missing")
+	if err != nil || pool != nil {
+		t.Errorf("expected nil, nil, got %+v, %v", pool, err)
+	}
+}
+
+func TestPoolService_List(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				// An offline pool reports null sizes and an empty topology.
+				offline := `{"id": 2, "name": "backup", "guid": "42", "path": "/mnt/backup", "status": "OFFLINE",
+					"healthy": false, "warning": false, "status_code": null, "status_detail": null,
+					"size": null, "allocated": null, "free": null, "freeing": null, "fragmentation": null,
+					"autotrim": {}, "topology": {"data": [], "log": [], "cache": [], "spare": [], "special": [], "dedup": []}}`
+				return json.RawMessage(`[` + string(samplePoolJSON()) + `,` + offline + `]`), nil
+			},
+		},
+	}
+
+	svc := NewPoolService(mock, Version{})
+	pools, err := svc.List(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if mock.calls[0].Method != "pool.query" || mock.calls[0].Params != nil {
+		t.Errorf("unexpected call: %+v", mock.calls[0])
+	}
+	if len(pools) != 2 {
+		t.Fatalf("expected 2 pools, got %d", len(pools))
+	}
+	offline := pools[1]
+	if offline.Status != "OFFLINE" || offline.Size != 0 || offline.Fragmentation != 0 || offline.Autotrim ||
+		offline.StatusCode != "" || len(offline.Topology.Disks()) != 0 {
+		t.Errorf("unexpected offline pool: %+v", offline)
+	}
+}
+
+func TestPoolTopology_Disks(t *testing.T) {
+	pool := poolFromResponse(func() PoolResponse {
+		var resp PoolResponse
+		if err := json.Unmarshal(samplePoolJSON(), &resp); err != nil {
+			t.Fatalf("unmarshal: %v", err)
+		}
+		return resp
+	}())
+
+	var names []string
+	for _, disk := range pool.Topology.Disks() {
+		names = append(names, disk.Disk)
+	}
+	want := []string{"sda", "sdb", "sdc", "sdd", "nvme0n1", "nvme1n1", "sde"}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("expected disks %v, got %v", want, names)
+	}
+}
+
+func TestPoolService_Attach(t *testing.T) {
+	mock := &mockAsyncCaller{}
+
+	svc := NewPoolService(mock, Version{})
+	err := svc.Attach(context.Background(), 1, AttachPoolDiskOpts{TargetVdev: "211", NewDisk: "sdf"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if mock.calls[0].Method != "pool.attach" {
+		t.Fatalf("expected method pool.attach, got %s", mock.calls[0].Method)
+	}
+	want := []any{int64(1), map[string]any{"target_vdev": "211", "new_disk": "sdf"}}
+	if !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("expected params %v, got %v", want, mock.calls[0].Params)
+	}
+}
+
+func TestPoolService_Replace(t *testing.T) {
+	mock := &mockAsyncCaller{}
+
+	svc := NewPoolService(mock, Version{})
+	err := svc.Replace(context.Background(), 1, ReplacePoolDiskOpts{
+		Label:            "212",
+		Disk:             "{serial_lunid}ZL2F_5000c500a1b2c3d4",
+		Force:            true,
+		PreserveSettings: BoolPtr(false),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if mock.calls[0].Method != "pool.replace" {
+		t.Fatalf("expected method pool.replace, got %s", mock.calls[0].Method)
+	}
+	want := []any{int64(1), map[string]any{
+		"label":             "212",
+		"disk":              "{serial_lunid}ZL2F_5000c500a1b2c3d4",
+		"force":             true,
+		"preserve_settings": false,
+	}}
+	if !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("expected params %v, got %v", want, mock.calls[0].Params)
+	}
+}
+
+func TestPoolService_Replace_Error(t *testing.T) {
+	mock := &mockAsyncCaller{
+		callAndWaitFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return nil, errors.New("[EINVAL] options.disk: Disk is already in use")
+		},
+	}
+
+	svc := NewPoolService(mock, Version{})
+	if err := svc.Replace(context.Background(), 1, ReplacePoolDiskOpts{Label: "212", Disk: "{serial}ZL2A"}); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestPoolService_Detach(t *testing.T) {
+	mock := &mockAsyncCaller{}
+
+	svc := NewPoolService(mock, Version{})
+	if err := svc.Detach(context.Background(), 1, "222", true); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []any{int64(1), map[string]any{"label": "222", "wipe": true}}
+	if mock.calls[0].Method != "pool.detach" || !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("unexpected call: %+v", mock.calls[0])
+	}
+}
+
+func TestPoolService_Remove(t *testing.T) {
+	mock := &mockAsyncCaller{}
+
+	svc := NewPoolService(mock, Version{})
+	if err := svc.Remove(context.Background(), 1, "231"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []any{int64(1), map[string]any{"label": "231"}}
+	if mock.calls[0].Method != "pool.remove" || !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("unexpected call: %+v", mock.calls[0])
+	}
+}
+
+func TestPoolService_Offline(t *testing.T) {
+	mock := &mockAsyncCaller{}
+
+	svc := NewPoolService(mock, Version{})
+	if err := svc.Offline(context.Background(), 1, "212"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []any{int64(1), map[string]any{"label": "212"}}
+	if mock.calls[0].Method != "pool.offline" || !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("unexpected call: %+v", mock.calls[0])
+	}
+}
+
+func TestPoolService_Online(t *testing.T) {
+	mock := &mockAsyncCaller{}
+
+	svc := NewPoolService(mock, Version{})
+	if err := svc.Online(context.Background(), 1, "212"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []any{int64(1), map[string]any{"label": "212"}}
+	if mock.calls[0].Method != "pool.online" || !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("unexpected call: %+v", mock.calls[0])
+	}
+}
+
+func TestPoolService_Expand(t *testing.T) {
+	mock := &mockAsyncCaller{}
+
+	svc := NewPoolService(mock, Version{})
+	if err := svc.Expand(context.Background(), 1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if mock.calls[0].Method != "pool.expand" || mock.calls[0].Params != int64(1) {
+		t.Errorf("unexpected call: %+v", mock.calls[0])
+	}
+}
+
+func TestPoolService_Export(t *testing.T) {
+	mock := &mockAsyncCaller{}
+
+	svc := NewPoolService(mock, Version{})
+	if err := svc.Export(context.Background(), 2, ExportPoolOpts{RestartServices: true}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []any{int64(2), map[string]any{"restart_services": true}}
+	if mock.calls[0].Method != "pool.export" || !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("unexpected call: %+v", mock.calls[0])
+	}
+}
+
+func TestPoolService_Export_Destroy(t *testing.T) {
+	mock := &mockAsyncCaller{}
+
+	svc := NewPoolService(mock, Version{})
+	_ = svc.Export(context.Background(), 2, ExportPoolOpts{Cascade: true, Destroy: true})
+
+	want := []any{int64(2), map[string]any{"cascade": true, "destroy": true}}
+	if !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("expected params %v, got %v", want, mock.calls[0].Params)
+	}
+}
+
+func TestPoolService_ImportFind(t *testing.T) {
+	mock := &mockAsyncCaller{
+		callAndWaitFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return json.RawMessage(`[{"name": "backup", "guid": "42", "status": "ONLINE", "hostname": "nas2"}]`), nil
+		},
+	}
+
+	svc := NewPoolService(mock, Version{})
+	pools, err := svc.ImportFind(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if mock.calls[0].Method != "pool.import_find" {
+		t.Fatalf("expected method pool.import_find, got %s", mock.calls[0].Method)
+	}
+	want := []ImportablePool{{Name: "backup", GUID: "42", Status: "ONLINE", Hostname: "nas2"}}
+	if !reflect.DeepEqual(pools, want) {
+		t.Errorf("expected %+v, got %+v", want, pools)
+	}
+}
+
+func TestPoolService_Import(t *testing.T) {
+	mock := &mockAsyncCaller{
+		callAndWaitFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return json.RawMessage(`true`), nil
+		},
+	}
+
+	svc := NewPoolService(mock, Version{})
+	err := svc.Import(context.Background(), ImportPoolOpts{GUID: "42", Name: "backup2", EnableAttachments: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]any{"guid": "42", "name": "backup2", "enable_attachments": true}
+	if mock.calls[0].Method != "pool.import_pool" || !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("unexpected call: %+v", mock.calls[0])
+	}
+}
+
+// poolScanJSON returns a pool.get_instance response carrying only the
+// fields WatchScan reads, with the given scan object.
+func poolScanJSON(scan string) json.RawMessage {
+	return json.RawMessage(`{"id": 1, "name": "tank", "status": "ONLINE", "scan": ` + scan + `, "topology": {}}`)
+}
+
+func TestPoolService_Get_Scan(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				return poolScanJSON(`{
+					"function": "SCRUB",
+					"state": "SCANNING",
+					"start_time": {"$date": 1760000000000},
+					"end_time": null,
+					"percentage": 42.5,
+					"bytes_to_process": 2000000,
+					"bytes_processed": 900000,
+					"bytes_issued": 850000,
+					"pause": {"$date": 1760000600000},
+					"errors": 2,
+					"total_secs_left": 3600
+				}`), nil
+			},
+		},
+	}
+
+	svc := NewPoolService(mock, Version{})
+	pool, err := svc.Get(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := &PoolScan{
+		Function:       ScanScrub,
+		State:          ScanScanning,
+		StartTime:      time.UnixMilli(1760000000000),
+		Percentage:     42.5,
+		BytesToProcess: 2000000,
+		BytesProcessed: 900000,
+		BytesIssued:    850000,
+		Paused:         true,
+		PausedAt:       time.UnixMilli(1760000600000),
+		Errors:         2,
+		TimeLeft:       time.Hour,
+	}
+	if !reflect.DeepEqual(pool.Scan, want) {
+		t.Errorf("expected scan %+v, got %+v", want, pool.Scan)
+	}
+	if pool.Scan.Done() {
+		t.Error("expected a running scan not to be done")
+	}
+}
+
+func TestPoolService_Get_NeverScanned(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				return poolScanJSON(`{"function": null, "state": null, "start_time": null, "end_time": null,
+					"percentage": null, "bytes_to_process": null, "bytes_processed": null, "bytes_issued": null,
+					"pause": null, "errors": null, "total_secs_left": null}`), nil
+			},
+		},
+	}
+
+	svc := NewPoolService(mock, Version{})
+	pool, err := svc.Get(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pool.Scan != nil {
+		t.Errorf("expected nil scan, got %+v", pool.Scan)
+	}
+}
+
+func TestPoolService_WatchScan(t *testing.T) {
+	scans := []string{
+		`{"function": "RESILVER", "state": "SCANNING", "percentage": 10.0, "total_secs_left": 120}`,
+		`{"function": "RESILVER", "state": "SCANNING", "percentage": 60.0, "total_secs_left": 40}`,
+		`{"function": "RESILVER", "state": "FINISHED", "percentage": 100.0, "end_time": {"$date": 1760000900000}}`,
+	}
+	polls := 0
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				scan := scans[min(polls, len(scans)-1)]
+				polls++
+				return poolScanJSON(scan), nil
+			},
+		},
+	}
+
+	svc := NewPoolService(mock, Version{})
+	sub, err := svc.WatchScan(context.Background(), 1, time.Millisecond)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer sub.Close()
+
+	var got []float64
+	var last PoolScan
+	for scan := range sub.C {
+		got = append(got, scan.Percentage)
+		last = scan
+	}
+
+	if want := []float64{10, 60, 100}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected progress %v, got %v", want, got)
+	}
+	if !last.Done() || last.Function != ScanResilver || last.EndTime.IsZero() {
+		t.Errorf("unexpected final scan: %+v", last)
+	}
+	for _, call := range mock.calls {
+		if call.Method != "pool.get_instance" || call.Params != int64(1) {
+			t.Errorf("unexpected call: %+v", call)
+		}
+	}
+}
+
+func TestPoolService_WatchScan_Close(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				return poolScanJSON(`{"function": "SCRUB", "state": "SCANNING", "percentage": 5.0}`), nil
+			},
+		},
+	}
+
+	svc := NewPoolService(mock, Version{})
+	sub, err := svc.WatchScan(context.Background(), 1, time.Hour)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if scan := <-sub.C; scan.Percentage != 5 {
+		t.Errorf("unexpected first scan: %+v", scan)
+	}
+	sub.Close()
+	if _, ok := <-sub.C; ok {
+		t.Error("expected channel to close after Close")
+	}
+}
+
+func TestPoolService_WatchScan_NeverScanned(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				return poolScanJSON(`{}`), nil
+			},
+		},
+	}
+
+	svc := NewPoolService(mock, Version{})
+	if _, err := svc.WatchScan(context.Background(), 1, 0); err == nil {
+		t.Fatal("expected error for a pool that has never been scanned")
+	}
+}
@@ -0,0 +1,157 @@
+package reconcile
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+
+	truenas "github.com/deevus/truenas-go"
+	"gopkg.in/yaml.v3"
+)
+
+// planApps compares each desired compose file with the app's current config.
+func (r *Reconciler) planApps(ctx context.Context, desired []App) (ups, dels []*Step, err error) {
+	for _, a := range desired {
+		cur, err := r.Apps.GetAppWithConfig(ctx, a.Name)
+		if err != nil {
+			return nil, nil, fmt.Errorf("get app %q: %w", a.Name, err)
+		}
+		switch {
+		case a.Absent && cur != nil:
+			dels = append(dels, r.deleteAppStep(a.Name))
+		case a.Absent:
+		case cur == nil:
+			ups = append(ups, r.createAppStep(a))
+		case !cur.CustomApp:
+			return nil, nil, fmt.Errorf("app %q is a catalog app; only custom apps can be reconciled", a.Name)
+		default:
+			step, err := r.updateAppStep(a, cur)
+			if err != nil {
+				return nil, nil, err
+			}
+			if step != nil {
+				ups = append(ups, step)
+			}
+		}
+	}
+	return ups, dels, nil
+}
+
+// parseCompose parses compose YAML into the JSON shape the middleware
+// returns as the app config.
+func parseCompose(compose string) (map[string]any, error) {
+	var parsed map[string]any
+	if err := yaml.Unmarshal([]byte(compose), &parsed); err != nil {
+		return nil, fmt.Errorf("parse compose: %w", err)
+	}
+	if len(parsed) == 0 {
+		return nil, errors.New("compose is required")
+	}
+	return normalize(parsed)
+}
+
+// normalize round-trips v through JSON so values compare equal regardless
+// of their source, e.g. YAML ints and JSON float64s.
+func normalize(v map[string]any) (map[string]any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var out map[string]any
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// composeChanges reports the top-level compose keys that differ.
+func composeChanges(cur, want map[string]any) []Change {
+	keys := make(map[string]bool)
+	for k := range cur {
+		keys[k] = true
+	}
+	for k := range want {
+		keys[k] = true
+	}
+	sorted := make([]string, 0, len(keys))
+	for k := range keys {
+		sorted = append(sorted, k)
+	}
+	sort.Strings(sorted)
+
+	var changes []Change
+	for _, k := range sorted {
+		if !reflect.DeepEqual(cur[k], want[k]) {
+			changes = append(changes, Change{Field: "compose." + k, Old: cur[k], New: want[k]})
+		}
+	}
+	return changes
+}
+
+func (r *Reconciler) createAppStep(a App) *Step {
+	want, _ := parseCompose(a.Compose) // checked by Validate
+	return &Step{
+		Action:  ActionCreate,
+		Kind:    KindApp,
+		Name:    a.Name,
+		Changes: composeChanges(nil, want),
+		apply: func(ctx context.Context) error {
+			_, err := r.Apps.CreateApp(ctx, truenas.CreateAppOpts{
+				Name:                a.Name,
+				CustomApp:           true,
+				CustomComposeConfig: a.Compose,
+			})
+			return err
+		},
+		undo: func(ctx context.Context) error {
+			return r.Apps.DeleteApp(ctx, a.Name)
+		},
+	}
+}
+
+// updateAppStep returns nil if the app's config already matches a.Compose.
+func (r *Reconciler) updateAppStep(a App, cur *truenas.App) (*Step, error) {
+	want, _ := parseCompose(a.Compose) // checked by Validate
+	have, err := normalize(cur.Config)
+	if err != nil {
+		return nil, fmt.Errorf("app %q: %w", a.Name, err)
+	}
+	changes := composeChanges(have, want)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	previous, err := yaml.Marshal(have)
+	if err != nil {
+		return nil, fmt.Errorf("app %q: %w", a.Name, err)
+	}
+
+	return &Step{
+		Action:  ActionUpdate,
+		Kind:    KindApp,
+		Name:    a.Name,
+		Changes: changes,
+		apply: func(ctx context.Context) error {
+			_, err := r.Apps.UpdateApp(ctx, a.Name, truenas.UpdateAppOpts{CustomComposeConfig: a.Compose})
+			return err
+		},
+		undo: func(ctx context.Context) error {
+			_, err := r.Apps.UpdateApp(ctx, a.Name, truenas.UpdateAppOpts{CustomComposeConfig: string(previous)})
+			return err
+		},
+	}, nil
+}
+
+// deleteAppStep cannot be undone: the app's volumes are removed with it.
+func (r *Reconciler) deleteAppStep(name string) *Step {
+	return &Step{
+		Action: ActionDelete,
+		Kind:   KindApp,
+		Name:   name,
+		apply: func(ctx context.Context) error {
+			return r.Apps.DeleteApp(ctx, name)
+		},
+	}
+}
@@ -0,0 +1,67 @@
+package reconcile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ApplyError reports a failed step and the outcome of rolling back the
+// steps applied before it.
+type ApplyError struct {
+	Step        *Step   // the step that failed
+	Err         error   // why it failed
+	RolledBack  []*Step // steps undone, most recent first
+	RollbackErr error   // steps that could not be undone, if any
+}
+
+func (e *ApplyError) Error() string {
+	msg := fmt.Sprintf("%s: %v", e.Step, e.Err)
+	if len(e.RolledBack) > 0 {
+		msg += fmt.Sprintf(" (rolled back %d steps)", len(e.RolledBack))
+	}
+	if e.RollbackErr != nil {
+		msg += fmt.Sprintf("; rollback incomplete: %v", e.RollbackErr)
+	}
+	return msg
+}
+
+func (e *ApplyError) Unwrap() error {
+	return e.Err
+}
+
+// Apply runs the steps in order. If a step fails, the steps already applied
+// are undone in reverse order and an *ApplyError is returned. Rollback runs
+// even if ctx is canceled, so an interrupted apply does not leave the system
+// half-converged.
+func (p *Plan) Apply(ctx context.Context) error {
+	for i, step := range p.Steps {
+		if err := ctx.Err(); err != nil {
+			return rollback(ctx, p.Steps[:i], step, err)
+		}
+		if err := step.apply(ctx); err != nil {
+			return rollback(ctx, p.Steps[:i], step, err)
+		}
+	}
+	return nil
+}
+
+func rollback(ctx context.Context, applied []*Step, failed *Step, err error) *ApplyError {
+	ctx = context.WithoutCancel(ctx)
+	applyErr := &ApplyError{Step: failed, Err: err}
+	var errs []error
+	for i := len(applied) - 1; i >= 0; i-- {
+		step := applied[i]
+		if step.undo == nil {
+			errs = append(errs, fmt.Errorf("%s cannot be undone", step))
+			continue
+		}
+		if err := step.undo(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("undo %s: %w", step, err))
+			continue
+		}
+		applyErr.RolledBack = append(applyErr.RolledBack, step)
+	}
+	applyErr.RollbackErr = errors.Join(errs...)
+	return applyErr
+}
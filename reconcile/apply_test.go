@@ -0,0 +1,235 @@
+package reconcile
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	truenas "github.com/deevus/truenas-go"
+)
+
+func TestApply(t *testing.T) {
+	f := newFakeSystem()
+	f.datasets["tank/data"] = truenas.Dataset{ID: "tank/data", Compression: "OFF"}
+	desired := State{
+		Datasets: []Dataset{{Name: "tank/data", Compression: "lz4"}, {Name: "tank/apps"}},
+		CronJobs: []CronJob{{Description: "backup", Command: "backup.sh", Schedule: "0 3 * * *"}},
+		Apps:     []App{{Name: "web", Compose: "services:\n  nginx:\n    image: nginx\n"}},
+	}
+	r := f.reconciler()
+
+	plan, err := r.Reconcile(context.Background(), desired)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(plan.Steps) != 4 {
+		t.Errorf("expected 4 steps, got %q", stepNames(plan.Steps))
+	}
+	if f.datasets["tank/data"].Compression != "LZ4" || len(f.jobs) != 1 || len(f.apps) != 1 {
+		t.Errorf("system not converged: %+v %+v %+v", f.datasets, f.jobs, f.apps)
+	}
+
+	// A second run finds nothing to do.
+	again, err := r.Plan(context.Background(), desired)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !again.Empty() {
+		t.Errorf("expected converged system, got %q", stepNames(again.Steps))
+	}
+}
+
+func TestApply_RollsBackOnFailure(t *testing.T) {
+	f := newFakeSystem()
+	f.datasets["tank/data"] = truenas.Dataset{ID: "tank/data", Compression: "OFF", Quota: 5}
+	f.jobs[7] = truenas.CronJob{ID: 7, User: "root", Command: "old.sh", Description: "backup", Enabled: true,
+		Schedule: truenas.Schedule{Minute: "0", Hour: "3", Dom: "*", Month: "*", Dow: "*"}}
+	f.failOn = "create app web"
+
+	plan, err := f.reconciler().Plan(context.Background(), State{
+		Datasets: []Dataset{{Name: "tank/data", Compression: "lz4", Quota: ptr(Size(10))}, {Name: "tank/apps"}},
+		CronJobs: []CronJob{{Description: "backup", Command: "new.sh", Schedule: "0 3 * * *"}, {Description: "extra", Command: "x", Schedule: "* * * * *"}},
+		Apps:     []App{{Name: "web", Compose: "services:\n  nginx:\n    image: nginx\n"}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	err = plan.Apply(context.Background())
+	var applyErr *ApplyError
+	if !errors.As(err, &applyErr) {
+		t.Fatalf("expected *ApplyError, got %v", err)
+	}
+	if applyErr.Step.Name != "web" || applyErr.RollbackErr != nil || len(applyErr.RolledBack) != 4 {
+		t.Errorf("unexpected apply error: %v", applyErr)
+	}
+	if !strings.Contains(err.Error(), `create app "web": boom (rolled back 4 steps)`) {
+		t.Errorf("unexpected message %q", err)
+	}
+
+	wantWrites := []string{
+		"update dataset tank/data",
+		"create dataset tank/apps",
+		"update cron_job backup",
+		"create cron_job extra",
+		"create app web",
+		"delete cron_job extra",
+		"update cron_job backup",
+		"delete dataset tank/apps",
+		"update dataset tank/data",
+	}
+	if !reflect.DeepEqual(f.writes, wantWrites) {
+		t.Errorf("writes = %q, want %q", f.writes, wantWrites)
+	}
+	if ds := f.datasets["tank/data"]; ds.Compression != "OFF" || ds.Quota != 5 {
+		t.Errorf("dataset not restored: %+v", ds)
+	}
+	if _, ok := f.datasets["tank/apps"]; ok {
+		t.Error("created dataset not removed")
+	}
+	if len(f.jobs) != 1 || f.jobs[7].Command != "old.sh" {
+		t.Errorf("cron jobs not restored: %+v", f.jobs)
+	}
+}
+
+func TestApply_RollsBackShares(t *testing.T) {
+	f := newFakeSystem()
+	f.smb[1] = truenas.SMBShare{ID: 1, Name: "media", Path: "/mnt/tank/media", HostsAllow: []string{"10.0.0.0/8"}}
+	f.smb[2] = truenas.SMBShare{ID: 2, Name: "old", Path: "/mnt/tank/old", ReadOnly: true}
+	f.smb[3] = truenas.SMBShare{ID: 3, Name: "gone", Path: "/mnt/tank/gone"}
+	f.nfs[4] = truenas.NFSShare{ID: 4, Path: "/mnt/tank/media", MaprootUser: "root"}
+	f.failOn = "delete smb_share gone"
+
+	plan, err := f.reconciler().Plan(context.Background(), State{Shares: Shares{
+		SMB: []SMBShare{{Name: "media", Path: "/mnt/tank/media", HostsAllow: []string{}}, {Name: "old", Absent: true}, {Name: "gone", Absent: true}},
+		NFS: []NFSShare{{Path: "/mnt/tank/media", Absent: true}},
+	}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	err = plan.Apply(context.Background())
+	var applyErr *ApplyError
+	if !errors.As(err, &applyErr) {
+		t.Fatalf("expected *ApplyError, got %v", err)
+	}
+	if applyErr.RollbackErr != nil || len(applyErr.RolledBack) != 3 {
+		t.Errorf("unexpected apply error: %v", applyErr)
+	}
+
+	wantWrites := []string{
+		"update smb_share media",
+		"delete nfs_share /mnt/tank/media",
+		"delete smb_share old",
+		"delete smb_share gone",
+		"create smb_share old",
+		"create nfs_share /mnt/tank/media",
+		"update smb_share media",
+	}
+	if !reflect.DeepEqual(f.writes, wantWrites) {
+		t.Errorf("writes = %q, want %q", f.writes, wantWrites)
+	}
+	if media := f.smb[1]; !reflect.DeepEqual(media.HostsAllow, []string{"10.0.0.0/8"}) {
+		t.Errorf("hosts_allow not restored: %+v", media)
+	}
+	var recreated, exported bool
+	for _, share := range f.nfs {
+		exported = exported || share.Path == "/mnt/tank/media" && share.MaprootUser == "root"
+	}
+	if !exported {
+		t.Errorf("deleted export not recreated: %+v", f.nfs)
+	}
+	for _, share := range f.smb {
+		if share.Name == "old" && share.Path == "/mnt/tank/old" && share.ReadOnly {
+			recreated = true
+		}
+	}
+	if !recreated {
+		t.Errorf("deleted share not recreated: %+v", f.smb)
+	}
+}
+
+func TestApply_IrreversibleSteps(t *testing.T) {
+	f := newFakeSystem()
+	f.datasets["tank/a"] = truenas.Dataset{ID: "tank/a"}
+	f.datasets["tank/b"] = truenas.Dataset{ID: "tank/b"}
+	f.failOn = "delete dataset tank/a"
+
+	plan, err := f.reconciler().Plan(context.Background(), State{
+		Datasets: []Dataset{{Name: "tank/a", Absent: true}, {Name: "tank/b", Absent: true}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if plan.Steps[0].Reversible() {
+		t.Error("expected dataset deletion to be irreversible")
+	}
+
+	err = plan.Apply(context.Background())
+	var applyErr *ApplyError
+	if !errors.As(err, &applyErr) {
+		t.Fatalf("expected *ApplyError, got %v", err)
+	}
+	if applyErr.RollbackErr == nil || !strings.Contains(applyErr.RollbackErr.Error(), `delete dataset "tank/b" cannot be undone`) {
+		t.Errorf("expected irreversible step reported, got %v", applyErr.RollbackErr)
+	}
+}
+
+func TestApply_FailedDeleteKeepsApps(t *testing.T) {
+	f := newFakeSystem()
+	f.jobs[1] = truenas.CronJob{ID: 1, Description: "old", Command: "old.sh", User: "root",
+		Schedule: truenas.Schedule{Minute: "0", Hour: "3", Dom: "*", Month: "*", Dow: "*"}}
+	f.apps["old"] = truenas.App{Name: "old", CustomApp: true}
+	f.failOn = "delete cron_job old"
+
+	plan, err := f.reconciler().Plan(context.Background(), State{
+		CronJobs: []CronJob{{Description: "old", Absent: true}},
+		Apps:     []App{{Name: "old", Absent: true}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	err = plan.Apply(context.Background())
+	var applyErr *ApplyError
+	if !errors.As(err, &applyErr) {
+		t.Fatalf("expected *ApplyError, got %v", err)
+	}
+	if applyErr.RollbackErr != nil {
+		t.Errorf("unexpected rollback error: %v", applyErr.RollbackErr)
+	}
+	if want := []string{"delete cron_job old"}; !reflect.DeepEqual(f.writes, want) {
+		t.Errorf("writes = %q, want %q", f.writes, want)
+	}
+	if _, ok := f.apps["old"]; !ok {
+		t.Error("expected app to survive the failed cron job deletion")
+	}
+}
+
+func TestApply_CanceledContextStillRollsBack(t *testing.T) {
+	f := newFakeSystem()
+	ctx, cancel := context.WithCancel(context.Background())
+	r := f.reconciler()
+	plan, err := r.Plan(ctx, State{Datasets: []Dataset{{Name: "tank/a"}, {Name: "tank/b"}}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Cancel after the first step.
+	first := plan.Steps[0].apply
+	plan.Steps[0].apply = func(ctx context.Context) error {
+		err := first(ctx)
+		cancel()
+		return err
+	}
+
+	err = plan.Apply(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if len(f.datasets) != 0 {
+		t.Errorf("expected rollback of tank/a, got %+v", f.datasets)
+	}
+}
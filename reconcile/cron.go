@@ -0,0 +1,177 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	truenas "github.com/deevus/truenas-go"
+)
+
+// planCronJobs matches desired jobs to existing ones by description.
+func (r *Reconciler) planCronJobs(ctx context.Context, desired []CronJob) (ups, dels []*Step, err error) {
+	if len(desired) == 0 {
+		return nil, nil, nil
+	}
+	existing, err := r.Cron.List(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("list cron jobs: %w", err)
+	}
+	current := make(map[string]truenas.CronJob, len(existing))
+	duplicate := make(map[string]bool)
+	for _, job := range existing {
+		if _, ok := current[job.Description]; ok {
+			duplicate[job.Description] = true
+		}
+		current[job.Description] = job
+	}
+
+	for _, j := range desired {
+		if duplicate[j.Description] {
+			return nil, nil, fmt.Errorf("cron job %q: several existing jobs have this description", j.Description)
+		}
+		cur, exists := current[j.Description]
+		switch {
+		case j.Absent && exists:
+			dels = append(dels, r.deleteCronJobStep(cur))
+		case j.Absent:
+		case !exists:
+			ups = append(ups, r.createCronJobStep(j))
+		default:
+			if step := r.updateCronJobStep(j, cur); step != nil {
+				ups = append(ups, step)
+			}
+		}
+	}
+	return ups, dels, nil
+}
+
+// cronJobOpts applies the defaults for fields the State leaves empty.
+func cronJobOpts(j CronJob) truenas.CreateCronJobOpts {
+	schedule, _ := parseSchedule(j.Schedule) // checked by Validate
+	opts := truenas.CreateCronJobOpts{
+		User:          j.User,
+		Command:       j.Command,
+		Description:   j.Description,
+		Enabled:       true,
+		CaptureStdout: j.CaptureStdout,
+		CaptureStderr: j.CaptureStderr,
+		Schedule:      schedule,
+	}
+	if opts.User == "" {
+		opts.User = "root"
+	}
+	if j.Enabled != nil {
+		opts.Enabled = *j.Enabled
+	}
+	return opts
+}
+
+// existingCronJobOpts returns the options that recreate job.
+func existingCronJobOpts(job truenas.CronJob) truenas.CreateCronJobOpts {
+	return truenas.CreateCronJobOpts{
+		User:          job.User,
+		Command:       job.Command,
+		Description:   job.Description,
+		Enabled:       job.Enabled,
+		CaptureStdout: job.CaptureStdout,
+		CaptureStderr: job.CaptureStderr,
+		Schedule:      job.Schedule,
+	}
+}
+
+// parseSchedule parses five cron fields: minute hour dom month dow.
+func parseSchedule(s string) (truenas.Schedule, error) {
+	f := strings.Fields(s)
+	if len(f) != 5 {
+		return truenas.Schedule{}, fmt.Errorf("schedule %q: want five cron fields (minute hour dom month dow)", s)
+	}
+	return truenas.Schedule{Minute: f[0], Hour: f[1], Dom: f[2], Month: f[3], Dow: f[4]}, nil
+}
+
+func formatSchedule(s truenas.Schedule) string {
+	return strings.Join([]string{s.Minute, s.Hour, s.Dom, s.Month, s.Dow}, " ")
+}
+
+// cronJobFields lists the compared fields of a job in display order.
+func cronJobFields(o truenas.CreateCronJobOpts) []Change {
+	return []Change{
+		{Field: "command", New: o.Command},
+		{Field: "user", New: o.User},
+		{Field: "schedule", New: formatSchedule(o.Schedule)},
+		{Field: "enabled", New: o.Enabled},
+		{Field: "capture_stdout", New: o.CaptureStdout},
+		{Field: "capture_stderr", New: o.CaptureStderr},
+	}
+}
+
+func (r *Reconciler) createCronJobStep(j CronJob) *Step {
+	opts := cronJobOpts(j)
+	var created int64
+	return &Step{
+		Action:  ActionCreate,
+		Kind:    KindCronJob,
+		Name:    j.Description,
+		Changes: cronJobFields(opts),
+		apply: func(ctx context.Context) error {
+			job, err := r.Cron.Create(ctx, opts)
+			if err != nil {
+				return err
+			}
+			created = job.ID
+			return nil
+		},
+		undo: func(ctx context.Context) error {
+			return r.Cron.Delete(ctx, created)
+		},
+	}
+}
+
+// updateCronJobStep returns nil if cur already matches j. Updates send every
+// field, so the undo restores the whole previous job.
+func (r *Reconciler) updateCronJobStep(j CronJob, cur truenas.CronJob) *Step {
+	opts := cronJobOpts(j)
+	old := existingCronJobOpts(cur)
+
+	var changes []Change
+	oldFields := cronJobFields(old)
+	for i, c := range cronJobFields(opts) {
+		if c.New != oldFields[i].New {
+			changes = append(changes, Change{Field: c.Field, Old: oldFields[i].New, New: c.New})
+		}
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+
+	return &Step{
+		Action:  ActionUpdate,
+		Kind:    KindCronJob,
+		Name:    j.Description,
+		Changes: changes,
+		apply: func(ctx context.Context) error {
+			_, err := r.Cron.Update(ctx, cur.ID, opts)
+			return err
+		},
+		undo: func(ctx context.Context) error {
+			_, err := r.Cron.Update(ctx, cur.ID, old)
+			return err
+		},
+	}
+}
+
+// deleteCronJobStep is undone by recreating the job, under a new ID.
+func (r *Reconciler) deleteCronJobStep(cur truenas.CronJob) *Step {
+	return &Step{
+		Action: ActionDelete,
+		Kind:   KindCronJob,
+		Name:   cur.Description,
+		apply: func(ctx context.Context) error {
+			return r.Cron.Delete(ctx, cur.ID)
+		},
+		undo: func(ctx context.Context) error {
+			_, err := r.Cron.Create(ctx, existingCronJobOpts(cur))
+			return err
+		},
+	}
+}
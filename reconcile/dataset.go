@@ -0,0 +1,188 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	truenas "github.com/deevus/truenas-go"
+)
+
+// planDatasets returns create/update steps, parents first, and delete
+// steps, children first.
+func (r *Reconciler) planDatasets(ctx context.Context, desired []Dataset) (ups, dels []*Step, err error) {
+	if len(desired) == 0 {
+		return nil, nil, nil
+	}
+	existing, err := r.Datasets.ListDatasets(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("list datasets: %w", err)
+	}
+	current := make(map[string]truenas.Dataset, len(existing))
+	for _, ds := range existing {
+		current[ds.ID] = ds
+	}
+
+	sorted := make([]Dataset, len(desired))
+	copy(sorted, desired)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return depth(sorted[i].Name) < depth(sorted[j].Name)
+	})
+
+	for _, d := range sorted {
+		cur, exists := current[d.Name]
+		switch {
+		case d.Absent && exists:
+			dels = append(dels, r.deleteDatasetStep(d.Name))
+		case d.Absent:
+		case !exists:
+			ups = append(ups, r.createDatasetStep(d))
+		default:
+			if step := r.updateDatasetStep(d, cur); step != nil {
+				ups = append(ups, step)
+			}
+		}
+	}
+
+	// Children must go before their parents.
+	for i, j := 0, len(dels)-1; i < j; i, j = i+1, j-1 {
+		dels[i], dels[j] = dels[j], dels[i]
+	}
+	return ups, dels, nil
+}
+
+func depth(name string) int {
+	return strings.Count(name, "/")
+}
+
+func (r *Reconciler) createDatasetStep(d Dataset) *Step {
+	opts := truenas.CreateDatasetOpts{
+		Name:        d.Name,
+		Compression: d.Compression,
+		Atime:       d.Atime,
+	}
+	var changes []Change
+	if d.Compression != "" {
+		changes = append(changes, Change{Field: "compression", New: d.Compression})
+	}
+	if d.Atime != "" {
+		changes = append(changes, Change{Field: "atime", New: d.Atime})
+	}
+	if d.Comments != nil {
+		opts.Comments = *d.Comments
+		changes = append(changes, Change{Field: "comments", New: *d.Comments})
+	}
+	if d.Quota != nil {
+		opts.Quota = int64(*d.Quota)
+		changes = append(changes, Change{Field: "quota", New: opts.Quota})
+	}
+	if d.RefQuota != nil {
+		opts.RefQuota = int64(*d.RefQuota)
+		changes = append(changes, Change{Field: "refquota", New: opts.RefQuota})
+	}
+
+	return &Step{
+		Action:  ActionCreate,
+		Kind:    KindDataset,
+		Name:    d.Name,
+		Changes: changes,
+		apply: func(ctx context.Context) error {
+			_, err := r.Datasets.CreateDataset(ctx, opts)
+			return err
+		},
+		undo: func(ctx context.Context) error {
+			return r.Datasets.DeleteDataset(ctx, d.Name, false)
+		},
+	}
+}
+
+// updateDatasetStep returns nil if cur already matches d.
+func (r *Reconciler) updateDatasetStep(d Dataset, cur truenas.Dataset) *Step {
+	var opts, revert truenas.UpdateDatasetOpts
+	var changes []Change
+
+	// The middleware reports these properties in upper case.
+	if d.Compression != "" {
+		if set, undo, ok := diffProperty(d.Compression, cur.Compression, cur.CompressionInherited, true); ok {
+			opts.Compression, revert.Compression = set, undo
+			changes = append(changes, Change{Field: "compression", Old: cur.Compression, New: set})
+		}
+	}
+	if d.Atime != "" {
+		if set, undo, ok := diffProperty(d.Atime, cur.Atime, cur.AtimeInherited, true); ok {
+			opts.Atime, revert.Atime = set, undo
+			changes = append(changes, Change{Field: "atime", Old: cur.Atime, New: set})
+		}
+	}
+	if d.Comments != nil {
+		if set, undo, ok := diffProperty(*d.Comments, cur.Comments, cur.CommentsInherited, false); ok {
+			opts.Comments, revert.Comments = &set, &undo
+			changes = append(changes, Change{Field: "comments", Old: cur.Comments, New: set})
+		}
+	}
+	if d.Quota != nil && int64(*d.Quota) != cur.Quota {
+		q := int64(*d.Quota)
+		opts.Quota, revert.Quota = &q, &cur.Quota
+		changes = append(changes, Change{Field: "quota", Old: cur.Quota, New: q})
+	}
+	if d.RefQuota != nil && int64(*d.RefQuota) != cur.RefQuota {
+		q := int64(*d.RefQuota)
+		opts.RefQuota, revert.RefQuota = &q, &cur.RefQuota
+		changes = append(changes, Change{Field: "refquota", Old: cur.RefQuota, New: q})
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+
+	return &Step{
+		Action:  ActionUpdate,
+		Kind:    KindDataset,
+		Name:    d.Name,
+		Changes: changes,
+		apply: func(ctx context.Context) error {
+			_, err := r.Datasets.UpdateDataset(ctx, d.Name, opts)
+			return err
+		},
+		undo: func(ctx context.Context) error {
+			_, err := r.Datasets.UpdateDataset(ctx, d.Name, revert)
+			return err
+		},
+	}
+}
+
+// inherit clears a property so the dataset inherits it from its parent.
+const inherit = "INHERIT"
+
+// diffProperty compares a desired property value with the current one and
+// returns the value to set and the value that undoes it, or ok false if they
+// already match. A desired INHERIT matches any inherited value, and undoing
+// a change to an inherited property restores inheritance rather than
+// pinning the inherited value on the dataset.
+func diffProperty(want, cur string, inherited, fold bool) (set, undo string, ok bool) {
+	if strings.EqualFold(want, inherit) {
+		if inherited {
+			return "", "", false
+		}
+		return inherit, cur, true
+	}
+	if want == cur || fold && strings.EqualFold(want, cur) {
+		return "", "", false
+	}
+	if inherited {
+		return want, inherit, true
+	}
+	return want, cur, true
+}
+
+// deleteDatasetStep cannot be undone: the data is gone.
+func (r *Reconciler) deleteDatasetStep(name string) *Step {
+	return &Step{
+		Action: ActionDelete,
+		Kind:   KindDataset,
+		Name:   name,
+		apply: func(ctx context.Context) error {
+			return r.Datasets.DeleteDataset(ctx, name, false)
+		},
+	}
+}
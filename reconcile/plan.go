@@ -0,0 +1,198 @@
+package reconcile
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	truenas "github.com/deevus/truenas-go"
+)
+
+// Action is what a Step does to a resource.
+type Action string
+
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+// Kind is the type of resource a Step acts on.
+type Kind string
+
+const (
+	KindDataset      Kind = "dataset"
+	KindSnapshotTask Kind = "snapshot_task"
+	KindSMBShare     Kind = "smb_share"
+	KindNFSShare     Kind = "nfs_share"
+	KindCronJob      Kind = "cron_job"
+	KindApp          Kind = "app"
+)
+
+// Change is a field-level difference. Old is nil for created resources.
+type Change struct {
+	Field string
+	Old   any
+	New   any
+}
+
+// Step is one create, update or delete of a resource.
+type Step struct {
+	Action  Action
+	Kind    Kind
+	Name    string
+	Changes []Change
+
+	apply func(ctx context.Context) error
+	undo  func(ctx context.Context) error // nil if the step cannot be undone
+}
+
+// String returns e.g. `update dataset "tank/data"`.
+func (s *Step) String() string {
+	return fmt.Sprintf("%s %s %q", s.Action, s.Kind, s.Name)
+}
+
+// Reversible reports whether the step can be rolled back once applied.
+// Deleting a dataset or app destroys its data, so it cannot.
+func (s *Step) Reversible() bool {
+	return s.undo != nil
+}
+
+// Plan is the ordered list of steps that converges the system on a State.
+type Plan struct {
+	Steps []*Step
+}
+
+// Empty reports whether the system already matches the desired state.
+func (p *Plan) Empty() bool {
+	return len(p.Steps) == 0
+}
+
+// String renders the plan for review: one line per step marked "+" for
+// create, "~" for update and "-" for delete, an indented "field: old -> new"
+// line per change, and a summary line.
+func (p *Plan) String() string {
+	var b strings.Builder
+	counts := make(map[Action]int)
+	for _, s := range p.Steps {
+		counts[s.Action]++
+		symbol := map[Action]string{ActionCreate: "+", ActionUpdate: "~", ActionDelete: "-"}[s.Action]
+		fmt.Fprintf(&b, "%s %s %q\n", symbol, s.Kind, s.Name)
+		for _, c := range s.Changes {
+			if s.Action == ActionCreate {
+				fmt.Fprintf(&b, "    %s: %s\n", c.Field, formatValue(c.New))
+			} else {
+				fmt.Fprintf(&b, "    %s: %s -> %s\n", c.Field, formatValue(c.Old), formatValue(c.New))
+			}
+		}
+	}
+	if p.Empty() {
+		b.WriteString("No changes.\n")
+	} else {
+		fmt.Fprintf(&b, "Plan: %d to create, %d to update, %d to delete.\n",
+			counts[ActionCreate], counts[ActionUpdate], counts[ActionDelete])
+	}
+	return b.String()
+}
+
+func formatValue(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return "(none)"
+	case string:
+		return fmt.Sprintf("%q", v)
+	case []string:
+		if len(v) == 0 {
+			return "(none)"
+		}
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(data)
+	case map[string]any, []any:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(data)
+	}
+	return fmt.Sprint(v)
+}
+
+// Reconciler plans changes using the services it reads and writes through.
+type Reconciler struct {
+	Datasets      truenas.DatasetServiceAPI
+	SnapshotTasks truenas.SnapshotTaskServiceAPI
+	SMB           truenas.SMBServiceAPI
+	NFS           truenas.NFSServiceAPI
+	Cron          truenas.CronServiceAPI
+	Apps          truenas.AppServiceAPI
+}
+
+// New creates a Reconciler using the services of a connected client.
+func New(c truenas.SubscribeCaller, v truenas.Version) *Reconciler {
+	return &Reconciler{
+		Datasets:      truenas.NewDatasetService(c, v),
+		SnapshotTasks: truenas.NewSnapshotTaskService(c, v),
+		SMB:           truenas.NewSMBService(c, v),
+		NFS:           truenas.NewNFSService(c, v),
+		Cron:          truenas.NewCronService(c, v),
+		Apps:          truenas.NewAppService(c, v),
+	}
+}
+
+// Plan compares desired with the current state and returns the steps to
+// converge on it. Nothing is changed until the plan is applied.
+func (r *Reconciler) Plan(ctx context.Context, desired State) (*Plan, error) {
+	if err := desired.Validate(); err != nil {
+		return nil, err
+	}
+
+	datasetUps, datasetDels, err := r.planDatasets(ctx, desired.Datasets)
+	if err != nil {
+		return nil, err
+	}
+	taskUps, taskDels, err := r.planSnapshotTasks(ctx, desired.SnapshotTasks)
+	if err != nil {
+		return nil, err
+	}
+	smbUps, smbDels, err := r.planSMBShares(ctx, desired.Shares.SMB)
+	if err != nil {
+		return nil, err
+	}
+	nfsUps, nfsDels, err := r.planNFSShares(ctx, desired.Shares.NFS)
+	if err != nil {
+		return nil, err
+	}
+	cronUps, cronDels, err := r.planCronJobs(ctx, desired.CronJobs)
+	if err != nil {
+		return nil, err
+	}
+	appUps, appDels, err := r.planApps(ctx, desired.Apps)
+	if err != nil {
+		return nil, err
+	}
+
+	// Everything else may use datasets, so datasets are created first and
+	// deleted last. App deletions cannot be undone either, so they run after
+	// the reversible deletions: if one of those fails, the apps still exist.
+	var plan Plan
+	for _, steps := range [][]*Step{
+		datasetUps, taskUps, smbUps, nfsUps, cronUps, appUps,
+		cronDels, nfsDels, smbDels, taskDels, appDels, datasetDels,
+	} {
+		plan.Steps = append(plan.Steps, steps...)
+	}
+	return &plan, nil
+}
+
+// Reconcile plans and applies desired in one call, returning the applied plan.
+func (r *Reconciler) Reconcile(ctx context.Context, desired State) (*Plan, error) {
+	plan, err := r.Plan(ctx, desired)
+	if err != nil {
+		return nil, err
+	}
+	return plan, plan.Apply(ctx)
+}
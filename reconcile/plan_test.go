@@ -0,0 +1,629 @@
+package reconcile
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	truenas "github.com/deevus/truenas-go"
+)
+
+// fakeSystem is an in-memory system behind the service mocks that records
+// every write.
+type fakeSystem struct {
+	datasets map[string]truenas.Dataset
+	tasks    map[int64]truenas.SnapshotTask
+	smb      map[int64]truenas.SMBShare
+	nfs      map[int64]truenas.NFSShare
+	jobs     map[int64]truenas.CronJob
+	apps     map[string]truenas.App
+	nextID   int64
+	writes   []string
+	failOn   string // write that fails, e.g. "create app web"
+}
+
+func newFakeSystem() *fakeSystem {
+	return &fakeSystem{
+		datasets: make(map[string]truenas.Dataset),
+		tasks:    make(map[int64]truenas.SnapshotTask),
+		smb:      make(map[int64]truenas.SMBShare),
+		nfs:      make(map[int64]truenas.NFSShare),
+		jobs:     make(map[int64]truenas.CronJob),
+		apps:     make(map[string]truenas.App),
+		nextID:   100,
+	}
+}
+
+func (f *fakeSystem) write(op string) error {
+	f.writes = append(f.writes, op)
+	if op == f.failOn {
+		return errors.New("boom")
+	}
+	return nil
+}
+
+func (f *fakeSystem) reconciler() *Reconciler {
+	return &Reconciler{
+		Datasets: &truenas.MockDatasetService{
+			ListDatasetsFunc: func(ctx context.Context) ([]truenas.Dataset, error) {
+				var out []truenas.Dataset
+				for _, ds := range f.datasets {
+					out = append(out, ds)
+				}
+				return out, nil
+			},
+			CreateDatasetFunc: func(ctx context.Context, opts truenas.CreateDatasetOpts) (*truenas.Dataset, error) {
+				if err := f.write("create dataset " + opts.Name); err != nil {
+					return nil, err
+				}
+				ds := truenas.Dataset{ID: opts.Name, Name: opts.Name, Compression: strings.ToUpper(opts.Compression), Quota: opts.Quota}
+				f.datasets[opts.Name] = ds
+				return &ds, nil
+			},
+			UpdateDatasetFunc: func(ctx context.Context, id string, opts truenas.UpdateDatasetOpts) (*truenas.Dataset, error) {
+				if err := f.write("update dataset " + id); err != nil {
+					return nil, err
+				}
+				ds := f.datasets[id]
+				if opts.Compression != "" {
+					ds.Compression = strings.ToUpper(opts.Compression)
+				}
+				if opts.Quota != nil {
+					ds.Quota = *opts.Quota
+				}
+				f.datasets[id] = ds
+				return &ds, nil
+			},
+			DeleteDatasetFunc: func(ctx context.Context, id string, recursive bool) error {
+				if err := f.write("delete dataset " + id); err != nil {
+					return err
+				}
+				delete(f.datasets, id)
+				return nil
+			},
+		},
+		SnapshotTasks: &truenas.MockSnapshotTaskService{
+			ListFunc: func(ctx context.Context) ([]truenas.SnapshotTask, error) {
+				var out []truenas.SnapshotTask
+				for _, task := range f.tasks {
+					out = append(out, task)
+				}
+				return out, nil
+			},
+			CreateFunc: func(ctx context.Context, opts truenas.CreateSnapshotTaskOpts) (*truenas.SnapshotTask, error) {
+				if err := f.write("create snapshot_task " + opts.Dataset); err != nil {
+					return nil, err
+				}
+				f.nextID++
+				task := truenas.SnapshotTask{
+					ID: f.nextID, Dataset: opts.Dataset, Recursive: opts.Recursive, Exclude: opts.Exclude,
+					LifetimeValue: opts.LifetimeValue, LifetimeUnit: opts.LifetimeUnit, NamingSchema: opts.NamingSchema,
+					AllowEmpty: *opts.AllowEmpty, Enabled: *opts.Enabled, Schedule: *opts.Schedule,
+				}
+				f.tasks[task.ID] = task
+				return &task, nil
+			},
+			UpdateFunc: func(ctx context.Context, id int64, opts truenas.UpdateSnapshotTaskOpts) (*truenas.SnapshotTask, error) {
+				if err := f.write("update snapshot_task " + f.tasks[id].Dataset); err != nil {
+					return nil, err
+				}
+				task := truenas.SnapshotTask{
+					ID: id, Dataset: opts.Dataset, Recursive: *opts.Recursive, Exclude: opts.Exclude,
+					LifetimeValue: *opts.LifetimeValue, LifetimeUnit: opts.LifetimeUnit, NamingSchema: opts.NamingSchema,
+					AllowEmpty: *opts.AllowEmpty, Enabled: *opts.Enabled, Schedule: *opts.Schedule,
+				}
+				f.tasks[id] = task
+				return &task, nil
+			},
+			DeleteFunc: func(ctx context.Context, id int64, fixateRemovalDate bool) error {
+				if err := f.write("delete snapshot_task " + f.tasks[id].Dataset); err != nil {
+					return err
+				}
+				delete(f.tasks, id)
+				return nil
+			},
+		},
+		SMB: &truenas.MockSMBService{
+			ListSharesFunc: func(ctx context.Context) ([]truenas.SMBShare, error) {
+				var out []truenas.SMBShare
+				for _, share := range f.smb {
+					out = append(out, share)
+				}
+				return out, nil
+			},
+			CreateShareFunc: func(ctx context.Context, opts truenas.CreateSMBShareOpts) (*truenas.SMBShare, error) {
+				if err := f.write("create smb_share " + opts.Name); err != nil {
+					return nil, err
+				}
+				f.nextID++
+				share := truenas.SMBShare{ID: f.nextID, Name: opts.Name, Path: opts.Path, ReadOnly: opts.ReadOnly, HostsAllow: opts.HostsAllow}
+				f.smb[share.ID] = share
+				return &share, nil
+			},
+			UpdateShareFunc: func(ctx context.Context, id int64, opts truenas.UpdateSMBShareOpts) (*truenas.SMBShare, error) {
+				if err := f.write("update smb_share " + f.smb[id].Name); err != nil {
+					return nil, err
+				}
+				share := f.smb[id]
+				if opts.ReadOnly != nil {
+					share.ReadOnly = *opts.ReadOnly
+				}
+				if opts.HostsAllow != nil {
+					share.HostsAllow = opts.HostsAllow
+				}
+				f.smb[id] = share
+				return &share, nil
+			},
+			DeleteShareFunc: func(ctx context.Context, id int64) error {
+				if err := f.write("delete smb_share " + f.smb[id].Name); err != nil {
+					return err
+				}
+				delete(f.smb, id)
+				return nil
+			},
+		},
+		NFS: &truenas.MockNFSService{
+			ListSharesFunc: func(ctx context.Context) ([]truenas.NFSShare, error) {
+				var out []truenas.NFSShare
+				for _, share := range f.nfs {
+					out = append(out, share)
+				}
+				return out, nil
+			},
+			CreateShareFunc: func(ctx context.Context, opts truenas.CreateNFSShareOpts) (*truenas.NFSShare, error) {
+				if err := f.write("create nfs_share " + opts.Path); err != nil {
+					return nil, err
+				}
+				f.nextID++
+				share := truenas.NFSShare{ID: f.nextID, Path: opts.Path, Networks: opts.Networks, MaprootUser: opts.MaprootUser}
+				f.nfs[share.ID] = share
+				return &share, nil
+			},
+			UpdateShareFunc: func(ctx context.Context, id int64, opts truenas.UpdateNFSShareOpts) (*truenas.NFSShare, error) {
+				if err := f.write("update nfs_share " + f.nfs[id].Path); err != nil {
+					return nil, err
+				}
+				share := f.nfs[id]
+				if opts.Networks != nil {
+					share.Networks = opts.Networks
+				}
+				if opts.MaprootUser != nil {
+					share.MaprootUser = *opts.MaprootUser
+				}
+				f.nfs[id] = share
+				return &share, nil
+			},
+			DeleteShareFunc: func(ctx context.Context, id int64) error {
+				if err := f.write("delete nfs_share " + f.nfs[id].Path); err != nil {
+					return err
+				}
+				delete(f.nfs, id)
+				return nil
+			},
+		},
+		Cron: &truenas.MockCronService{
+			ListFunc: func(ctx context.Context) ([]truenas.CronJob, error) {
+				var out []truenas.CronJob
+				for _, j := range f.jobs {
+					out = append(out, j)
+				}
+				return out, nil
+			},
+			CreateFunc: func(ctx context.Context, opts truenas.CreateCronJobOpts) (*truenas.CronJob, error) {
+				if err := f.write("create cron_job " + opts.Description); err != nil {
+					return nil, err
+				}
+				f.nextID++
+				job := cronJobFromOpts(f.nextID, opts)
+				f.jobs[job.ID] = job
+				return &job, nil
+			},
+			UpdateFunc: func(ctx context.Context, id int64, opts truenas.UpdateCronJobOpts) (*truenas.CronJob, error) {
+				if err := f.write("update cron_job " + opts.Description); err != nil {
+					return nil, err
+				}
+				job := cronJobFromOpts(id, opts)
+				f.jobs[id] = job
+				return &job, nil
+			},
+			DeleteFunc: func(ctx context.Context, id int64) error {
+				if err := f.write("delete cron_job " + f.jobs[id].Description); err != nil {
+					return err
+				}
+				delete(f.jobs, id)
+				return nil
+			},
+		},
+		Apps: &truenas.MockAppService{
+			GetAppWithConfigFunc: func(ctx context.Context, name string) (*truenas.App, error) {
+				app, ok := f.apps[name]
+				if !ok {
+					return nil, nil
+				}
+				return &app, nil
+			},
+			CreateAppFunc: func(ctx context.Context, opts truenas.CreateAppOpts) (*truenas.App, error) {
+				if err := f.write("create app " + opts.Name); err != nil {
+					return nil, err
+				}
+				config, _ := parseCompose(opts.CustomComposeConfig)
+				app := truenas.App{Name: opts.Name, CustomApp: true, Config: config}
+				f.apps[opts.Name] = app
+				return &app, nil
+			},
+			UpdateAppFunc: func(ctx context.Context, name string, opts truenas.UpdateAppOpts) (*truenas.App, error) {
+				if err := f.write("update app " + name); err != nil {
+					return nil, err
+				}
+				app := f.apps[name]
+				app.Config, _ = parseCompose(opts.CustomComposeConfig)
+				f.apps[name] = app
+				return &app, nil
+			},
+			DeleteAppFunc: func(ctx context.Context, name string) error {
+				if err := f.write("delete app " + name); err != nil {
+					return err
+				}
+				delete(f.apps, name)
+				return nil
+			},
+		},
+	}
+}
+
+func cronJobFromOpts(id int64, opts truenas.CreateCronJobOpts) truenas.CronJob {
+	return truenas.CronJob{
+		ID: id, User: opts.User, Command: opts.Command, Description: opts.Description,
+		Enabled: opts.Enabled, CaptureStdout: opts.CaptureStdout, CaptureStderr: opts.CaptureStderr,
+		Schedule: opts.Schedule,
+	}
+}
+
+func stepNames(steps []*Step) []string {
+	names := make([]string, len(steps))
+	for i, s := range steps {
+		names[i] = string(s.Action) + " " + string(s.Kind) + " " + s.Name
+	}
+	return names
+}
+
+func TestPlan_CreatesInDependencyOrder(t *testing.T) {
+	f := newFakeSystem()
+	desired := State{
+		Apps:     []App{{Name: "web", Compose: "services:\n  nginx:\n    image: nginx\n"}},
+		CronJobs: []CronJob{{Description: "backup", Command: "backup.sh", Schedule: "0 3 * * *"}},
+		Datasets: []Dataset{
+			{Name: "tank/apps/web/data"},
+			{Name: "tank/apps", Compression: "lz4", Quota: ptr(Size(1024))},
+			{Name: "tank/apps/web"},
+		},
+	}
+
+	plan, err := f.reconciler().Plan(context.Background(), desired)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{
+		"create dataset tank/apps",
+		"create dataset tank/apps/web",
+		"create dataset tank/apps/web/data",
+		"create cron_job backup",
+		"create app web",
+	}
+	if got := stepNames(plan.Steps); !reflect.DeepEqual(got, want) {
+		t.Errorf("steps = %q, want %q", got, want)
+	}
+	if f.writes != nil {
+		t.Errorf("expected planning to write nothing, got %q", f.writes)
+	}
+
+	wantChanges := []Change{{Field: "compression", New: "lz4"}, {Field: "quota", New: int64(1024)}}
+	if got := plan.Steps[0].Changes; !reflect.DeepEqual(got, wantChanges) {
+		t.Errorf("changes = %+v, want %+v", got, wantChanges)
+	}
+	job := plan.Steps[3].Changes
+	if job[1] != (Change{Field: "user", New: "root"}) || job[3] != (Change{Field: "enabled", New: true}) {
+		t.Errorf("expected cron defaults, got %+v", job)
+	}
+}
+
+func TestPlan_UpdatesOnlyManagedFields(t *testing.T) {
+	f := newFakeSystem()
+	f.datasets["tank/data"] = truenas.Dataset{ID: "tank/data", Compression: "LZ4", Atime: "ON", Quota: 10, Comments: "keep"}
+	f.jobs[7] = truenas.CronJob{ID: 7, User: "root", Command: "backup.sh", Description: "backup", Enabled: true,
+		Schedule: truenas.Schedule{Minute: "0", Hour: "3", Dom: "*", Month: "*", Dow: "*"}}
+	f.apps["web"] = truenas.App{Name: "web", CustomApp: true, Config: map[string]any{
+		"services": map[string]any{"nginx": map[string]any{"image": "nginx:1.26"}},
+		"volumes":  map[string]any{"data": map[string]any{}},
+	}}
+
+	desired := State{
+		Datasets: []Dataset{{Name: "tank/data", Compression: "lz4", Atime: "off", Quota: ptr(Size(20))}},
+		CronJobs: []CronJob{{Description: "backup", Command: "backup.sh", Schedule: "0 4 * * *"}},
+		Apps:     []App{{Name: "web", Compose: "services:\n  nginx:\n    image: nginx:1.27\nvolumes:\n  data: {}\n"}},
+	}
+	plan, err := f.reconciler().Plan(context.Background(), desired)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(plan.Steps) != 3 {
+		t.Fatalf("expected 3 updates, got %q", stepNames(plan.Steps))
+	}
+
+	wantDataset := []Change{{Field: "atime", Old: "ON", New: "off"}, {Field: "quota", Old: int64(10), New: int64(20)}}
+	if got := plan.Steps[0].Changes; !reflect.DeepEqual(got, wantDataset) {
+		t.Errorf("dataset changes = %+v, want %+v", got, wantDataset)
+	}
+	wantCron := []Change{{Field: "schedule", Old: "0 3 * * *", New: "0 4 * * *"}}
+	if got := plan.Steps[1].Changes; !reflect.DeepEqual(got, wantCron) {
+		t.Errorf("cron changes = %+v, want %+v", got, wantCron)
+	}
+	if got := plan.Steps[2].Changes; len(got) != 1 || got[0].Field != "compose.services" {
+		t.Errorf("expected only compose.services to change, got %+v", got)
+	}
+}
+
+func TestPlan_InheritedProperties(t *testing.T) {
+	current := []truenas.Dataset{
+		{ID: "tank/a", Compression: "LZ4", CompressionInherited: true},
+		{ID: "tank/b", Compression: "GZIP"},
+		{ID: "tank/c", Atime: "ON", AtimeInherited: true, Comments: "", CommentsInherited: true},
+	}
+	var updates []truenas.UpdateDatasetOpts
+	r := &Reconciler{Datasets: &truenas.MockDatasetService{
+		ListDatasetsFunc: func(ctx context.Context) ([]truenas.Dataset, error) {
+			return current, nil
+		},
+		UpdateDatasetFunc: func(ctx context.Context, id string, opts truenas.UpdateDatasetOpts) (*truenas.Dataset, error) {
+			updates = append(updates, opts)
+			return &truenas.Dataset{ID: id}, nil
+		},
+	}}
+
+	plan, err := r.Plan(context.Background(), State{Datasets: []Dataset{
+		{Name: "tank/a", Compression: "inherit"},
+		{Name: "tank/b", Compression: "INHERIT"},
+		{Name: "tank/c", Atime: "OFF", Comments: ptr("INHERIT")},
+	}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := stepNames(plan.Steps); !reflect.DeepEqual(got, []string{"update dataset tank/b", "update dataset tank/c"}) {
+		t.Fatalf("steps = %q", got)
+	}
+	if got, want := plan.Steps[1].Changes, []Change{{Field: "atime", Old: "ON", New: "OFF"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("tank/c changes = %+v, want %+v", got, want)
+	}
+
+	ctx := context.Background()
+	for _, step := range plan.Steps {
+		if err := step.apply(ctx); err != nil {
+			t.Fatal(err)
+		}
+		if err := step.undo(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want := []truenas.UpdateDatasetOpts{
+		{Compression: "INHERIT"}, // tank/b: clear the local value
+		{Compression: "GZIP"},    // undo: restore it
+		{Atime: "OFF"},           // tank/c: set locally
+		{Atime: "INHERIT"},       // undo: inherit again rather than pin ON
+	}
+	if !reflect.DeepEqual(updates, want) {
+		t.Errorf("updates = %+v, want %+v", updates, want)
+	}
+}
+
+func TestPlan_NoChanges(t *testing.T) {
+	f := newFakeSystem()
+	f.datasets["tank/data"] = truenas.Dataset{ID: "tank/data", Compression: "LZ4"}
+	f.apps["web"] = truenas.App{Name: "web", CustomApp: true, Config: map[string]any{"services": map[string]any{"a": map[string]any{"image": "x", "ports": []any{float64(80)}}}}}
+
+	plan, err := f.reconciler().Plan(context.Background(), State{
+		Datasets: []Dataset{{Name: "tank/data", Compression: "lz4"}, {Name: "tank/gone", Absent: true}},
+		Apps:     []App{{Name: "web", Compose: "services:\n  a:\n    image: x\n    ports: [80]\n"}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !plan.Empty() {
+		t.Errorf("expected no steps, got %q", stepNames(plan.Steps))
+	}
+	if plan.String() != "No changes.\n" {
+		t.Errorf("unexpected rendering %q", plan.String())
+	}
+}
+
+func TestPlan_DeletesInReverseOrder(t *testing.T) {
+	f := newFakeSystem()
+	f.datasets["tank/a"] = truenas.Dataset{ID: "tank/a"}
+	f.datasets["tank/a/b"] = truenas.Dataset{ID: "tank/a/b"}
+	f.jobs[1] = truenas.CronJob{ID: 1, Description: "old"}
+	f.apps["old"] = truenas.App{Name: "old", CustomApp: true}
+
+	plan, err := f.reconciler().Plan(context.Background(), State{
+		Datasets: []Dataset{{Name: "tank/a", Absent: true}, {Name: "tank/a/b", Absent: true}, {Name: "tank/new"}},
+		CronJobs: []CronJob{{Description: "old", Absent: true}},
+		Apps:     []App{{Name: "old", Absent: true}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{
+		"create dataset tank/new",
+		"delete cron_job old",
+		"delete app old",
+		"delete dataset tank/a/b",
+		"delete dataset tank/a",
+	}
+	if got := stepNames(plan.Steps); !reflect.DeepEqual(got, want) {
+		t.Errorf("steps = %q, want %q", got, want)
+	}
+}
+
+func TestPlan_SnapshotTasks(t *testing.T) {
+	hourly := truenas.WindowedSchedule{Schedule: truenas.Schedule{Minute: "0", Hour: "*", Dom: "*", Month: "*", Dow: "*"}, Begin: "00:00", End: "23:59"}
+	f := newFakeSystem()
+	f.tasks[1] = truenas.SnapshotTask{ID: 1, Dataset: "tank/data", NamingSchema: defaultNamingSchema, LifetimeValue: 2,
+		LifetimeUnit: truenas.LifetimeWeek, AllowEmpty: true, Enabled: true, Schedule: hourly}
+	f.tasks[2] = truenas.SnapshotTask{ID: 2, Dataset: "tank/same", NamingSchema: defaultNamingSchema, LifetimeValue: 2,
+		LifetimeUnit: truenas.LifetimeWeek, AllowEmpty: true, Enabled: true, Schedule: hourly}
+	f.tasks[3] = truenas.SnapshotTask{ID: 3, Dataset: "tank/old", NamingSchema: "old-%Y%m%d", Schedule: hourly}
+
+	plan, err := f.reconciler().Plan(context.Background(), State{
+		Datasets: []Dataset{{Name: "tank/new"}},
+		SnapshotTasks: []SnapshotTask{
+			{Dataset: "tank/data", Schedule: "0 */4 * * *", LifetimeValue: 4, LifetimeUnit: "day"},
+			{Dataset: "tank/same"},
+			{Dataset: "tank/new", NamingSchema: "daily-%Y-%m-%d", Schedule: "0 0 * * *", Recursive: true, Exclude: []string{"tank/new/tmp"}},
+			{Dataset: "tank/old", NamingSchema: "old-%Y%m%d", Absent: true},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{
+		"create dataset tank/new",
+		"update snapshot_task tank/data@auto-%Y-%m-%d_%H-%M",
+		"create snapshot_task tank/new@daily-%Y-%m-%d",
+		"delete snapshot_task tank/old@old-%Y%m%d",
+	}
+	if got := stepNames(plan.Steps); !reflect.DeepEqual(got, want) {
+		t.Fatalf("steps = %q, want %q", got, want)
+	}
+	wantChanges := []Change{
+		{Field: "schedule", Old: "0 * * * *", New: "0 */4 * * *"},
+		{Field: "lifetime", Old: "2 WEEK", New: "4 DAY"},
+	}
+	if got := plan.Steps[1].Changes; !reflect.DeepEqual(got, wantChanges) {
+		t.Errorf("update changes = %+v, want %+v", got, wantChanges)
+	}
+
+	if err := plan.Apply(context.Background()); err != nil {
+		t.Fatalf("apply: %v", err)
+	}
+	data := f.tasks[1]
+	if data.LifetimeValue != 4 || data.LifetimeUnit != truenas.LifetimeDay || data.Schedule.Hour != "*/4" {
+		t.Errorf("task not updated: %+v", data)
+	}
+	if data.Schedule.Begin != "00:00" || data.Schedule.End != "23:59" {
+		t.Errorf("expected the begin/end window to be kept, got %+v", data.Schedule)
+	}
+	if _, ok := f.tasks[3]; ok {
+		t.Error("expected tank/old task to be deleted")
+	}
+
+	again, err := f.reconciler().Plan(context.Background(), State{SnapshotTasks: []SnapshotTask{
+		{Dataset: "tank/data", Schedule: "0 */4 * * *", LifetimeValue: 4, LifetimeUnit: "DAY"},
+		{Dataset: "tank/new", NamingSchema: "daily-%Y-%m-%d", Schedule: "0 0 * * *", Recursive: true, Exclude: []string{"tank/new/tmp"}},
+	}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !again.Empty() {
+		t.Errorf("expected converged tasks, got %q", stepNames(again.Steps))
+	}
+}
+
+func TestPlan_Shares(t *testing.T) {
+	f := newFakeSystem()
+	f.smb[1] = truenas.SMBShare{ID: 1, Name: "Media", Path: "/mnt/tank/media", Enabled: true}
+	f.smb[2] = truenas.SMBShare{ID: 2, Name: "old", Path: "/mnt/tank/old", Comment: "retired"}
+	f.nfs[3] = truenas.NFSShare{ID: 3, Path: "/mnt/tank/media", MaprootUser: "nobody"}
+
+	plan, err := f.reconciler().Plan(context.Background(), State{Shares: Shares{
+		SMB: []SMBShare{
+			{Name: "media", Path: "/mnt/tank/media", ReadOnly: ptr(true), HostsAllow: []string{"10.0.0.0/8"}, Enabled: ptr(true)},
+			{Name: "backup", Path: "/mnt/tank/backup", Guest: ptr(false)},
+			{Name: "OLD", Absent: true},
+		},
+		NFS: []NFSShare{
+			{Path: "/mnt/tank/media", Networks: []string{"10.0.0.0/8"}, MaprootUser: ptr("root")},
+			{Path: "/mnt/tank/gone", Absent: true},
+		},
+	}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{
+		"update smb_share media",
+		"create smb_share backup",
+		"update nfs_share /mnt/tank/media",
+		"delete smb_share old",
+	}
+	if got := stepNames(plan.Steps); !reflect.DeepEqual(got, want) {
+		t.Fatalf("steps = %q, want %q", got, want)
+	}
+	wantSMB := []Change{
+		{Field: "read_only", Old: false, New: true},
+		{Field: "hosts_allow", Old: []string(nil), New: []string{"10.0.0.0/8"}},
+	}
+	if got := plan.Steps[0].Changes; !reflect.DeepEqual(got, wantSMB) {
+		t.Errorf("smb changes = %+v, want %+v", got, wantSMB)
+	}
+	wantNFS := []Change{
+		{Field: "networks", Old: []string(nil), New: []string{"10.0.0.0/8"}},
+		{Field: "maproot_user", Old: "nobody", New: "root"},
+	}
+	if got := plan.Steps[2].Changes; !reflect.DeepEqual(got, wantNFS) {
+		t.Errorf("nfs changes = %+v, want %+v", got, wantNFS)
+	}
+	if !strings.Contains(plan.String(), `hosts_allow: (none) -> ["10.0.0.0/8"]`) {
+		t.Errorf("expected list rendered as JSON, got:\n%s", plan)
+	}
+}
+
+func TestPlan_Errors(t *testing.T) {
+	f := newFakeSystem()
+	f.jobs[1] = truenas.CronJob{ID: 1, Description: "dup"}
+	f.jobs[2] = truenas.CronJob{ID: 2, Description: "dup"}
+	f.apps["plex"] = truenas.App{Name: "plex"}
+
+	r := f.reconciler()
+	_, err := r.Plan(context.Background(), State{CronJobs: []CronJob{{Description: "dup", Command: "x", Schedule: "* * * * *"}}})
+	if err == nil || !strings.Contains(err.Error(), "several existing jobs") {
+		t.Errorf("expected ambiguity error, got %v", err)
+	}
+	_, err = r.Plan(context.Background(), State{Apps: []App{{Name: "plex", Compose: "services: {}"}}})
+	if err == nil || !strings.Contains(err.Error(), "catalog app") {
+		t.Errorf("expected catalog app error, got %v", err)
+	}
+	_, err = r.Plan(context.Background(), State{Datasets: []Dataset{{Name: "bad"}}})
+	if err == nil || !strings.Contains(err.Error(), "full dataset path") {
+		t.Errorf("expected validation error, got %v", err)
+	}
+
+	r.Datasets = &truenas.MockDatasetService{
+		ListDatasetsFunc: func(ctx context.Context) ([]truenas.Dataset, error) {
+			return nil, errors.New("connection lost")
+		},
+	}
+	_, err = r.Plan(context.Background(), State{Datasets: []Dataset{{Name: "tank/x"}}})
+	if err == nil || !strings.Contains(err.Error(), "list datasets: connection lost") {
+		t.Errorf("expected read error, got %v", err)
+	}
+}
+
+func TestPlan_String(t *testing.T) {
+	plan := &Plan{Steps: []*Step{
+		{Action: ActionCreate, Kind: KindDataset, Name: "tank/apps", Changes: []Change{{Field: "quota", New: int64(1024)}}},
+		{Action: ActionUpdate, Kind: KindCronJob, Name: "backup", Changes: []Change{{Field: "schedule", Old: "0 3 * * *", New: "0 4 * * *"}}},
+		{Action: ActionUpdate, Kind: KindApp, Name: "web", Changes: []Change{{Field: "compose.volumes", New: map[string]any{"data": map[string]any{}}}}},
+		{Action: ActionDelete, Kind: KindApp, Name: "old"},
+	}}
+	want := `+ dataset "tank/apps"
+    quota: 1024
+~ cron_job "backup"
+    schedule: "0 3 * * *" -> "0 4 * * *"
+~ app "web"
+    compose.volumes: (none) -> {"data":{}}
+- app "old"
+Plan: 1 to create, 2 to update, 1 to delete.
+`
+	if got := plan.String(); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
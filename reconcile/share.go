@@ -0,0 +1,377 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	truenas "github.com/deevus/truenas-go"
+)
+
+// planSMBShares matches desired shares to existing ones by name. SMB share
+// names are case-insensitive.
+func (r *Reconciler) planSMBShares(ctx context.Context, desired []SMBShare) (ups, dels []*Step, err error) {
+	if len(desired) == 0 {
+		return nil, nil, nil
+	}
+	existing, err := r.SMB.ListShares(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("list smb shares: %w", err)
+	}
+	current := make(map[string]truenas.SMBShare, len(existing))
+	for _, share := range existing {
+		current[strings.ToLower(share.Name)] = share
+	}
+
+	for _, s := range desired {
+		cur, exists := current[strings.ToLower(s.Name)]
+		switch {
+		case s.Absent && exists:
+			dels = append(dels, r.deleteSMBShareStep(cur))
+		case s.Absent:
+		case !exists:
+			ups = append(ups, r.createSMBShareStep(s))
+		default:
+			if step := r.updateSMBShareStep(s, cur); step != nil {
+				ups = append(ups, step)
+			}
+		}
+	}
+	return ups, dels, nil
+}
+
+func (r *Reconciler) createSMBShareStep(s SMBShare) *Step {
+	opts := truenas.CreateSMBShareOpts{
+		Name:       s.Name,
+		Path:       s.Path,
+		Purpose:    truenas.SMBSharePurpose(strings.ToUpper(s.Purpose)),
+		Enabled:    s.Enabled,
+		Browsable:  s.Browsable,
+		HostsAllow: s.HostsAllow,
+		HostsDeny:  s.HostsDeny,
+	}
+	changes := []Change{{Field: "path", New: s.Path}}
+	if s.Purpose != "" {
+		changes = append(changes, Change{Field: "purpose", New: string(opts.Purpose)})
+	}
+	if s.Comment != nil {
+		opts.Comment = *s.Comment
+		changes = append(changes, Change{Field: "comment", New: *s.Comment})
+	}
+	if s.Enabled != nil {
+		changes = append(changes, Change{Field: "enabled", New: *s.Enabled})
+	}
+	if s.ReadOnly != nil {
+		opts.ReadOnly = *s.ReadOnly
+		changes = append(changes, Change{Field: "read_only", New: *s.ReadOnly})
+	}
+	if s.Browsable != nil {
+		changes = append(changes, Change{Field: "browsable", New: *s.Browsable})
+	}
+	if s.Guest != nil {
+		opts.Guest = *s.Guest
+		changes = append(changes, Change{Field: "guest", New: *s.Guest})
+	}
+	if s.HostsAllow != nil {
+		changes = append(changes, Change{Field: "hosts_allow", New: s.HostsAllow})
+	}
+	if s.HostsDeny != nil {
+		changes = append(changes, Change{Field: "hosts_deny", New: s.HostsDeny})
+	}
+
+	var created int64
+	return &Step{
+		Action:  ActionCreate,
+		Kind:    KindSMBShare,
+		Name:    s.Name,
+		Changes: changes,
+		apply: func(ctx context.Context) error {
+			share, err := r.SMB.CreateShare(ctx, opts)
+			if err != nil {
+				return err
+			}
+			created = share.ID
+			return nil
+		},
+		undo: func(ctx context.Context) error {
+			return r.SMB.DeleteShare(ctx, created)
+		},
+	}
+}
+
+// updateSMBShareStep returns nil if cur already matches s.
+func (r *Reconciler) updateSMBShareStep(s SMBShare, cur truenas.SMBShare) *Step {
+	var opts, revert truenas.UpdateSMBShareOpts
+	var changes []Change
+
+	if s.Path != "" && s.Path != cur.Path {
+		opts.Path, revert.Path = s.Path, cur.Path
+		changes = append(changes, Change{Field: "path", Old: cur.Path, New: s.Path})
+	}
+	if s.Purpose != "" && !strings.EqualFold(s.Purpose, string(cur.Purpose)) {
+		opts.Purpose, revert.Purpose = truenas.SMBSharePurpose(strings.ToUpper(s.Purpose)), cur.Purpose
+		changes = append(changes, Change{Field: "purpose", Old: string(cur.Purpose), New: string(opts.Purpose)})
+	}
+	opts.Comment, revert.Comment = diffString(&changes, "comment", s.Comment, cur.Comment)
+	opts.Enabled, revert.Enabled = diffBool(&changes, "enabled", s.Enabled, cur.Enabled)
+	opts.ReadOnly, revert.ReadOnly = diffBool(&changes, "read_only", s.ReadOnly, cur.ReadOnly)
+	opts.Browsable, revert.Browsable = diffBool(&changes, "browsable", s.Browsable, cur.Browsable)
+	opts.Guest, revert.Guest = diffBool(&changes, "guest", s.Guest, cur.Guest)
+	opts.HostsAllow, revert.HostsAllow = diffList(&changes, "hosts_allow", s.HostsAllow, cur.HostsAllow)
+	opts.HostsDeny, revert.HostsDeny = diffList(&changes, "hosts_deny", s.HostsDeny, cur.HostsDeny)
+	if len(changes) == 0 {
+		return nil
+	}
+
+	return &Step{
+		Action:  ActionUpdate,
+		Kind:    KindSMBShare,
+		Name:    s.Name,
+		Changes: changes,
+		apply: func(ctx context.Context) error {
+			_, err := r.SMB.UpdateShare(ctx, cur.ID, opts)
+			return err
+		},
+		undo: func(ctx context.Context) error {
+			_, err := r.SMB.UpdateShare(ctx, cur.ID, revert)
+			return err
+		},
+	}
+}
+
+// deleteSMBShareStep is undone by recreating the share, under a new ID.
+// Deleting a share leaves its files in place.
+func (r *Reconciler) deleteSMBShareStep(cur truenas.SMBShare) *Step {
+	return &Step{
+		Action: ActionDelete,
+		Kind:   KindSMBShare,
+		Name:   cur.Name,
+		apply: func(ctx context.Context) error {
+			return r.SMB.DeleteShare(ctx, cur.ID)
+		},
+		undo: func(ctx context.Context) error {
+			_, err := r.SMB.CreateShare(ctx, existingSMBShareOpts(cur))
+			return err
+		},
+	}
+}
+
+// existingSMBShareOpts returns the options that recreate share.
+func existingSMBShareOpts(share truenas.SMBShare) truenas.CreateSMBShareOpts {
+	audit := share.Audit
+	return truenas.CreateSMBShareOpts{
+		Name:                   share.Name,
+		Path:                   share.Path,
+		Purpose:                share.Purpose,
+		Comment:                share.Comment,
+		Enabled:                ptr(share.Enabled),
+		ReadOnly:               share.ReadOnly,
+		Browsable:              ptr(share.Browsable),
+		Guest:                  share.Guest,
+		AccessBasedEnumeration: share.AccessBasedEnumeration,
+		TimeMachine:            share.TimeMachine,
+		TimeMachineQuota:       share.TimeMachineQuota,
+		RecycleBin:             share.RecycleBin,
+		HostsAllow:             share.HostsAllow,
+		HostsDeny:              share.HostsDeny,
+		AuxSMBConf:             share.AuxSMBConf,
+		Audit:                  &audit,
+	}
+}
+
+// planNFSShares matches desired shares to existing ones by path.
+func (r *Reconciler) planNFSShares(ctx context.Context, desired []NFSShare) (ups, dels []*Step, err error) {
+	if len(desired) == 0 {
+		return nil, nil, nil
+	}
+	existing, err := r.NFS.ListShares(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("list nfs shares: %w", err)
+	}
+	current := make(map[string]truenas.NFSShare, len(existing))
+	for _, share := range existing {
+		current[share.Path] = share
+	}
+
+	for _, s := range desired {
+		cur, exists := current[s.Path]
+		switch {
+		case s.Absent && exists:
+			dels = append(dels, r.deleteNFSShareStep(cur))
+		case s.Absent:
+		case !exists:
+			ups = append(ups, r.createNFSShareStep(s))
+		default:
+			if step := r.updateNFSShareStep(s, cur); step != nil {
+				ups = append(ups, step)
+			}
+		}
+	}
+	return ups, dels, nil
+}
+
+func (r *Reconciler) createNFSShareStep(s NFSShare) *Step {
+	opts := truenas.CreateNFSShareOpts{
+		Path:     s.Path,
+		Networks: s.Networks,
+		Hosts:    s.Hosts,
+		Enabled:  s.Enabled,
+	}
+	var changes []Change
+	if s.Comment != nil {
+		opts.Comment = *s.Comment
+		changes = append(changes, Change{Field: "comment", New: *s.Comment})
+	}
+	if s.Networks != nil {
+		changes = append(changes, Change{Field: "networks", New: s.Networks})
+	}
+	if s.Hosts != nil {
+		changes = append(changes, Change{Field: "hosts", New: s.Hosts})
+	}
+	if s.ReadOnly != nil {
+		opts.ReadOnly = *s.ReadOnly
+		changes = append(changes, Change{Field: "read_only", New: *s.ReadOnly})
+	}
+	for _, m := range []struct {
+		field string
+		want  *string
+		set   *string
+	}{
+		{"maproot_user", s.MaprootUser, &opts.MaprootUser},
+		{"maproot_group", s.MaprootGroup, &opts.MaprootGroup},
+		{"mapall_user", s.MapallUser, &opts.MapallUser},
+		{"mapall_group", s.MapallGroup, &opts.MapallGroup},
+	} {
+		if m.want != nil && *m.want != "" {
+			*m.set = *m.want
+			changes = append(changes, Change{Field: m.field, New: *m.want})
+		}
+	}
+	if s.Enabled != nil {
+		changes = append(changes, Change{Field: "enabled", New: *s.Enabled})
+	}
+
+	var created int64
+	return &Step{
+		Action:  ActionCreate,
+		Kind:    KindNFSShare,
+		Name:    s.Path,
+		Changes: changes,
+		apply: func(ctx context.Context) error {
+			share, err := r.NFS.CreateShare(ctx, opts)
+			if err != nil {
+				return err
+			}
+			created = share.ID
+			return nil
+		},
+		undo: func(ctx context.Context) error {
+			return r.NFS.DeleteShare(ctx, created)
+		},
+	}
+}
+
+// updateNFSShareStep returns nil if cur already matches s.
+func (r *Reconciler) updateNFSShareStep(s NFSShare, cur truenas.NFSShare) *Step {
+	var opts, revert truenas.UpdateNFSShareOpts
+	var changes []Change
+
+	opts.Comment, revert.Comment = diffString(&changes, "comment", s.Comment, cur.Comment)
+	opts.Networks, revert.Networks = diffList(&changes, "networks", s.Networks, cur.Networks)
+	opts.Hosts, revert.Hosts = diffList(&changes, "hosts", s.Hosts, cur.Hosts)
+	opts.ReadOnly, revert.ReadOnly = diffBool(&changes, "read_only", s.ReadOnly, cur.ReadOnly)
+	opts.MaprootUser, revert.MaprootUser = diffString(&changes, "maproot_user", s.MaprootUser, cur.MaprootUser)
+	opts.MaprootGroup, revert.MaprootGroup = diffString(&changes, "maproot_group", s.MaprootGroup, cur.MaprootGroup)
+	opts.MapallUser, revert.MapallUser = diffString(&changes, "mapall_user", s.MapallUser, cur.MapallUser)
+	opts.MapallGroup, revert.MapallGroup = diffString(&changes, "mapall_group", s.MapallGroup, cur.MapallGroup)
+	opts.Enabled, revert.Enabled = diffBool(&changes, "enabled", s.Enabled, cur.Enabled)
+	if len(changes) == 0 {
+		return nil
+	}
+
+	return &Step{
+		Action:  ActionUpdate,
+		Kind:    KindNFSShare,
+		Name:    s.Path,
+		Changes: changes,
+		apply: func(ctx context.Context) error {
+			_, err := r.NFS.UpdateShare(ctx, cur.ID, opts)
+			return err
+		},
+		undo: func(ctx context.Context) error {
+			_, err := r.NFS.UpdateShare(ctx, cur.ID, revert)
+			return err
+		},
+	}
+}
+
+// deleteNFSShareStep is undone by recreating the share, under a new ID.
+func (r *Reconciler) deleteNFSShareStep(cur truenas.NFSShare) *Step {
+	return &Step{
+		Action: ActionDelete,
+		Kind:   KindNFSShare,
+		Name:   cur.Path,
+		apply: func(ctx context.Context) error {
+			return r.NFS.DeleteShare(ctx, cur.ID)
+		},
+		undo: func(ctx context.Context) error {
+			_, err := r.NFS.CreateShare(ctx, existingNFSShareOpts(cur))
+			return err
+		},
+	}
+}
+
+// existingNFSShareOpts returns the options that recreate share.
+func existingNFSShareOpts(share truenas.NFSShare) truenas.CreateNFSShareOpts {
+	return truenas.CreateNFSShareOpts{
+		Path:            share.Path,
+		Comment:         share.Comment,
+		Networks:        share.Networks,
+		Hosts:           share.Hosts,
+		ReadOnly:        share.ReadOnly,
+		MaprootUser:     share.MaprootUser,
+		MaprootGroup:    share.MaprootGroup,
+		MapallUser:      share.MapallUser,
+		MapallGroup:     share.MapallGroup,
+		Security:        share.Security,
+		Enabled:         ptr(share.Enabled),
+		ExposeSnapshots: share.ExposeSnapshots,
+	}
+}
+
+// diffBool records a change to a managed bool and returns the values to set
+// and to restore, or nils if want is unmanaged or already matches.
+func diffBool(changes *[]Change, field string, want *bool, cur bool) (set, undo *bool) {
+	if want == nil || *want == cur {
+		return nil, nil
+	}
+	*changes = append(*changes, Change{Field: field, Old: cur, New: *want})
+	return ptr(*want), ptr(cur)
+}
+
+// diffString is diffBool for strings.
+func diffString(changes *[]Change, field string, want *string, cur string) (set, undo *string) {
+	if want == nil || *want == cur {
+		return nil, nil
+	}
+	*changes = append(*changes, Change{Field: field, Old: cur, New: *want})
+	return ptr(*want), ptr(cur)
+}
+
+// diffList is diffBool for lists. The returned lists are never nil when
+// set, since a nil list leaves the field unchanged.
+func diffList(changes *[]Change, field string, want, cur []string) (set, undo []string) {
+	if want == nil || slices.Equal(want, cur) {
+		return nil, nil
+	}
+	*changes = append(*changes, Change{Field: field, Old: cur, New: want})
+	if cur == nil {
+		cur = []string{}
+	}
+	return want, cur
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
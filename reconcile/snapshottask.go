@@ -0,0 +1,243 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	truenas "github.com/deevus/truenas-go"
+)
+
+// Defaults the middleware gives new snapshot tasks.
+const (
+	defaultNamingSchema  = "auto-%Y-%m-%d_%H-%M"
+	defaultTaskSchedule  = "0 * * * *"
+	defaultLifetimeValue = 2
+	defaultLifetimeUnit  = truenas.LifetimeWeek
+)
+
+// snapshotTaskName identifies a task by dataset and naming schema, e.g.
+// "tank/data@auto-%Y-%m-%d_%H-%M".
+func snapshotTaskName(dataset, namingSchema string) string {
+	if namingSchema == "" {
+		namingSchema = defaultNamingSchema
+	}
+	return dataset + "@" + namingSchema
+}
+
+func validLifetimeUnit(unit string) bool {
+	switch truenas.LifetimeUnit(strings.ToUpper(unit)) {
+	case truenas.LifetimeHour, truenas.LifetimeDay, truenas.LifetimeWeek, truenas.LifetimeMonth, truenas.LifetimeYear:
+		return true
+	}
+	return false
+}
+
+// planSnapshotTasks matches desired tasks to existing ones by dataset and
+// naming schema.
+func (r *Reconciler) planSnapshotTasks(ctx context.Context, desired []SnapshotTask) (ups, dels []*Step, err error) {
+	if len(desired) == 0 {
+		return nil, nil, nil
+	}
+	existing, err := r.SnapshotTasks.List(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("list snapshot tasks: %w", err)
+	}
+	current := make(map[string]truenas.SnapshotTask, len(existing))
+	duplicate := make(map[string]bool)
+	for _, task := range existing {
+		name := snapshotTaskName(task.Dataset, task.NamingSchema)
+		if _, ok := current[name]; ok {
+			duplicate[name] = true
+		}
+		current[name] = task
+	}
+
+	for _, t := range desired {
+		name := snapshotTaskName(t.Dataset, t.NamingSchema)
+		if duplicate[name] {
+			return nil, nil, fmt.Errorf("snapshot task %q: several existing tasks have this dataset and naming schema", name)
+		}
+		cur, exists := current[name]
+		switch {
+		case t.Absent && exists:
+			dels = append(dels, r.deleteSnapshotTaskStep(cur))
+		case t.Absent:
+		case !exists:
+			ups = append(ups, r.createSnapshotTaskStep(t))
+		default:
+			if step := r.updateSnapshotTaskStep(t, cur); step != nil {
+				ups = append(ups, step)
+			}
+		}
+	}
+	return ups, dels, nil
+}
+
+// snapshotTaskOpts applies the defaults for fields the State leaves empty.
+// The schedule has no begin/end window, so the server default applies.
+func snapshotTaskOpts(t SnapshotTask) truenas.CreateSnapshotTaskOpts {
+	scheduleText := t.Schedule
+	if scheduleText == "" {
+		scheduleText = defaultTaskSchedule
+	}
+	schedule, _ := parseSchedule(scheduleText) // checked by Validate
+	opts := truenas.CreateSnapshotTaskOpts{
+		Dataset:       t.Dataset,
+		Recursive:     t.Recursive,
+		Exclude:       nonEmpty(t.Exclude),
+		LifetimeValue: t.LifetimeValue,
+		LifetimeUnit:  truenas.LifetimeUnit(strings.ToUpper(t.LifetimeUnit)),
+		NamingSchema:  t.NamingSchema,
+		AllowEmpty:    ptr(true),
+		Enabled:       ptr(true),
+		Schedule:      &truenas.WindowedSchedule{Schedule: schedule},
+	}
+	if opts.LifetimeValue == 0 {
+		opts.LifetimeValue = defaultLifetimeValue
+	}
+	if opts.LifetimeUnit == "" {
+		opts.LifetimeUnit = defaultLifetimeUnit
+	}
+	if opts.NamingSchema == "" {
+		opts.NamingSchema = defaultNamingSchema
+	}
+	if t.AllowEmpty != nil {
+		opts.AllowEmpty = ptr(*t.AllowEmpty)
+	}
+	if t.Enabled != nil {
+		opts.Enabled = ptr(*t.Enabled)
+	}
+	return opts
+}
+
+// existingSnapshotTaskOpts returns the options that recreate task.
+func existingSnapshotTaskOpts(task truenas.SnapshotTask) truenas.CreateSnapshotTaskOpts {
+	schedule := task.Schedule
+	return truenas.CreateSnapshotTaskOpts{
+		Dataset:       task.Dataset,
+		Recursive:     task.Recursive,
+		Exclude:       nonEmpty(task.Exclude),
+		LifetimeValue: task.LifetimeValue,
+		LifetimeUnit:  task.LifetimeUnit,
+		NamingSchema:  task.NamingSchema,
+		AllowEmpty:    ptr(task.AllowEmpty),
+		Enabled:       ptr(task.Enabled),
+		Schedule:      &schedule,
+	}
+}
+
+// snapshotTaskUpdate converts full task options into an update that sets
+// every field, keeping the current begin/end window.
+func snapshotTaskUpdate(o truenas.CreateSnapshotTaskOpts, window truenas.WindowedSchedule) truenas.UpdateSnapshotTaskOpts {
+	schedule := truenas.WindowedSchedule{Schedule: o.Schedule.Schedule, Begin: window.Begin, End: window.End}
+	exclude := o.Exclude
+	if exclude == nil {
+		exclude = []string{} // nil would leave the list unchanged
+	}
+	return truenas.UpdateSnapshotTaskOpts{
+		Dataset:       o.Dataset,
+		Recursive:     ptr(o.Recursive),
+		Exclude:       exclude,
+		LifetimeValue: ptr(o.LifetimeValue),
+		LifetimeUnit:  o.LifetimeUnit,
+		NamingSchema:  o.NamingSchema,
+		AllowEmpty:    o.AllowEmpty,
+		Enabled:       o.Enabled,
+		Schedule:      &schedule,
+	}
+}
+
+// snapshotTaskFields lists the compared fields of a task in display order.
+func snapshotTaskFields(o truenas.CreateSnapshotTaskOpts) []Change {
+	return []Change{
+		{Field: "schedule", New: formatSchedule(o.Schedule.Schedule)},
+		{Field: "lifetime", New: fmt.Sprintf("%d %s", o.LifetimeValue, o.LifetimeUnit)},
+		{Field: "recursive", New: o.Recursive},
+		{Field: "exclude", New: o.Exclude},
+		{Field: "allow_empty", New: *o.AllowEmpty},
+		{Field: "enabled", New: *o.Enabled},
+	}
+}
+
+func (r *Reconciler) createSnapshotTaskStep(t SnapshotTask) *Step {
+	opts := snapshotTaskOpts(t)
+	var created int64
+	return &Step{
+		Action:  ActionCreate,
+		Kind:    KindSnapshotTask,
+		Name:    snapshotTaskName(t.Dataset, t.NamingSchema),
+		Changes: snapshotTaskFields(opts),
+		apply: func(ctx context.Context) error {
+			task, err := r.SnapshotTasks.Create(ctx, opts)
+			if err != nil {
+				return err
+			}
+			created = task.ID
+			return nil
+		},
+		undo: func(ctx context.Context) error {
+			return r.SnapshotTasks.Delete(ctx, created, false)
+		},
+	}
+}
+
+// updateSnapshotTaskStep returns nil if cur already matches t. Updates send
+// every field, so the undo restores the whole previous task.
+func (r *Reconciler) updateSnapshotTaskStep(t SnapshotTask, cur truenas.SnapshotTask) *Step {
+	opts := snapshotTaskOpts(t)
+	old := existingSnapshotTaskOpts(cur)
+
+	var changes []Change
+	oldFields := snapshotTaskFields(old)
+	for i, c := range snapshotTaskFields(opts) {
+		if !reflect.DeepEqual(c.New, oldFields[i].New) {
+			changes = append(changes, Change{Field: c.Field, Old: oldFields[i].New, New: c.New})
+		}
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+
+	return &Step{
+		Action:  ActionUpdate,
+		Kind:    KindSnapshotTask,
+		Name:    snapshotTaskName(t.Dataset, t.NamingSchema),
+		Changes: changes,
+		apply: func(ctx context.Context) error {
+			_, err := r.SnapshotTasks.Update(ctx, cur.ID, snapshotTaskUpdate(opts, cur.Schedule))
+			return err
+		},
+		undo: func(ctx context.Context) error {
+			_, err := r.SnapshotTasks.Update(ctx, cur.ID, snapshotTaskUpdate(old, cur.Schedule))
+			return err
+		},
+	}
+}
+
+// deleteSnapshotTaskStep is undone by recreating the task, under a new ID.
+// Deleting a task keeps the snapshots it took.
+func (r *Reconciler) deleteSnapshotTaskStep(cur truenas.SnapshotTask) *Step {
+	return &Step{
+		Action: ActionDelete,
+		Kind:   KindSnapshotTask,
+		Name:   snapshotTaskName(cur.Dataset, cur.NamingSchema),
+		apply: func(ctx context.Context) error {
+			return r.SnapshotTasks.Delete(ctx, cur.ID, false)
+		},
+		undo: func(ctx context.Context) error {
+			_, err := r.SnapshotTasks.Create(ctx, existingSnapshotTaskOpts(cur))
+			return err
+		},
+	}
+}
+
+// nonEmpty returns nil for an empty list, so lists compare equal whether
+// they were omitted or given as [].
+func nonEmpty(list []string) []string {
+	if len(list) == 0 {
+		return nil
+	}
+	return list
+}
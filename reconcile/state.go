@@ -0,0 +1,272 @@
+// Package reconcile converges a TrueNAS system on a declared desired state.
+//
+// Declare resources in a State, in Go or YAML, compute a Plan of field-level
+// changes against the current state read through the services, then apply it:
+//
+//	r := reconcile.New(c, c.Version())
+//	plan, err := r.Plan(ctx, desired)
+//	if err != nil {
+//		return err
+//	}
+//	fmt.Print(plan)
+//	err = plan.Apply(ctx)
+//
+// Only declared resources are managed. Resources missing from the State are
+// left alone, and a resource is deleted only when declared with Absent set.
+// Fields left empty are not managed either, so a State can pin just the
+// properties it cares about. Removing a field from the State stops managing
+// it and leaves the dataset's value as it is; set a dataset property to
+// INHERIT to clear the local value instead.
+//
+// Apply runs steps in dependency order: parent datasets before children,
+// datasets before snapshot tasks, shares, cron jobs and apps, and deletions
+// last. App and dataset deletions cannot be undone, so they run after all
+// other deletions. If a step fails, the steps already applied are rolled
+// back.
+package reconcile
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	truenas "github.com/deevus/truenas-go"
+	"gopkg.in/yaml.v3"
+)
+
+// State is the desired state of the managed resources.
+type State struct {
+	Datasets      []Dataset      `yaml:"datasets"`
+	SnapshotTasks []SnapshotTask `yaml:"snapshot_tasks"`
+	Shares        Shares         `yaml:"shares"`
+	CronJobs      []CronJob      `yaml:"cron_jobs"`
+	Apps          []App          `yaml:"apps"`
+}
+
+// Dataset is a desired filesystem dataset, identified by its full name.
+type Dataset struct {
+	Name        string  `yaml:"name"`
+	Compression string  `yaml:"compression"` // Empty = unmanaged, INHERIT = inherit from the parent
+	Atime       string  `yaml:"atime"`       // Empty = unmanaged, INHERIT = inherit from the parent
+	Comments    *string `yaml:"comments"`    // INHERIT = inherit from the parent
+	Quota       *Size   `yaml:"quota"`       // 0 removes the quota
+	RefQuota    *Size   `yaml:"refquota"`    // 0 removes the quota
+	Absent      bool    `yaml:"absent"`      // delete the dataset if it exists
+}
+
+// SnapshotTask is a desired periodic snapshot task. Tasks have no name, so
+// the dataset and naming schema identify the task.
+type SnapshotTask struct {
+	Dataset       string   `yaml:"dataset"`
+	NamingSchema  string   `yaml:"naming_schema"`  // Default "auto-%Y-%m-%d_%H-%M"
+	Schedule      string   `yaml:"schedule"`       // Five cron fields; default hourly
+	LifetimeValue int64    `yaml:"lifetime_value"` // Default 2
+	LifetimeUnit  string   `yaml:"lifetime_unit"`  // HOUR, DAY, WEEK, MONTH or YEAR; default WEEK
+	Recursive     bool     `yaml:"recursive"`
+	Exclude       []string `yaml:"exclude"`     // Child datasets skipped by a recursive task
+	AllowEmpty    *bool    `yaml:"allow_empty"` // Default true
+	Enabled       *bool    `yaml:"enabled"`     // Default true
+	Absent        bool     `yaml:"absent"`
+}
+
+// Shares are the desired SMB and NFS shares.
+type Shares struct {
+	SMB []SMBShare `yaml:"smb"`
+	NFS []NFSShare `yaml:"nfs"`
+}
+
+// SMBShare is a desired SMB share, identified by its name.
+type SMBShare struct {
+	Name       string   `yaml:"name"`
+	Path       string   `yaml:"path"`    // Required unless absent
+	Purpose    string   `yaml:"purpose"` // Empty = unmanaged
+	Comment    *string  `yaml:"comment"`
+	Enabled    *bool    `yaml:"enabled"`
+	ReadOnly   *bool    `yaml:"read_only"`
+	Browsable  *bool    `yaml:"browsable"`
+	Guest      *bool    `yaml:"guest"`
+	HostsAllow []string `yaml:"hosts_allow"` // Omitted = unmanaged, [] = clear
+	HostsDeny  []string `yaml:"hosts_deny"`  // Omitted = unmanaged, [] = clear
+	Absent     bool     `yaml:"absent"`
+}
+
+// NFSShare is a desired NFS share, identified by its path.
+type NFSShare struct {
+	Path         string   `yaml:"path"`
+	Comment      *string  `yaml:"comment"`
+	Networks     []string `yaml:"networks"` // Omitted = unmanaged, [] = all networks
+	Hosts        []string `yaml:"hosts"`    // Omitted = unmanaged, [] = all hosts
+	ReadOnly     *bool    `yaml:"read_only"`
+	MaprootUser  *string  `yaml:"maproot_user"` // "" unsets the mapping
+	MaprootGroup *string  `yaml:"maproot_group"`
+	MapallUser   *string  `yaml:"mapall_user"` // "" unsets the mapping
+	MapallGroup  *string  `yaml:"mapall_group"`
+	Enabled      *bool    `yaml:"enabled"`
+	Absent       bool     `yaml:"absent"`
+}
+
+// CronJob is a desired cron job. Cron jobs have no name, so the description
+// identifies the job and must be unique on the system.
+type CronJob struct {
+	Description   string `yaml:"description"`
+	Command       string `yaml:"command"`
+	User          string `yaml:"user"`     // Default root
+	Schedule      string `yaml:"schedule"` // Five cron fields, e.g. "0 3 * * *"
+	Enabled       *bool  `yaml:"enabled"`  // Default true
+	CaptureStdout bool   `yaml:"capture_stdout"`
+	CaptureStderr bool   `yaml:"capture_stderr"`
+	Absent        bool   `yaml:"absent"`
+}
+
+// App is a desired custom app defined by a Docker Compose file.
+type App struct {
+	Name    string `yaml:"name"`
+	Compose string `yaml:"compose"` // Compose YAML
+	Absent  bool   `yaml:"absent"`
+}
+
+// Size is a byte count that YAML may also give as a string like "10GiB".
+type Size int64
+
+// UnmarshalYAML accepts a number of bytes or a size string.
+func (s *Size) UnmarshalYAML(node *yaml.Node) error {
+	var n int64
+	if err := node.Decode(&n); err == nil {
+		*s = Size(n)
+		return nil
+	}
+	var str string
+	if err := node.Decode(&str); err != nil {
+		return err
+	}
+	n, err := truenas.ParseSize(str)
+	if err != nil {
+		return fmt.Errorf("line %d: invalid size %q: %w", node.Line, str, err)
+	}
+	*s = Size(n)
+	return nil
+}
+
+// Load reads a State from YAML. Unknown keys are errors, so typos do not
+// silently leave a field unmanaged.
+func Load(r io.Reader) (*State, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	var state State
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&state); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("parse state: %w", err)
+	}
+	if err := state.Validate(); err != nil {
+		return nil, err
+	}
+	return &state, nil
+}
+
+// LoadFile reads a State from a YAML file.
+func LoadFile(path string) (*State, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	state, err := Load(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return state, nil
+}
+
+// Validate checks that every resource is identified once and has the
+// fields needed to create it.
+func (s *State) Validate() error {
+	var errs []error
+	seen := make(map[string]bool)
+	unique := func(kind Kind, name string) {
+		key := string(kind) + "\x00" + name
+		if seen[key] {
+			errs = append(errs, fmt.Errorf("%s %q declared more than once", kind, name))
+		}
+		seen[key] = true
+	}
+
+	for i, d := range s.Datasets {
+		if d.Name == "" || !strings.Contains(d.Name, "/") {
+			errs = append(errs, fmt.Errorf("datasets[%d]: name must be a full dataset path like pool/name, got %q", i, d.Name))
+			continue
+		}
+		unique(KindDataset, d.Name)
+	}
+	for i, t := range s.SnapshotTasks {
+		if t.Dataset == "" {
+			errs = append(errs, fmt.Errorf("snapshot_tasks[%d]: dataset is required", i))
+			continue
+		}
+		name := snapshotTaskName(t.Dataset, t.NamingSchema)
+		unique(KindSnapshotTask, name)
+		if t.Absent {
+			continue
+		}
+		if t.Schedule != "" {
+			if _, err := parseSchedule(t.Schedule); err != nil {
+				errs = append(errs, fmt.Errorf("snapshot task %q: %w", name, err))
+			}
+		}
+		if t.LifetimeUnit != "" && !validLifetimeUnit(t.LifetimeUnit) {
+			errs = append(errs, fmt.Errorf("snapshot task %q: lifetime_unit %q: want HOUR, DAY, WEEK, MONTH or YEAR", name, t.LifetimeUnit))
+		}
+	}
+	for i, sh := range s.Shares.SMB {
+		if sh.Name == "" {
+			errs = append(errs, fmt.Errorf("shares.smb[%d]: name is required", i))
+			continue
+		}
+		unique(KindSMBShare, strings.ToLower(sh.Name))
+		if !sh.Absent && sh.Path == "" {
+			errs = append(errs, fmt.Errorf("smb share %q: path is required", sh.Name))
+		}
+	}
+	for i, sh := range s.Shares.NFS {
+		if sh.Path == "" {
+			errs = append(errs, fmt.Errorf("shares.nfs[%d]: path is required", i))
+			continue
+		}
+		unique(KindNFSShare, sh.Path)
+	}
+	for i, j := range s.CronJobs {
+		if j.Description == "" {
+			errs = append(errs, fmt.Errorf("cron_jobs[%d]: description is required to identify the job", i))
+			continue
+		}
+		unique(KindCronJob, j.Description)
+		if j.Absent {
+			continue
+		}
+		if j.Command == "" {
+			errs = append(errs, fmt.Errorf("cron job %q: command is required", j.Description))
+		}
+		if _, err := parseSchedule(j.Schedule); err != nil {
+			errs = append(errs, fmt.Errorf("cron job %q: %w", j.Description, err))
+		}
+	}
+	for i, a := range s.Apps {
+		if a.Name == "" {
+			errs = append(errs, fmt.Errorf("apps[%d]: name is required", i))
+			continue
+		}
+		unique(KindApp, a.Name)
+		if a.Absent {
+			continue
+		}
+		if _, err := parseCompose(a.Compose); err != nil {
+			errs = append(errs, fmt.Errorf("app %q: %w", a.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
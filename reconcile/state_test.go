@@ -0,0 +1,138 @@
+package reconcile
+
+import (
+	"strings"
+	"testing"
+)
+
+const stateYAML = `
+datasets:
+  - name: tank/apps
+    compression: lz4
+    quota: 100GiB
+  - name: tank/apps/web
+    refquota: 1073741824
+    comments: ""
+  - name: tank/old
+    absent: true
+snapshot_tasks:
+  - dataset: tank/apps
+    schedule: "0 0 * * *"
+    lifetime_value: 30
+    lifetime_unit: day
+    recursive: true
+    exclude: [tank/apps/cache]
+shares:
+  smb:
+    - name: apps
+      path: /mnt/tank/apps
+      read_only: true
+  nfs:
+    - path: /mnt/tank/apps
+      networks: [10.0.0.0/8]
+      maproot_user: root
+cron_jobs:
+  - description: nightly backup
+    command: /root/backup.sh
+    schedule: "0 3 * * *"
+    enabled: false
+apps:
+  - name: web
+    compose: |
+      services:
+        nginx:
+          image: nginx:1.27
+`
+
+func TestLoad(t *testing.T) {
+	state, err := Load(strings.NewReader(stateYAML))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(state.Datasets) != 3 || len(state.SnapshotTasks) != 1 || len(state.Shares.SMB) != 1 ||
+		len(state.Shares.NFS) != 1 || len(state.CronJobs) != 1 || len(state.Apps) != 1 {
+		t.Fatalf("unexpected state: %+v", state)
+	}
+	apps := state.Datasets[0]
+	if apps.Quota == nil || *apps.Quota != 100<<30 {
+		t.Errorf("expected 100GiB quota, got %v", apps.Quota)
+	}
+	web := state.Datasets[1]
+	if web.RefQuota == nil || *web.RefQuota != 1<<30 {
+		t.Errorf("expected 1GiB refquota, got %v", web.RefQuota)
+	}
+	if web.Comments == nil || *web.Comments != "" {
+		t.Errorf("expected managed empty comments, got %v", web.Comments)
+	}
+	if !state.Datasets[2].Absent {
+		t.Error("expected tank/old to be absent")
+	}
+	if task := state.SnapshotTasks[0]; task.LifetimeValue != 30 || !task.Recursive || len(task.Exclude) != 1 {
+		t.Errorf("unexpected snapshot task %+v", task)
+	}
+	if smb := state.Shares.SMB[0]; smb.ReadOnly == nil || !*smb.ReadOnly || smb.HostsAllow != nil {
+		t.Errorf("unexpected smb share %+v", smb)
+	}
+	if nfs := state.Shares.NFS[0]; nfs.MaprootUser == nil || *nfs.MaprootUser != "root" || len(nfs.Networks) != 1 {
+		t.Errorf("unexpected nfs share %+v", nfs)
+	}
+	if job := state.CronJobs[0]; job.Enabled == nil || *job.Enabled {
+		t.Errorf("expected disabled job, got %v", job.Enabled)
+	}
+	if !strings.Contains(state.Apps[0].Compose, "nginx:1.27") {
+		t.Errorf("unexpected compose %q", state.Apps[0].Compose)
+	}
+}
+
+func TestLoad_Empty(t *testing.T) {
+	state, err := Load(strings.NewReader(""))
+	if err != nil || state == nil {
+		t.Fatalf("expected empty state, got %v, %v", state, err)
+	}
+}
+
+func TestLoad_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"unknown field", "datasets:\n  - name: tank/a\n    compresion: lz4\n", "compresion"},
+		{"bad size", "datasets:\n  - name: tank/a\n    quota: lots\n", `invalid size "lots"`},
+		{"pool only", "datasets:\n  - name: tank\n", "full dataset path"},
+		{"duplicate", "datasets:\n  - name: tank/a\n  - name: tank/a\n", "declared more than once"},
+		{"no task dataset", "snapshot_tasks:\n  - schedule: '0 * * * *'\n", "dataset is required"},
+		{"duplicate task", "snapshot_tasks:\n  - dataset: tank/a\n  - dataset: tank/a\n    naming_schema: auto-%Y-%m-%d_%H-%M\n", "declared more than once"},
+		{"task schedule", "snapshot_tasks:\n  - dataset: tank/a\n    schedule: hourly\n", "five cron fields"},
+		{"lifetime unit", "snapshot_tasks:\n  - dataset: tank/a\n    lifetime_unit: fortnight\n", "lifetime_unit"},
+		{"no share name", "shares:\n  smb:\n    - path: /mnt/tank/a\n", "name is required"},
+		{"no share path", "shares:\n  smb:\n    - name: a\n", "path is required"},
+		{"duplicate share", "shares:\n  smb:\n    - name: a\n      path: /mnt/tank/a\n    - name: A\n      path: /mnt/tank/b\n", "declared more than once"},
+		{"no export path", "shares:\n  nfs:\n    - read_only: true\n", "path is required"},
+		{"schedule", "cron_jobs:\n  - description: x\n    command: y\n    schedule: daily\n", "five cron fields"},
+		{"no command", "cron_jobs:\n  - description: x\n    schedule: '* * * * *'\n", "command is required"},
+		{"no description", "cron_jobs:\n  - command: y\n", "description is required"},
+		{"no compose", "apps:\n  - name: web\n", "compose is required"},
+		{"bad compose", "apps:\n  - name: web\n    compose: '[oops'\n", "parse compose"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Load(strings.NewReader(tt.input))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestValidate_AbsentNeedsOnlyIdentity(t *testing.T) {
+	state := State{
+		SnapshotTasks: []SnapshotTask{{Dataset: "tank/old", Absent: true}},
+		Shares:        Shares{SMB: []SMBShare{{Name: "old", Absent: true}}},
+		CronJobs:      []CronJob{{Description: "old", Absent: true}},
+		Apps:          []App{{Name: "old", Absent: true}},
+	}
+	if err := state.Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,183 @@
+package truenas
+
+// ReplicationDirection is whether a replication task sends snapshots to, or
+// fetches them from, the remote side.
+type ReplicationDirection string
+
+const (
+	ReplicationPush ReplicationDirection = "PUSH"
+	ReplicationPull ReplicationDirection = "PULL"
+)
+
+// ReplicationTransport is how a replication task moves snapshots.
+type ReplicationTransport string
+
+const (
+	ReplicationTransportSSH       ReplicationTransport = "SSH"
+	ReplicationTransportSSHNetcat ReplicationTransport = "SSH+NETCAT" // Negotiated over SSH, sent over a raw TCP stream
+	ReplicationTransportLocal     ReplicationTransport = "LOCAL"
+)
+
+// ReplicationNetcatSide is the side of an SSH+NETCAT replication that opens
+// the listening socket.
+type ReplicationNetcatSide string
+
+const (
+	ReplicationNetcatLocal  ReplicationNetcatSide = "LOCAL"
+	ReplicationNetcatRemote ReplicationNetcatSide = "REMOTE"
+)
+
+// ReplicationRetentionPolicy is how long replicated snapshots are kept on
+// the target.
+type ReplicationRetentionPolicy string
+
+const (
+	ReplicationRetentionSource ReplicationRetentionPolicy = "SOURCE" // Same as the source snapshots
+	ReplicationRetentionCustom ReplicationRetentionPolicy = "CUSTOM" // Lifetime set on the task
+	ReplicationRetentionNone   ReplicationRetentionPolicy = "NONE"   // Never deleted
+)
+
+// LifetimeUnit is the unit of a snapshot lifetime.
+type LifetimeUnit string
+
+const (
+	LifetimeHour  LifetimeUnit = "HOUR"
+	LifetimeDay   LifetimeUnit = "DAY"
+	LifetimeWeek  LifetimeUnit = "WEEK"
+	LifetimeMonth LifetimeUnit = "MONTH"
+	LifetimeYear  LifetimeUnit = "YEAR"
+)
+
+// ReplicationReadonly controls the readonly property of target datasets.
+type ReplicationReadonly string
+
+const (
+	ReplicationReadonlySet     ReplicationReadonly = "SET"
+	ReplicationReadonlyRequire ReplicationReadonly = "REQUIRE"
+	ReplicationReadonlyIgnore  ReplicationReadonly = "IGNORE"
+)
+
+// ReplicationCompression is the stream compression used over SSH.
+type ReplicationCompression string
+
+const (
+	ReplicationCompressionLZ4   ReplicationCompression = "LZ4"
+	ReplicationCompressionPIGZ  ReplicationCompression = "PIGZ"
+	ReplicationCompressionPLZIP ReplicationCompression = "PLZIP"
+)
+
+// ReplicationKeyFormat is the format of a replication encryption key.
+type ReplicationKeyFormat string
+
+const (
+	ReplicationKeyHex        ReplicationKeyFormat = "HEX"
+	ReplicationKeyPassphrase ReplicationKeyFormat = "PASSPHRASE"
+)
+
+// ReplicationTaskResponse represents a replication task from the
+// replication query API.
+type ReplicationTaskResponse struct {
+	ID                              int64                             `json:"id"`
+	Name                            string                            `json:"name"`
+	Direction                       string                            `json:"direction"`
+	Transport                       string                            `json:"transport"`
+	SSHCredentials                  *KeychainCredentialRefResponse    `json:"ssh_credentials"`
+	NetcatActiveSide                *string                           `json:"netcat_active_side"`
+	NetcatActiveSideListenAddress   *string                           `json:"netcat_active_side_listen_address"`
+	NetcatActiveSidePortMin         *int64                            `json:"netcat_active_side_port_min"`
+	NetcatActiveSidePortMax         *int64                            `json:"netcat_active_side_port_max"`
+	NetcatPassiveSideConnectAddress *string                           `json:"netcat_passive_side_connect_address"`
+	Sudo                            bool                              `json:"sudo"`
+	SourceDatasets                  []string                          `json:"source_datasets"`
+	TargetDataset                   string                            `json:"target_dataset"`
+	Recursive                       bool                              `json:"recursive"`
+	Exclude                         []string                          `json:"exclude"`
+	Properties                      bool                              `json:"properties"`
+	PropertiesExclude               []string                          `json:"properties_exclude"`
+	PropertiesOverride              map[string]any                    `json:"properties_override"`
+	Replicate                       bool                              `json:"replicate"`
+	Encryption                      bool                              `json:"encryption"`
+	EncryptionInherit               *bool                             `json:"encryption_inherit"`
+	EncryptionKey                   *string                           `json:"encryption_key"`
+	EncryptionKeyFormat             *string                           `json:"encryption_key_format"`
+	EncryptionKeyLocation           *string                           `json:"encryption_key_location"`
+	PeriodicSnapshotTasks           []ReplicationSnapshotTaskResponse `json:"periodic_snapshot_tasks"`
+	NamingSchema                    []string                          `json:"naming_schema"`
+	AlsoIncludeNamingSchema         []string                          `json:"also_include_naming_schema"`
+	NameRegex                       *string                           `json:"name_regex"`
+	Auto                            bool                              `json:"auto"`
+	Schedule                        *WindowedScheduleResponse         `json:"schedule"`
+	RestrictSchedule                *WindowedScheduleResponse         `json:"restrict_schedule"`
+	OnlyMatchingSchedule            bool                              `json:"only_matching_schedule"`
+	AllowFromScratch                bool                              `json:"allow_from_scratch"`
+	Readonly                        string                            `json:"readonly"`
+	HoldPendingSnapshots            bool                              `json:"hold_pending_snapshots"`
+	RetentionPolicy                 string                            `json:"retention_policy"`
+	LifetimeValue                   *int64                            `json:"lifetime_value"`
+	LifetimeUnit                    *string                           `json:"lifetime_unit"`
+	Lifetimes                       []ReplicationLifetimeResponse     `json:"lifetimes"`
+	Compression                     *string                           `json:"compression"`
+	SpeedLimit                      *int64                            `json:"speed_limit"`
+	LargeBlock                      bool                              `json:"large_block"`
+	Embed                           bool                              `json:"embed"`
+	Compressed                      bool                              `json:"compressed"`
+	Retries                         int64                             `json:"retries"`
+	LoggingLevel                    *string                           `json:"logging_level"`
+	Enabled                         bool                              `json:"enabled"`
+	State                           TaskStateResponse                 `json:"state"`
+	Job                             *JobStatus                        `json:"job"`
+	HasEncryptedDatasetKeys         bool                              `json:"has_encrypted_dataset_keys"`
+}
+
+// KeychainCredentialRefResponse is the SSH keychain credential embedded in
+// replication and rsync tasks.
+type KeychainCredentialRefResponse struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+// ReplicationSnapshotTaskResponse is a periodic snapshot task embedded in a
+// replication task.
+type ReplicationSnapshotTaskResponse struct {
+	ID           int64  `json:"id"`
+	Dataset      string `json:"dataset"`
+	Recursive    bool   `json:"recursive"`
+	NamingSchema string `json:"naming_schema"`
+	Enabled      bool   `json:"enabled"`
+}
+
+// ReplicationLifetimeResponse keeps snapshots matching a schedule for a
+// custom lifetime.
+type ReplicationLifetimeResponse struct {
+	Schedule      ScheduleResponse `json:"schedule"`
+	LifetimeValue int64            `json:"lifetime_value"`
+	LifetimeUnit  string           `json:"lifetime_unit"`
+}
+
+// TaskStateResponse is the outcome of a replication or periodic snapshot
+// task's last run.
+type TaskStateResponse struct {
+	State        string        `json:"state"`
+	Datetime     *DateResponse `json:"datetime"`
+	LastSnapshot *string       `json:"last_snapshot"`
+	Error        *string       `json:"error"`
+	Warnings     []string      `json:"warnings"`
+}
+
+// DateResponse is a timestamp encoded as {"$date": <unix millis>}.
+type DateResponse struct {
+	Date int64 `json:"$date"`
+}
+
+// ReplicationConfigResponse represents the global replication configuration.
+type ReplicationConfigResponse struct {
+	ID                          int64  `json:"id"`
+	MaxParallelReplicationTasks *int64 `json:"max_parallel_replication_tasks"`
+}
+
+// ReplicationSnapshotCountResponse is the result of
+// replication.count_eligible_manual_snapshots.
+type ReplicationSnapshotCountResponse struct {
+	Total    int64 `json:"total"`
+	Eligible int64 `json:"eligible"`
+}
@@ -0,0 +1,784 @@
+package truenas
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// ReplicationTask is the user-facing representation of a ZFS replication
+// task.
+type ReplicationTask struct {
+	ID        int64
+	Name      string
+	Direction ReplicationDirection
+	Transport ReplicationTransport
+	// SSHCredentialsID is the keychain credential used to reach the remote
+	// side; 0 for LOCAL transport.
+	SSHCredentialsID int64
+	// Netcat settings apply to SSH+NETCAT transport only.
+	NetcatActiveSide                ReplicationNetcatSide
+	NetcatActiveSideListenAddress   string
+	NetcatActiveSidePortMin         int64
+	NetcatActiveSidePortMax         int64
+	NetcatPassiveSideConnectAddress string
+	Sudo                            bool
+	SourceDatasets                  []string
+	TargetDataset                   string
+	Recursive                       bool
+	Exclude                         []string
+	Properties                      bool
+	PropertiesExclude               []string
+	PropertiesOverride              map[string]any
+	Replicate                       bool
+	Encryption                      bool
+	EncryptionInherit               bool
+	EncryptionKeyFormat             ReplicationKeyFormat
+	EncryptionKeyLocation           string
+	// PeriodicSnapshotTaskIDs are the snapshot tasks whose snapshots are
+	// replicated, and whose completion triggers an automatic run.
+	PeriodicSnapshotTaskIDs []int64
+	// NamingSchema selects snapshots to push by strftime-style name, e.g.
+	// "auto-%Y-%m-%d_%H-%M". Used for pull tasks and push tasks without
+	// periodic snapshot tasks.
+	NamingSchema []string
+	// AlsoIncludeNamingSchema selects extra snapshots for push tasks with
+	// periodic snapshot tasks.
+	AlsoIncludeNamingSchema []string
+	NameRegex               string
+	Auto                    bool
+	Schedule                *WindowedSchedule // nil when runs follow the periodic snapshot tasks
+	RestrictSchedule        *WindowedSchedule
+	OnlyMatchingSchedule    bool
+	AllowFromScratch        bool
+	Readonly                ReplicationReadonly
+	HoldPendingSnapshots    bool
+	RetentionPolicy         ReplicationRetentionPolicy
+	// LifetimeValue and LifetimeUnit apply to CUSTOM retention.
+	LifetimeValue int64
+	LifetimeUnit  LifetimeUnit
+	Lifetimes     []ReplicationLifetime
+	Compression   ReplicationCompression
+	SpeedLimit    int64 // Bytes per second; 0 when unlimited
+	LargeBlock    bool
+	Embed         bool
+	Compressed    bool
+	Retries       int64
+	LoggingLevel  string
+	Enabled       bool
+	State         TaskState
+	// JobID is the task's running or most recent job; 0 if it has not run.
+	JobID                   int64
+	HasEncryptedDatasetKeys bool
+}
+
+// ReplicationLifetime keeps snapshots matching Schedule for a custom
+// lifetime.
+type ReplicationLifetime struct {
+	Schedule      Schedule
+	LifetimeValue int64
+	LifetimeUnit  LifetimeUnit
+}
+
+// TaskState is the outcome of a replication or periodic snapshot task's
+// last run.
+type TaskState struct {
+	State        string // e.g. "PENDING", "RUNNING", "FINISHED", "ERROR"
+	LastRun      time.Time
+	LastSnapshot string // Replication tasks only
+	Error        string
+	Warnings     []string
+}
+
+// CreateReplicationTaskOpts contains options for creating a replication
+// task. Name, Direction, Transport, TargetDataset and RetentionPolicy are
+// required.
+type CreateReplicationTaskOpts struct {
+	Name                            string
+	Direction                       ReplicationDirection
+	Transport                       ReplicationTransport
+	SSHCredentialsID                int64
+	NetcatActiveSide                ReplicationNetcatSide
+	NetcatActiveSideListenAddress   string
+	NetcatActiveSidePortMin         int64
+	NetcatActiveSidePortMax         int64
+	NetcatPassiveSideConnectAddress string
+	Sudo                            bool
+	SourceDatasets                  []string
+	TargetDataset                   string
+	Recursive                       bool
+	Exclude                         []string
+	Properties                      *bool // Default: true
+	PropertiesExclude               []string
+	PropertiesOverride              map[string]any
+	Replicate                       bool
+	Encryption                      bool
+	EncryptionInherit               *bool
+	EncryptionKey                   string
+	EncryptionKeyFormat             ReplicationKeyFormat
+	EncryptionKeyLocation           string
+	PeriodicSnapshotTaskIDs         []int64
+	NamingSchema                    []string
+	AlsoIncludeNamingSchema         []string
+	NameRegex                       string
+	Auto                            bool
+	Schedule                        *WindowedSchedule
+	RestrictSchedule                *WindowedSchedule
+	OnlyMatchingSchedule            bool
+	AllowFromScratch                bool
+	Readonly                        ReplicationReadonly // Default: SET
+	HoldPendingSnapshots            bool
+	RetentionPolicy                 ReplicationRetentionPolicy
+	LifetimeValue                   int64
+	LifetimeUnit                    LifetimeUnit
+	Lifetimes                       []ReplicationLifetime
+	Compression                     ReplicationCompression
+	SpeedLimit                      int64
+	LargeBlock                      *bool // Default: true
+	Embed                           bool
+	Compressed                      *bool // Default: true
+	Retries                         int64 // 0 = server default (5)
+	LoggingLevel                    string
+	Enabled                         *bool // Default: true
+}
+
+// UpdateReplicationTaskOpts contains options for updating a replication
+// task. Nil fields are left unchanged. Empty strings and zero integers in
+// nullable fields are sent as null, and a pointer to a zero WindowedSchedule
+// clears a schedule.
+type UpdateReplicationTaskOpts struct {
+	Name                            string // Empty = don't change
+	Direction                       ReplicationDirection
+	Transport                       ReplicationTransport
+	SSHCredentialsID                *int64
+	NetcatActiveSide                *ReplicationNetcatSide
+	NetcatActiveSideListenAddress   *string
+	NetcatActiveSidePortMin         *int64
+	NetcatActiveSidePortMax         *int64
+	NetcatPassiveSideConnectAddress *string
+	Sudo                            *bool
+	SourceDatasets                  []string
+	TargetDataset                   string // Empty = don't change
+	Recursive                       *bool
+	Exclude                         []string
+	Properties                      *bool
+	PropertiesExclude               []string
+	PropertiesOverride              map[string]any
+	Replicate                       *bool
+	Encryption                      *bool
+	EncryptionInherit               *bool
+	EncryptionKey                   *string
+	EncryptionKeyFormat             *ReplicationKeyFormat
+	EncryptionKeyLocation           *string
+	PeriodicSnapshotTaskIDs         []int64
+	NamingSchema                    []string
+	AlsoIncludeNamingSchema         []string
+	NameRegex                       *string
+	Auto                            *bool
+	Schedule                        *WindowedSchedule
+	RestrictSchedule                *WindowedSchedule
+	OnlyMatchingSchedule            *bool
+	AllowFromScratch                *bool
+	Readonly                        ReplicationReadonly
+	HoldPendingSnapshots            *bool
+	RetentionPolicy                 ReplicationRetentionPolicy
+	LifetimeValue                   *int64
+	LifetimeUnit                    *LifetimeUnit
+	Lifetimes                       []ReplicationLifetime
+	Compression                     *ReplicationCompression
+	SpeedLimit                      *int64
+	LargeBlock                      *bool
+	Embed                           *bool
+	Compressed                      *bool
+	Retries                         *int64
+	LoggingLevel                    *string
+	Enabled                         *bool
+}
+
+// EligibleSnapshotsOpts selects the existing snapshots a replication task
+// would pick up.
+type EligibleSnapshotsOpts struct {
+	Datasets         []string
+	NamingSchema     []string
+	NameRegex        string
+	Transport        ReplicationTransport
+	SSHCredentialsID int64
+}
+
+// ReplicationSnapshotCount is the number of snapshots found and how many of
+// them match the requested naming schemas.
+type ReplicationSnapshotCount struct {
+	Total    int64
+	Eligible int64
+}
+
+// UnmatchedSnapshotsOpts describes the replication whose target is checked
+// for snapshots missing on the source.
+type UnmatchedSnapshotsOpts struct {
+	Direction        ReplicationDirection
+	SourceDatasets   []string
+	TargetDataset    string
+	Transport        ReplicationTransport
+	SSHCredentialsID int64
+}
+
+// ReplicationConfig is the user-facing representation of the global
+// replication configuration.
+type ReplicationConfig struct {
+	MaxParallelTasks int64 // 0 when unlimited
+}
+
+// UpdateReplicationConfigOpts contains options for updating the global
+// replication configuration.
+type UpdateReplicationConfigOpts struct {
+	MaxParallelTasks *int64 // 0 = unlimited
+}
+
+// ReplicationService provides typed methods for the replication.* API
+// namespace.
+type ReplicationService struct {
+	client  AsyncCaller
+	version Version
+}
+
+// NewReplicationService creates a new ReplicationService.
+func NewReplicationService(c AsyncCaller, v Version) *ReplicationService {
+	return &ReplicationService{client: c, version: v}
+}
+
+// Create creates a replication task and returns the full object.
+func (s *ReplicationService) Create(ctx context.Context, opts CreateReplicationTaskOpts) (*ReplicationTask, error) {
+	params := replicationCreateParams(opts)
+	result, err := callMethod(ctx, s.client, s.version, "replication.create", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var createResp struct {
+		ID int64 `json:"id"`
+	}
+	if err := json.Unmarshal(result, &createResp); err != nil {
+		return nil, fmt.Errorf("parse create response: %w", err)
+	}
+
+	return s.Get(ctx, createResp.ID)
+}
+
+// Get returns a replication task by ID, or nil if not found.
+func (s *ReplicationService) Get(ctx context.Context, id int64) (*ReplicationTask, error) {
+	result, err := callMethod(ctx, s.client, s.version, "replication.get_instance", id)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var resp ReplicationTaskResponse
+	if err := json.Unmarshal(result, &resp); err != nil {
+		return nil, fmt.Errorf("parse get_instance response: %w", err)
+	}
+
+	task := replicationTaskFromResponse(resp)
+	return &task, nil
+}
+
+// GetByName returns a replication task by name, or nil if not found.
+func (s *ReplicationService) GetByName(ctx context.Context, name string) (*ReplicationTask, error) {
+	filter := [][]any{{"name", "=", name}}
+	result, err := callMethod(ctx, s.client, s.version, "replication.query", filter)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := parseReplicationTasks(result)
+	if err != nil {
+		return nil, err
+	}
+	if len(tasks) == 0 {
+		return nil, nil
+	}
+	return &tasks[0], nil
+}
+
+// List returns all replication tasks.
+func (s *ReplicationService) List(ctx context.Context) ([]ReplicationTask, error) {
+	result, err := callMethod(ctx, s.client, s.version, "replication.query", nil)
+	if err != nil {
+		return nil, err
+	}
+	return parseReplicationTasks(result)
+}
+
+// parseReplicationTasks parses a replication.query response.
+func parseReplicationTasks(result json.RawMessage) ([]ReplicationTask, error) {
+	var responses []ReplicationTaskResponse
+	if err := json.Unmarshal(result, &responses); err != nil {
+		return nil, fmt.Errorf("parse query response: %w", err)
+	}
+
+	tasks := make([]ReplicationTask, len(responses))
+	for i, resp := range responses {
+		tasks[i] = replicationTaskFromResponse(resp)
+	}
+	return tasks, nil
+}
+
+// Update updates a replication task and returns the full object.
+func (s *ReplicationService) Update(ctx context.Context, id int64, opts UpdateReplicationTaskOpts) (*ReplicationTask, error) {
+	params := replicationUpdateParams(opts)
+	_, err := callMethod(ctx, s.client, s.version, "replication.update", []any{id, params})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Get(ctx, id)
+}
+
+// Delete deletes a replication task by ID. Replicated snapshots are kept.
+func (s *ReplicationService) Delete(ctx context.Context, id int64) error {
+	_, err := callMethod(ctx, s.client, s.version, "replication.delete", id)
+	return err
+}
+
+// Run starts a replication task and returns a handle to its job without
+// waiting for it to finish.
+func (s *ReplicationService) Run(ctx context.Context, id int64) (*Job, error) {
+	result, err := callMethod(ctx, s.client, s.version, "replication.run", id)
+	if err != nil {
+		return nil, err
+	}
+	return newJob(s.client, s.version, result)
+}
+
+// ListDatasets returns the datasets on the side of a replication reached
+// through transport. sshCredentialsID is ignored for LOCAL transport.
+func (s *ReplicationService) ListDatasets(ctx context.Context, transport ReplicationTransport, sshCredentialsID int64) ([]string, error) {
+	params := []any{string(transport), nullableID(sshCredentialsID)}
+	result, err := callMethod(ctx, s.client, s.version, "replication.list_datasets", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var datasets []string
+	if err := json.Unmarshal(result, &datasets); err != nil {
+		return nil, fmt.Errorf("parse list_datasets response: %w", err)
+	}
+	return datasets, nil
+}
+
+// ListNamingSchemas returns the naming schemas of all periodic snapshot
+// tasks.
+func (s *ReplicationService) ListNamingSchemas(ctx context.Context) ([]string, error) {
+	result, err := callMethod(ctx, s.client, s.version, "replication.list_naming_schemas", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var schemas []string
+	if err := json.Unmarshal(result, &schemas); err != nil {
+		return nil, fmt.Errorf("parse list_naming_schemas response: %w", err)
+	}
+	return schemas, nil
+}
+
+// CountEligibleManualSnapshots counts the existing snapshots of opts.Datasets
+// and how many of them match opts.NamingSchema or opts.NameRegex.
+func (s *ReplicationService) CountEligibleManualSnapshots(ctx context.Context, opts EligibleSnapshotsOpts) (*ReplicationSnapshotCount, error) {
+	params := map[string]any{
+		"datasets":        nonNilStrings(opts.Datasets),
+		"naming_schema":   nonNilStrings(opts.NamingSchema),
+		"transport":       string(opts.Transport),
+		"ssh_credentials": nullableID(opts.SSHCredentialsID),
+	}
+	if opts.NameRegex != "" {
+		params["name_regex"] = opts.NameRegex
+	}
+	result, err := callMethod(ctx, s.client, s.version, "replication.count_eligible_manual_snapshots", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp ReplicationSnapshotCountResponse
+	if err := json.Unmarshal(result, &resp); err != nil {
+		return nil, fmt.Errorf("parse count_eligible_manual_snapshots response: %w", err)
+	}
+	return &ReplicationSnapshotCount{Total: resp.Total, Eligible: resp.Eligible}, nil
+}
+
+// TargetUnmatchedSnapshots returns, per target dataset, the snapshots that
+// exist on the target but not on the source. A replication that is not
+// allowed to start from scratch fails when these exist.
+func (s *ReplicationService) TargetUnmatchedSnapshots(ctx context.Context, opts UnmatchedSnapshotsOpts) (map[string][]string, error) {
+	params := []any{
+		string(opts.Direction),
+		nonNilStrings(opts.SourceDatasets),
+		opts.TargetDataset,
+		string(opts.Transport),
+		nullableID(opts.SSHCredentialsID),
+	}
+	result, err := callMethod(ctx, s.client, s.version, "replication.target_unmatched_snapshots", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var unmatched map[string][]string
+	if err := json.Unmarshal(result, &unmatched); err != nil {
+		return nil, fmt.Errorf("parse target_unmatched_snapshots response: %w", err)
+	}
+	return unmatched, nil
+}
+
+// GetConfig returns the global replication configuration.
+func (s *ReplicationService) GetConfig(ctx context.Context) (*ReplicationConfig, error) {
+	result, err := callMethod(ctx, s.client, s.version, "replication.config.config", nil)
+	if err != nil {
+		return nil, err
+	}
+	return parseReplicationConfig(result, "config")
+}
+
+// UpdateConfig updates the global replication configuration and returns it.
+func (s *ReplicationService) UpdateConfig(ctx context.Context, opts UpdateReplicationConfigOpts) (*ReplicationConfig, error) {
+	params := map[string]any{}
+	setNullableInt64(params, "max_parallel_replication_tasks", opts.MaxParallelTasks)
+	result, err := callMethod(ctx, s.client, s.version, "replication.config.update", params)
+	if err != nil {
+		return nil, err
+	}
+	return parseReplicationConfig(result, "update")
+}
+
+func parseReplicationConfig(result json.RawMessage, method string) (*ReplicationConfig, error) {
+	var resp ReplicationConfigResponse
+	if err := json.Unmarshal(result, &resp); err != nil {
+		return nil, fmt.Errorf("parse %s response: %w", method, err)
+	}
+	return &ReplicationConfig{MaxParallelTasks: derefInt64(resp.MaxParallelReplicationTasks)}, nil
+}
+
+// nullableID returns id, or nil for 0.
+func nullableID(id int64) any {
+	if id == 0 {
+		return nil
+	}
+	return id
+}
+
+// replicationCreateParams converts CreateReplicationTaskOpts to API
+// parameters.
+func replicationCreateParams(opts CreateReplicationTaskOpts) map[string]any {
+	params := map[string]any{
+		"name":             opts.Name,
+		"direction":        string(opts.Direction),
+		"transport":        string(opts.Transport),
+		"target_dataset":   opts.TargetDataset,
+		"recursive":        opts.Recursive,
+		"auto":             opts.Auto,
+		"retention_policy": string(opts.RetentionPolicy),
+	}
+	if opts.SSHCredentialsID != 0 {
+		params["ssh_credentials"] = opts.SSHCredentialsID
+	}
+	if opts.NetcatActiveSide != "" {
+		params["netcat_active_side"] = string(opts.NetcatActiveSide)
+	}
+	if opts.NetcatActiveSideListenAddress != "" {
+		params["netcat_active_side_listen_address"] = opts.NetcatActiveSideListenAddress
+	}
+	if opts.NetcatActiveSidePortMin != 0 {
+		params["netcat_active_side_port_min"] = opts.NetcatActiveSidePortMin
+	}
+	if opts.NetcatActiveSidePortMax != 0 {
+		params["netcat_active_side_port_max"] = opts.NetcatActiveSidePortMax
+	}
+	if opts.NetcatPassiveSideConnectAddress != "" {
+		params["netcat_passive_side_connect_address"] = opts.NetcatPassiveSideConnectAddress
+	}
+	if opts.Sudo {
+		params["sudo"] = true
+	}
+	if len(opts.SourceDatasets) > 0 {
+		params["source_datasets"] = opts.SourceDatasets
+	}
+	if len(opts.Exclude) > 0 {
+		params["exclude"] = opts.Exclude
+	}
+	if opts.Properties != nil {
+		params["properties"] = *opts.Properties
+	}
+	if len(opts.PropertiesExclude) > 0 {
+		params["properties_exclude"] = opts.PropertiesExclude
+	}
+	if len(opts.PropertiesOverride) > 0 {
+		params["properties_override"] = opts.PropertiesOverride
+	}
+	if opts.Replicate {
+		params["replicate"] = true
+	}
+	if opts.Encryption {
+		params["encryption"] = true
+	}
+	if opts.EncryptionInherit != nil {
+		params["encryption_inherit"] = *opts.EncryptionInherit
+	}
+	if opts.EncryptionKey != "" {
+		params["encryption_key"] = opts.EncryptionKey
+	}
+	if opts.EncryptionKeyFormat != "" {
+		params["encryption_key_format"] = string(opts.EncryptionKeyFormat)
+	}
+	if opts.EncryptionKeyLocation != "" {
+		params["encryption_key_location"] = opts.EncryptionKeyLocation
+	}
+	if len(opts.PeriodicSnapshotTaskIDs) > 0 {
+		params["periodic_snapshot_tasks"] = opts.PeriodicSnapshotTaskIDs
+	}
+	if len(opts.NamingSchema) > 0 {
+		params["naming_schema"] = opts.NamingSchema
+	}
+	if len(opts.AlsoIncludeNamingSchema) > 0 {
+		params["also_include_naming_schema"] = opts.AlsoIncludeNamingSchema
+	}
+	if opts.NameRegex != "" {
+		params["name_regex"] = opts.NameRegex
+	}
+	if opts.Schedule != nil {
+		params["schedule"] = windowedScheduleParams(*opts.Schedule)
+	}
+	if opts.RestrictSchedule != nil {
+		params["restrict_schedule"] = windowedScheduleParams(*opts.RestrictSchedule)
+	}
+	if opts.OnlyMatchingSchedule {
+		params["only_matching_schedule"] = true
+	}
+	if opts.AllowFromScratch {
+		params["allow_from_scratch"] = true
+	}
+	if opts.Readonly != "" {
+		params["readonly"] = string(opts.Readonly)
+	}
+	if opts.HoldPendingSnapshots {
+		params["hold_pending_snapshots"] = true
+	}
+	if opts.LifetimeValue != 0 {
+		params["lifetime_value"] = opts.LifetimeValue
+	}
+	if opts.LifetimeUnit != "" {
+		params["lifetime_unit"] = string(opts.LifetimeUnit)
+	}
+	if len(opts.Lifetimes) > 0 {
+		params["lifetimes"] = replicationLifetimesParams(opts.Lifetimes)
+	}
+	if opts.Compression != "" {
+		params["compression"] = string(opts.Compression)
+	}
+	if opts.SpeedLimit != 0 {
+		params["speed_limit"] = opts.SpeedLimit
+	}
+	if opts.LargeBlock != nil {
+		params["large_block"] = *opts.LargeBlock
+	}
+	if opts.Embed {
+		params["embed"] = true
+	}
+	if opts.Compressed != nil {
+		params["compressed"] = *opts.Compressed
+	}
+	if opts.Retries != 0 {
+		params["retries"] = opts.Retries
+	}
+	if opts.LoggingLevel != "" {
+		params["logging_level"] = opts.LoggingLevel
+	}
+	if opts.Enabled != nil {
+		params["enabled"] = *opts.Enabled
+	}
+	return params
+}
+
+// replicationUpdateParams converts UpdateReplicationTaskOpts to API
+// parameters, including only the fields that are set.
+func replicationUpdateParams(opts UpdateReplicationTaskOpts) map[string]any {
+	params := map[string]any{}
+	if opts.Name != "" {
+		params["name"] = opts.Name
+	}
+	if opts.Direction != "" {
+		params["direction"] = string(opts.Direction)
+	}
+	if opts.Transport != "" {
+		params["transport"] = string(opts.Transport)
+	}
+	setNullableInt64(params, "ssh_credentials", opts.SSHCredentialsID)
+	setNullableString(params, "netcat_active_side", opts.NetcatActiveSide)
+	setNullableString(params, "netcat_active_side_listen_address", opts.NetcatActiveSideListenAddress)
+	setNullableInt64(params, "netcat_active_side_port_min", opts.NetcatActiveSidePortMin)
+	setNullableInt64(params, "netcat_active_side_port_max", opts.NetcatActiveSidePortMax)
+	setNullableString(params, "netcat_passive_side_connect_address", opts.NetcatPassiveSideConnectAddress)
+	setBool(params, "sudo", opts.Sudo)
+	if opts.SourceDatasets != nil {
+		params["source_datasets"] = opts.SourceDatasets
+	}
+	if opts.TargetDataset != "" {
+		params["target_dataset"] = opts.TargetDataset
+	}
+	setBool(params, "recursive", opts.Recursive)
+	if opts.Exclude != nil {
+		params["exclude"] = opts.Exclude
+	}
+	setBool(params, "properties", opts.Properties)
+	if opts.PropertiesExclude != nil {
+		params["properties_exclude"] = opts.PropertiesExclude
+	}
+	if opts.PropertiesOverride != nil {
+		params["properties_override"] = opts.PropertiesOverride
+	}
+	setBool(params, "replicate", opts.Replicate)
+	setBool(params, "encryption", opts.Encryption)
+	setBool(params, "encryption_inherit", opts.EncryptionInherit)
+	setNullableString(params, "encryption_key", opts.EncryptionKey)
+	setNullableString(params, "encryption_key_format", opts.EncryptionKeyFormat)
+	setNullableString(params, "encryption_key_location", opts.EncryptionKeyLocation)
+	if opts.PeriodicSnapshotTaskIDs != nil {
+		params["periodic_snapshot_tasks"] = opts.PeriodicSnapshotTaskIDs
+	}
+	if opts.NamingSchema != nil {
+		params["naming_schema"] = opts.NamingSchema
+	}
+	if opts.AlsoIncludeNamingSchema != nil {
+		params["also_include_naming_schema"] = opts.AlsoIncludeNamingSchema
+	}
+	setNullableString(params, "name_regex", opts.NameRegex)
+	setBool(params, "auto", opts.Auto)
+	setWindowedSchedule(params, "schedule", opts.Schedule)
+	setWindowedSchedule(params, "restrict_schedule", opts.RestrictSchedule)
+	setBool(params, "only_matching_schedule", opts.OnlyMatchingSchedule)
+	setBool(params, "allow_from_scratch", opts.AllowFromScratch)
+	if opts.Readonly != "" {
+		params["readonly"] = string(opts.Readonly)
+	}
+	setBool(params, "hold_pending_snapshots", opts.HoldPendingSnapshots)
+	if opts.RetentionPolicy != "" {
+		params["retention_policy"] = string(opts.RetentionPolicy)
+	}
+	setNullableInt64(params, "lifetime_value", opts.LifetimeValue)
+	setNullableString(params, "lifetime_unit", opts.LifetimeUnit)
+	if opts.Lifetimes != nil {
+		params["lifetimes"] = replicationLifetimesParams(opts.Lifetimes)
+	}
+	setNullableString(params, "compression", opts.Compression)
+	setNullableInt64(params, "speed_limit", opts.SpeedLimit)
+	setBool(params, "large_block", opts.LargeBlock)
+	setBool(params, "embed", opts.Embed)
+	setBool(params, "compressed", opts.Compressed)
+	if opts.Retries != nil {
+		params["retries"] = *opts.Retries
+	}
+	setNullableString(params, "logging_level", opts.LoggingLevel)
+	setBool(params, "enabled", opts.Enabled)
+	return params
+}
+
+func replicationLifetimesParams(lifetimes []ReplicationLifetime) []map[string]any {
+	out := make([]map[string]any, len(lifetimes))
+	for i, l := range lifetimes {
+		out[i] = map[string]any{
+			"schedule":       scheduleParams(l.Schedule),
+			"lifetime_value": l.LifetimeValue,
+			"lifetime_unit":  string(l.LifetimeUnit),
+		}
+	}
+	return out
+}
+
+// replicationTaskFromResponse converts a wire-format ReplicationTaskResponse
+// to a user-facing ReplicationTask.
+func replicationTaskFromResponse(resp ReplicationTaskResponse) ReplicationTask {
+	task := ReplicationTask{
+		ID:                              resp.ID,
+		Name:                            resp.Name,
+		Direction:                       ReplicationDirection(resp.Direction),
+		Transport:                       ReplicationTransport(resp.Transport),
+		NetcatActiveSide:                ReplicationNetcatSide(derefString(resp.NetcatActiveSide)),
+		NetcatActiveSideListenAddress:   derefString(resp.NetcatActiveSideListenAddress),
+		NetcatActiveSidePortMin:         derefInt64(resp.NetcatActiveSidePortMin),
+		NetcatActiveSidePortMax:         derefInt64(resp.NetcatActiveSidePortMax),
+		NetcatPassiveSideConnectAddress: derefString(resp.NetcatPassiveSideConnectAddress),
+		Sudo:                            resp.Sudo,
+		SourceDatasets:                  resp.SourceDatasets,
+		TargetDataset:                   resp.TargetDataset,
+		Recursive:                       resp.Recursive,
+		Exclude:                         resp.Exclude,
+		Properties:                      resp.Properties,
+		PropertiesExclude:               resp.PropertiesExclude,
+		PropertiesOverride:              resp.PropertiesOverride,
+		Replicate:                       resp.Replicate,
+		Encryption:                      resp.Encryption,
+		EncryptionInherit:               resp.EncryptionInherit != nil && *resp.EncryptionInherit,
+		EncryptionKeyFormat:             ReplicationKeyFormat(derefString(resp.EncryptionKeyFormat)),
+		EncryptionKeyLocation:           derefString(resp.EncryptionKeyLocation),
+		NamingSchema:                    resp.NamingSchema,
+		AlsoIncludeNamingSchema:         resp.AlsoIncludeNamingSchema,
+		NameRegex:                       derefString(resp.NameRegex),
+		Auto:                            resp.Auto,
+		Schedule:                        windowedScheduleFromResponse(resp.Schedule),
+		RestrictSchedule:                windowedScheduleFromResponse(resp.RestrictSchedule),
+		OnlyMatchingSchedule:            resp.OnlyMatchingSchedule,
+		AllowFromScratch:                resp.AllowFromScratch,
+		Readonly:                        ReplicationReadonly(resp.Readonly),
+		HoldPendingSnapshots:            resp.HoldPendingSnapshots,
+		RetentionPolicy:                 ReplicationRetentionPolicy(resp.RetentionPolicy),
+		LifetimeValue:                   derefInt64(resp.LifetimeValue),
+		LifetimeUnit:                    LifetimeUnit(derefString(resp.LifetimeUnit)),
+		Compression:                     ReplicationCompression(derefString(resp.Compression)),
+		SpeedLimit:                      derefInt64(resp.SpeedLimit),
+		LargeBlock:                      resp.LargeBlock,
+		Embed:                           resp.Embed,
+		Compressed:                      resp.Compressed,
+		Retries:                         resp.Retries,
+		LoggingLevel:                    derefString(resp.LoggingLevel),
+		Enabled:                         resp.Enabled,
+		State:                           taskStateFromResponse(resp.State),
+		HasEncryptedDatasetKeys:         resp.HasEncryptedDatasetKeys,
+	}
+	if resp.SSHCredentials != nil {
+		task.SSHCredentialsID = resp.SSHCredentials.ID
+	}
+	if len(resp.PeriodicSnapshotTasks) > 0 {
+		task.PeriodicSnapshotTaskIDs = make([]int64, len(resp.PeriodicSnapshotTasks))
+		for i, t := range resp.PeriodicSnapshotTasks {
+			task.PeriodicSnapshotTaskIDs[i] = t.ID
+		}
+	}
+	if len(resp.Lifetimes) > 0 {
+		task.Lifetimes = make([]ReplicationLifetime, len(resp.Lifetimes))
+		for i, l := range resp.Lifetimes {
+			task.Lifetimes[i] = ReplicationLifetime{
+				Schedule:      scheduleFromResponse(l.Schedule),
+				LifetimeValue: l.LifetimeValue,
+				LifetimeUnit:  LifetimeUnit(l.LifetimeUnit),
+			}
+		}
+	}
+	if resp.Job != nil {
+		task.JobID = resp.Job.ID
+	}
+	return task
+}
+
+// taskStateFromResponse converts a wire-format TaskStateResponse to a
+// TaskState.
+func taskStateFromResponse(resp TaskStateResponse) TaskState {
+	state := TaskState{
+		State:        resp.State,
+		LastSnapshot: derefString(resp.LastSnapshot),
+		Error:        derefString(resp.Error),
+		Warnings:     resp.Warnings,
+	}
+	if resp.Datetime != nil {
+		state.LastRun = time.UnixMilli(resp.Datetime.Date)
+	}
+	return state
+}
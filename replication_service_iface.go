@@ -0,0 +1,132 @@
+package truenas
+
+import "context"
+
+// ReplicationServiceAPI defines the interface for replication task operations.
+type ReplicationServiceAPI interface {
+	Create(ctx context.Context, opts CreateReplicationTaskOpts) (*ReplicationTask, error)
+	Get(ctx context.Context, id int64) (*ReplicationTask, error)
+	GetByName(ctx context.Context, name string) (*ReplicationTask, error)
+	List(ctx context.Context) ([]ReplicationTask, error)
+	Update(ctx context.Context, id int64, opts UpdateReplicationTaskOpts) (*ReplicationTask, error)
+	Delete(ctx context.Context, id int64) error
+	Run(ctx context.Context, id int64) (*Job, error)
+	ListDatasets(ctx context.Context, transport ReplicationTransport, sshCredentialsID int64) ([]string, error)
+	ListNamingSchemas(ctx context.Context) ([]string, error)
+	CountEligibleManualSnapshots(ctx context.Context, opts EligibleSnapshotsOpts) (*ReplicationSnapshotCount, error)
+	TargetUnmatchedSnapshots(ctx context.Context, opts UnmatchedSnapshotsOpts) (map[string][]string, error)
+	GetConfig(ctx context.Context) (*ReplicationConfig, error)
+	UpdateConfig(ctx context.Context, opts UpdateReplicationConfigOpts) (*ReplicationConfig, error)
+}
+
+// Compile-time checks.
+var _ ReplicationServiceAPI = (*ReplicationService)(nil)
+var _ ReplicationServiceAPI = (*MockReplicationService)(nil)
+
+// MockReplicationService is a test double for ReplicationServiceAPI.
+type MockReplicationService struct {
+	CreateFunc                       func(ctx context.Context, opts CreateReplicationTaskOpts) (*ReplicationTask, error)
+	GetFunc                          func(ctx context.Context, id int64) (*ReplicationTask, error)
+	GetByNameFunc                    func(ctx context.Context, name string) (*ReplicationTask, error)
+	ListFunc                         func(ctx context.Context) ([]ReplicationTask, error)
+	UpdateFunc                       func(ctx context.Context, id int64, opts UpdateReplicationTaskOpts) (*ReplicationTask, error)
+	DeleteFunc                       func(ctx context.Context, id int64) error
+	RunFunc                          func(ctx context.Context, id int64) (*Job, error)
+	ListDatasetsFunc                 func(ctx context.Context, transport ReplicationTransport, sshCredentialsID int64) ([]string, error)
+	ListNamingSchemasFunc            func(ctx context.Context) ([]string, error)
+	CountEligibleManualSnapshotsFunc func(ctx context.Context, opts EligibleSnapshotsOpts) (*ReplicationSnapshotCount, error)
+	TargetUnmatchedSnapshotsFunc     func(ctx context.Context, opts UnmatchedSnapshotsOpts) (map[string][]string, error)
+	GetConfigFunc                    func(ctx context.Context) (*ReplicationConfig, error)
+	UpdateConfigFunc                 func(ctx context.Context, opts UpdateReplicationConfigOpts) (*ReplicationConfig, error)
+}
+
+func (m *MockReplicationService) Create(ctx context.Context, opts CreateReplicationTaskOpts) (*ReplicationTask, error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, opts)
+	}
+	return nil, nil
+}
+
+func (m *MockReplicationService) Get(ctx context.Context, id int64) (*ReplicationTask, error) {
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockReplicationService) GetByName(ctx context.Context, name string) (*ReplicationTask, error) {
+	if m.GetByNameFunc != nil {
+		return m.GetByNameFunc(ctx, name)
+	}
+	return nil, nil
+}
+
+func (m *MockReplicationService) List(ctx context.Context) ([]ReplicationTask, error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockReplicationService) Update(ctx context.Context, id int64, opts UpdateReplicationTaskOpts) (*ReplicationTask, error) {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, id, opts)
+	}
+	return nil, nil
+}
+
+func (m *MockReplicationService) Delete(ctx context.Context, id int64) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockReplicationService) Run(ctx context.Context, id int64) (*Job, error) {
+	if m.RunFunc != nil {
+		return m.RunFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockReplicationService) ListDatasets(ctx context.Context, transport ReplicationTransport, sshCredentialsID int64) ([]string, error) {
+	if m.ListDatasetsFunc != nil {
+		return m.ListDatasetsFunc(ctx, transport, sshCredentialsID)
+	}
+	return nil, nil
+}
+
+func (m *MockReplicationService) ListNamingSchemas(ctx context.Context) ([]string, error) {
+	if m.ListNamingSchemasFunc != nil {
+		return m.ListNamingSchemasFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockReplicationService) CountEligibleManualSnapshots(ctx context.Context, opts EligibleSnapshotsOpts) (*ReplicationSnapshotCount, error) {
+	if m.CountEligibleManualSnapshotsFunc != nil {
+		return m.CountEligibleManualSnapshotsFunc(ctx, opts)
+	}
+	return nil, nil
+}
+
+func (m *MockReplicationService) TargetUnmatchedSnapshots(ctx context.Context, opts UnmatchedSnapshotsOpts) (map[string][]string, error) {
+	if m.TargetUnmatchedSnapshotsFunc != nil {
+		return m.TargetUnmatchedSnapshotsFunc(ctx, opts)
+	}
+	return nil, nil
+}
+
+func (m *MockReplicationService) GetConfig(ctx context.Context) (*ReplicationConfig, error) {
+	if m.GetConfigFunc != nil {
+		return m.GetConfigFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockReplicationService) UpdateConfig(ctx context.Context, opts UpdateReplicationConfigOpts) (*ReplicationConfig, error) {
+	if m.UpdateConfigFunc != nil {
+		return m.UpdateConfigFunc(ctx, opts)
+	}
+	return nil, nil
+}
@@ -0,0 +1,50 @@
+package truenas
+
+import (
+	"context"
+	"testing"
+)
+
+func TestMockReplicationService_ImplementsInterface(t *testing.T) {
+	var _ ReplicationServiceAPI = (*ReplicationService)(nil)
+	var _ ReplicationServiceAPI = (*MockReplicationService)(nil)
+}
+
+func TestMockReplicationService_DefaultsToNil(t *testing.T) {
+	mock := &MockReplicationService{}
+	ctx := context.Background()
+
+	task, err := mock.Get(ctx, 1)
+	if err != nil {
+		t.Fatalf("expected nil error, got: %v", err)
+	}
+	if task != nil {
+		t.Fatalf("expected nil result, got: %v", task)
+	}
+
+	job, err := mock.Run(ctx, 1)
+	if err != nil || job != nil {
+		t.Fatalf("expected nil, nil from Run, got: %v, %v", job, err)
+	}
+}
+
+func TestMockReplicationService_CallsFunc(t *testing.T) {
+	called := false
+	mock := &MockReplicationService{
+		RunFunc: func(ctx context.Context, id int64) (*Job, error) {
+			called = true
+			return &Job{ID: 100 + id}, nil
+		},
+	}
+
+	job, err := mock.Run(context.Background(), 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !called {
+		t.Fatal("expected RunFunc to be called")
+	}
+	if job.ID != 103 {
+		t.Fatalf("unexpected job: %+v", job)
+	}
+}
@@ -0,0 +1,539 @@
+package truenas
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// sampleReplicationTaskJSON returns a single JSON object response for
+// replication.get_instance.
+func sampleReplicationTaskJSON() json.RawMessage {
+	return json.RawMessage(`{
+		"id": 3,
+		"name": "tank/data -> dr",
+		"direction": "PUSH",
+		"transport": "SSH",
+		"ssh_credentials": {"id": 2, "name": "dr-host", "type": "SSH_CREDENTIALS", "attributes": {}},
+		"netcat_active_side": null,
+		"netcat_active_side_listen_address": null,
+		"netcat_active_side_port_min": null,
+		"netcat_active_side_port_max": null,
+		"netcat_passive_side_connect_address": null,
+		"sudo": false,
+		"source_datasets": ["tank/data"],
+		"target_dataset": "backup/data",
+		"recursive": true,
+		"exclude": ["tank/data/scratch"],
+		"properties": true,
+		"properties_exclude": [],
+		"properties_override": {},
+		"replicate": false,
+		"encryption": false,
+		"encryption_inherit": null,
+		"encryption_key": null,
+		"encryption_key_format": null,
+		"encryption_key_location": null,
+		"periodic_snapshot_tasks": [
+			{"id": 7, "dataset": "tank/data", "recursive": true, "naming_schema": "auto-%Y-%m-%d_%H-%M", "enabled": true}
+		],
+		"naming_schema": [],
+		"also_include_naming_schema": ["manual-%Y-%m-%d"],
+		"name_regex": null,
+		"auto": true,
+		"schedule": null,
+		"restrict_schedule": {"minute": "0", "hour": "*", "dom": "*", "month": "*", "dow": "*", "begin": "22:00", "end": "06:00"},
+		"only_matching_schedule": false,
+		"allow_from_scratch": false,
+		"readonly": "SET",
+		"hold_pending_snapshots": true,
+		"retention_policy": "CUSTOM",
+		"lifetime_value": 2,
+		"lifetime_unit": "WEEK",
+		"lifetimes": [
+			{"schedule": {"minute": "0", "hour": "0", "dom": "1", "month": "*", "dow": "*"}, "lifetime_value": 1, "lifetime_unit": "YEAR"}
+		],
+		"compression": "LZ4",
+		"speed_limit": null,
+		"large_block": true,
+		"embed": false,
+		"compressed": true,
+		"retries": 5,
+		"logging_level": null,
+		"enabled": true,
+		"state": {
+			"state": "FINISHED",
+			"datetime": {"$date": 1760000000000},
+			"last_snapshot": "tank/data@auto-2025-10-09_09-00",
+			"warnings": []
+		},
+		"job": {"id": 812, "state": "SUCCESS"},
+		"has_encrypted_dataset_keys": false
+	}`)
+}
+
+func TestReplicationService_Create(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				return sampleReplicationTaskJSON(), nil
+			},
+		},
+	}
+
+	svc := NewReplicationService(mock, Version{})
+	task, err := svc.Create(context.Background(), CreateReplicationTaskOpts{
+		Name:                    "tank/data -> dr",
+		Direction:               ReplicationPush,
+		Transport:               ReplicationTransportSSH,
+		SSHCredentialsID:        2,
+		SourceDatasets:          []string{"tank/data"},
+		TargetDataset:           "backup/data",
+		Recursive:               true,
+		Exclude:                 []string{"tank/data/scratch"},
+		PeriodicSnapshotTaskIDs: []int64{7},
+		AlsoIncludeNamingSchema: []string{"manual-%Y-%m-%d"},
+		Auto:                    true,
+		RestrictSchedule: &WindowedSchedule{
+			Schedule: Schedule{Minute: "0", Hour: "*", Dom: "*", Month: "*", Dow: "*"},
+			Begin:    "22:00",
+			End:      "06:00",
+		},
+		HoldPendingSnapshots: true,
+		RetentionPolicy:      ReplicationRetentionCustom,
+		LifetimeValue:        2,
+		LifetimeUnit:         LifetimeWeek,
+		Compression:          ReplicationCompressionLZ4,
+		Enabled:              BoolPtr(true),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if mock.calls[0].Method != "replication.create" {
+		t.Fatalf("expected method replication.create, got %s", mock.calls[0].Method)
+	}
+	want := map[string]any{
+		"name":                       "tank/data -> dr",
+		"direction":                  "PUSH",
+		"transport":                  "SSH",
+		"ssh_credentials":            int64(2),
+		"source_datasets":            []string{"tank/data"},
+		"target_dataset":             "backup/data",
+		"recursive":                  true,
+		"exclude":                    []string{"tank/data/scratch"},
+		"periodic_snapshot_tasks":    []int64{7},
+		"also_include_naming_schema": []string{"manual-%Y-%m-%d"},
+		"auto":                       true,
+		"restrict_schedule": map[string]any{
+			"minute": "0", "hour": "*", "dom": "*", "month": "*", "dow": "*",
+			"begin": "22:00", "end": "06:00",
+		},
+		"hold_pending_snapshots": true,
+		"retention_policy":       "CUSTOM",
+		"lifetime_value":         int64(2),
+		"lifetime_unit":          "WEEK",
+		"compression":            "LZ4",
+		"enabled":                true,
+	}
+	if !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("expected params %v, got %v", want, mock.calls[0].Params)
+	}
+	if mock.calls[1].Method != "replication.get_instance" || mock.calls[1].Params != int64(3) {
+		t.Errorf("expected re-read of task 3, got %s %v", mock.calls[1].Method, mock.calls[1].Params)
+	}
+
+	if task.ID != 3 || task.SSHCredentialsID != 2 || task.Schedule != nil || task.JobID != 812 {
+		t.Errorf("unexpected task: %+v", task)
+	}
+	if !reflect.DeepEqual(task.PeriodicSnapshotTaskIDs, []int64{7}) {
+		t.Errorf("unexpected periodic snapshot tasks: %v", task.PeriodicSnapshotTaskIDs)
+	}
+	if task.RestrictSchedule == nil || task.RestrictSchedule.Begin != "22:00" || task.RestrictSchedule.Hour != "*" {
+		t.Errorf("unexpected restrict schedule: %+v", task.RestrictSchedule)
+	}
+	if task.RetentionPolicy != ReplicationRetentionCustom || task.LifetimeUnit != LifetimeWeek || task.LifetimeValue != 2 {
+		t.Errorf("unexpected retention: %s %d %s", task.RetentionPolicy, task.LifetimeValue, task.LifetimeUnit)
+	}
+	wantLifetimes := []ReplicationLifetime{{
+		Schedule:      Schedule{Minute: "0", Hour: "0", Dom: "1", Month: "*", Dow: "*"},
+		LifetimeValue: 1,
+		LifetimeUnit:  LifetimeYear,
+	}}
+	if !reflect.DeepEqual(task.Lifetimes, wantLifetimes) {
+		t.Errorf("unexpected lifetimes: %+v", task.Lifetimes)
+	}
+	if task.State.State != "FINISHED" || !task.State.LastRun.Equal(time.UnixMilli(1760000000000)) ||
+		task.State.LastSnapshot != "tank/data@auto-2025-10-09_09-00" {
+		t.Errorf("unexpected state: %+v", task.State)
+	}
+}
+
+func TestReplicationService_Create_Error(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				return nil, errors.New("[EINVAL] replication_create.name: This name is already used")
+			},
+		},
+	}
+
+	svc := NewReplicationService(mock, Version{})
+	if _, err := svc.Create(context.Background(), CreateReplicationTaskOpts{Name: "dup"}); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestReplicationService_Get_NotFound(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				return nil, errors.New("[ENOENT] None: Replication task 9 does not exist")
+			},
+		},
+	}
+
+	svc := NewReplicationService(mock, Version{})
+	task, err := svc.Get(context.Background(), 9)
+	if err != nil || task != nil {
+		t.Errorf("expected nil, nil, got %+v, %v", task, err)
+	}
+}
+
+func TestReplicationService_List_And_GetByName(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				if method != "replication.query" {
+					t.Errorf("expected method replication.query, got %s", method)
+				}
+				return json.RawMessage(`[` + string(sampleReplicationTaskJSON()) + `]`), nil
+			},
+		},
+	}
+
+	svc := NewReplicationService(mock, Version{})
+	tasks, err := svc.List(context.Background())
+	if err != nil || len(tasks) != 1 || tasks[0].TargetDataset != "backup/data" {
+		t.Errorf("unexpected result: %+v, %v", tasks, err)
+	}
+
+	task, err := svc.GetByName(context.Background(), "tank/data -> dr")
+	if err != nil || task == nil || task.ID != 3 {
+		t.Errorf("unexpected result: %+v, %v", task, err)
+	}
+	if want := [][]any{{"name", "=", "tank/data -> dr"}}; !reflect.DeepEqual(mock.calls[1].Params, want) {
+		t.Errorf("expected filter %v, got %v", want, mock.calls[1].Params)
+	}
+}
+
+func TestReplicationService_GetByName_NotFound(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				return json.RawMessage(`[]`), nil
+			},
+		},
+	}
+
+	svc := NewReplicationService(mock, Version{})
+	task, err := svc.GetByName(context.Background(), "missing")
+	if err != nil || task != nil {
+		t.Errorf("expected nil, nil, got %+v, %v", task, err)
+	}
+}
+
+func TestReplicationService_Update(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				return sampleReplicationTaskJSON(), nil
+			},
+		},
+	}
+
+	svc := NewReplicationService(mock, Version{})
+	_, err := svc.Update(context.Background(), 3, UpdateReplicationTaskOpts{
+		Transport:        ReplicationTransportLocal,
+		SSHCredentialsID: Int64Ptr(0),
+		Compression:      new(ReplicationCompression),
+		Schedule:         &WindowedSchedule{},
+		SourceDatasets:   []string{"tank/data", "tank/home"},
+		Exclude:          []string{},
+		Enabled:          BoolPtr(false),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if mock.calls[0].Method != "replication.update" {
+		t.Fatalf("expected method replication.update, got %s", mock.calls[0].Method)
+	}
+	want := []any{int64(3), map[string]any{
+		"transport":       "LOCAL",
+		"ssh_credentials": nil,
+		"compression":     nil,
+		"schedule":        nil,
+		"source_datasets": []string{"tank/data", "tank/home"},
+		"exclude":         []string{},
+		"enabled":         false,
+	}}
+	if !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("expected params %v, got %v", want, mock.calls[0].Params)
+	}
+	if mock.calls[1].Method != "replication.get_instance" {
+		t.Errorf("expected re-read, got %s", mock.calls[1].Method)
+	}
+}
+
+func TestReplicationService_Delete(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				return json.RawMessage(`true`), nil
+			},
+		},
+	}
+
+	svc := NewReplicationService(mock, Version{})
+	if err := svc.Delete(context.Background(), 3); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if mock.calls[0].Method != "replication.delete" || mock.calls[0].Params != int64(3) {
+		t.Errorf("unexpected call: %+v", mock.calls[0])
+	}
+}
+
+func TestReplicationService_Run(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				return json.RawMessage(`815`), nil
+			},
+		},
+		callAndWaitFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return json.RawMessage(`null`), nil
+		},
+	}
+
+	svc := NewReplicationService(mock, Version{})
+	job, err := svc.Run(context.Background(), 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if mock.calls[0].Method != "replication.run" || mock.calls[0].Params != int64(3) {
+		t.Errorf("unexpected call: %+v", mock.calls[0])
+	}
+	if job.ID != 815 {
+		t.Fatalf("expected job 815, got %d", job.ID)
+	}
+
+	if _, err := job.Wait(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if mock.calls[1].Method != "core.job_wait" || mock.calls[1].Params != int64(815) {
+		t.Errorf("expected core.job_wait for job 815, got %+v", mock.calls[1])
+	}
+}
+
+func TestReplicationService_Run_Error(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				return nil, errors.New("[EINVAL] Task is already running")
+			},
+		},
+	}
+
+	svc := NewReplicationService(mock, Version{})
+	job, err := svc.Run(context.Background(), 3)
+	if err == nil || job != nil {
+		t.Fatalf("expected error, got %+v, %v", job, err)
+	}
+}
+
+func TestReplicationService_ListDatasets(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				return json.RawMessage(`["backup", "backup/data"]`), nil
+			},
+		},
+	}
+
+	svc := NewReplicationService(mock, Version{})
+	datasets, err := svc.ListDatasets(context.Background(), ReplicationTransportSSH, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if mock.calls[0].Method != "replication.list_datasets" {
+		t.Fatalf("expected method replication.list_datasets, got %s", mock.calls[0].Method)
+	}
+	if want := []any{"SSH", int64(2)}; !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("expected params %v, got %v", want, mock.calls[0].Params)
+	}
+	if !reflect.DeepEqual(datasets, []string{"backup", "backup/data"}) {
+		t.Errorf("unexpected datasets: %v", datasets)
+	}
+
+	if _, err := svc.ListDatasets(context.Background(), ReplicationTransportLocal, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []any{"LOCAL", nil}; !reflect.DeepEqual(mock.calls[1].Params, want) {
+		t.Errorf("expected params %v, got %v", want, mock.calls[1].Params)
+	}
+}
+
+func TestReplicationService_ListNamingSchemas(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				return json.RawMessage(`["auto-%Y-%m-%d_%H-%M"]`), nil
+			},
+		},
+	}
+
+	svc := NewReplicationService(mock, Version{})
+	schemas, err := svc.ListNamingSchemas(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if mock.calls[0].Method != "replication.list_naming_schemas" {
+		t.Errorf("expected method replication.list_naming_schemas, got %s", mock.calls[0].Method)
+	}
+	if !reflect.DeepEqual(schemas, []string{"auto-%Y-%m-%d_%H-%M"}) {
+		t.Errorf("unexpected schemas: %v", schemas)
+	}
+}
+
+func TestReplicationService_CountEligibleManualSnapshots(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				return json.RawMessage(`{"total": 12, "eligible": 9}`), nil
+			},
+		},
+	}
+
+	svc := NewReplicationService(mock, Version{})
+	count, err := svc.CountEligibleManualSnapshots(context.Background(), EligibleSnapshotsOpts{
+		Datasets:     []string{"tank/data"},
+		NamingSchema: []string{"manual-%Y-%m-%d"},
+		Transport:    ReplicationTransportLocal,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if mock.calls[0].Method != "replication.count_eligible_manual_snapshots" {
+		t.Fatalf("expected method replication.count_eligible_manual_snapshots, got %s", mock.calls[0].Method)
+	}
+	want := map[string]any{
+		"datasets":        []string{"tank/data"},
+		"naming_schema":   []string{"manual-%Y-%m-%d"},
+		"transport":       "LOCAL",
+		"ssh_credentials": nil,
+	}
+	if !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("expected params %v, got %v", want, mock.calls[0].Params)
+	}
+	if count.Total != 12 || count.Eligible != 9 {
+		t.Errorf("unexpected count: %+v", count)
+	}
+}
+
+func TestReplicationService_TargetUnmatchedSnapshots(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				return json.RawMessage(`{"backup/data": ["auto-2025-10-01_00-00"]}`), nil
+			},
+		},
+	}
+
+	svc := NewReplicationService(mock, Version{})
+	unmatched, err := svc.TargetUnmatchedSnapshots(context.Background(), UnmatchedSnapshotsOpts{
+		Direction:        ReplicationPush,
+		SourceDatasets:   []string{"tank/data"},
+		TargetDataset:    "backup/data",
+		Transport:        ReplicationTransportSSH,
+		SSHCredentialsID: 2,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if mock.calls[0].Method != "replication.target_unmatched_snapshots" {
+		t.Fatalf("expected method replication.target_unmatched_snapshots, got %s", mock.calls[0].Method)
+	}
+	want := []any{"PUSH", []string{"tank/data"}, "backup/data", "SSH", int64(2)}
+	if !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("expected params %v, got %v", want, mock.calls[0].Params)
+	}
+	if !reflect.DeepEqual(unmatched, map[string][]string{"backup/data": {"auto-2025-10-01_00-00"}}) {
+		t.Errorf("unexpected result: %v", unmatched)
+	}
+}
+
+func TestReplicationService_GetConfig(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				return json.RawMessage(`{"id": 1, "max_parallel_replication_tasks": 5}`), nil
+			},
+		},
+	}
+
+	svc := NewReplicationService(mock, Version{})
+	config, err := svc.GetConfig(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if mock.calls[0].Method != "replication.config.config" {
+		t.Errorf("expected method replication.config.config, got %s", mock.calls[0].Method)
+	}
+	if config.MaxParallelTasks != 5 {
+		t.Errorf("unexpected config: %+v", config)
+	}
+}
+
+func TestReplicationService_UpdateConfig(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				return json.RawMessage(`{"id": 1, "max_parallel_replication_tasks": null}`), nil
+			},
+		},
+	}
+
+	svc := NewReplicationService(mock, Version{})
+	config, err := svc.UpdateConfig(context.Background(), UpdateReplicationConfigOpts{MaxParallelTasks: Int64Ptr(0)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if mock.calls[0].Method != "replication.config.update" {
+		t.Fatalf("expected method replication.config.update, got %s", mock.calls[0].Method)
+	}
+	if want := map[string]any{"max_parallel_replication_tasks": nil}; !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("expected params %v, got %v", want, mock.calls[0].Params)
+	}
+	if config.MaxParallelTasks != 0 {
+		t.Errorf("unexpected config: %+v", config)
+	}
+}
+
+func TestReplicationService_UpdateConfig_ParseError(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				return json.RawMessage(`not json`), nil
+			},
+		},
+	}
+
+	svc := NewReplicationService(mock, Version{})
+	if _, err := svc.UpdateConfig(context.Background(), UpdateReplicationConfigOpts{}); err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -85,7 +85,7 @@ func NewReportingService(c SubscribeCaller, v Version) *ReportingService {
 
 // ListGraphs returns all available reporting graph definitions.
 func (s *ReportingService) ListGraphs(ctx context.Context) ([]ReportingGraph, error) {
-	result, err := s.client.Call(ctx, "reporting.netdata_graphs", nil)
+	result, err := callMethod(ctx, s.client, s.version, "reporting.netdata_graphs", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -118,7 +118,7 @@ func (s *ReportingService) GetData(ctx context.Context, params ReportingGetDataP
 		"page": params.Page,
 	}}
 
-	result, err := s.client.Call(ctx, "reporting.netdata_get_data", callParams)
+	result, err := callMethod(ctx, s.client, s.version, "reporting.netdata_get_data", callParams)
 	if err != nil {
 		return nil, err
 	}
@@ -164,7 +164,7 @@ func reportingDataFromResponse(resp ReportingDataResponse) ReportingData {
 
 // SubscribeRealtime subscribes to real-time system metrics (CPU, memory, disk, network).
 func (s *ReportingService) SubscribeRealtime(ctx context.Context) (*Subscription[RealtimeUpdate], error) {
-	rawSub, err := s.client.Subscribe(ctx, "reporting.realtime", nil)
+	rawSub, err := subscribeMethod(ctx, s.client, s.version, "reporting.realtime", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,47 @@
+package truenas
+
+// RsyncMode is how an rsync task reaches the remote host.
+type RsyncMode string
+
+const (
+	RsyncModeModule RsyncMode = "MODULE" // An rsync daemon module
+	RsyncModeSSH    RsyncMode = "SSH"
+)
+
+// RsyncDirection is whether an rsync task copies to, or from, the remote
+// host.
+type RsyncDirection string
+
+const (
+	RsyncPush RsyncDirection = "PUSH"
+	RsyncPull RsyncDirection = "PULL"
+)
+
+// RsyncTaskResponse represents an rsync task from the rsynctask query API.
+type RsyncTaskResponse struct {
+	ID             int64                          `json:"id"`
+	Path           string                         `json:"path"`
+	User           string                         `json:"user"`
+	Mode           string                         `json:"mode"`
+	RemoteHost     *string                        `json:"remotehost"`
+	RemotePort     *int64                         `json:"remoteport"`
+	RemoteModule   *string                        `json:"remotemodule"`
+	RemotePath     string                         `json:"remotepath"`
+	SSHCredentials *KeychainCredentialRefResponse `json:"ssh_credentials"`
+	Direction      string                         `json:"direction"`
+	Desc           string                         `json:"desc"`
+	Schedule       ScheduleResponse               `json:"schedule"`
+	Recursive      bool                           `json:"recursive"`
+	Times          bool                           `json:"times"`
+	Compress       bool                           `json:"compress"`
+	Archive        bool                           `json:"archive"`
+	Delete         bool                           `json:"delete"`
+	Quiet          bool                           `json:"quiet"`
+	PreservePerm   bool                           `json:"preserveperm"`
+	PreserveAttr   bool                           `json:"preserveattr"`
+	DelayUpdates   bool                           `json:"delayupdates"`
+	Extra          []string                       `json:"extra"`
+	Enabled        bool                           `json:"enabled"`
+	Locked         bool                           `json:"locked"`
+	Job            *JobResponse                   `json:"job"` // Last run, null if never run
+}
@@ -0,0 +1,343 @@
+package truenas
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// RsyncTask is the user-facing representation of an rsync task.
+type RsyncTask struct {
+	ID           int64
+	Path         string
+	User         string
+	Mode         RsyncMode
+	RemoteHost   string
+	RemotePort   int64  // 0 when the default port is used
+	RemoteModule string // MODULE mode only
+	RemotePath   string // SSH mode only
+	// SSHCredentialsID is the keychain SSH connection used in SSH mode; 0
+	// when the remote host is reached with User's own SSH key.
+	SSHCredentialsID int64
+	Direction        RsyncDirection
+	Description      string
+	Schedule         Schedule
+	Recursive        bool
+	Times            bool
+	Compress         bool
+	Archive          bool
+	Delete           bool
+	Quiet            bool
+	PreservePerm     bool
+	PreserveAttr     bool
+	DelayUpdates     bool
+	Extra            []string
+	Enabled          bool
+	Locked           bool
+	// LastRun is the task's most recent job, or nil if it has not run.
+	LastRun *JobInfo
+}
+
+// CreateRsyncTaskOpts contains options for creating an rsync task. Path and
+// User are required, plus RemoteHost and either RemoteModule (MODULE mode)
+// or RemotePath (SSH mode) unless SSHCredentialsID supplies the host.
+type CreateRsyncTaskOpts struct {
+	Path             string
+	User             string
+	Mode             RsyncMode // Default: MODULE
+	RemoteHost       string
+	RemotePort       int64
+	RemoteModule     string
+	RemotePath       string
+	SSHCredentialsID int64
+	// ValidateRemotePath checks that RemotePath exists before saving.
+	ValidateRemotePath *bool // Default: true
+	// SSHKeyscan adds the remote host key to User's known_hosts.
+	SSHKeyscan   bool
+	Direction    RsyncDirection // Default: PUSH
+	Description  string
+	Schedule     Schedule // Zero = server default (hourly)
+	Recursive    *bool    // Default: true
+	Times        *bool    // Default: true
+	Compress     *bool    // Default: true
+	Archive      bool
+	Delete       bool
+	Quiet        bool
+	PreservePerm bool
+	PreserveAttr bool
+	DelayUpdates *bool // Default: true
+	Extra        []string
+	Enabled      *bool // Default: true
+}
+
+// UpdateRsyncTaskOpts contains options for updating an rsync task. Nil
+// fields are left unchanged. Empty strings and zero integers in nullable
+// fields are sent as null.
+type UpdateRsyncTaskOpts struct {
+	Path               string // Empty = don't change
+	User               string // Empty = don't change
+	Mode               RsyncMode
+	RemoteHost         *string
+	RemotePort         *int64
+	RemoteModule       *string
+	RemotePath         *string
+	SSHCredentialsID   *int64
+	ValidateRemotePath *bool
+	SSHKeyscan         *bool
+	Direction          RsyncDirection
+	Description        *string
+	Schedule           *Schedule
+	Recursive          *bool
+	Times              *bool
+	Compress           *bool
+	Archive            *bool
+	Delete             *bool
+	Quiet              *bool
+	PreservePerm       *bool
+	PreserveAttr       *bool
+	DelayUpdates       *bool
+	Extra              []string
+	Enabled            *bool
+}
+
+// RsyncTaskService provides typed methods for the rsynctask.* API namespace.
+type RsyncTaskService struct {
+	client  AsyncCaller
+	version Version
+}
+
+// NewRsyncTaskService creates a new RsyncTaskService.
+func NewRsyncTaskService(c AsyncCaller, v Version) *RsyncTaskService {
+	return &RsyncTaskService{client: c, version: v}
+}
+
+// Create creates an rsync task and returns the full object.
+func (s *RsyncTaskService) Create(ctx context.Context, opts CreateRsyncTaskOpts) (*RsyncTask, error) {
+	params := rsyncTaskCreateParams(opts)
+	result, err := callMethod(ctx, s.client, s.version, "rsynctask.create", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var createResp struct {
+		ID int64 `json:"id"`
+	}
+	if err := json.Unmarshal(result, &createResp); err != nil {
+		return nil, fmt.Errorf("parse create response: %w", err)
+	}
+
+	return s.Get(ctx, createResp.ID)
+}
+
+// Get returns an rsync task by ID, or nil if not found.
+func (s *RsyncTaskService) Get(ctx context.Context, id int64) (*RsyncTask, error) {
+	result, err := callMethod(ctx, s.client, s.version, "rsynctask.get_instance", id)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var resp RsyncTaskResponse
+	if err := json.Unmarshal(result, &resp); err != nil {
+		return nil, fmt.Errorf("parse get_instance response: %w", err)
+	}
+
+	task := rsyncTaskFromResponse(resp)
+	return &task, nil
+}
+
+// List returns all rsync tasks.
+func (s *RsyncTaskService) List(ctx context.Context) ([]RsyncTask, error) {
+	result, err := callMethod(ctx, s.client, s.version, "rsynctask.query", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responses []RsyncTaskResponse
+	if err := json.Unmarshal(result, &responses); err != nil {
+		return nil, fmt.Errorf("parse query response: %w", err)
+	}
+
+	tasks := make([]RsyncTask, len(responses))
+	for i, resp := range responses {
+		tasks[i] = rsyncTaskFromResponse(resp)
+	}
+	return tasks, nil
+}
+
+// Update updates an rsync task and returns the full object.
+func (s *RsyncTaskService) Update(ctx context.Context, id int64, opts UpdateRsyncTaskOpts) (*RsyncTask, error) {
+	params := rsyncTaskUpdateParams(opts)
+	_, err := callMethod(ctx, s.client, s.version, "rsynctask.update", []any{id, params})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Get(ctx, id)
+}
+
+// Delete deletes an rsync task by ID.
+func (s *RsyncTaskService) Delete(ctx context.Context, id int64) error {
+	_, err := callMethod(ctx, s.client, s.version, "rsynctask.delete", id)
+	return err
+}
+
+// Run runs an rsync task and waits for it to complete. Progress is reported
+// to the callback attached to ctx with client.WithJobProgress; the outcome
+// is also recorded in the task's LastRun.
+func (s *RsyncTaskService) Run(ctx context.Context, id int64) error {
+	_, err := callMethodAndWait(ctx, s.client, s.version, "rsynctask.run", id)
+	return err
+}
+
+// rsyncTaskCreateParams converts CreateRsyncTaskOpts to API parameters.
+func rsyncTaskCreateParams(opts CreateRsyncTaskOpts) map[string]any {
+	params := map[string]any{
+		"path": opts.Path,
+		"user": opts.User,
+	}
+	if opts.Mode != "" {
+		params["mode"] = string(opts.Mode)
+	}
+	if opts.RemoteHost != "" {
+		params["remotehost"] = opts.RemoteHost
+	}
+	if opts.RemotePort != 0 {
+		params["remoteport"] = opts.RemotePort
+	}
+	if opts.RemoteModule != "" {
+		params["remotemodule"] = opts.RemoteModule
+	}
+	if opts.RemotePath != "" {
+		params["remotepath"] = opts.RemotePath
+	}
+	if opts.SSHCredentialsID != 0 {
+		params["ssh_credentials"] = opts.SSHCredentialsID
+	}
+	if opts.ValidateRemotePath != nil {
+		params["validate_rpath"] = *opts.ValidateRemotePath
+	}
+	if opts.SSHKeyscan {
+		params["ssh_keyscan"] = true
+	}
+	if opts.Direction != "" {
+		params["direction"] = string(opts.Direction)
+	}
+	if opts.Description != "" {
+		params["desc"] = opts.Description
+	}
+	if opts.Schedule != (Schedule{}) {
+		params["schedule"] = scheduleParams(opts.Schedule)
+	}
+	setBool(params, "recursive", opts.Recursive)
+	setBool(params, "times", opts.Times)
+	setBool(params, "compress", opts.Compress)
+	if opts.Archive {
+		params["archive"] = true
+	}
+	if opts.Delete {
+		params["delete"] = true
+	}
+	if opts.Quiet {
+		params["quiet"] = true
+	}
+	if opts.PreservePerm {
+		params["preserveperm"] = true
+	}
+	if opts.PreserveAttr {
+		params["preserveattr"] = true
+	}
+	setBool(params, "delayupdates", opts.DelayUpdates)
+	if len(opts.Extra) > 0 {
+		params["extra"] = opts.Extra
+	}
+	setBool(params, "enabled", opts.Enabled)
+	return params
+}
+
+// rsyncTaskUpdateParams converts UpdateRsyncTaskOpts to API parameters,
+// including only the fields that are set.
+func rsyncTaskUpdateParams(opts UpdateRsyncTaskOpts) map[string]any {
+	params := map[string]any{}
+	if opts.Path != "" {
+		params["path"] = opts.Path
+	}
+	if opts.User != "" {
+		params["user"] = opts.User
+	}
+	if opts.Mode != "" {
+		params["mode"] = string(opts.Mode)
+	}
+	setNullableString(params, "remotehost", opts.RemoteHost)
+	setNullableInt64(params, "remoteport", opts.RemotePort)
+	setNullableString(params, "remotemodule", opts.RemoteModule)
+	if opts.RemotePath != nil {
+		params["remotepath"] = *opts.RemotePath
+	}
+	setNullableInt64(params, "ssh_credentials", opts.SSHCredentialsID)
+	setBool(params, "validate_rpath", opts.ValidateRemotePath)
+	setBool(params, "ssh_keyscan", opts.SSHKeyscan)
+	if opts.Direction != "" {
+		params["direction"] = string(opts.Direction)
+	}
+	if opts.Description != nil {
+		params["desc"] = *opts.Description
+	}
+	if opts.Schedule != nil {
+		params["schedule"] = scheduleParams(*opts.Schedule)
+	}
+	setBool(params, "recursive", opts.Recursive)
+	setBool(params, "times", opts.Times)
+	setBool(params, "compress", opts.Compress)
+	setBool(params, "archive", opts.Archive)
+	setBool(params, "delete", opts.Delete)
+	setBool(params, "quiet", opts.Quiet)
+	setBool(params, "preserveperm", opts.PreservePerm)
+	setBool(params, "preserveattr", opts.PreserveAttr)
+	setBool(params, "delayupdates", opts.DelayUpdates)
+	if opts.Extra != nil {
+		params["extra"] = opts.Extra
+	}
+	setBool(params, "enabled", opts.Enabled)
+	return params
+}
+
+// rsyncTaskFromResponse converts a wire-format RsyncTaskResponse to a
+// user-facing RsyncTask.
+func rsyncTaskFromResponse(resp RsyncTaskResponse) RsyncTask {
+	task := RsyncTask{
+		ID:           resp.ID,
+		Path:         resp.Path,
+		User:         resp.User,
+		Mode:         RsyncMode(resp.Mode),
+		RemoteHost:   derefString(resp.RemoteHost),
+		RemotePort:   derefInt64(resp.RemotePort),
+		RemoteModule: derefString(resp.RemoteModule),
+		RemotePath:   resp.RemotePath,
+		Direction:    RsyncDirection(resp.Direction),
+		Description:  resp.Desc,
+		Schedule:     scheduleFromResponse(resp.Schedule),
+		Recursive:    resp.Recursive,
+		Times:        resp.Times,
+		Compress:     resp.Compress,
+		Archive:      resp.Archive,
+		Delete:       resp.Delete,
+		Quiet:        resp.Quiet,
+		PreservePerm: resp.PreservePerm,
+		PreserveAttr: resp.PreserveAttr,
+		DelayUpdates: resp.DelayUpdates,
+		Extra:        resp.Extra,
+		Enabled:      resp.Enabled,
+		Locked:       resp.Locked,
+	}
+	if resp.SSHCredentials != nil {
+		task.SSHCredentialsID = resp.SSHCredentials.ID
+	}
+	if resp.Job != nil {
+		lastRun := jobInfoFromResponse(*resp.Job)
+		task.LastRun = &lastRun
+	}
+	return task
+}
@@ -0,0 +1,69 @@
+package truenas
+
+import "context"
+
+// RsyncTaskServiceAPI defines the interface for rsync task operations.
+type RsyncTaskServiceAPI interface {
+	Create(ctx context.Context, opts CreateRsyncTaskOpts) (*RsyncTask, error)
+	Get(ctx context.Context, id int64) (*RsyncTask, error)
+	List(ctx context.Context) ([]RsyncTask, error)
+	Update(ctx context.Context, id int64, opts UpdateRsyncTaskOpts) (*RsyncTask, error)
+	Delete(ctx context.Context, id int64) error
+	Run(ctx context.Context, id int64) error
+}
+
+// Compile-time checks.
+var _ RsyncTaskServiceAPI = (*RsyncTaskService)(nil)
+var _ RsyncTaskServiceAPI = (*MockRsyncTaskService)(nil)
+
+// MockRsyncTaskService is a test double for RsyncTaskServiceAPI.
+type MockRsyncTaskService struct {
+	CreateFunc func(ctx context.Context, opts CreateRsyncTaskOpts) (*RsyncTask, error)
+	GetFunc    func(ctx context.Context, id int64) (*RsyncTask, error)
+	ListFunc   func(ctx context.Context) ([]RsyncTask, error)
+	UpdateFunc func(ctx context.Context, id int64, opts UpdateRsyncTaskOpts) (*RsyncTask, error)
+	DeleteFunc func(ctx context.Context, id int64) error
+	RunFunc    func(ctx context.Context, id int64) error
+}
+
+func (m *MockRsyncTaskService) Create(ctx context.Context, opts CreateRsyncTaskOpts) (*RsyncTask, error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, opts)
+	}
+	return nil, nil
+}
+
+func (m *MockRsyncTaskService) Get(ctx context.Context, id int64) (*RsyncTask, error) {
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockRsyncTaskService) List(ctx context.Context) ([]RsyncTask, error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockRsyncTaskService) Update(ctx context.Context, id int64, opts UpdateRsyncTaskOpts) (*RsyncTask, error) {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, id, opts)
+	}
+	return nil, nil
+}
+
+func (m *MockRsyncTaskService) Delete(ctx context.Context, id int64) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockRsyncTaskService) Run(ctx context.Context, id int64) error {
+	if m.RunFunc != nil {
+		return m.RunFunc(ctx, id)
+	}
+	return nil
+}
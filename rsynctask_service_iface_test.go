@@ -0,0 +1,41 @@
+package truenas
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestMockRsyncTaskService_ImplementsInterface(t *testing.T) {
+	var _ RsyncTaskServiceAPI = (*RsyncTaskService)(nil)
+	var _ RsyncTaskServiceAPI = (*MockRsyncTaskService)(nil)
+}
+
+func TestMockRsyncTaskService_DefaultsToNil(t *testing.T) {
+	mock := &MockRsyncTaskService{}
+	ctx := context.Background()
+
+	task, err := mock.Get(ctx, 1)
+	if err != nil {
+		t.Fatalf("expected nil error, got: %v", err)
+	}
+	if task != nil {
+		t.Fatalf("expected nil result, got: %v", task)
+	}
+
+	if err := mock.Run(ctx, 1); err != nil {
+		t.Fatalf("expected nil error from Run, got: %v", err)
+	}
+}
+
+func TestMockRsyncTaskService_CallsFunc(t *testing.T) {
+	mock := &MockRsyncTaskService{
+		RunFunc: func(ctx context.Context, id int64) error {
+			return errors.New("rsync command returned 23")
+		},
+	}
+
+	if err := mock.Run(context.Background(), 5); err == nil {
+		t.Fatal("expected RunFunc's error")
+	}
+}
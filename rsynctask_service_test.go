@@ -0,0 +1,282 @@
+package truenas
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// sampleRsyncTaskJSON returns a single JSON object response for
+// rsynctask.get_instance.
+func sampleRsyncTaskJSON() json.RawMessage {
+	return json.RawMessage(`{
+		"id": 5,
+		"path": "/mnt/tank/media",
+		"user": "root",
+		"mode": "SSH",
+		"remotehost": null,
+		"remoteport": null,
+		"remotemodule": null,
+		"remotepath": "/srv/backup/media",
+		"ssh_credentials": {"id": 2, "name": "nas2", "type": "SSH_CREDENTIALS", "attributes": {}},
+		"direction": "PUSH",
+		"desc": "Media to nas2",
+		"schedule": {"minute": "30", "hour": "2", "dom": "*", "month": "*", "dow": "*"},
+		"recursive": true,
+		"times": true,
+		"compress": true,
+		"archive": true,
+		"delete": false,
+		"quiet": false,
+		"preserveperm": false,
+		"preserveattr": false,
+		"delayupdates": true,
+		"extra": ["--bwlimit=50M"],
+		"enabled": true,
+		"locked": false,
+		"job": {
+			"id": 901,
+			"method": "rsynctask.run",
+			"description": null,
+			"abortable": true,
+			"progress": {"percent": 100, "description": "Finished", "extra": null},
+			"state": "FAILED",
+			"error": "rsync command returned 23",
+			"result": null,
+			"logs_excerpt": "rsync: [sender] link_stat failed"
+		}
+	}`)
+}
+
+func TestRsyncTaskService_Create(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				return sampleRsyncTaskJSON(), nil
+			},
+		},
+	}
+
+	svc := NewRsyncTaskService(mock, Version{})
+	task, err := svc.Create(context.Background(), CreateRsyncTaskOpts{
+		Path:             "/mnt/tank/media",
+		User:             "root",
+		Mode:             RsyncModeSSH,
+		RemotePath:       "/srv/backup/media",
+		SSHCredentialsID: 2,
+		Description:      "Media to nas2",
+		Schedule:         Schedule{Minute: "30", Hour: "2", Dom: "*", Month: "*", Dow: "*"},
+		Archive:          true,
+		Extra:            []string{"--bwlimit=50M"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if mock.calls[0].Method != "rsynctask.create" {
+		t.Fatalf("expected method rsynctask.create, got %s", mock.calls[0].Method)
+	}
+	want := map[string]any{
+		"path":            "/mnt/tank/media",
+		"user":            "root",
+		"mode":            "SSH",
+		"remotepath":      "/srv/backup/media",
+		"ssh_credentials": int64(2),
+		"desc":            "Media to nas2",
+		"schedule":        map[string]any{"minute": "30", "hour": "2", "dom": "*", "month": "*", "dow": "*"},
+		"archive":         true,
+		"extra":           []string{"--bwlimit=50M"},
+	}
+	if !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("expected params %v, got %v", want, mock.calls[0].Params)
+	}
+	if mock.calls[1].Method != "rsynctask.get_instance" || mock.calls[1].Params != int64(5) {
+		t.Errorf("expected re-read of task 5, got %s %v", mock.calls[1].Method, mock.calls[1].Params)
+	}
+
+	if task.ID != 5 || task.Mode != RsyncModeSSH || task.SSHCredentialsID != 2 || task.RemoteHost != "" {
+		t.Errorf("unexpected task: %+v", task)
+	}
+	if task.Schedule.Minute != "30" || task.Schedule.Hour != "2" {
+		t.Errorf("unexpected schedule: %+v", task.Schedule)
+	}
+	if task.LastRun == nil || task.LastRun.ID != 901 || task.LastRun.State != JobStateFailed ||
+		task.LastRun.Error != "rsync command returned 23" || task.LastRun.Percent != 100 {
+		t.Errorf("unexpected last run: %+v", task.LastRun)
+	}
+}
+
+func TestRsyncTaskService_Create_ModuleDefaults(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				return json.RawMessage(`{"id": 6}`), nil
+			},
+		},
+	}
+
+	svc := NewRsyncTaskService(mock, Version{})
+	_, _ = svc.Create(context.Background(), CreateRsyncTaskOpts{
+		Path:         "/mnt/tank/media",
+		User:         "root",
+		RemoteHost:   "backup.example.com",
+		RemoteModule: "media",
+		Compress:     BoolPtr(false),
+	})
+
+	want := map[string]any{
+		"path":         "/mnt/tank/media",
+		"user":         "root",
+		"remotehost":   "backup.example.com",
+		"remotemodule": "media",
+		"compress":     false,
+	}
+	if !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("expected params %v, got %v", want, mock.calls[0].Params)
+	}
+}
+
+func TestRsyncTaskService_Get_NotFound(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				return nil, errors.New("[ENOENT] None: Rsync task 9 does not exist")
+			},
+		},
+	}
+
+	svc := NewRsyncTaskService(mock, Version{})
+	task, err := svc.Get(context.Background(), 9)
+	if err != nil || task != nil {
+		t.Errorf("expected nil, nil, got %+v, %v", task, err)
+	}
+}
+
+func TestRsyncTaskService_List(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				if method != "rsynctask.query" {
+					t.Errorf("expected method rsynctask.query, got %s", method)
+				}
+				never := `{"id": 6, "path": "/mnt/tank/docs", "user": "root", "mode": "MODULE",
+					"remotehost": "backup.example.com", "remoteport": 873, "remotemodule": "docs",
+					"remotepath": "", "ssh_credentials": null, "direction": "PULL", "desc": "",
+					"schedule": {"minute": "0", "hour": "*", "dom": "*", "month": "*", "dow": "*"},
+					"extra": [], "enabled": false, "locked": false, "job": null}`
+				return json.RawMessage(`[` + string(sampleRsyncTaskJSON()) + `,` + never + `]`), nil
+			},
+		},
+	}
+
+	svc := NewRsyncTaskService(mock, Version{})
+	tasks, err := svc.List(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(tasks) != 2 {
+		t.Fatalf("expected 2 tasks, got %d", len(tasks))
+	}
+	module := tasks[1]
+	if module.Mode != RsyncModeModule || module.RemoteHost != "backup.example.com" || module.RemotePort != 873 ||
+		module.Direction != RsyncPull || module.SSHCredentialsID != 0 || module.LastRun != nil {
+		t.Errorf("unexpected task: %+v", module)
+	}
+}
+
+func TestRsyncTaskService_Update(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				return sampleRsyncTaskJSON(), nil
+			},
+		},
+	}
+
+	svc := NewRsyncTaskService(mock, Version{})
+	_, err := svc.Update(context.Background(), 5, UpdateRsyncTaskOpts{
+		Mode:             RsyncModeModule,
+		RemoteHost:       StringPtr("backup.example.com"),
+		RemoteModule:     StringPtr("media"),
+		SSHCredentialsID: Int64Ptr(0),
+		Schedule:         &Schedule{Minute: "0", Hour: "3", Dom: "*", Month: "*", Dow: "*"},
+		Delete:           BoolPtr(true),
+		Extra:            []string{},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if mock.calls[0].Method != "rsynctask.update" {
+		t.Fatalf("expected method rsynctask.update, got %s", mock.calls[0].Method)
+	}
+	want := []any{int64(5), map[string]any{
+		"mode":            "MODULE",
+		"remotehost":      "backup.example.com",
+		"remotemodule":    "media",
+		"ssh_credentials": nil,
+		"schedule":        map[string]any{"minute": "0", "hour": "3", "dom": "*", "month": "*", "dow": "*"},
+		"delete":          true,
+		"extra":           []string{},
+	}}
+	if !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("expected params %v, got %v", want, mock.calls[0].Params)
+	}
+	if mock.calls[1].Method != "rsynctask.get_instance" {
+		t.Errorf("expected re-read, got %s", mock.calls[1].Method)
+	}
+}
+
+func TestRsyncTaskService_Delete(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				return json.RawMessage(`true`), nil
+			},
+		},
+	}
+
+	svc := NewRsyncTaskService(mock, Version{})
+	if err := svc.Delete(context.Background(), 5); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if mock.calls[0].Method != "rsynctask.delete" || mock.calls[0].Params != int64(5) {
+		t.Errorf("unexpected call: %+v", mock.calls[0])
+	}
+}
+
+func TestRsyncTaskService_Run(t *testing.T) {
+	mock := &mockAsyncCaller{
+		callAndWaitFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return json.RawMessage(`null`), nil
+		},
+	}
+
+	svc := NewRsyncTaskService(mock, Version{})
+	if err := svc.Run(context.Background(), 5); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Verify CallAndWait was used (not Call)
+	if len(mock.calls) != 1 {
+		t.Fatalf("expected 1 call, got %d", len(mock.calls))
+	}
+	if mock.calls[0].Method != "rsynctask.run" || mock.calls[0].Params != int64(5) {
+		t.Errorf("unexpected call: %+v", mock.calls[0])
+	}
+}
+
+func TestRsyncTaskService_Run_Error(t *testing.T) {
+	mock := &mockAsyncCaller{
+		callAndWaitFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return nil, errors.New("rsync command returned 23")
+		},
+	}
+
+	svc := NewRsyncTaskService(mock, Version{})
+	if err := svc.Run(context.Background(), 5); err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,22 @@
+package truenas
+
+// ScrubTaskResponse represents a scrub task from the pool.scrub query API.
+type ScrubTaskResponse struct {
+	ID          int64            `json:"id"`
+	Pool        int64            `json:"pool"`
+	PoolName    string           `json:"pool_name"`
+	Threshold   int64            `json:"threshold"`
+	Description string           `json:"description"`
+	Schedule    ScheduleResponse `json:"schedule"`
+	Enabled     bool             `json:"enabled"`
+}
+
+// ResilverConfigResponse represents the resilver priority window from the
+// pool.resilver config API.
+type ResilverConfigResponse struct {
+	ID      int64   `json:"id"`
+	Begin   string  `json:"begin"`
+	End     string  `json:"end"`
+	Enabled bool    `json:"enabled"`
+	Weekday []int64 `json:"weekday"`
+}
@@ -0,0 +1,257 @@
+package truenas
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// ScrubTask is the user-facing representation of a scheduled pool scrub.
+type ScrubTask struct {
+	ID       int64
+	PoolID   int64
+	PoolName string
+	// Threshold is the number of days that must pass since the last scrub
+	// before a scheduled run starts a new one.
+	Threshold   int64
+	Description string
+	Schedule    Schedule
+	Enabled     bool
+}
+
+// CreateScrubTaskOpts contains options for creating a scrub task.
+type CreateScrubTaskOpts struct {
+	PoolID      int64
+	Threshold   *int64 // Default: 35
+	Description string
+	Schedule    Schedule // Zero = server default (Sundays at midnight)
+	Enabled     *bool    // Default: true
+}
+
+// UpdateScrubTaskOpts contains options for updating a scrub task. Nil
+// fields are left unchanged.
+type UpdateScrubTaskOpts struct {
+	PoolID      int64 // 0 = don't change
+	Threshold   *int64
+	Description *string
+	Schedule    *Schedule
+	Enabled     *bool
+}
+
+// ResilverConfig is the window in which resilvers run at raised priority.
+type ResilverConfig struct {
+	Enabled bool
+	Begin   string // "HH:MM"
+	End     string // "HH:MM"
+	// Weekdays are the days the window applies, 1 (Monday) to 7 (Sunday).
+	Weekdays []int64
+}
+
+// UpdateResilverConfigOpts contains options for updating the resilver
+// priority window. Nil fields are left unchanged.
+type UpdateResilverConfigOpts struct {
+	Enabled  *bool
+	Begin    string  // Empty = don't change
+	End      string  // Empty = don't change
+	Weekdays []int64 // Nil = don't change
+}
+
+// ScrubService provides typed methods for the pool.scrub.* and
+// pool.resilver.* API namespaces.
+type ScrubService struct {
+	client  AsyncCaller
+	version Version
+}
+
+// NewScrubService creates a new ScrubService.
+func NewScrubService(c AsyncCaller, v Version) *ScrubService {
+	return &ScrubService{client: c, version: v}
+}
+
+// Create creates a scrub task and returns the full object.
+func (s *ScrubService) Create(ctx context.Context, opts CreateScrubTaskOpts) (*ScrubTask, error) {
+	params := map[string]any{"pool": opts.PoolID}
+	if opts.Threshold != nil {
+		params["threshold"] = *opts.Threshold
+	}
+	if opts.Description != "" {
+		params["description"] = opts.Description
+	}
+	if opts.Schedule != (Schedule{}) {
+		params["schedule"] = scheduleParams(opts.Schedule)
+	}
+	setBool(params, "enabled", opts.Enabled)
+
+	result, err := callMethod(ctx, s.client, s.version, "pool.scrub.create", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var createResp struct {
+		ID int64 `json:"id"`
+	}
+	if err := json.Unmarshal(result, &createResp); err != nil {
+		return nil, fmt.Errorf("parse create response: %w", err)
+	}
+
+	return s.Get(ctx, createResp.ID)
+}
+
+// Get returns a scrub task by ID, or nil if not found.
+func (s *ScrubService) Get(ctx context.Context, id int64) (*ScrubTask, error) {
+	result, err := callMethod(ctx, s.client, s.version, "pool.scrub.get_instance", id)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var resp ScrubTaskResponse
+	if err := json.Unmarshal(result, &resp); err != nil {
+		return nil, fmt.Errorf("parse get_instance response: %w", err)
+	}
+
+	task := scrubTaskFromResponse(resp)
+	return &task, nil
+}
+
+// List returns all scrub tasks.
+func (s *ScrubService) List(ctx context.Context) ([]ScrubTask, error) {
+	result, err := callMethod(ctx, s.client, s.version, "pool.scrub.query", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responses []ScrubTaskResponse
+	if err := json.Unmarshal(result, &responses); err != nil {
+		return nil, fmt.Errorf("parse query response: %w", err)
+	}
+
+	tasks := make([]ScrubTask, len(responses))
+	for i, resp := range responses {
+		tasks[i] = scrubTaskFromResponse(resp)
+	}
+	return tasks, nil
+}
+
+// Update updates a scrub task and returns the full object.
+func (s *ScrubService) Update(ctx context.Context, id int64, opts UpdateScrubTaskOpts) (*ScrubTask, error) {
+	params := map[string]any{}
+	if opts.PoolID != 0 {
+		params["pool"] = opts.PoolID
+	}
+	if opts.Threshold != nil {
+		params["threshold"] = *opts.Threshold
+	}
+	if opts.Description != nil {
+		params["description"] = *opts.Description
+	}
+	if opts.Schedule != nil {
+		params["schedule"] = scheduleParams(*opts.Schedule)
+	}
+	setBool(params, "enabled", opts.Enabled)
+
+	_, err := callMethod(ctx, s.client, s.version, "pool.scrub.update", []any{id, params})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Get(ctx, id)
+}
+
+// Delete deletes a scrub task by ID.
+func (s *ScrubService) Delete(ctx context.Context, id int64) error {
+	_, err := callMethod(ctx, s.client, s.version, "pool.scrub.delete", id)
+	return err
+}
+
+// Run starts a scrub of the named pool if at least threshold days have
+// passed since its last scrub, as a scheduled run would. It returns without
+// waiting for the scrub.
+func (s *ScrubService) Run(ctx context.Context, pool string, threshold int64) error {
+	_, err := callMethod(ctx, s.client, s.version, "pool.scrub.run", []any{pool, threshold})
+	return err
+}
+
+// Start starts, or resumes a paused, scrub of the named pool. The returned
+// job runs until the scrub completes; use PoolService.WatchScan to follow
+// its progress or Job.Wait to block until it is done.
+func (s *ScrubService) Start(ctx context.Context, pool string) (*Job, error) {
+	result, err := callMethod(ctx, s.client, s.version, "pool.scrub.scrub", []any{pool, "START"})
+	if err != nil {
+		return nil, err
+	}
+	return newJob(s.client, s.version, result)
+}
+
+// Pause pauses the running scrub of the named pool. Start resumes it.
+func (s *ScrubService) Pause(ctx context.Context, pool string) error {
+	_, err := callMethodAndWait(ctx, s.client, s.version, "pool.scrub.scrub", []any{pool, "PAUSE"})
+	return err
+}
+
+// Stop cancels the running scrub of the named pool.
+func (s *ScrubService) Stop(ctx context.Context, pool string) error {
+	_, err := callMethodAndWait(ctx, s.client, s.version, "pool.scrub.scrub", []any{pool, "STOP"})
+	return err
+}
+
+// GetResilverConfig returns the resilver priority window.
+func (s *ScrubService) GetResilverConfig(ctx context.Context) (*ResilverConfig, error) {
+	result, err := callMethod(ctx, s.client, s.version, "pool.resilver.config", nil)
+	if err != nil {
+		return nil, err
+	}
+	return parseResilverConfig(result, "config")
+}
+
+// UpdateResilverConfig updates the resilver priority window and returns the
+// new configuration.
+func (s *ScrubService) UpdateResilverConfig(ctx context.Context, opts UpdateResilverConfigOpts) (*ResilverConfig, error) {
+	params := map[string]any{}
+	setBool(params, "enabled", opts.Enabled)
+	if opts.Begin != "" {
+		params["begin"] = opts.Begin
+	}
+	if opts.End != "" {
+		params["end"] = opts.End
+	}
+	if opts.Weekdays != nil {
+		params["weekday"] = opts.Weekdays
+	}
+
+	result, err := callMethod(ctx, s.client, s.version, "pool.resilver.update", params)
+	if err != nil {
+		return nil, err
+	}
+	return parseResilverConfig(result, "update")
+}
+
+// parseResilverConfig parses a pool.resilver.config or update response.
+func parseResilverConfig(result json.RawMessage, method string) (*ResilverConfig, error) {
+	var resp ResilverConfigResponse
+	if err := json.Unmarshal(result, &resp); err != nil {
+		return nil, fmt.Errorf("parse %s response: %w", method, err)
+	}
+	return &ResilverConfig{
+		Enabled:  resp.Enabled,
+		Begin:    resp.Begin,
+		End:      resp.End,
+		Weekdays: resp.Weekday,
+	}, nil
+}
+
+// scrubTaskFromResponse converts a wire-format ScrubTaskResponse to a
+// user-facing ScrubTask.
+func scrubTaskFromResponse(resp ScrubTaskResponse) ScrubTask {
+	return ScrubTask{
+		ID:          resp.ID,
+		PoolID:      resp.Pool,
+		PoolName:    resp.PoolName,
+		Threshold:   resp.Threshold,
+		Description: resp.Description,
+		Schedule:    scheduleFromResponse(resp.Schedule),
+		Enabled:     resp.Enabled,
+	}
+}
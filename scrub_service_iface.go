@@ -0,0 +1,114 @@
+package truenas
+
+import "context"
+
+// ScrubServiceAPI defines the interface for scrub and resilver operations.
+type ScrubServiceAPI interface {
+	Create(ctx context.Context, opts CreateScrubTaskOpts) (*ScrubTask, error)
+	Get(ctx context.Context, id int64) (*ScrubTask, error)
+	List(ctx context.Context) ([]ScrubTask, error)
+	Update(ctx context.Context, id int64, opts UpdateScrubTaskOpts) (*ScrubTask, error)
+	Delete(ctx context.Context, id int64) error
+	Run(ctx context.Context, pool string, threshold int64) error
+	Start(ctx context.Context, pool string) (*Job, error)
+	Pause(ctx context.Context, pool string) error
+	Stop(ctx context.Context, pool string) error
+	GetResilverConfig(ctx context.Context) (*ResilverConfig, error)
+	UpdateResilverConfig(ctx context.Context, opts UpdateResilverConfigOpts) (*ResilverConfig, error)
+}
+
+// Compile-time checks.
+var _ ScrubServiceAPI = (*ScrubService)(nil)
+var _ ScrubServiceAPI = (*MockScrubService)(nil)
+
+// MockScrubService is a test double for ScrubServiceAPI.
+type MockScrubService struct {
+	CreateFunc               func(ctx context.Context, opts CreateScrubTaskOpts) (*ScrubTask, error)
+	GetFunc                  func(ctx context.Context, id int64) (*ScrubTask, error)
+	ListFunc                 func(ctx context.Context) ([]ScrubTask, error)
+	UpdateFunc               func(ctx context.Context, id int64, opts UpdateScrubTaskOpts) (*ScrubTask, error)
+	DeleteFunc               func(ctx context.Context, id int64) error
+	RunFunc                  func(ctx context.Context, pool string, threshold int64) error
+	StartFunc                func(ctx context.Context, pool string) (*Job, error)
+	PauseFunc                func(ctx context.Context, pool string) error
+	StopFunc                 func(ctx context.Context, pool string) error
+	GetResilverConfigFunc    func(ctx context.Context) (*ResilverConfig, error)
+	UpdateResilverConfigFunc func(ctx context.Context, opts UpdateResilverConfigOpts) (*ResilverConfig, error)
+}
+
+func (m *MockScrubService) Create(ctx context.Context, opts CreateScrubTaskOpts) (*ScrubTask, error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, opts)
+	}
+	return nil, nil
+}
+
+func (m *MockScrubService) Get(ctx context.Context, id int64) (*ScrubTask, error) {
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockScrubService) List(ctx context.Context) ([]ScrubTask, error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockScrubService) Update(ctx context.Context, id int64, opts UpdateScrubTaskOpts) (*ScrubTask, error) {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, id, opts)
+	}
+	return nil, nil
+}
+
+func (m *MockScrubService) Delete(ctx context.Context, id int64) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockScrubService) Run(ctx context.Context, pool string, threshold int64) error {
+	if m.RunFunc != nil {
+		return m.RunFunc(ctx, pool, threshold)
+	}
+	return nil
+}
+
+func (m *MockScrubService) Start(ctx context.Context, pool string) (*Job, error) {
+	if m.StartFunc != nil {
+		return m.StartFunc(ctx, pool)
+	}
+	return nil, nil
+}
+
+func (m *MockScrubService) Pause(ctx context.Context, pool string) error {
+	if m.PauseFunc != nil {
+		return m.PauseFunc(ctx, pool)
+	}
+	return nil
+}
+
+func (m *MockScrubService) Stop(ctx context.Context, pool string) error {
+	if m.StopFunc != nil {
+		return m.StopFunc(ctx, pool)
+	}
+	return nil
+}
+
+func (m *MockScrubService) GetResilverConfig(ctx context.Context) (*ResilverConfig, error) {
+	if m.GetResilverConfigFunc != nil {
+		return m.GetResilverConfigFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockScrubService) UpdateResilverConfig(ctx context.Context, opts UpdateResilverConfigOpts) (*ResilverConfig, error) {
+	if m.UpdateResilverConfigFunc != nil {
+		return m.UpdateResilverConfigFunc(ctx, opts)
+	}
+	return nil, nil
+}
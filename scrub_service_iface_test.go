@@ -0,0 +1,45 @@
+package truenas
+
+import (
+	"context"
+	"testing"
+)
+
+func TestMockScrubService_ImplementsInterface(t *testing.T) {
+	var _ ScrubServiceAPI = (*ScrubService)(nil)
+	var _ ScrubServiceAPI = (*MockScrubService)(nil)
+}
+
+func TestMockScrubService_DefaultsToNil(t *testing.T) {
+	mock := &MockScrubService{}
+	ctx := context.Background()
+
+	job, err := mock.Start(ctx, "tank")
+	if err != nil {
+		t.Fatalf("expected nil error, got: %v", err)
+	}
+	if job != nil {
+		t.Fatalf("expected nil result, got: %v", job)
+	}
+
+	if err := mock.Pause(ctx, "tank"); err != nil {
+		t.Fatalf("expected nil error from Pause, got: %v", err)
+	}
+}
+
+func TestMockScrubService_CallsFunc(t *testing.T) {
+	var stopped string
+	mock := &MockScrubService{
+		StopFunc: func(ctx context.Context, pool string) error {
+			stopped = pool
+			return nil
+		},
+	}
+
+	if err := mock.Stop(context.Background(), "tank"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if stopped != "tank" {
+		t.Fatalf("expected StopFunc to be called with tank, got %q", stopped)
+	}
+}
@@ -0,0 +1,301 @@
+package truenas
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// sampleScrubTaskJSON returns a single JSON object response for
+// pool.scrub.get_instance.
+func sampleScrubTaskJSON() json.RawMessage {
+	return json.RawMessage(`{
+		"id": 3,
+		"pool": 1,
+		"pool_name": "tank",
+		"threshold": 14,
+		"description": "Fortnightly scrub",
+		"schedule": {"minute": "00", "hour": "02", "dom": "*", "month": "*", "dow": "6"},
+		"enabled": true
+	}`)
+}
+
+func TestScrubService_Create(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				return sampleScrubTaskJSON(), nil
+			},
+		},
+	}
+
+	svc := NewScrubService(mock, Version{})
+	task, err := svc.Create(context.Background(), CreateScrubTaskOpts{
+		PoolID:      1,
+		Threshold:   Int64Ptr(14),
+		Description: "Fortnightly scrub",
+		Schedule:    Schedule{Minute: "00", Hour: "02", Dom: "*", Month: "*", Dow: "6"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if mock.calls[0].Method != "pool.scrub.create" {
+		t.Fatalf("expected method pool.scrub.create, got %s", mock.calls[0].Method)
+	}
+	want := map[string]any{
+		"pool":        int64(1),
+		"threshold":   int64(14),
+		"description": "Fortnightly scrub",
+		"schedule":    map[string]any{"minute": "00", "hour": "02", "dom": "*", "month": "*", "dow": "6"},
+	}
+	if !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("expected params %v, got %v", want, mock.calls[0].Params)
+	}
+	if mock.calls[1].Method != "pool.scrub.get_instance" || mock.calls[1].Params != int64(3) {
+		t.Errorf("expected re-read of task 3, got %s %v", mock.calls[1].Method, mock.calls[1].Params)
+	}
+
+	wantTask := &ScrubTask{
+		ID:          3,
+		PoolID:      1,
+		PoolName:    "tank",
+		Threshold:   14,
+		Description: "Fortnightly scrub",
+		Schedule:    Schedule{Minute: "00", Hour: "02", Dom: "*", Month: "*", Dow: "6"},
+		Enabled:     true,
+	}
+	if !reflect.DeepEqual(task, wantTask) {
+		t.Errorf("expected %+v, got %+v", wantTask, task)
+	}
+}
+
+func TestScrubService_Create_Defaults(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				return sampleScrubTaskJSON(), nil
+			},
+		},
+	}
+
+	svc := NewScrubService(mock, Version{})
+	_, _ = svc.Create(context.Background(), CreateScrubTaskOpts{PoolID: 1, Enabled: BoolPtr(false)})
+
+	want := map[string]any{"pool": int64(1), "enabled": false}
+	if !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("expected params %v, got %v", want, mock.calls[0].Params)
+	}
+}
+
+func TestScrubService_Get_NotFound(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				return nil, errors.New("[ENOENT] None: Pool Scrub Task 9 does not exist")
+			},
+		},
+	}
+
+	svc := NewScrubService(mock, Version{})
+	task, err := svc.Get(context.Background(), 9)
+	if err != nil || task != nil {
+		t.Errorf("expected nil, nil, got %+v, %v", task, err)
+	}
+}
+
+func TestScrubService_List(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				return json.RawMessage(`[` + string(sampleScrubTaskJSON()) + `]`), nil
+			},
+		},
+	}
+
+	svc := NewScrubService(mock, Version{})
+	tasks, err := svc.List(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if mock.calls[0].Method != "pool.scrub.query" {
+		t.Fatalf("expected method pool.scrub.query, got %s", mock.calls[0].Method)
+	}
+	if len(tasks) != 1 || tasks[0].PoolName != "tank" || tasks[0].Threshold != 14 {
+		t.Errorf("unexpected tasks: %+v", tasks)
+	}
+}
+
+func TestScrubService_Update(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				return sampleScrubTaskJSON(), nil
+			},
+		},
+	}
+
+	svc := NewScrubService(mock, Version{})
+	_, err := svc.Update(context.Background(), 3, UpdateScrubTaskOpts{
+		Threshold: Int64Ptr(7),
+		Schedule:  &Schedule{Minute: "30", Hour: "01", Dom: "*", Month: "*", Dow: "*"},
+		Enabled:   BoolPtr(false),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if mock.calls[0].Method != "pool.scrub.update" {
+		t.Fatalf("expected method pool.scrub.update, got %s", mock.calls[0].Method)
+	}
+	want := []any{int64(3), map[string]any{
+		"threshold": int64(7),
+		"schedule":  map[string]any{"minute": "30", "hour": "01", "dom": "*", "month": "*", "dow": "*"},
+		"enabled":   false,
+	}}
+	if !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("expected params %v, got %v", want, mock.calls[0].Params)
+	}
+	if mock.calls[1].Method != "pool.scrub.get_instance" {
+		t.Errorf("expected re-read, got %s", mock.calls[1].Method)
+	}
+}
+
+func TestScrubService_Delete(t *testing.T) {
+	mock := &mockAsyncCaller{}
+
+	svc := NewScrubService(mock, Version{})
+	if err := svc.Delete(context.Background(), 3); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if mock.calls[0].Method != "pool.scrub.delete" || mock.calls[0].Params != int64(3) {
+		t.Errorf("unexpected call: %+v", mock.calls[0])
+	}
+}
+
+func TestScrubService_Run(t *testing.T) {
+	mock := &mockAsyncCaller{}
+
+	svc := NewScrubService(mock, Version{})
+	if err := svc.Run(context.Background(), "tank", 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []any{"tank", int64(0)}
+	if mock.calls[0].Method != "pool.scrub.run" || !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("unexpected call: %+v", mock.calls[0])
+	}
+}
+
+func TestScrubService_Start(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				return json.RawMessage(`812`), nil
+			},
+		},
+	}
+
+	svc := NewScrubService(mock, Version{})
+	job, err := svc.Start(context.Background(), "tank")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []any{"tank", "START"}
+	if mock.calls[0].Method != "pool.scrub.scrub" || !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("unexpected call: %+v", mock.calls[0])
+	}
+	if job.ID != 812 {
+		t.Errorf("expected job 812, got %d", job.ID)
+	}
+}
+
+func TestScrubService_Pause(t *testing.T) {
+	mock := &mockAsyncCaller{}
+
+	svc := NewScrubService(mock, Version{})
+	if err := svc.Pause(context.Background(), "tank"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []any{"tank", "PAUSE"}
+	if mock.calls[0].Method != "pool.scrub.scrub" || !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("unexpected call: %+v", mock.calls[0])
+	}
+}
+
+func TestScrubService_Stop(t *testing.T) {
+	mock := &mockAsyncCaller{
+		callAndWaitFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return nil, errors.New("[EINVAL] There is no scrub running on pool tank")
+		},
+	}
+
+	svc := NewScrubService(mock, Version{})
+	if err := svc.Stop(context.Background(), "tank"); err == nil {
+		t.Fatal("expected error")
+	}
+
+	want := []any{"tank", "STOP"}
+	if !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("expected params %v, got %v", want, mock.calls[0].Params)
+	}
+}
+
+func TestScrubService_GetResilverConfig(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				return json.RawMessage(`{"id": 1, "begin": "18:00", "end": "9:00", "enabled": true, "weekday": [1, 2, 3, 4, 5]}`), nil
+			},
+		},
+	}
+
+	svc := NewScrubService(mock, Version{})
+	config, err := svc.GetResilverConfig(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if mock.calls[0].Method != "pool.resilver.config" {
+		t.Fatalf("expected method pool.resilver.config, got %s", mock.calls[0].Method)
+	}
+	want := &ResilverConfig{Enabled: true, Begin: "18:00", End: "9:00", Weekdays: []int64{1, 2, 3, 4, 5}}
+	if !reflect.DeepEqual(config, want) {
+		t.Errorf("expected %+v, got %+v", want, config)
+	}
+}
+
+func TestScrubService_UpdateResilverConfig(t *testing.T) {
+	mock := &mockAsyncCaller{
+		mockCaller: mockCaller{
+			callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+				return json.RawMessage(`{"id": 1, "begin": "22:00", "end": "6:00", "enabled": true, "weekday": [6, 7]}`), nil
+			},
+		},
+	}
+
+	svc := NewScrubService(mock, Version{})
+	config, err := svc.UpdateResilverConfig(context.Background(), UpdateResilverConfigOpts{
+		Begin:    "22:00",
+		End:      "6:00",
+		Weekdays: []int64{6, 7},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if mock.calls[0].Method != "pool.resilver.update" {
+		t.Fatalf("expected method pool.resilver.update, got %s", mock.calls[0].Method)
+	}
+	want := map[string]any{"begin": "22:00", "end": "6:00", "weekday": []int64{6, 7}}
+	if !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("expected params %v, got %v", want, mock.calls[0].Params)
+	}
+	if config.Begin != "22:00" || !reflect.DeepEqual(config.Weekdays, []int64{6, 7}) {
+		t.Errorf("unexpected config: %+v", config)
+	}
+}
@@ -0,0 +1,130 @@
+package truenas
+
+// SMBSharePurpose is a preset that configures an SMB share for a use case.
+// The preset's parameters override the share's own; see SMBService.ListPresets.
+type SMBSharePurpose string
+
+const (
+	SMBPurposeNoPreset            SMBSharePurpose = "NO_PRESET"
+	SMBPurposeDefaultShare        SMBSharePurpose = "DEFAULT_SHARE"
+	SMBPurposeTimeMachine         SMBSharePurpose = "TIMEMACHINE"
+	SMBPurposeEnhancedTimeMachine SMBSharePurpose = "ENHANCED_TIMEMACHINE"
+	SMBPurposeMultiProtocolNFS    SMBSharePurpose = "MULTI_PROTOCOL_NFS"
+	SMBPurposePrivateDatasets     SMBSharePurpose = "PRIVATE_DATASETS"
+	SMBPurposeWORMDropbox         SMBSharePurpose = "WORM_DROPBOX"
+	SMBPurposeVeeamRepository     SMBSharePurpose = "VEEAM_REPOSITORY_SHARE"
+)
+
+// SMBEncryption is the SMB server's transport encryption setting.
+type SMBEncryption string
+
+const (
+	SMBEncryptionDefault   SMBEncryption = "DEFAULT"
+	SMBEncryptionNegotiate SMBEncryption = "NEGOTIATE"
+	SMBEncryptionDesired   SMBEncryption = "DESIRED"
+	SMBEncryptionRequired  SMBEncryption = "REQUIRED"
+)
+
+// SMBSharePermission is the access an SMB share ACL entry grants.
+type SMBSharePermission string
+
+const (
+	SMBPermissionFull   SMBSharePermission = "FULL"
+	SMBPermissionChange SMBSharePermission = "CHANGE"
+	SMBPermissionRead   SMBSharePermission = "READ"
+)
+
+// SMBACLType is whether an SMB share ACL entry allows or denies access.
+type SMBACLType string
+
+const (
+	SMBACLAllowed SMBACLType = "ALLOWED"
+	SMBACLDenied  SMBACLType = "DENIED"
+)
+
+// SMBShareResponse represents an SMB share from the sharing.smb query API.
+type SMBShareResponse struct {
+	ID               int64            `json:"id"`
+	Purpose          string           `json:"purpose"`
+	Path             string           `json:"path"`
+	Name             string           `json:"name"`
+	Comment          string           `json:"comment"`
+	RO               bool             `json:"ro"`
+	Browsable        bool             `json:"browsable"`
+	Timemachine      bool             `json:"timemachine"`
+	TimemachineQuota int64            `json:"timemachine_quota"`
+	Recyclebin       bool             `json:"recyclebin"`
+	Guestok          bool             `json:"guestok"`
+	ABE              bool             `json:"abe"`
+	Hostsallow       []string         `json:"hostsallow"`
+	Hostsdeny        []string         `json:"hostsdeny"`
+	ACL              bool             `json:"acl"`
+	Durablehandle    bool             `json:"durablehandle"`
+	Shadowcopy       bool             `json:"shadowcopy"`
+	Streams          bool             `json:"streams"`
+	Auxsmbconf       string           `json:"auxsmbconf"`
+	Enabled          bool             `json:"enabled"`
+	Locked           bool             `json:"locked"`
+	Audit            SMBAuditResponse `json:"audit"`
+}
+
+// SMBAuditResponse represents the audit settings of an SMB share.
+type SMBAuditResponse struct {
+	Enable     bool     `json:"enable"`
+	WatchList  []string `json:"watch_list"`
+	IgnoreList []string `json:"ignore_list"`
+}
+
+// SMBShareACLResponse represents the share ACL returned by
+// sharing.smb.getacl and sharing.smb.setacl.
+type SMBShareACLResponse struct {
+	ShareName string                     `json:"share_name"`
+	ShareACL  []SMBShareACLEntryResponse `json:"share_acl"`
+}
+
+// SMBShareACLEntryResponse represents one entry of an SMB share ACL.
+type SMBShareACLEntryResponse struct {
+	AePerm   string               `json:"ae_perm"`
+	AeType   string               `json:"ae_type"`
+	AeWhoSID *string              `json:"ae_who_sid"`
+	AeWhoID  *SMBACLWhoIDResponse `json:"ae_who_id"`
+	AeWhoStr *string              `json:"ae_who_str"`
+}
+
+// SMBACLWhoIDResponse identifies the principal of an SMB share ACL entry by
+// Unix id.
+type SMBACLWhoIDResponse struct {
+	IDType string `json:"id_type"`
+	ID     int64  `json:"id"`
+}
+
+// SMBPresetResponse represents one entry of sharing.smb.presets.
+type SMBPresetResponse struct {
+	VerboseName string         `json:"verbose_name"`
+	Params      map[string]any `json:"params"`
+}
+
+// SMBConfigResponse represents the SMB service configuration.
+type SMBConfigResponse struct {
+	ID             int64    `json:"id"`
+	NetBIOSName    string   `json:"netbiosname"`
+	NetBIOSAlias   []string `json:"netbiosalias"`
+	Workgroup      string   `json:"workgroup"`
+	Description    string   `json:"description"`
+	EnableSMB1     bool     `json:"enable_smb1"`
+	UnixCharset    string   `json:"unixcharset"`
+	LocalMaster    bool     `json:"localmaster"`
+	Syslog         bool     `json:"syslog"`
+	AAPLExtensions bool     `json:"aapl_extensions"`
+	AdminGroup     *string  `json:"admin_group"`
+	Guest          string   `json:"guest"`
+	Filemask       string   `json:"filemask"`
+	Dirmask        string   `json:"dirmask"`
+	NTLMv1Auth     bool     `json:"ntlmv1_auth"`
+	Multichannel   bool     `json:"multichannel"`
+	Encryption     string   `json:"encryption"`
+	BindIP         []string `json:"bindip"`
+	ServerSID      *string  `json:"server_sid"`
+	SMBOptions     string   `json:"smb_options"`
+	Debug          bool     `json:"debug"`
+}
@@ -0,0 +1,629 @@
+package truenas
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// SMBShare is the user-facing representation of a TrueNAS SMB share.
+type SMBShare struct {
+	ID        int64
+	Name      string
+	Path      string
+	Purpose   SMBSharePurpose
+	Comment   string
+	Enabled   bool
+	ReadOnly  bool
+	Browsable bool
+	Guest     bool
+	// AccessBasedEnumeration hides files and directories the client
+	// cannot access.
+	AccessBasedEnumeration bool
+	TimeMachine            bool
+	TimeMachineQuota       int64 // Bytes; 0 = no quota
+	RecycleBin             bool
+	HostsAllow             []string
+	HostsDeny              []string
+	ACL                    bool
+	DurableHandle          bool
+	ShadowCopy             bool
+	Streams                bool
+	AuxSMBConf             string
+	Locked                 bool // The share's dataset is locked
+	Audit                  SMBAudit
+}
+
+// SMBAudit contains the audit settings of an SMB share. The lists hold group
+// names whose members are, or are not, audited.
+type SMBAudit struct {
+	Enable     bool
+	WatchList  []string
+	IgnoreList []string
+}
+
+// CreateSMBShareOpts contains options for creating an SMB share.
+// Pointer fields use the server default when nil. Bool fields are only sent
+// when true.
+type CreateSMBShareOpts struct {
+	Name                   string
+	Path                   string
+	Purpose                SMBSharePurpose // Empty = DEFAULT_SHARE
+	Comment                string
+	Enabled                *bool
+	ReadOnly               bool
+	Browsable              *bool
+	Guest                  bool
+	AccessBasedEnumeration bool
+	TimeMachine            bool
+	TimeMachineQuota       int64 // Only sent when non-zero
+	RecycleBin             bool
+	HostsAllow             []string
+	HostsDeny              []string
+	AuxSMBConf             string
+	Audit                  *SMBAudit
+}
+
+// UpdateSMBShareOpts contains options for updating an SMB share.
+// Pointer fields distinguish "don't change" (nil) from "set to zero/empty".
+// String fields use empty string to mean "don't change", and slice fields
+// use nil; an empty, non-nil slice clears the list.
+type UpdateSMBShareOpts struct {
+	Name                   string // Empty = don't change
+	Path                   string // Empty = don't change
+	Purpose                SMBSharePurpose
+	Comment                *string
+	Enabled                *bool
+	ReadOnly               *bool
+	Browsable              *bool
+	Guest                  *bool
+	AccessBasedEnumeration *bool
+	TimeMachine            *bool
+	TimeMachineQuota       *int64
+	RecycleBin             *bool
+	HostsAllow             []string
+	HostsDeny              []string
+	AuxSMBConf             *string
+	Audit                  *SMBAudit
+}
+
+// SMBShareACLEntry is an entry of an SMB share ACL, which controls access
+// to the share itself rather than to its files. The principal is set by
+// exactly one of SID, ID (with IDType) or Who.
+type SMBShareACLEntry struct {
+	Permission SMBSharePermission
+	Type       SMBACLType
+	SID        string // e.g. "S-1-1-0" for everyone
+	IDType     string // "USER", "GROUP" or "BOTH"; used with ID
+	ID         *int64 // Unix uid or gid
+	Who        string // User or group name
+}
+
+// SMBPreset is a predefined share configuration for an SMBSharePurpose.
+type SMBPreset struct {
+	Purpose     SMBSharePurpose
+	VerboseName string
+	// Params are the share parameters the preset sets, in API form
+	// (e.g. "timemachine": true).
+	Params map[string]any
+}
+
+// SMBConfig is the user-facing representation of the SMB service
+// configuration.
+type SMBConfig struct {
+	NetBIOSName    string
+	NetBIOSAlias   []string
+	Workgroup      string
+	Description    string
+	EnableSMB1     bool
+	UnixCharset    string
+	LocalMaster    bool
+	Syslog         bool
+	AAPLExtensions bool
+	AdminGroup     string
+	Guest          string
+	Filemask       string
+	Dirmask        string
+	NTLMv1Auth     bool
+	Multichannel   bool
+	Encryption     SMBEncryption
+	BindIP         []string
+	ServerSID      string
+	SMBOptions     string
+	Debug          bool
+}
+
+// UpdateSMBConfigOpts contains options for updating the SMB service
+// configuration.
+// Pointer fields distinguish "don't change" (nil) from "set to zero/empty".
+// String fields use empty string to mean "don't change", and slice fields
+// use nil; an empty, non-nil slice clears the list.
+type UpdateSMBConfigOpts struct {
+	NetBIOSName    string // Empty = don't change
+	NetBIOSAlias   []string
+	Workgroup      string // Empty = don't change
+	Description    *string
+	EnableSMB1     *bool
+	LocalMaster    *bool
+	Syslog         *bool
+	AAPLExtensions *bool
+	AdminGroup     *string // Empty string = no admin group
+	Guest          string  // Empty = don't change
+	NTLMv1Auth     *bool
+	Multichannel   *bool
+	Encryption     SMBEncryption // Empty = don't change
+	BindIP         []string
+	SMBOptions     *string
+	Debug          *bool
+}
+
+// SMBService provides typed methods for the sharing.smb.* and smb.* API
+// namespaces.
+type SMBService struct {
+	client  Caller
+	version Version
+}
+
+// NewSMBService creates a new SMBService.
+func NewSMBService(c Caller, v Version) *SMBService {
+	return &SMBService{client: c, version: v}
+}
+
+// CreateShare creates an SMB share and returns the full object.
+func (s *SMBService) CreateShare(ctx context.Context, opts CreateSMBShareOpts) (*SMBShare, error) {
+	params := smbShareCreateParams(opts)
+	result, err := callMethod(ctx, s.client, s.version, "sharing.smb.create", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var createResp struct {
+		ID int64 `json:"id"`
+	}
+	if err := json.Unmarshal(result, &createResp); err != nil {
+		return nil, fmt.Errorf("parse create response: %w", err)
+	}
+
+	return s.GetShare(ctx, createResp.ID)
+}
+
+// GetShare returns an SMB share by ID, or nil if not found.
+func (s *SMBService) GetShare(ctx context.Context, id int64) (*SMBShare, error) {
+	result, err := callMethod(ctx, s.client, s.version, "sharing.smb.get_instance", id)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var resp SMBShareResponse
+	if err := json.Unmarshal(result, &resp); err != nil {
+		return nil, fmt.Errorf("parse get_instance response: %w", err)
+	}
+
+	share := smbShareFromResponse(resp)
+	return &share, nil
+}
+
+// GetShareByName returns an SMB share by name, or nil if not found.
+func (s *SMBService) GetShareByName(ctx context.Context, name string) (*SMBShare, error) {
+	filter := [][]any{{"name", "=", name}}
+	result, err := callMethod(ctx, s.client, s.version, "sharing.smb.query", filter)
+	if err != nil {
+		return nil, err
+	}
+
+	shares, err := parseSMBShares(result)
+	if err != nil {
+		return nil, err
+	}
+	if len(shares) == 0 {
+		return nil, nil
+	}
+	return &shares[0], nil
+}
+
+// ListShares returns all SMB shares.
+func (s *SMBService) ListShares(ctx context.Context) ([]SMBShare, error) {
+	result, err := callMethod(ctx, s.client, s.version, "sharing.smb.query", nil)
+	if err != nil {
+		return nil, err
+	}
+	return parseSMBShares(result)
+}
+
+// parseSMBShares parses a sharing.smb.query response.
+func parseSMBShares(result json.RawMessage) ([]SMBShare, error) {
+	var responses []SMBShareResponse
+	if err := json.Unmarshal(result, &responses); err != nil {
+		return nil, fmt.Errorf("parse query response: %w", err)
+	}
+
+	shares := make([]SMBShare, len(responses))
+	for i, resp := range responses {
+		shares[i] = smbShareFromResponse(resp)
+	}
+	return shares, nil
+}
+
+// UpdateShare updates an SMB share and returns the full object.
+func (s *SMBService) UpdateShare(ctx context.Context, id int64, opts UpdateSMBShareOpts) (*SMBShare, error) {
+	params := smbShareUpdateParams(opts)
+	_, err := callMethod(ctx, s.client, s.version, "sharing.smb.update", []any{id, params})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetShare(ctx, id)
+}
+
+// DeleteShare deletes an SMB share by ID. The shared path is not touched.
+func (s *SMBService) DeleteShare(ctx context.Context, id int64) error {
+	_, err := callMethod(ctx, s.client, s.version, "sharing.smb.delete", id)
+	return err
+}
+
+// GetShareACL returns the share ACL of the named SMB share.
+func (s *SMBService) GetShareACL(ctx context.Context, shareName string) ([]SMBShareACLEntry, error) {
+	result, err := callMethod(ctx, s.client, s.version, "sharing.smb.getacl", map[string]any{"share_name": shareName})
+	if err != nil {
+		return nil, err
+	}
+	return parseSMBShareACL(result, "getacl")
+}
+
+// SetShareACL replaces the share ACL of the named SMB share and returns the
+// ACL as stored. An empty ACL restores the default of full access for
+// everyone.
+func (s *SMBService) SetShareACL(ctx context.Context, shareName string, entries []SMBShareACLEntry) ([]SMBShareACLEntry, error) {
+	acl := make([]map[string]any, len(entries))
+	for i, e := range entries {
+		acl[i] = smbShareACLEntryParams(e)
+	}
+	params := map[string]any{
+		"share_name": shareName,
+		"share_acl":  acl,
+	}
+	result, err := callMethod(ctx, s.client, s.version, "sharing.smb.setacl", params)
+	if err != nil {
+		return nil, err
+	}
+	return parseSMBShareACL(result, "setacl")
+}
+
+// ListPresets returns the share presets selectable as a share's Purpose,
+// sorted by purpose.
+func (s *SMBService) ListPresets(ctx context.Context) ([]SMBPreset, error) {
+	result, err := callMethod(ctx, s.client, s.version, "sharing.smb.presets", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responses map[string]SMBPresetResponse
+	if err := json.Unmarshal(result, &responses); err != nil {
+		return nil, fmt.Errorf("parse presets response: %w", err)
+	}
+
+	presets := make([]SMBPreset, 0, len(responses))
+	for purpose, resp := range responses {
+		presets = append(presets, SMBPreset{
+			Purpose:     SMBSharePurpose(purpose),
+			VerboseName: resp.VerboseName,
+			Params:      resp.Params,
+		})
+	}
+	sort.Slice(presets, func(i, j int) bool { return presets[i].Purpose < presets[j].Purpose })
+	return presets, nil
+}
+
+// GetConfig returns the SMB service configuration.
+func (s *SMBService) GetConfig(ctx context.Context) (*SMBConfig, error) {
+	result, err := callMethod(ctx, s.client, s.version, "smb.config", nil)
+	if err != nil {
+		return nil, err
+	}
+	return parseSMBConfig(result, "config")
+}
+
+// UpdateConfig updates the SMB service configuration and returns it.
+func (s *SMBService) UpdateConfig(ctx context.Context, opts UpdateSMBConfigOpts) (*SMBConfig, error) {
+	result, err := callMethod(ctx, s.client, s.version, "smb.update", smbConfigUpdateParams(opts))
+	if err != nil {
+		return nil, err
+	}
+	return parseSMBConfig(result, "update")
+}
+
+func parseSMBShareACL(result json.RawMessage, method string) ([]SMBShareACLEntry, error) {
+	var resp SMBShareACLResponse
+	if err := json.Unmarshal(result, &resp); err != nil {
+		return nil, fmt.Errorf("parse %s response: %w", method, err)
+	}
+
+	entries := make([]SMBShareACLEntry, len(resp.ShareACL))
+	for i, e := range resp.ShareACL {
+		entries[i] = smbShareACLEntryFromResponse(e)
+	}
+	return entries, nil
+}
+
+func parseSMBConfig(result json.RawMessage, method string) (*SMBConfig, error) {
+	var resp SMBConfigResponse
+	if err := json.Unmarshal(result, &resp); err != nil {
+		return nil, fmt.Errorf("parse %s response: %w", method, err)
+	}
+
+	config := smbConfigFromResponse(resp)
+	return &config, nil
+}
+
+// smbShareFromResponse converts a wire-format SMBShareResponse to a
+// user-facing SMBShare.
+func smbShareFromResponse(resp SMBShareResponse) SMBShare {
+	return SMBShare{
+		ID:                     resp.ID,
+		Name:                   resp.Name,
+		Path:                   resp.Path,
+		Purpose:                SMBSharePurpose(resp.Purpose),
+		Comment:                resp.Comment,
+		Enabled:                resp.Enabled,
+		ReadOnly:               resp.RO,
+		Browsable:              resp.Browsable,
+		Guest:                  resp.Guestok,
+		AccessBasedEnumeration: resp.ABE,
+		TimeMachine:            resp.Timemachine,
+		TimeMachineQuota:       resp.TimemachineQuota,
+		RecycleBin:             resp.Recyclebin,
+		HostsAllow:             resp.Hostsallow,
+		HostsDeny:              resp.Hostsdeny,
+		ACL:                    resp.ACL,
+		DurableHandle:          resp.Durablehandle,
+		ShadowCopy:             resp.Shadowcopy,
+		Streams:                resp.Streams,
+		AuxSMBConf:             resp.Auxsmbconf,
+		Locked:                 resp.Locked,
+		Audit: SMBAudit{
+			Enable:     resp.Audit.Enable,
+			WatchList:  resp.Audit.WatchList,
+			IgnoreList: resp.Audit.IgnoreList,
+		},
+	}
+}
+
+// smbShareACLEntryFromResponse converts a wire-format ACL entry to a
+// user-facing SMBShareACLEntry.
+func smbShareACLEntryFromResponse(resp SMBShareACLEntryResponse) SMBShareACLEntry {
+	entry := SMBShareACLEntry{
+		Permission: SMBSharePermission(resp.AePerm),
+		Type:       SMBACLType(resp.AeType),
+	}
+	if resp.AeWhoSID != nil {
+		entry.SID = *resp.AeWhoSID
+	}
+	if resp.AeWhoID != nil {
+		entry.IDType = resp.AeWhoID.IDType
+		id := resp.AeWhoID.ID
+		entry.ID = &id
+	}
+	if resp.AeWhoStr != nil {
+		entry.Who = *resp.AeWhoStr
+	}
+	return entry
+}
+
+// smbConfigFromResponse converts a wire-format SMBConfigResponse to a
+// user-facing SMBConfig.
+func smbConfigFromResponse(resp SMBConfigResponse) SMBConfig {
+	config := SMBConfig{
+		NetBIOSName:    resp.NetBIOSName,
+		NetBIOSAlias:   resp.NetBIOSAlias,
+		Workgroup:      resp.Workgroup,
+		Description:    resp.Description,
+		EnableSMB1:     resp.EnableSMB1,
+		UnixCharset:    resp.UnixCharset,
+		LocalMaster:    resp.LocalMaster,
+		Syslog:         resp.Syslog,
+		AAPLExtensions: resp.AAPLExtensions,
+		Guest:          resp.Guest,
+		Filemask:       resp.Filemask,
+		Dirmask:        resp.Dirmask,
+		NTLMv1Auth:     resp.NTLMv1Auth,
+		Multichannel:   resp.Multichannel,
+		Encryption:     SMBEncryption(resp.Encryption),
+		BindIP:         resp.BindIP,
+		SMBOptions:     resp.SMBOptions,
+		Debug:          resp.Debug,
+	}
+	if resp.AdminGroup != nil {
+		config.AdminGroup = *resp.AdminGroup
+	}
+	if resp.ServerSID != nil {
+		config.ServerSID = *resp.ServerSID
+	}
+	return config
+}
+
+// smbShareCreateParams builds API parameters for sharing.smb.create.
+func smbShareCreateParams(opts CreateSMBShareOpts) map[string]any {
+	params := map[string]any{
+		"name": opts.Name,
+		"path": opts.Path,
+	}
+	if opts.Purpose != "" {
+		params["purpose"] = string(opts.Purpose)
+	}
+	if opts.Comment != "" {
+		params["comment"] = opts.Comment
+	}
+	if opts.Enabled != nil {
+		params["enabled"] = *opts.Enabled
+	}
+	if opts.ReadOnly {
+		params["ro"] = true
+	}
+	if opts.Browsable != nil {
+		params["browsable"] = *opts.Browsable
+	}
+	if opts.Guest {
+		params["guestok"] = true
+	}
+	if opts.AccessBasedEnumeration {
+		params["abe"] = true
+	}
+	if opts.TimeMachine {
+		params["timemachine"] = true
+	}
+	if opts.TimeMachineQuota != 0 {
+		params["timemachine_quota"] = opts.TimeMachineQuota
+	}
+	if opts.RecycleBin {
+		params["recyclebin"] = true
+	}
+	if len(opts.HostsAllow) > 0 {
+		params["hostsallow"] = opts.HostsAllow
+	}
+	if len(opts.HostsDeny) > 0 {
+		params["hostsdeny"] = opts.HostsDeny
+	}
+	if opts.AuxSMBConf != "" {
+		params["auxsmbconf"] = opts.AuxSMBConf
+	}
+	if opts.Audit != nil {
+		params["audit"] = smbAuditParams(*opts.Audit)
+	}
+	return params
+}
+
+// smbShareUpdateParams builds API parameters for sharing.smb.update.
+func smbShareUpdateParams(opts UpdateSMBShareOpts) map[string]any {
+	params := map[string]any{}
+	if opts.Name != "" {
+		params["name"] = opts.Name
+	}
+	if opts.Path != "" {
+		params["path"] = opts.Path
+	}
+	if opts.Purpose != "" {
+		params["purpose"] = string(opts.Purpose)
+	}
+	if opts.Comment != nil {
+		params["comment"] = *opts.Comment
+	}
+	if opts.Enabled != nil {
+		params["enabled"] = *opts.Enabled
+	}
+	if opts.ReadOnly != nil {
+		params["ro"] = *opts.ReadOnly
+	}
+	if opts.Browsable != nil {
+		params["browsable"] = *opts.Browsable
+	}
+	if opts.Guest != nil {
+		params["guestok"] = *opts.Guest
+	}
+	if opts.AccessBasedEnumeration != nil {
+		params["abe"] = *opts.AccessBasedEnumeration
+	}
+	if opts.TimeMachine != nil {
+		params["timemachine"] = *opts.TimeMachine
+	}
+	if opts.TimeMachineQuota != nil {
+		params["timemachine_quota"] = *opts.TimeMachineQuota
+	}
+	if opts.RecycleBin != nil {
+		params["recyclebin"] = *opts.RecycleBin
+	}
+	if opts.HostsAllow != nil {
+		params["hostsallow"] = opts.HostsAllow
+	}
+	if opts.HostsDeny != nil {
+		params["hostsdeny"] = opts.HostsDeny
+	}
+	if opts.AuxSMBConf != nil {
+		params["auxsmbconf"] = *opts.AuxSMBConf
+	}
+	if opts.Audit != nil {
+		params["audit"] = smbAuditParams(*opts.Audit)
+	}
+	return params
+}
+
+// smbAuditParams builds the audit object of a share.
+func smbAuditParams(audit SMBAudit) map[string]any {
+	return map[string]any{
+		"enable":      audit.Enable,
+		"watch_list":  nonNilStrings(audit.WatchList),
+		"ignore_list": nonNilStrings(audit.IgnoreList),
+	}
+}
+
+// smbShareACLEntryParams builds one share_acl entry for sharing.smb.setacl.
+func smbShareACLEntryParams(e SMBShareACLEntry) map[string]any {
+	entry := map[string]any{
+		"ae_perm": string(e.Permission),
+		"ae_type": string(e.Type),
+	}
+	switch {
+	case e.SID != "":
+		entry["ae_who_sid"] = e.SID
+	case e.ID != nil:
+		entry["ae_who_id"] = map[string]any{"id_type": e.IDType, "id": *e.ID}
+	default:
+		entry["ae_who_str"] = e.Who
+	}
+	return entry
+}
+
+// smbConfigUpdateParams builds API parameters for smb.update.
+func smbConfigUpdateParams(opts UpdateSMBConfigOpts) map[string]any {
+	params := map[string]any{}
+	if opts.NetBIOSName != "" {
+		params["netbiosname"] = opts.NetBIOSName
+	}
+	if opts.NetBIOSAlias != nil {
+		params["netbiosalias"] = opts.NetBIOSAlias
+	}
+	if opts.Workgroup != "" {
+		params["workgroup"] = opts.Workgroup
+	}
+	if opts.Description != nil {
+		params["description"] = *opts.Description
+	}
+	if opts.EnableSMB1 != nil {
+		params["enable_smb1"] = *opts.EnableSMB1
+	}
+	if opts.LocalMaster != nil {
+		params["localmaster"] = *opts.LocalMaster
+	}
+	if opts.Syslog != nil {
+		params["syslog"] = *opts.Syslog
+	}
+	if opts.AAPLExtensions != nil {
+		params["aapl_extensions"] = *opts.AAPLExtensions
+	}
+	setNullableString(params, "admin_group", opts.AdminGroup)
+	if opts.Guest != "" {
+		params["guest"] = opts.Guest
+	}
+	if opts.NTLMv1Auth != nil {
+		params["ntlmv1_auth"] = *opts.NTLMv1Auth
+	}
+	if opts.Multichannel != nil {
+		params["multichannel"] = *opts.Multichannel
+	}
+	if opts.Encryption != "" {
+		params["encryption"] = string(opts.Encryption)
+	}
+	if opts.BindIP != nil {
+		params["bindip"] = opts.BindIP
+	}
+	if opts.SMBOptions != nil {
+		params["smb_options"] = *opts.SMBOptions
+	}
+	if opts.Debug != nil {
+		params["debug"] = *opts.Debug
+	}
+	return params
+}
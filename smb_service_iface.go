@@ -0,0 +1,114 @@
+package truenas
+
+import "context"
+
+// SMBServiceAPI defines the interface for SMB share and service operations.
+type SMBServiceAPI interface {
+	CreateShare(ctx context.Context, opts CreateSMBShareOpts) (*SMBShare, error)
+	GetShare(ctx context.Context, id int64) (*SMBShare, error)
+	GetShareByName(ctx context.Context, name string) (*SMBShare, error)
+	ListShares(ctx context.Context) ([]SMBShare, error)
+	UpdateShare(ctx context.Context, id int64, opts UpdateSMBShareOpts) (*SMBShare, error)
+	DeleteShare(ctx context.Context, id int64) error
+	GetShareACL(ctx context.Context, shareName string) ([]SMBShareACLEntry, error)
+	SetShareACL(ctx context.Context, shareName string, entries []SMBShareACLEntry) ([]SMBShareACLEntry, error)
+	ListPresets(ctx context.Context) ([]SMBPreset, error)
+	GetConfig(ctx context.Context) (*SMBConfig, error)
+	UpdateConfig(ctx context.Context, opts UpdateSMBConfigOpts) (*SMBConfig, error)
+}
+
+// Compile-time checks.
+var _ SMBServiceAPI = (*SMBService)(nil)
+var _ SMBServiceAPI = (*MockSMBService)(nil)
+
+// MockSMBService is a test double for SMBServiceAPI.
+type MockSMBService struct {
+	CreateShareFunc    func(ctx context.Context, opts CreateSMBShareOpts) (*SMBShare, error)
+	GetShareFunc       func(ctx context.Context, id int64) (*SMBShare, error)
+	GetShareByNameFunc func(ctx context.Context, name string) (*SMBShare, error)
+	ListSharesFunc     func(ctx context.Context) ([]SMBShare, error)
+	UpdateShareFunc    func(ctx context.Context, id int64, opts UpdateSMBShareOpts) (*SMBShare, error)
+	DeleteShareFunc    func(ctx context.Context, id int64) error
+	GetShareACLFunc    func(ctx context.Context, shareName string) ([]SMBShareACLEntry, error)
+	SetShareACLFunc    func(ctx context.Context, shareName string, entries []SMBShareACLEntry) ([]SMBShareACLEntry, error)
+	ListPresetsFunc    func(ctx context.Context) ([]SMBPreset, error)
+	GetConfigFunc      func(ctx context.Context) (*SMBConfig, error)
+	UpdateConfigFunc   func(ctx context.Context, opts UpdateSMBConfigOpts) (*SMBConfig, error)
+}
+
+func (m *MockSMBService) CreateShare(ctx context.Context, opts CreateSMBShareOpts) (*SMBShare, error) {
+	if m.CreateShareFunc != nil {
+		return m.CreateShareFunc(ctx, opts)
+	}
+	return nil, nil
+}
+
+func (m *MockSMBService) GetShare(ctx context.Context, id int64) (*SMBShare, error) {
+	if m.GetShareFunc != nil {
+		return m.GetShareFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockSMBService) GetShareByName(ctx context.Context, name string) (*SMBShare, error) {
+	if m.GetShareByNameFunc != nil {
+		return m.GetShareByNameFunc(ctx, name)
+	}
+	return nil, nil
+}
+
+func (m *MockSMBService) ListShares(ctx context.Context) ([]SMBShare, error) {
+	if m.ListSharesFunc != nil {
+		return m.ListSharesFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockSMBService) UpdateShare(ctx context.Context, id int64, opts UpdateSMBShareOpts) (*SMBShare, error) {
+	if m.UpdateShareFunc != nil {
+		return m.UpdateShareFunc(ctx, id, opts)
+	}
+	return nil, nil
+}
+
+func (m *MockSMBService) DeleteShare(ctx context.Context, id int64) error {
+	if m.DeleteShareFunc != nil {
+		return m.DeleteShareFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockSMBService) GetShareACL(ctx context.Context, shareName string) ([]SMBShareACLEntry, error) {
+	if m.GetShareACLFunc != nil {
+		return m.GetShareACLFunc(ctx, shareName)
+	}
+	return nil, nil
+}
+
+func (m *MockSMBService) SetShareACL(ctx context.Context, shareName string, entries []SMBShareACLEntry) ([]SMBShareACLEntry, error) {
+	if m.SetShareACLFunc != nil {
+		return m.SetShareACLFunc(ctx, shareName, entries)
+	}
+	return nil, nil
+}
+
+func (m *MockSMBService) ListPresets(ctx context.Context) ([]SMBPreset, error) {
+	if m.ListPresetsFunc != nil {
+		return m.ListPresetsFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockSMBService) GetConfig(ctx context.Context) (*SMBConfig, error) {
+	if m.GetConfigFunc != nil {
+		return m.GetConfigFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockSMBService) UpdateConfig(ctx context.Context, opts UpdateSMBConfigOpts) (*SMBConfig, error) {
+	if m.UpdateConfigFunc != nil {
+		return m.UpdateConfigFunc(ctx, opts)
+	}
+	return nil, nil
+}
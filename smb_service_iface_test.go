@@ -0,0 +1,51 @@
+package truenas
+
+import (
+	"context"
+	"testing"
+)
+
+func TestMockSMBService_ImplementsInterface(t *testing.T) {
+	var _ SMBServiceAPI = (*SMBService)(nil)
+	var _ SMBServiceAPI = (*MockSMBService)(nil)
+}
+
+func TestMockSMBService_DefaultsToNil(t *testing.T) {
+	mock := &MockSMBService{}
+	ctx := context.Background()
+
+	share, err := mock.GetShare(ctx, 1)
+	if err != nil {
+		t.Fatalf("expected nil error, got: %v", err)
+	}
+	if share != nil {
+		t.Fatalf("expected nil result, got: %v", share)
+	}
+
+	config, err := mock.GetConfig(ctx)
+	if err != nil || config != nil {
+		t.Fatalf("expected nil, nil from GetConfig, got: %v, %v", config, err)
+	}
+}
+
+func TestMockSMBService_CallsFunc(t *testing.T) {
+	called := false
+	mock := &MockSMBService{
+		SetShareACLFunc: func(ctx context.Context, shareName string, entries []SMBShareACLEntry) ([]SMBShareACLEntry, error) {
+			called = true
+			return entries, nil
+		},
+	}
+
+	entries := []SMBShareACLEntry{{Permission: SMBPermissionRead, Type: SMBACLAllowed, SID: "S-1-1-0"}}
+	acl, err := mock.SetShareACL(context.Background(), "data", entries)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !called {
+		t.Fatal("expected SetShareACLFunc to be called")
+	}
+	if len(acl) != 1 || acl[0].Permission != SMBPermissionRead {
+		t.Fatalf("unexpected ACL: %+v", acl)
+	}
+}
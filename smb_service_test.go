@@ -0,0 +1,372 @@
+package truenas
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// sampleSMBShareJSON returns a single JSON object response for
+// sharing.smb.get_instance.
+func sampleSMBShareJSON() json.RawMessage {
+	return json.RawMessage(`{
+		"id": 3,
+		"purpose": "TIMEMACHINE",
+		"path": "/mnt/tank/backups/mac",
+		"name": "timemachine",
+		"comment": "Mac backups",
+		"ro": false,
+		"browsable": true,
+		"timemachine": true,
+		"timemachine_quota": 536870912000,
+		"recyclebin": false,
+		"guestok": false,
+		"abe": true,
+		"hostsallow": ["192.168.1.0/24"],
+		"hostsdeny": ["ALL"],
+		"aapl_name_mangling": false,
+		"acl": true,
+		"durablehandle": true,
+		"shadowcopy": true,
+		"streams": true,
+		"fsrvp": false,
+		"auxsmbconf": "",
+		"enabled": true,
+		"afp": false,
+		"audit": {"enable": true, "watch_list": ["staff"], "ignore_list": []},
+		"path_local": "/mnt/tank/backups/mac",
+		"locked": false
+	}`)
+}
+
+func TestSMBService_CreateShare(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return sampleSMBShareJSON(), nil
+		},
+	}
+
+	svc := NewSMBService(mock, Version{})
+	share, err := svc.CreateShare(context.Background(), CreateSMBShareOpts{
+		Name:             "timemachine",
+		Path:             "/mnt/tank/backups/mac",
+		Purpose:          SMBPurposeTimeMachine,
+		Browsable:        BoolPtr(false),
+		TimeMachineQuota: 536870912000,
+		HostsAllow:       []string{"192.168.1.0/24"},
+		Audit:            &SMBAudit{Enable: true, WatchList: []string{"staff"}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if mock.calls[0].Method != "sharing.smb.create" {
+		t.Fatalf("expected method sharing.smb.create, got %s", mock.calls[0].Method)
+	}
+	want := map[string]any{
+		"name":              "timemachine",
+		"path":              "/mnt/tank/backups/mac",
+		"purpose":           "TIMEMACHINE",
+		"browsable":         false,
+		"timemachine_quota": int64(536870912000),
+		"hostsallow":        []string{"192.168.1.0/24"},
+		"audit": map[string]any{
+			"enable":      true,
+			"watch_list":  []string{"staff"},
+			"ignore_list": []string{},
+		},
+	}
+	if !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("expected params %v, got %v", want, mock.calls[0].Params)
+	}
+	if mock.calls[1].Method != "sharing.smb.get_instance" || mock.calls[1].Params != int64(3) {
+		t.Errorf("expected re-read of share 3, got %s %v", mock.calls[1].Method, mock.calls[1].Params)
+	}
+
+	if share.ID != 3 || share.Purpose != SMBPurposeTimeMachine || !share.TimeMachine || !share.AccessBasedEnumeration {
+		t.Errorf("unexpected share: %+v", share)
+	}
+	if !reflect.DeepEqual(share.HostsDeny, []string{"ALL"}) || !share.Audit.Enable || share.Audit.WatchList[0] != "staff" {
+		t.Errorf("unexpected share: %+v", share)
+	}
+}
+
+func TestSMBService_CreateShare_Error(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return nil, errors.New("[EINVAL] sharingsmb_create.path: path must reside within a pool")
+		},
+	}
+
+	svc := NewSMBService(mock, Version{})
+	if _, err := svc.CreateShare(context.Background(), CreateSMBShareOpts{Name: "x", Path: "/tmp"}); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestSMBService_GetShare_NotFound(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return nil, errors.New("[ENOENT] None: SMB share 9 does not exist")
+		},
+	}
+
+	svc := NewSMBService(mock, Version{})
+	share, err := svc.GetShare(context.Background(), 9)
+	if err != nil || share != nil {
+		t.Errorf("expected nil, nil, got %+v, %v", share, err)
+	}
+}
+
+func TestSMBService_ListShares_And_GetShareByName(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			if method != "sharing.smb.query" {
+				t.Errorf("expected method sharing.smb.query, got %s", method)
+			}
+			if params != nil {
+				return json.RawMessage(`[]`), nil
+			}
+			return json.RawMessage(`[` + string(sampleSMBShareJSON()) + `]`), nil
+		},
+	}
+
+	svc := NewSMBService(mock, Version{})
+	shares, err := svc.ListShares(context.Background())
+	if err != nil || len(shares) != 1 || shares[0].Name != "timemachine" {
+		t.Errorf("unexpected result: %+v, %v", shares, err)
+	}
+
+	share, err := svc.GetShareByName(context.Background(), "missing")
+	if err != nil || share != nil {
+		t.Errorf("expected nil, nil, got %+v, %v", share, err)
+	}
+	if want := [][]any{{"name", "=", "missing"}}; !reflect.DeepEqual(mock.calls[1].Params, want) {
+		t.Errorf("expected filter %v, got %v", want, mock.calls[1].Params)
+	}
+}
+
+func TestSMBService_UpdateShare(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return sampleSMBShareJSON(), nil
+		},
+	}
+
+	svc := NewSMBService(mock, Version{})
+	_, err := svc.UpdateShare(context.Background(), 3, UpdateSMBShareOpts{
+		ReadOnly:  BoolPtr(true),
+		HostsDeny: []string{},
+		Comment:   StringPtr(""),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []any{int64(3), map[string]any{"ro": true, "hostsdeny": []string{}, "comment": ""}}
+	if mock.calls[0].Method != "sharing.smb.update" || !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("unexpected call: %s %v", mock.calls[0].Method, mock.calls[0].Params)
+	}
+}
+
+func TestSMBService_DeleteShare(t *testing.T) {
+	mock := &mockCaller{}
+
+	svc := NewSMBService(mock, Version{})
+	if err := svc.DeleteShare(context.Background(), 3); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if mock.calls[0].Method != "sharing.smb.delete" || mock.calls[0].Params != int64(3) {
+		t.Errorf("unexpected call: %s %v", mock.calls[0].Method, mock.calls[0].Params)
+	}
+}
+
+func TestSMBService_GetShareACL(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return json.RawMessage(`{
+				"share_name": "timemachine",
+				"share_acl": [
+					{"ae_perm": "FULL", "ae_type": "ALLOWED", "ae_who_sid": "S-1-1-0", "ae_who_id": null, "ae_who_str": null},
+					{"ae_perm": "READ", "ae_type": "DENIED", "ae_who_sid": null, "ae_who_id": {"id_type": "GROUP", "id": 3001}, "ae_who_str": null}
+				]
+			}`), nil
+		},
+	}
+
+	svc := NewSMBService(mock, Version{})
+	acl, err := svc.GetShareACL(context.Background(), "timemachine")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if mock.calls[0].Method != "sharing.smb.getacl" || !reflect.DeepEqual(mock.calls[0].Params, map[string]any{"share_name": "timemachine"}) {
+		t.Errorf("unexpected call: %s %v", mock.calls[0].Method, mock.calls[0].Params)
+	}
+	if len(acl) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(acl))
+	}
+	if acl[0].Permission != SMBPermissionFull || acl[0].Type != SMBACLAllowed || acl[0].SID != "S-1-1-0" || acl[0].ID != nil {
+		t.Errorf("unexpected entry 0: %+v", acl[0])
+	}
+	if acl[1].Type != SMBACLDenied || acl[1].IDType != "GROUP" || acl[1].ID == nil || *acl[1].ID != 3001 {
+		t.Errorf("unexpected entry 1: %+v", acl[1])
+	}
+}
+
+func TestSMBService_SetShareACL(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return json.RawMessage(`{"share_name": "data", "share_acl": [{"ae_perm": "CHANGE", "ae_type": "ALLOWED", "ae_who_sid": "S-1-5-21-1-2-3-1000", "ae_who_id": null, "ae_who_str": "staff"}]}`), nil
+		},
+	}
+
+	svc := NewSMBService(mock, Version{})
+	acl, err := svc.SetShareACL(context.Background(), "data", []SMBShareACLEntry{
+		{Permission: SMBPermissionChange, Type: SMBACLAllowed, Who: "staff"},
+		{Permission: SMBPermissionRead, Type: SMBACLAllowed, IDType: "USER", ID: Int64Ptr(3000)},
+		{Permission: SMBPermissionFull, Type: SMBACLDenied, SID: "S-1-1-0"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]any{
+		"share_name": "data",
+		"share_acl": []map[string]any{
+			{"ae_perm": "CHANGE", "ae_type": "ALLOWED", "ae_who_str": "staff"},
+			{"ae_perm": "READ", "ae_type": "ALLOWED", "ae_who_id": map[string]any{"id_type": "USER", "id": int64(3000)}},
+			{"ae_perm": "FULL", "ae_type": "DENIED", "ae_who_sid": "S-1-1-0"},
+		},
+	}
+	if mock.calls[0].Method != "sharing.smb.setacl" || !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("unexpected call: %s %v", mock.calls[0].Method, mock.calls[0].Params)
+	}
+	if len(acl) != 1 || acl[0].Who != "staff" || acl[0].SID == "" {
+		t.Errorf("unexpected stored ACL: %+v", acl)
+	}
+}
+
+func TestSMBService_ListPresets(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			if method != "sharing.smb.presets" {
+				t.Errorf("expected method sharing.smb.presets, got %s", method)
+			}
+			return json.RawMessage(`{
+				"TIMEMACHINE": {"verbose_name": "Basic time machine share", "params": {"timemachine": true, "auxsmbconf": ""}},
+				"DEFAULT_SHARE": {"verbose_name": "Default share parameters", "params": {}}
+			}`), nil
+		},
+	}
+
+	svc := NewSMBService(mock, Version{})
+	presets, err := svc.ListPresets(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(presets) != 2 || presets[0].Purpose != SMBPurposeDefaultShare || presets[1].Purpose != SMBPurposeTimeMachine {
+		t.Fatalf("unexpected presets: %+v", presets)
+	}
+	if presets[1].VerboseName != "Basic time machine share" || presets[1].Params["timemachine"] != true {
+		t.Errorf("unexpected preset: %+v", presets[1])
+	}
+}
+
+// sampleSMBConfigJSON returns a JSON response for smb.config.
+func sampleSMBConfigJSON() json.RawMessage {
+	return json.RawMessage(`{
+		"id": 1,
+		"netbiosname": "truenas",
+		"netbiosalias": [],
+		"workgroup": "WORKGROUP",
+		"description": "TrueNAS Server",
+		"enable_smb1": false,
+		"unixcharset": "UTF-8",
+		"localmaster": false,
+		"syslog": false,
+		"aapl_extensions": true,
+		"admin_group": null,
+		"guest": "nobody",
+		"filemask": "DEFAULT",
+		"dirmask": "DEFAULT",
+		"ntlmv1_auth": false,
+		"multichannel": true,
+		"encryption": "DESIRED",
+		"bindip": [],
+		"server_sid": "S-1-5-21-1-2-3",
+		"smb_options": "",
+		"debug": false
+	}`)
+}
+
+func TestSMBService_GetConfig(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			if method != "smb.config" {
+				t.Errorf("expected method smb.config, got %s", method)
+			}
+			return sampleSMBConfigJSON(), nil
+		},
+	}
+
+	svc := NewSMBService(mock, Version{})
+	config, err := svc.GetConfig(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if config.NetBIOSName != "truenas" || config.Workgroup != "WORKGROUP" || !config.Multichannel {
+		t.Errorf("unexpected config: %+v", config)
+	}
+	if config.Encryption != SMBEncryptionDesired || config.AdminGroup != "" || config.ServerSID != "S-1-5-21-1-2-3" {
+		t.Errorf("unexpected config: %+v", config)
+	}
+}
+
+func TestSMBService_UpdateConfig(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return sampleSMBConfigJSON(), nil
+		},
+	}
+
+	svc := NewSMBService(mock, Version{})
+	config, err := svc.UpdateConfig(context.Background(), UpdateSMBConfigOpts{
+		Workgroup:    "WORKGROUP",
+		Multichannel: BoolPtr(true),
+		Encryption:   SMBEncryptionDesired,
+		AdminGroup:   StringPtr(""),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]any{
+		"workgroup":    "WORKGROUP",
+		"multichannel": true,
+		"encryption":   "DESIRED",
+		"admin_group":  nil,
+	}
+	if mock.calls[0].Method != "smb.update" || !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("unexpected call: %s %v", mock.calls[0].Method, mock.calls[0].Params)
+	}
+	// smb.update returns the new configuration, so no re-read is needed.
+	if len(mock.calls) != 1 || config.NetBIOSName != "truenas" {
+		t.Errorf("expected a single call returning the config, got %d calls, %+v", len(mock.calls), config)
+	}
+}
+
+func TestSMBService_UpdateConfig_ParseError(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return json.RawMessage(`not json`), nil
+		},
+	}
+
+	svc := NewSMBService(mock, Version{})
+	if _, err := svc.UpdateConfig(context.Background(), UpdateSMBConfigOpts{}); err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -24,7 +24,14 @@ type UserRefsProperty struct {
 
 // PropertyValue represents a ZFS property with a string value.
 type PropertyValue struct {
-	Value string `json:"value"`
+	Value  string `json:"value"`
+	Source string `json:"source"` // e.g. "LOCAL", "INHERITED", "DEFAULT"
+}
+
+// Inherited reports whether the value comes from a parent dataset or the
+// default rather than being set on the dataset itself.
+func (p PropertyValue) Inherited() bool {
+	return p.Source == "INHERITED" || p.Source == "DEFAULT"
 }
 
 // ParsedValue represents a ZFS property with a parsed numeric value.
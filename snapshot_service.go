@@ -6,27 +6,6 @@ import (
 	"fmt"
 )
 
-// Snapshot method names (without prefix).
-const (
-	methodSnapshotCreate   = "create"
-	methodSnapshotQuery    = "query"
-	methodSnapshotDelete   = "delete"
-	methodSnapshotHold     = "hold"
-	methodSnapshotRelease  = "release"
-	methodSnapshotClone    = "clone"
-	methodSnapshotRollback = "rollback"
-)
-
-// resolveSnapshotMethod returns the full API method name for the given version.
-// Pre-25.10 uses "zfs.snapshot.*", 25.10+ uses "pool.snapshot.*".
-func resolveSnapshotMethod(v Version, method string) string {
-	prefix := "zfs.snapshot"
-	if v.AtLeast(25, 10) {
-		prefix = "pool.snapshot"
-	}
-	return prefix + "." + method
-}
-
 // Snapshot is the user-facing representation of a TrueNAS ZFS snapshot.
 type Snapshot struct {
 	ID           string
@@ -66,8 +45,7 @@ func (s *SnapshotService) Create(ctx context.Context, opts CreateSnapshotOpts) (
 		params["recursive"] = true
 	}
 
-	method := resolveSnapshotMethod(s.version, methodSnapshotCreate)
-	_, err := s.client.Call(ctx, method, params)
+	_, err := callMethod(ctx, s.client, s.version, "zfs.snapshot.create", params)
 	if err != nil {
 		return nil, err
 	}
@@ -79,8 +57,7 @@ func (s *SnapshotService) Create(ctx context.Context, opts CreateSnapshotOpts) (
 // Get returns a snapshot by ID, or nil if not found.
 func (s *SnapshotService) Get(ctx context.Context, id string) (*Snapshot, error) {
 	filter := [][]any{{"id", "=", id}}
-	method := resolveSnapshotMethod(s.version, methodSnapshotQuery)
-	result, err := s.client.Call(ctx, method, filter)
+	result, err := callMethod(ctx, s.client, s.version, "zfs.snapshot.query", filter)
 	if err != nil {
 		return nil, err
 	}
@@ -100,8 +77,7 @@ func (s *SnapshotService) Get(ctx context.Context, id string) (*Snapshot, error)
 
 // List returns all snapshots.
 func (s *SnapshotService) List(ctx context.Context) ([]Snapshot, error) {
-	method := resolveSnapshotMethod(s.version, methodSnapshotQuery)
-	result, err := s.client.Call(ctx, method, nil)
+	result, err := callMethod(ctx, s.client, s.version, "zfs.snapshot.query", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -120,22 +96,19 @@ func (s *SnapshotService) List(ctx context.Context) ([]Snapshot, error) {
 
 // Delete deletes a snapshot by ID.
 func (s *SnapshotService) Delete(ctx context.Context, id string) error {
-	method := resolveSnapshotMethod(s.version, methodSnapshotDelete)
-	_, err := s.client.Call(ctx, method, id)
+	_, err := callMethod(ctx, s.client, s.version, "zfs.snapshot.delete", id)
 	return err
 }
 
 // Hold places a hold on a snapshot.
 func (s *SnapshotService) Hold(ctx context.Context, id string) error {
-	method := resolveSnapshotMethod(s.version, methodSnapshotHold)
-	_, err := s.client.Call(ctx, method, id)
+	_, err := callMethod(ctx, s.client, s.version, "zfs.snapshot.hold", id)
 	return err
 }
 
 // Release releases a hold on a snapshot.
 func (s *SnapshotService) Release(ctx context.Context, id string) error {
-	method := resolveSnapshotMethod(s.version, methodSnapshotRelease)
-	_, err := s.client.Call(ctx, method, id)
+	_, err := callMethod(ctx, s.client, s.version, "zfs.snapshot.release", id)
 	return err
 }
 
@@ -148,8 +121,7 @@ func (s *SnapshotService) Query(ctx context.Context, filters [][]any) ([]Snapsho
 		params = filters
 	}
 
-	method := resolveSnapshotMethod(s.version, methodSnapshotQuery)
-	result, err := s.client.Call(ctx, method, params)
+	result, err := callMethod(ctx, s.client, s.version, "zfs.snapshot.query", params)
 	if err != nil {
 		return nil, err
 	}
@@ -168,8 +140,7 @@ func (s *SnapshotService) Query(ctx context.Context, filters [][]any) ([]Snapsho
 
 // Rollback rolls back to the given snapshot by ID (dataset@name).
 func (s *SnapshotService) Rollback(ctx context.Context, id string) error {
-	method := resolveSnapshotMethod(s.version, methodSnapshotRollback)
-	_, err := s.client.Call(ctx, method, id)
+	_, err := callMethod(ctx, s.client, s.version, "zfs.snapshot.rollback", id)
 	return err
 }
 
@@ -179,8 +150,7 @@ func (s *SnapshotService) Clone(ctx context.Context, snapshot, datasetDst string
 		"snapshot":    snapshot,
 		"dataset_dst": datasetDst,
 	}
-	method := resolveSnapshotMethod(s.version, methodSnapshotClone)
-	_, err := s.client.Call(ctx, method, params)
+	_, err := callMethod(ctx, s.client, s.version, "zfs.snapshot.clone", params)
 	return err
 }
 
@@ -196,3 +166,30 @@ func snapshotFromResponse(resp SnapshotResponse) Snapshot {
 		HasHold:      resp.HasHold(),
 	}
 }
+
+// snapshotProperties are the properties SnapshotResponse decodes.
+var snapshotProperties = []string{"createtxg", "used", "referenced", "userrefs"}
+
+// snapshotQueryParams adapts zfs.snapshot.query params for pool.snapshot.query,
+// which returns only the properties named in query-options.extra.properties.
+// It accepts filters alone ([][]any) or positional [filters]; params that
+// already carry query options are passed through unchanged.
+func snapshotQueryParams(params any) any {
+	var filters any = []any{}
+	switch p := params.(type) {
+	case nil:
+	case [][]any:
+		filters = p
+	case []any:
+		if len(p) > 1 {
+			return params
+		}
+		if len(p) == 1 {
+			filters = p[0]
+		}
+	default:
+		return params
+	}
+	options := map[string]any{"extra": map[string]any{"properties": snapshotProperties}}
+	return []any{filters, options}
+}
@@ -4,69 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"reflect"
 	"testing"
 )
 
-func TestResolveSnapshotMethod(t *testing.T) {
-	tests := []struct {
-		name    string
-		version Version
-		method  string
-		want    string
-	}{
-		{
-			name:    "24.10 uses zfs.snapshot",
-			version: Version{Major: 24, Minor: 10},
-			method:  methodSnapshotCreate,
-			want:    "zfs.snapshot.create",
-		},
-		{
-			name:    "25.04 uses zfs.snapshot",
-			version: Version{Major: 25, Minor: 4},
-			method:  methodSnapshotQuery,
-			want:    "zfs.snapshot.query",
-		},
-		{
-			name:    "25.10 uses pool.snapshot",
-			version: Version{Major: 25, Minor: 10},
-			method:  methodSnapshotCreate,
-			want:    "pool.snapshot.create",
-		},
-		{
-			name:    "26.0 uses pool.snapshot",
-			version: Version{Major: 26, Minor: 0},
-			method:  methodSnapshotDelete,
-			want:    "pool.snapshot.delete",
-		},
-		{
-			name:    "hold method",
-			version: Version{Major: 24, Minor: 10},
-			method:  methodSnapshotHold,
-			want:    "zfs.snapshot.hold",
-		},
-		{
-			name:    "release method",
-			version: Version{Major: 25, Minor: 10},
-			method:  methodSnapshotRelease,
-			want:    "pool.snapshot.release",
-		},
-		{
-			name:    "clone method",
-			version: Version{Major: 24, Minor: 10},
-			method:  methodSnapshotClone,
-			want:    "zfs.snapshot.clone",
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			if got := resolveSnapshotMethod(tt.version, tt.method); got != tt.want {
-				t.Errorf("resolveSnapshotMethod() = %v, want %v", got, tt.want)
-			}
-		})
-	}
-}
-
 // sampleSnapshotJSON returns a JSON response for a single snapshot with no hold.
 func sampleSnapshotJSON() json.RawMessage {
 	return json.RawMessage(`[{
@@ -329,6 +270,56 @@ func TestSnapshotService_Get(t *testing.T) {
 	}
 }
 
+func TestSnapshotService_Get_VersionPrefix(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			if method != "pool.snapshot.query" {
+				t.Errorf("expected method pool.snapshot.query, got %s", method)
+			}
+			want := []any{
+				[][]any{{"id", "=", "pool/dataset@snap1"}},
+				map[string]any{"extra": map[string]any{"properties": []string{"createtxg", "used", "referenced", "userrefs"}}},
+			}
+			if !reflect.DeepEqual(params, want) {
+				t.Errorf("params = %#v, want %#v", params, want)
+			}
+			return sampleSnapshotJSON(), nil
+		},
+	}
+
+	svc := NewSnapshotService(mock, Version{Major: 25, Minor: 10})
+	snap, err := svc.Get(context.Background(), "pool/dataset@snap1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if snap == nil || snap.Used != 1024 {
+		t.Errorf("unexpected snapshot %+v", snap)
+	}
+}
+
+func TestSnapshotQueryParams(t *testing.T) {
+	filters := [][]any{{"dataset", "=", "tank"}}
+	options := map[string]any{"extra": map[string]any{"properties": snapshotProperties}}
+	custom := []any{filters, map[string]any{"extra": map[string]any{"holds": true}}}
+	tests := []struct {
+		name   string
+		params any
+		want   any
+	}{
+		{"none", nil, []any{[]any{}, options}},
+		{"filters", filters, []any{filters, options}},
+		{"positional filters", []any{filters}, []any{filters, options}},
+		{"own options", custom, custom},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := snapshotQueryParams(tt.params); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestSnapshotService_Get_WithHold(t *testing.T) {
 	mock := &mockCaller{
 		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
@@ -0,0 +1,18 @@
+package truenas
+
+// SnapshotTaskResponse represents a periodic snapshot task from the
+// pool.snapshottask query API.
+type SnapshotTaskResponse struct {
+	ID            int64                    `json:"id"`
+	Dataset       string                   `json:"dataset"`
+	Recursive     bool                     `json:"recursive"`
+	Exclude       []string                 `json:"exclude"`
+	LifetimeValue int64                    `json:"lifetime_value"`
+	LifetimeUnit  string                   `json:"lifetime_unit"`
+	NamingSchema  string                   `json:"naming_schema"`
+	AllowEmpty    bool                     `json:"allow_empty"`
+	Enabled       bool                     `json:"enabled"`
+	Schedule      WindowedScheduleResponse `json:"schedule"`
+	VMwareSync    bool                     `json:"vmware_sync"`
+	State         TaskStateResponse        `json:"state"`
+}
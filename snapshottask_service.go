@@ -0,0 +1,312 @@
+package truenas
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// SnapshotTask is the user-facing representation of a periodic snapshot
+// task.
+type SnapshotTask struct {
+	ID            int64
+	Dataset       string
+	Recursive     bool
+	Exclude       []string
+	LifetimeValue int64
+	LifetimeUnit  LifetimeUnit
+	// NamingSchema is the strftime-style name of the snapshots taken, e.g.
+	// "auto-%Y-%m-%d_%H-%M".
+	NamingSchema string
+	AllowEmpty   bool
+	Enabled      bool
+	Schedule     WindowedSchedule
+	VMwareSync   bool
+	State        TaskState
+}
+
+// CreateSnapshotTaskOpts contains options for creating a periodic snapshot
+// task. Only Dataset is required.
+type CreateSnapshotTaskOpts struct {
+	Dataset       string
+	Recursive     bool
+	Exclude       []string
+	LifetimeValue int64             // 0 = server default (2)
+	LifetimeUnit  LifetimeUnit      // Default: WEEK
+	NamingSchema  string            // Default: "auto-%Y-%m-%d_%H-%M"
+	AllowEmpty    *bool             // Default: true
+	Enabled       *bool             // Default: true
+	Schedule      *WindowedSchedule // Default: hourly
+}
+
+// UpdateSnapshotTaskOpts contains options for updating a periodic snapshot
+// task. Nil fields are left unchanged.
+type UpdateSnapshotTaskOpts struct {
+	Dataset       string // Empty = don't change
+	Recursive     *bool
+	Exclude       []string
+	LifetimeValue *int64
+	LifetimeUnit  LifetimeUnit // Empty = don't change
+	NamingSchema  string       // Empty = don't change
+	AllowEmpty    *bool
+	Enabled       *bool
+	Schedule      *WindowedSchedule
+	// FixateRemovalDate keeps the current removal date of existing snapshots
+	// whose retention the update would change. See PreviewUpdateRetention.
+	FixateRemovalDate bool
+}
+
+// SnapshotTaskService provides typed methods for the pool.snapshottask.* API
+// namespace. pool.snapshottask.foreseen_count is private to the middleware
+// (absent from the 25.04 catalog) and has no wrapper.
+type SnapshotTaskService struct {
+	client  Caller
+	version Version
+}
+
+// NewSnapshotTaskService creates a new SnapshotTaskService.
+func NewSnapshotTaskService(c Caller, v Version) *SnapshotTaskService {
+	return &SnapshotTaskService{client: c, version: v}
+}
+
+// Create creates a periodic snapshot task and returns the full object.
+func (s *SnapshotTaskService) Create(ctx context.Context, opts CreateSnapshotTaskOpts) (*SnapshotTask, error) {
+	params := snapshotTaskCreateParams(opts)
+	result, err := callMethod(ctx, s.client, s.version, "pool.snapshottask.create", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var createResp struct {
+		ID int64 `json:"id"`
+	}
+	if err := json.Unmarshal(result, &createResp); err != nil {
+		return nil, fmt.Errorf("parse create response: %w", err)
+	}
+
+	return s.Get(ctx, createResp.ID)
+}
+
+// Get returns a periodic snapshot task by ID, or nil if not found.
+func (s *SnapshotTaskService) Get(ctx context.Context, id int64) (*SnapshotTask, error) {
+	result, err := callMethod(ctx, s.client, s.version, "pool.snapshottask.get_instance", id)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var resp SnapshotTaskResponse
+	if err := json.Unmarshal(result, &resp); err != nil {
+		return nil, fmt.Errorf("parse get_instance response: %w", err)
+	}
+
+	task := snapshotTaskFromResponse(resp)
+	return &task, nil
+}
+
+// List returns all periodic snapshot tasks.
+func (s *SnapshotTaskService) List(ctx context.Context) ([]SnapshotTask, error) {
+	result, err := callMethod(ctx, s.client, s.version, "pool.snapshottask.query", nil)
+	if err != nil {
+		return nil, err
+	}
+	return parseSnapshotTasks(result)
+}
+
+// ListByDataset returns the periodic snapshot tasks of a dataset.
+func (s *SnapshotTaskService) ListByDataset(ctx context.Context, dataset string) ([]SnapshotTask, error) {
+	filter := [][]any{{"dataset", "=", dataset}}
+	result, err := callMethod(ctx, s.client, s.version, "pool.snapshottask.query", filter)
+	if err != nil {
+		return nil, err
+	}
+	return parseSnapshotTasks(result)
+}
+
+// parseSnapshotTasks parses a pool.snapshottask.query response.
+func parseSnapshotTasks(result json.RawMessage) ([]SnapshotTask, error) {
+	var responses []SnapshotTaskResponse
+	if err := json.Unmarshal(result, &responses); err != nil {
+		return nil, fmt.Errorf("parse query response: %w", err)
+	}
+
+	tasks := make([]SnapshotTask, len(responses))
+	for i, resp := range responses {
+		tasks[i] = snapshotTaskFromResponse(resp)
+	}
+	return tasks, nil
+}
+
+// Update updates a periodic snapshot task and returns the full object.
+func (s *SnapshotTaskService) Update(ctx context.Context, id int64, opts UpdateSnapshotTaskOpts) (*SnapshotTask, error) {
+	params := snapshotTaskUpdateParams(opts)
+	if opts.FixateRemovalDate {
+		params["fixate_removal_date"] = true
+	}
+	_, err := callMethod(ctx, s.client, s.version, "pool.snapshottask.update", []any{id, params})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Get(ctx, id)
+}
+
+// Delete deletes a periodic snapshot task by ID. When fixateRemovalDate is
+// true, the snapshots it took keep their current removal date instead of
+// falling back to the retention of any remaining tasks.
+func (s *SnapshotTaskService) Delete(ctx context.Context, id int64, fixateRemovalDate bool) error {
+	params := []any{id}
+	if fixateRemovalDate {
+		params = append(params, map[string]any{"fixate_removal_date": true})
+	}
+	_, err := callMethod(ctx, s.client, s.version, "pool.snapshottask.delete", params)
+	return err
+}
+
+// Run takes a snapshot for a task now, outside its schedule. The snapshot
+// is taken in the background.
+func (s *SnapshotTaskService) Run(ctx context.Context, id int64) error {
+	_, err := callMethod(ctx, s.client, s.version, "pool.snapshottask.run", id)
+	return err
+}
+
+// MaxCount returns the maximum number of snapshots a periodic snapshot task
+// may retain for a single dataset.
+func (s *SnapshotTaskService) MaxCount(ctx context.Context) (int64, error) {
+	result, err := callMethod(ctx, s.client, s.version, "pool.snapshottask.max_count", nil)
+	if err != nil {
+		return 0, err
+	}
+
+	var count int64
+	if err := json.Unmarshal(result, &count); err != nil {
+		return 0, fmt.Errorf("parse max_count response: %w", err)
+	}
+	return count, nil
+}
+
+// MaxTotalCount returns the maximum number of snapshots all periodic
+// snapshot tasks together may retain.
+func (s *SnapshotTaskService) MaxTotalCount(ctx context.Context) (int64, error) {
+	result, err := callMethod(ctx, s.client, s.version, "pool.snapshottask.max_total_count", nil)
+	if err != nil {
+		return 0, err
+	}
+
+	var count int64
+	if err := json.Unmarshal(result, &count); err != nil {
+		return 0, fmt.Errorf("parse max_total_count response: %w", err)
+	}
+	return count, nil
+}
+
+// PreviewUpdateRetention returns, per dataset, the existing snapshots whose
+// retention would change if task id were updated with opts. Unless the
+// update sets FixateRemovalDate, these snapshots may be deleted on the next
+// retention pass. Nothing is changed.
+func (s *SnapshotTaskService) PreviewUpdateRetention(ctx context.Context, id int64, opts UpdateSnapshotTaskOpts) (map[string][]string, error) {
+	params := []any{id, snapshotTaskUpdateParams(opts)}
+	result, err := callMethod(ctx, s.client, s.version, "pool.snapshottask.update_will_change_retention_for", params)
+	if err != nil {
+		return nil, err
+	}
+	return parseRetentionChanges(result, "update_will_change_retention_for")
+}
+
+// PreviewDeleteRetention returns, per dataset, the existing snapshots whose
+// retention would change if task id were deleted. Nothing is changed.
+func (s *SnapshotTaskService) PreviewDeleteRetention(ctx context.Context, id int64) (map[string][]string, error) {
+	result, err := callMethod(ctx, s.client, s.version, "pool.snapshottask.delete_will_change_retention_for", id)
+	if err != nil {
+		return nil, err
+	}
+	return parseRetentionChanges(result, "delete_will_change_retention_for")
+}
+
+func parseRetentionChanges(result json.RawMessage, method string) (map[string][]string, error) {
+	var changes map[string][]string
+	if err := json.Unmarshal(result, &changes); err != nil {
+		return nil, fmt.Errorf("parse %s response: %w", method, err)
+	}
+	return changes, nil
+}
+
+// snapshotTaskCreateParams converts CreateSnapshotTaskOpts to API
+// parameters.
+func snapshotTaskCreateParams(opts CreateSnapshotTaskOpts) map[string]any {
+	params := map[string]any{
+		"dataset":   opts.Dataset,
+		"recursive": opts.Recursive,
+	}
+	if len(opts.Exclude) > 0 {
+		params["exclude"] = opts.Exclude
+	}
+	if opts.LifetimeValue != 0 {
+		params["lifetime_value"] = opts.LifetimeValue
+	}
+	if opts.LifetimeUnit != "" {
+		params["lifetime_unit"] = string(opts.LifetimeUnit)
+	}
+	if opts.NamingSchema != "" {
+		params["naming_schema"] = opts.NamingSchema
+	}
+	if opts.AllowEmpty != nil {
+		params["allow_empty"] = *opts.AllowEmpty
+	}
+	if opts.Enabled != nil {
+		params["enabled"] = *opts.Enabled
+	}
+	if opts.Schedule != nil {
+		params["schedule"] = windowedScheduleParams(*opts.Schedule)
+	}
+	return params
+}
+
+// snapshotTaskUpdateParams converts UpdateSnapshotTaskOpts to API
+// parameters, excluding FixateRemovalDate.
+func snapshotTaskUpdateParams(opts UpdateSnapshotTaskOpts) map[string]any {
+	params := map[string]any{}
+	if opts.Dataset != "" {
+		params["dataset"] = opts.Dataset
+	}
+	setBool(params, "recursive", opts.Recursive)
+	if opts.Exclude != nil {
+		params["exclude"] = opts.Exclude
+	}
+	if opts.LifetimeValue != nil {
+		params["lifetime_value"] = *opts.LifetimeValue
+	}
+	if opts.LifetimeUnit != "" {
+		params["lifetime_unit"] = string(opts.LifetimeUnit)
+	}
+	if opts.NamingSchema != "" {
+		params["naming_schema"] = opts.NamingSchema
+	}
+	setBool(params, "allow_empty", opts.AllowEmpty)
+	setBool(params, "enabled", opts.Enabled)
+	if opts.Schedule != nil {
+		params["schedule"] = windowedScheduleParams(*opts.Schedule)
+	}
+	return params
+}
+
+// snapshotTaskFromResponse converts a wire-format SnapshotTaskResponse to a
+// user-facing SnapshotTask.
+func snapshotTaskFromResponse(resp SnapshotTaskResponse) SnapshotTask {
+	return SnapshotTask{
+		ID:            resp.ID,
+		Dataset:       resp.Dataset,
+		Recursive:     resp.Recursive,
+		Exclude:       resp.Exclude,
+		LifetimeValue: resp.LifetimeValue,
+		LifetimeUnit:  LifetimeUnit(resp.LifetimeUnit),
+		NamingSchema:  resp.NamingSchema,
+		AllowEmpty:    resp.AllowEmpty,
+		Enabled:       resp.Enabled,
+		Schedule:      *windowedScheduleFromResponse(&resp.Schedule),
+		VMwareSync:    resp.VMwareSync,
+		State:         taskStateFromResponse(resp.State),
+	}
+}
@@ -0,0 +1,114 @@
+package truenas
+
+import "context"
+
+// SnapshotTaskServiceAPI defines the interface for periodic snapshot task operations.
+type SnapshotTaskServiceAPI interface {
+	Create(ctx context.Context, opts CreateSnapshotTaskOpts) (*SnapshotTask, error)
+	Get(ctx context.Context, id int64) (*SnapshotTask, error)
+	List(ctx context.Context) ([]SnapshotTask, error)
+	ListByDataset(ctx context.Context, dataset string) ([]SnapshotTask, error)
+	Update(ctx context.Context, id int64, opts UpdateSnapshotTaskOpts) (*SnapshotTask, error)
+	Delete(ctx context.Context, id int64, fixateRemovalDate bool) error
+	Run(ctx context.Context, id int64) error
+	MaxCount(ctx context.Context) (int64, error)
+	MaxTotalCount(ctx context.Context) (int64, error)
+	PreviewUpdateRetention(ctx context.Context, id int64, opts UpdateSnapshotTaskOpts) (map[string][]string, error)
+	PreviewDeleteRetention(ctx context.Context, id int64) (map[string][]string, error)
+}
+
+// Compile-time checks.
+var _ SnapshotTaskServiceAPI = (*SnapshotTaskService)(nil)
+var _ SnapshotTaskServiceAPI = (*MockSnapshotTaskService)(nil)
+
+// MockSnapshotTaskService is a test double for SnapshotTaskServiceAPI.
+type MockSnapshotTaskService struct {
+	CreateFunc                 func(ctx context.Context, opts CreateSnapshotTaskOpts) (*SnapshotTask, error)
+	GetFunc                    func(ctx context.Context, id int64) (*SnapshotTask, error)
+	ListFunc                   func(ctx context.Context) ([]SnapshotTask, error)
+	ListByDatasetFunc          func(ctx context.Context, dataset string) ([]SnapshotTask, error)
+	UpdateFunc                 func(ctx context.Context, id int64, opts UpdateSnapshotTaskOpts) (*SnapshotTask, error)
+	DeleteFunc                 func(ctx context.Context, id int64, fixateRemovalDate bool) error
+	RunFunc                    func(ctx context.Context, id int64) error
+	MaxCountFunc               func(ctx context.Context) (int64, error)
+	MaxTotalCountFunc          func(ctx context.Context) (int64, error)
+	PreviewUpdateRetentionFunc func(ctx context.Context, id int64, opts UpdateSnapshotTaskOpts) (map[string][]string, error)
+	PreviewDeleteRetentionFunc func(ctx context.Context, id int64) (map[string][]string, error)
+}
+
+func (m *MockSnapshotTaskService) Create(ctx context.Context, opts CreateSnapshotTaskOpts) (*SnapshotTask, error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, opts)
+	}
+	return nil, nil
+}
+
+func (m *MockSnapshotTaskService) Get(ctx context.Context, id int64) (*SnapshotTask, error) {
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockSnapshotTaskService) List(ctx context.Context) ([]SnapshotTask, error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockSnapshotTaskService) ListByDataset(ctx context.Context, dataset string) ([]SnapshotTask, error) {
+	if m.ListByDatasetFunc != nil {
+		return m.ListByDatasetFunc(ctx, dataset)
+	}
+	return nil, nil
+}
+
+func (m *MockSnapshotTaskService) Update(ctx context.Context, id int64, opts UpdateSnapshotTaskOpts) (*SnapshotTask, error) {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, id, opts)
+	}
+	return nil, nil
+}
+
+func (m *MockSnapshotTaskService) Delete(ctx context.Context, id int64, fixateRemovalDate bool) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id, fixateRemovalDate)
+	}
+	return nil
+}
+
+func (m *MockSnapshotTaskService) Run(ctx context.Context, id int64) error {
+	if m.RunFunc != nil {
+		return m.RunFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockSnapshotTaskService) MaxCount(ctx context.Context) (int64, error) {
+	if m.MaxCountFunc != nil {
+		return m.MaxCountFunc(ctx)
+	}
+	return 0, nil
+}
+
+func (m *MockSnapshotTaskService) MaxTotalCount(ctx context.Context) (int64, error) {
+	if m.MaxTotalCountFunc != nil {
+		return m.MaxTotalCountFunc(ctx)
+	}
+	return 0, nil
+}
+
+func (m *MockSnapshotTaskService) PreviewUpdateRetention(ctx context.Context, id int64, opts UpdateSnapshotTaskOpts) (map[string][]string, error) {
+	if m.PreviewUpdateRetentionFunc != nil {
+		return m.PreviewUpdateRetentionFunc(ctx, id, opts)
+	}
+	return nil, nil
+}
+
+func (m *MockSnapshotTaskService) PreviewDeleteRetention(ctx context.Context, id int64) (map[string][]string, error) {
+	if m.PreviewDeleteRetentionFunc != nil {
+		return m.PreviewDeleteRetentionFunc(ctx, id)
+	}
+	return nil, nil
+}
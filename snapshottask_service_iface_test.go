@@ -0,0 +1,50 @@
+package truenas
+
+import (
+	"context"
+	"testing"
+)
+
+func TestMockSnapshotTaskService_ImplementsInterface(t *testing.T) {
+	var _ SnapshotTaskServiceAPI = (*SnapshotTaskService)(nil)
+	var _ SnapshotTaskServiceAPI = (*MockSnapshotTaskService)(nil)
+}
+
+func TestMockSnapshotTaskService_DefaultsToNil(t *testing.T) {
+	mock := &MockSnapshotTaskService{}
+	ctx := context.Background()
+
+	task, err := mock.Get(ctx, 1)
+	if err != nil {
+		t.Fatalf("expected nil error, got: %v", err)
+	}
+	if task != nil {
+		t.Fatalf("expected nil result, got: %v", task)
+	}
+
+	count, err := mock.MaxCount(ctx)
+	if err != nil || count != 0 {
+		t.Fatalf("expected 0, nil from MaxCount, got: %d, %v", count, err)
+	}
+}
+
+func TestMockSnapshotTaskService_CallsFunc(t *testing.T) {
+	called := false
+	mock := &MockSnapshotTaskService{
+		PreviewDeleteRetentionFunc: func(ctx context.Context, id int64) (map[string][]string, error) {
+			called = true
+			return map[string][]string{"tank/data": {"auto-1"}}, nil
+		},
+	}
+
+	changes, err := mock.PreviewDeleteRetention(context.Background(), 7)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !called {
+		t.Fatal("expected PreviewDeleteRetentionFunc to be called")
+	}
+	if len(changes["tank/data"]) != 1 {
+		t.Fatalf("unexpected changes: %v", changes)
+	}
+}
@@ -0,0 +1,325 @@
+package truenas
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// sampleSnapshotTaskJSON returns a single JSON object response for
+// pool.snapshottask.get_instance.
+func sampleSnapshotTaskJSON() json.RawMessage {
+	return json.RawMessage(`{
+		"id": 7,
+		"dataset": "tank/data",
+		"recursive": true,
+		"exclude": ["tank/data/scratch"],
+		"lifetime_value": 2,
+		"lifetime_unit": "WEEK",
+		"enabled": true,
+		"naming_schema": "auto-%Y-%m-%d_%H-%M",
+		"allow_empty": false,
+		"schedule": {"minute": "0", "hour": "*", "dom": "*", "month": "*", "dow": "*", "begin": "08:00", "end": "18:00"},
+		"vmware_sync": false,
+		"state": {"state": "FINISHED", "datetime": {"$date": 1760000000000}}
+	}`)
+}
+
+func TestSnapshotTaskService_Create(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return sampleSnapshotTaskJSON(), nil
+		},
+	}
+
+	svc := NewSnapshotTaskService(mock, Version{})
+	task, err := svc.Create(context.Background(), CreateSnapshotTaskOpts{
+		Dataset:       "tank/data",
+		Recursive:     true,
+		Exclude:       []string{"tank/data/scratch"},
+		LifetimeValue: 2,
+		LifetimeUnit:  LifetimeWeek,
+		AllowEmpty:    BoolPtr(false),
+		Schedule: &WindowedSchedule{
+			Schedule: Schedule{Minute: "0", Hour: "*", Dom: "*", Month: "*", Dow: "*"},
+			Begin:    "08:00",
+			End:      "18:00",
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if mock.calls[0].Method != "pool.snapshottask.create" {
+		t.Fatalf("expected method pool.snapshottask.create, got %s", mock.calls[0].Method)
+	}
+	want := map[string]any{
+		"dataset":        "tank/data",
+		"recursive":      true,
+		"exclude":        []string{"tank/data/scratch"},
+		"lifetime_value": int64(2),
+		"lifetime_unit":  "WEEK",
+		"allow_empty":    false,
+		"schedule": map[string]any{
+			"minute": "0", "hour": "*", "dom": "*", "month": "*", "dow": "*",
+			"begin": "08:00", "end": "18:00",
+		},
+	}
+	if !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("expected params %v, got %v", want, mock.calls[0].Params)
+	}
+	if mock.calls[1].Method != "pool.snapshottask.get_instance" || mock.calls[1].Params != int64(7) {
+		t.Errorf("expected re-read of task 7, got %s %v", mock.calls[1].Method, mock.calls[1].Params)
+	}
+
+	if task.ID != 7 || task.LifetimeUnit != LifetimeWeek || task.AllowEmpty || task.NamingSchema != "auto-%Y-%m-%d_%H-%M" {
+		t.Errorf("unexpected task: %+v", task)
+	}
+	if task.Schedule.Begin != "08:00" || task.Schedule.End != "18:00" || task.Schedule.Minute != "0" {
+		t.Errorf("unexpected schedule: %+v", task.Schedule)
+	}
+	if task.State.State != "FINISHED" || !task.State.LastRun.Equal(time.UnixMilli(1760000000000)) {
+		t.Errorf("unexpected state: %+v", task.State)
+	}
+}
+
+func TestSnapshotTaskService_Create_Error(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return nil, errors.New("[EINVAL] periodic_snapshot_create.dataset: Dataset not found")
+		},
+	}
+
+	svc := NewSnapshotTaskService(mock, Version{})
+	if _, err := svc.Create(context.Background(), CreateSnapshotTaskOpts{Dataset: "tank/missing"}); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestSnapshotTaskService_Get_NotFound(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return nil, errors.New("[ENOENT] None: Periodic snapshot task 9 does not exist")
+		},
+	}
+
+	svc := NewSnapshotTaskService(mock, Version{})
+	task, err := svc.Get(context.Background(), 9)
+	if err != nil || task != nil {
+		t.Errorf("expected nil, nil, got %+v, %v", task, err)
+	}
+}
+
+func TestSnapshotTaskService_List_And_ListByDataset(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			if method != "pool.snapshottask.query" {
+				t.Errorf("expected method pool.snapshottask.query, got %s", method)
+			}
+			return json.RawMessage(`[` + string(sampleSnapshotTaskJSON()) + `]`), nil
+		},
+	}
+
+	svc := NewSnapshotTaskService(mock, Version{})
+	tasks, err := svc.List(context.Background())
+	if err != nil || len(tasks) != 1 || tasks[0].Dataset != "tank/data" {
+		t.Errorf("unexpected result: %+v, %v", tasks, err)
+	}
+	if mock.calls[0].Params != nil {
+		t.Errorf("expected no filter, got %v", mock.calls[0].Params)
+	}
+
+	tasks, err = svc.ListByDataset(context.Background(), "tank/data")
+	if err != nil || len(tasks) != 1 || tasks[0].ID != 7 {
+		t.Errorf("unexpected result: %+v, %v", tasks, err)
+	}
+	if want := [][]any{{"dataset", "=", "tank/data"}}; !reflect.DeepEqual(mock.calls[1].Params, want) {
+		t.Errorf("expected filter %v, got %v", want, mock.calls[1].Params)
+	}
+}
+
+func TestSnapshotTaskService_Update(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return sampleSnapshotTaskJSON(), nil
+		},
+	}
+
+	svc := NewSnapshotTaskService(mock, Version{})
+	_, err := svc.Update(context.Background(), 7, UpdateSnapshotTaskOpts{
+		LifetimeValue:     Int64Ptr(3),
+		LifetimeUnit:      LifetimeDay,
+		Exclude:           []string{},
+		Enabled:           BoolPtr(false),
+		FixateRemovalDate: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if mock.calls[0].Method != "pool.snapshottask.update" {
+		t.Fatalf("expected method pool.snapshottask.update, got %s", mock.calls[0].Method)
+	}
+	want := []any{int64(7), map[string]any{
+		"lifetime_value":      int64(3),
+		"lifetime_unit":       "DAY",
+		"exclude":             []string{},
+		"enabled":             false,
+		"fixate_removal_date": true,
+	}}
+	if !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("expected params %v, got %v", want, mock.calls[0].Params)
+	}
+	if mock.calls[1].Method != "pool.snapshottask.get_instance" {
+		t.Errorf("expected re-read, got %s", mock.calls[1].Method)
+	}
+}
+
+func TestSnapshotTaskService_Delete(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return json.RawMessage(`true`), nil
+		},
+	}
+
+	svc := NewSnapshotTaskService(mock, Version{})
+	if err := svc.Delete(context.Background(), 7, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := svc.Delete(context.Background(), 7, true); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if mock.calls[0].Method != "pool.snapshottask.delete" {
+		t.Fatalf("expected method pool.snapshottask.delete, got %s", mock.calls[0].Method)
+	}
+	if want := []any{int64(7)}; !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("expected params %v, got %v", want, mock.calls[0].Params)
+	}
+	if want := []any{int64(7), map[string]any{"fixate_removal_date": true}}; !reflect.DeepEqual(mock.calls[1].Params, want) {
+		t.Errorf("expected params %v, got %v", want, mock.calls[1].Params)
+	}
+}
+
+func TestSnapshotTaskService_Run(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return json.RawMessage(`null`), nil
+		},
+	}
+
+	svc := NewSnapshotTaskService(mock, Version{})
+	if err := svc.Run(context.Background(), 7); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if mock.calls[0].Method != "pool.snapshottask.run" || mock.calls[0].Params != int64(7) {
+		t.Errorf("unexpected call: %+v", mock.calls[0])
+	}
+}
+
+func TestSnapshotTaskService_MaxCount(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return json.RawMessage(`512`), nil
+		},
+	}
+
+	svc := NewSnapshotTaskService(mock, Version{})
+	count, err := svc.MaxCount(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if mock.calls[0].Method != "pool.snapshottask.max_count" {
+		t.Errorf("expected method pool.snapshottask.max_count, got %s", mock.calls[0].Method)
+	}
+	if count != 512 {
+		t.Errorf("expected 512, got %d", count)
+	}
+}
+
+func TestSnapshotTaskService_MaxTotalCount(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return json.RawMessage(`10000`), nil
+		},
+	}
+
+	svc := NewSnapshotTaskService(mock, Version{})
+	count, err := svc.MaxTotalCount(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if mock.calls[0].Method != "pool.snapshottask.max_total_count" {
+		t.Errorf("expected method pool.snapshottask.max_total_count, got %s", mock.calls[0].Method)
+	}
+	if count != 10000 {
+		t.Errorf("expected 10000, got %d", count)
+	}
+}
+
+func TestSnapshotTaskService_MaxCount_ParseError(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return json.RawMessage(`"many"`), nil
+		},
+	}
+
+	svc := NewSnapshotTaskService(mock, Version{})
+	if _, err := svc.MaxCount(context.Background()); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestSnapshotTaskService_PreviewUpdateRetention(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return json.RawMessage(`{"tank/data": ["auto-2025-10-01_00-00", "auto-2025-10-02_00-00"]}`), nil
+		},
+	}
+
+	svc := NewSnapshotTaskService(mock, Version{})
+	changes, err := svc.PreviewUpdateRetention(context.Background(), 7, UpdateSnapshotTaskOpts{
+		LifetimeValue:     Int64Ptr(1),
+		LifetimeUnit:      LifetimeDay,
+		FixateRemovalDate: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if mock.calls[0].Method != "pool.snapshottask.update_will_change_retention_for" {
+		t.Fatalf("expected method pool.snapshottask.update_will_change_retention_for, got %s", mock.calls[0].Method)
+	}
+	// fixate_removal_date is not accepted by the preview.
+	want := []any{int64(7), map[string]any{"lifetime_value": int64(1), "lifetime_unit": "DAY"}}
+	if !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("expected params %v, got %v", want, mock.calls[0].Params)
+	}
+	wantChanges := map[string][]string{"tank/data": {"auto-2025-10-01_00-00", "auto-2025-10-02_00-00"}}
+	if !reflect.DeepEqual(changes, wantChanges) {
+		t.Errorf("unexpected changes: %v", changes)
+	}
+}
+
+func TestSnapshotTaskService_PreviewDeleteRetention(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return json.RawMessage(`{}`), nil
+		},
+	}
+
+	svc := NewSnapshotTaskService(mock, Version{})
+	changes, err := svc.PreviewDeleteRetention(context.Background(), 7)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if mock.calls[0].Method != "pool.snapshottask.delete_will_change_retention_for" || mock.calls[0].Params != int64(7) {
+		t.Errorf("unexpected call: %+v", mock.calls[0])
+	}
+	if len(changes) != 0 {
+		t.Errorf("expected no changes, got %v", changes)
+	}
+}
@@ -31,7 +31,7 @@ func NewSystemService(c Caller, v Version) *SystemService {
 
 // GetInfo returns system information.
 func (s *SystemService) GetInfo(ctx context.Context) (*SystemInfo, error) {
-	result, err := s.client.Call(ctx, "system.info", nil)
+	result, err := callMethod(ctx, s.client, s.version, "system.info", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -47,7 +47,7 @@ func (s *SystemService) GetInfo(ctx context.Context) (*SystemInfo, error) {
 
 // GetVersion returns the TrueNAS version string.
 func (s *SystemService) GetVersion(ctx context.Context) (string, error) {
-	result, err := s.client.Call(ctx, "system.version", nil)
+	result, err := callMethod(ctx, s.client, s.version, "system.version", nil)
 	if err != nil {
 		return "", err
 	}
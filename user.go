@@ -0,0 +1,31 @@
+package truenas
+
+// UserResponse represents a user account from the TrueNAS API.
+type UserResponse struct {
+	ID                   int64             `json:"id"`
+	UID                  int64             `json:"uid"`
+	Username             string            `json:"username"`
+	FullName             string            `json:"full_name"`
+	Email                string            `json:"email"`
+	Home                 string            `json:"home"`
+	Shell                string            `json:"shell"`
+	Group                UserGroupResponse `json:"group"`
+	Groups               []int64           `json:"groups"`
+	SSHPubKey            string            `json:"sshpubkey"`
+	PasswordDisabled     bool              `json:"password_disabled"`
+	SSHPasswordEnabled   bool              `json:"ssh_password_enabled"`
+	Locked               bool              `json:"locked"`
+	SMB                  bool              `json:"smb"`
+	SudoCommands         []string          `json:"sudo_commands"`
+	SudoCommandsNoPasswd []string          `json:"sudo_commands_nopasswd"`
+	Builtin              bool              `json:"builtin"`
+	Local                bool              `json:"local"`
+	Immutable            bool              `json:"immutable"`
+}
+
+// UserGroupResponse is the primary group embedded in a UserResponse.
+type UserGroupResponse struct {
+	ID   int64  `json:"id"`
+	GID  int64  `json:"bsdgrp_gid"`
+	Name string `json:"bsdgrp_group"`
+}
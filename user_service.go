@@ -0,0 +1,456 @@
+package truenas
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// User is the user-facing representation of a TrueNAS user account.
+type User struct {
+	ID       int64
+	UID      int64
+	Username string
+	FullName string
+	Email    string
+	Home     string
+	Shell    string
+	// GroupID is the id (not the GID) of the primary group.
+	GroupID   int64
+	GroupName string
+	// Groups are the ids of the auxiliary groups.
+	Groups               []int64
+	SSHPubKey            string // authorized_keys content, one key per line
+	PasswordDisabled     bool
+	SSHPasswordEnabled   bool
+	Locked               bool
+	SMB                  bool
+	SudoCommands         []string
+	SudoCommandsNoPasswd []string
+	Builtin              bool
+	Local                bool
+	Immutable            bool
+}
+
+// CreateUserOpts contains options for creating a user.
+type CreateUserOpts struct {
+	UID      *int64 // Nil = next available UID
+	Username string
+	FullName string
+	Email    string // Empty = none
+	Password string // Required unless PasswordDisabled
+	// PasswordDisabled creates an account that cannot log in with a
+	// password, as suits service accounts.
+	PasswordDisabled bool
+	// Group is the id of an existing primary group. Leave nil and set
+	// GroupCreate to create a group named after the user.
+	Group       *int64
+	GroupCreate bool
+	Groups      []int64 // Auxiliary group ids
+	Home        string  // Empty = /var/empty
+	HomeCreate  bool    // Create the home directory under Home
+	HomeMode    string  // e.g. "700"; empty = server default
+	Shell       string  // Empty = server default; see ShellChoices
+	SSHPubKey   string
+	// SSHPasswordEnabled allows SSH password logins.
+	SSHPasswordEnabled   bool
+	Locked               bool
+	SMB                  bool // Requires a password
+	SudoCommands         []string
+	SudoCommandsNoPasswd []string
+}
+
+// UpdateUserOpts contains options for updating a user.
+// Pointer fields distinguish "don't change" (nil) from "set to zero/empty".
+// String fields use empty string to mean "don't change", and slice fields
+// use nil; an empty, non-nil slice clears the list.
+type UpdateUserOpts struct {
+	Username             string // Empty = don't change
+	FullName             *string
+	Email                *string
+	Password             string // Empty = don't change
+	PasswordDisabled     *bool
+	Group                *int64
+	Groups               []int64
+	Home                 string // Empty = don't change
+	HomeCreate           bool   // Only sent when true
+	HomeMode             string // Empty = don't change
+	Shell                string // Empty = don't change
+	SSHPubKey            *string
+	SSHPasswordEnabled   *bool
+	Locked               *bool
+	SMB                  *bool
+	SudoCommands         []string
+	SudoCommandsNoPasswd []string
+}
+
+// UserService provides typed methods for the user.* API namespace.
+type UserService struct {
+	client  Caller
+	version Version
+}
+
+// NewUserService creates a new UserService.
+func NewUserService(c Caller, v Version) *UserService {
+	return &UserService{client: c, version: v}
+}
+
+// Create creates a user and returns the full object.
+func (s *UserService) Create(ctx context.Context, opts CreateUserOpts) (*User, error) {
+	params := userCreateParams(opts)
+	result, err := callMethod(ctx, s.client, s.version, "user.create", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var createResp struct {
+		ID int64 `json:"id"`
+	}
+	if err := json.Unmarshal(result, &createResp); err != nil {
+		return nil, fmt.Errorf("parse create response: %w", err)
+	}
+
+	return s.Get(ctx, createResp.ID)
+}
+
+// Get returns a user by ID, or nil if not found.
+func (s *UserService) Get(ctx context.Context, id int64) (*User, error) {
+	result, err := callMethod(ctx, s.client, s.version, "user.get_instance", id)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var resp UserResponse
+	if err := json.Unmarshal(result, &resp); err != nil {
+		return nil, fmt.Errorf("parse get_instance response: %w", err)
+	}
+
+	user := userFromResponse(resp)
+	return &user, nil
+}
+
+// GetByName returns a user by username, or nil if not found.
+func (s *UserService) GetByName(ctx context.Context, username string) (*User, error) {
+	users, err := s.query(ctx, [][]any{{"username", "=", username}})
+	if err != nil {
+		return nil, err
+	}
+	if len(users) == 0 {
+		return nil, nil
+	}
+	return &users[0], nil
+}
+
+// List returns the local users, including built-in accounts. Directory
+// service users are not included.
+func (s *UserService) List(ctx context.Context) ([]User, error) {
+	return s.query(ctx, [][]any{{"local", "=", true}})
+}
+
+func (s *UserService) query(ctx context.Context, filter [][]any) ([]User, error) {
+	result, err := callMethod(ctx, s.client, s.version, "user.query", filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var responses []UserResponse
+	if err := json.Unmarshal(result, &responses); err != nil {
+		return nil, fmt.Errorf("parse query response: %w", err)
+	}
+
+	users := make([]User, len(responses))
+	for i, resp := range responses {
+		users[i] = userFromResponse(resp)
+	}
+	return users, nil
+}
+
+// Update updates a user and returns the full object.
+func (s *UserService) Update(ctx context.Context, id int64, opts UpdateUserOpts) (*User, error) {
+	return s.update(ctx, id, userUpdateParams(opts))
+}
+
+func (s *UserService) update(ctx context.Context, id int64, params map[string]any) (*User, error) {
+	_, err := callMethod(ctx, s.client, s.version, "user.update", []any{id, params})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Get(ctx, id)
+}
+
+// Delete deletes a user by ID. When deleteGroup is true, the user's primary
+// group is deleted too unless other users share it.
+func (s *UserService) Delete(ctx context.Context, id int64, deleteGroup bool) error {
+	_, err := callMethod(ctx, s.client, s.version, "user.delete", []any{id, map[string]any{"delete_group": deleteGroup}})
+	return err
+}
+
+// NextUID returns the next free UID.
+func (s *UserService) NextUID(ctx context.Context) (int64, error) {
+	result, err := callMethod(ctx, s.client, s.version, "user.get_next_uid", nil)
+	if err != nil {
+		return 0, err
+	}
+
+	var uid int64
+	if err := json.Unmarshal(result, &uid); err != nil {
+		return 0, fmt.Errorf("parse get_next_uid response: %w", err)
+	}
+	return uid, nil
+}
+
+// SetPassword changes a local user's password. oldPassword may be empty when
+// the session has full admin rights.
+func (s *UserService) SetPassword(ctx context.Context, username, oldPassword, newPassword string) error {
+	params := map[string]any{
+		"username":     username,
+		"new_password": newPassword,
+	}
+	if oldPassword != "" {
+		params["old_password"] = oldPassword
+	}
+	_, err := callMethod(ctx, s.client, s.version, "user.set_password", params)
+	return err
+}
+
+// ShellChoices returns the login shells available to a user in the given
+// groups, keyed by path with the shell's name as value
+// (e.g. "/usr/bin/bash" → "bash").
+func (s *UserService) ShellChoices(ctx context.Context, groupIDs []int64) (map[string]string, error) {
+	if groupIDs == nil {
+		groupIDs = []int64{}
+	}
+	result, err := callMethod(ctx, s.client, s.version, "user.shell_choices", []any{groupIDs})
+	if err != nil {
+		return nil, err
+	}
+
+	var choices map[string]string
+	if err := json.Unmarshal(result, &choices); err != nil {
+		return nil, fmt.Errorf("parse shell_choices response: %w", err)
+	}
+	return choices, nil
+}
+
+// AddSSHPublicKey adds a key to a user's authorized keys, keeping the keys
+// already there, and returns the updated user. Adding a key the user
+// already has changes nothing.
+func (s *UserService) AddSSHPublicKey(ctx context.Context, id int64, key string) (*User, error) {
+	user, err := s.mustGet(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	key = strings.TrimSpace(key)
+	keys := sshKeyLines(user.SSHPubKey)
+	for _, k := range keys {
+		if k == key {
+			return user, nil
+		}
+	}
+	keys = append(keys, key)
+	return s.update(ctx, id, map[string]any{"sshpubkey": strings.Join(keys, "\n")})
+}
+
+// RemoveSSHPublicKey removes a key from a user's authorized keys and returns
+// the updated user. Removing a key the user does not have changes nothing.
+func (s *UserService) RemoveSSHPublicKey(ctx context.Context, id int64, key string) (*User, error) {
+	user, err := s.mustGet(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	key = strings.TrimSpace(key)
+	keys := sshKeyLines(user.SSHPubKey)
+	kept := keys[:0]
+	for _, k := range keys {
+		if k != key {
+			kept = append(kept, k)
+		}
+	}
+	if len(kept) == len(keys) {
+		return user, nil
+	}
+	var sshpubkey any
+	if len(kept) > 0 {
+		sshpubkey = strings.Join(kept, "\n")
+	}
+	return s.update(ctx, id, map[string]any{"sshpubkey": sshpubkey})
+}
+
+// mustGet returns a user by ID, or an error if not found.
+func (s *UserService) mustGet(ctx context.Context, id int64) (*User, error) {
+	user, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, fmt.Errorf("user %d not found", id)
+	}
+	return user, nil
+}
+
+// sshKeyLines splits authorized_keys content into its non-empty lines.
+func sshKeyLines(content string) []string {
+	var keys []string
+	for _, line := range strings.Split(content, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			keys = append(keys, line)
+		}
+	}
+	return keys
+}
+
+// userFromResponse converts a wire-format UserResponse to a user-facing User.
+func userFromResponse(resp UserResponse) User {
+	return User{
+		ID:                   resp.ID,
+		UID:                  resp.UID,
+		Username:             resp.Username,
+		FullName:             resp.FullName,
+		Email:                resp.Email,
+		Home:                 resp.Home,
+		Shell:                resp.Shell,
+		GroupID:              resp.Group.ID,
+		GroupName:            resp.Group.Name,
+		Groups:               resp.Groups,
+		SSHPubKey:            resp.SSHPubKey,
+		PasswordDisabled:     resp.PasswordDisabled,
+		SSHPasswordEnabled:   resp.SSHPasswordEnabled,
+		Locked:               resp.Locked,
+		SMB:                  resp.SMB,
+		SudoCommands:         resp.SudoCommands,
+		SudoCommandsNoPasswd: resp.SudoCommandsNoPasswd,
+		Builtin:              resp.Builtin,
+		Local:                resp.Local,
+		Immutable:            resp.Immutable,
+	}
+}
+
+// userCreateParams builds API parameters for user.create.
+func userCreateParams(opts CreateUserOpts) map[string]any {
+	params := map[string]any{
+		"username":               opts.Username,
+		"full_name":              opts.FullName,
+		"password_disabled":      opts.PasswordDisabled,
+		"group_create":           opts.GroupCreate,
+		"groups":                 nonNilInt64s(opts.Groups),
+		"home_create":            opts.HomeCreate,
+		"ssh_password_enabled":   opts.SSHPasswordEnabled,
+		"locked":                 opts.Locked,
+		"smb":                    opts.SMB,
+		"sudo_commands":          nonNilStrings(opts.SudoCommands),
+		"sudo_commands_nopasswd": nonNilStrings(opts.SudoCommandsNoPasswd),
+	}
+	if opts.UID != nil {
+		params["uid"] = *opts.UID
+	}
+	if opts.Email != "" {
+		params["email"] = opts.Email
+	}
+	if opts.Password != "" {
+		params["password"] = opts.Password
+	}
+	if opts.Group != nil {
+		params["group"] = *opts.Group
+	}
+	if opts.Home != "" {
+		params["home"] = opts.Home
+	}
+	if opts.HomeMode != "" {
+		params["home_mode"] = opts.HomeMode
+	}
+	if opts.Shell != "" {
+		params["shell"] = opts.Shell
+	}
+	if opts.SSHPubKey != "" {
+		params["sshpubkey"] = opts.SSHPubKey
+	}
+	return params
+}
+
+// userUpdateParams builds API parameters for user.update.
+func userUpdateParams(opts UpdateUserOpts) map[string]any {
+	params := map[string]any{}
+	if opts.Username != "" {
+		params["username"] = opts.Username
+	}
+	if opts.FullName != nil {
+		params["full_name"] = *opts.FullName
+	}
+	if opts.Email != nil {
+		if *opts.Email == "" {
+			params["email"] = nil
+		} else {
+			params["email"] = *opts.Email
+		}
+	}
+	if opts.Password != "" {
+		params["password"] = opts.Password
+	}
+	if opts.PasswordDisabled != nil {
+		params["password_disabled"] = *opts.PasswordDisabled
+	}
+	if opts.Group != nil {
+		params["group"] = *opts.Group
+	}
+	if opts.Groups != nil {
+		params["groups"] = opts.Groups
+	}
+	if opts.Home != "" {
+		params["home"] = opts.Home
+	}
+	if opts.HomeCreate {
+		params["home_create"] = true
+	}
+	if opts.HomeMode != "" {
+		params["home_mode"] = opts.HomeMode
+	}
+	if opts.Shell != "" {
+		params["shell"] = opts.Shell
+	}
+	if opts.SSHPubKey != nil {
+		if *opts.SSHPubKey == "" {
+			params["sshpubkey"] = nil
+		} else {
+			params["sshpubkey"] = *opts.SSHPubKey
+		}
+	}
+	if opts.SSHPasswordEnabled != nil {
+		params["ssh_password_enabled"] = *opts.SSHPasswordEnabled
+	}
+	if opts.Locked != nil {
+		params["locked"] = *opts.Locked
+	}
+	if opts.SMB != nil {
+		params["smb"] = *opts.SMB
+	}
+	if opts.SudoCommands != nil {
+		params["sudo_commands"] = opts.SudoCommands
+	}
+	if opts.SudoCommandsNoPasswd != nil {
+		params["sudo_commands_nopasswd"] = opts.SudoCommandsNoPasswd
+	}
+	return params
+}
+
+// nonNilInt64s returns s, or an empty slice if s is nil, so it marshals as
+// [] rather than null.
+func nonNilInt64s(s []int64) []int64 {
+	if s == nil {
+		return []int64{}
+	}
+	return s
+}
+
+// nonNilStrings returns s, or an empty slice if s is nil, so it marshals as
+// [] rather than null.
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
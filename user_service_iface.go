@@ -0,0 +1,114 @@
+package truenas
+
+import "context"
+
+// UserServiceAPI defines the interface for user account operations.
+type UserServiceAPI interface {
+	Create(ctx context.Context, opts CreateUserOpts) (*User, error)
+	Get(ctx context.Context, id int64) (*User, error)
+	GetByName(ctx context.Context, username string) (*User, error)
+	List(ctx context.Context) ([]User, error)
+	Update(ctx context.Context, id int64, opts UpdateUserOpts) (*User, error)
+	Delete(ctx context.Context, id int64, deleteGroup bool) error
+	NextUID(ctx context.Context) (int64, error)
+	SetPassword(ctx context.Context, username, oldPassword, newPassword string) error
+	ShellChoices(ctx context.Context, groupIDs []int64) (map[string]string, error)
+	AddSSHPublicKey(ctx context.Context, id int64, key string) (*User, error)
+	RemoveSSHPublicKey(ctx context.Context, id int64, key string) (*User, error)
+}
+
+// Compile-time checks.
+var _ UserServiceAPI = (*UserService)(nil)
+var _ UserServiceAPI = (*MockUserService)(nil)
+
+// MockUserService is a test double for UserServiceAPI.
+type MockUserService struct {
+	CreateFunc             func(ctx context.Context, opts CreateUserOpts) (*User, error)
+	GetFunc                func(ctx context.Context, id int64) (*User, error)
+	GetByNameFunc          func(ctx context.Context, username string) (*User, error)
+	ListFunc               func(ctx context.Context) ([]User, error)
+	UpdateFunc             func(ctx context.Context, id int64, opts UpdateUserOpts) (*User, error)
+	DeleteFunc             func(ctx context.Context, id int64, deleteGroup bool) error
+	NextUIDFunc            func(ctx context.Context) (int64, error)
+	SetPasswordFunc        func(ctx context.Context, username, oldPassword, newPassword string) error
+	ShellChoicesFunc       func(ctx context.Context, groupIDs []int64) (map[string]string, error)
+	AddSSHPublicKeyFunc    func(ctx context.Context, id int64, key string) (*User, error)
+	RemoveSSHPublicKeyFunc func(ctx context.Context, id int64, key string) (*User, error)
+}
+
+func (m *MockUserService) Create(ctx context.Context, opts CreateUserOpts) (*User, error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, opts)
+	}
+	return nil, nil
+}
+
+func (m *MockUserService) Get(ctx context.Context, id int64) (*User, error) {
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockUserService) GetByName(ctx context.Context, username string) (*User, error) {
+	if m.GetByNameFunc != nil {
+		return m.GetByNameFunc(ctx, username)
+	}
+	return nil, nil
+}
+
+func (m *MockUserService) List(ctx context.Context) ([]User, error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockUserService) Update(ctx context.Context, id int64, opts UpdateUserOpts) (*User, error) {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, id, opts)
+	}
+	return nil, nil
+}
+
+func (m *MockUserService) Delete(ctx context.Context, id int64, deleteGroup bool) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id, deleteGroup)
+	}
+	return nil
+}
+
+func (m *MockUserService) NextUID(ctx context.Context) (int64, error) {
+	if m.NextUIDFunc != nil {
+		return m.NextUIDFunc(ctx)
+	}
+	return 0, nil
+}
+
+func (m *MockUserService) SetPassword(ctx context.Context, username, oldPassword, newPassword string) error {
+	if m.SetPasswordFunc != nil {
+		return m.SetPasswordFunc(ctx, username, oldPassword, newPassword)
+	}
+	return nil
+}
+
+func (m *MockUserService) ShellChoices(ctx context.Context, groupIDs []int64) (map[string]string, error) {
+	if m.ShellChoicesFunc != nil {
+		return m.ShellChoicesFunc(ctx, groupIDs)
+	}
+	return nil, nil
+}
+
+func (m *MockUserService) AddSSHPublicKey(ctx context.Context, id int64, key string) (*User, error) {
+	if m.AddSSHPublicKeyFunc != nil {
+		return m.AddSSHPublicKeyFunc(ctx, id, key)
+	}
+	return nil, nil
+}
+
+func (m *MockUserService) RemoveSSHPublicKey(ctx context.Context, id int64, key string) (*User, error) {
+	if m.RemoveSSHPublicKeyFunc != nil {
+		return m.RemoveSSHPublicKeyFunc(ctx, id, key)
+	}
+	return nil, nil
+}
@@ -0,0 +1,54 @@
+package truenas
+
+import (
+	"context"
+	"testing"
+)
+
+func TestMockUserService_ImplementsInterface(t *testing.T) {
+	var _ UserServiceAPI = (*UserService)(nil)
+	var _ UserServiceAPI = (*MockUserService)(nil)
+}
+
+func TestMockUserService_DefaultsToNil(t *testing.T) {
+	mock := &MockUserService{}
+	ctx := context.Background()
+
+	user, err := mock.Get(ctx, 1)
+	if err != nil {
+		t.Fatalf("expected nil error, got: %v", err)
+	}
+	if user != nil {
+		t.Fatalf("expected nil result, got: %v", user)
+	}
+
+	uid, err := mock.NextUID(ctx)
+	if err != nil || uid != 0 {
+		t.Fatalf("expected 0, nil from NextUID, got: %d, %v", uid, err)
+	}
+
+	if err := mock.SetPassword(ctx, "svc", "", "secret"); err != nil {
+		t.Fatalf("expected nil error from SetPassword, got: %v", err)
+	}
+}
+
+func TestMockUserService_CallsFunc(t *testing.T) {
+	called := false
+	mock := &MockUserService{
+		AddSSHPublicKeyFunc: func(ctx context.Context, id int64, key string) (*User, error) {
+			called = true
+			return &User{ID: id, SSHPubKey: key}, nil
+		},
+	}
+
+	user, err := mock.AddSSHPublicKey(context.Background(), 42, "ssh-ed25519 AAAA")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !called {
+		t.Fatal("expected AddSSHPublicKeyFunc to be called")
+	}
+	if user.ID != 42 || user.SSHPubKey != "ssh-ed25519 AAAA" {
+		t.Fatalf("unexpected user: %+v", user)
+	}
+}
@@ -0,0 +1,365 @@
+package truenas
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// sampleUserJSON returns a single JSON object response for user.get_instance.
+func sampleUserJSON(sshpubkey string) json.RawMessage {
+	key, _ := json.Marshal(sshpubkey)
+	return json.RawMessage(`{
+		"id": 70,
+		"uid": 3000,
+		"username": "svc-backup",
+		"full_name": "Backup service",
+		"email": null,
+		"home": "/mnt/tank/home/svc-backup",
+		"shell": "/usr/sbin/nologin",
+		"group": {"id": 120, "bsdgrp_gid": 3000, "bsdgrp_group": "svc-backup"},
+		"groups": [41],
+		"sshpubkey": ` + string(key) + `,
+		"password_disabled": true,
+		"ssh_password_enabled": false,
+		"locked": false,
+		"smb": false,
+		"sudo_commands": [],
+		"sudo_commands_nopasswd": ["/usr/sbin/zfs"],
+		"builtin": false,
+		"local": true,
+		"immutable": false
+	}`)
+}
+
+func TestUserService_Create(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			// user.create returns the full object, as does the re-read.
+			return sampleUserJSON(""), nil
+		},
+	}
+
+	svc := NewUserService(mock, Version{})
+	user, err := svc.Create(context.Background(), CreateUserOpts{
+		Username:             "svc-backup",
+		FullName:             "Backup service",
+		PasswordDisabled:     true,
+		GroupCreate:          true,
+		Home:                 "/mnt/tank/home",
+		HomeCreate:           true,
+		HomeMode:             "700",
+		Shell:                "/usr/sbin/nologin",
+		SudoCommandsNoPasswd: []string{"/usr/sbin/zfs"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	p := mock.calls[0].Params.(map[string]any)
+	if p["username"] != "svc-backup" || p["password_disabled"] != true || p["group_create"] != true {
+		t.Errorf("unexpected create params: %v", p)
+	}
+	if p["home"] != "/mnt/tank/home" || p["home_create"] != true || p["home_mode"] != "700" {
+		t.Errorf("unexpected home params: %v", p)
+	}
+	for _, k := range []string{"uid", "group", "password", "email", "sshpubkey"} {
+		if _, ok := p[k]; ok {
+			t.Errorf("expected %s to be omitted, got %v", k, p[k])
+		}
+	}
+	if groups, ok := p["groups"].([]int64); !ok || groups == nil {
+		t.Errorf("expected groups to be an empty list, got %#v", p["groups"])
+	}
+
+	if mock.calls[1].Method != "user.get_instance" || mock.calls[1].Params != int64(70) {
+		t.Errorf("expected re-read of user 70, got %s %v", mock.calls[1].Method, mock.calls[1].Params)
+	}
+	if user.ID != 70 || user.UID != 3000 || user.GroupID != 120 || user.GroupName != "svc-backup" {
+		t.Errorf("unexpected user: %+v", user)
+	}
+	if !user.PasswordDisabled || user.Email != "" || !reflect.DeepEqual(user.Groups, []int64{41}) {
+		t.Errorf("unexpected user: %+v", user)
+	}
+}
+
+func TestUserService_Create_Error(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return nil, errors.New("[EEXIST] user_create.username: A user with the username \"svc\" already exists")
+		},
+	}
+
+	svc := NewUserService(mock, Version{})
+	user, err := svc.Create(context.Background(), CreateUserOpts{Username: "svc"})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if user != nil {
+		t.Errorf("expected nil user, got %+v", user)
+	}
+}
+
+func TestUserService_Get_NotFound(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return nil, errors.New("[ENOENT] None: User 99 does not exist")
+		},
+	}
+
+	svc := NewUserService(mock, Version{})
+	user, err := svc.Get(context.Background(), 99)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if user != nil {
+		t.Errorf("expected nil user, got %+v", user)
+	}
+}
+
+func TestUserService_GetByName(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			if method != "user.query" {
+				t.Errorf("expected method user.query, got %s", method)
+			}
+			filter := params.([][]any)
+			if filter[0][2] == "nobody-here" {
+				return json.RawMessage(`[]`), nil
+			}
+			return json.RawMessage(`[` + string(sampleUserJSON("")) + `]`), nil
+		},
+	}
+
+	svc := NewUserService(mock, Version{})
+	user, err := svc.GetByName(context.Background(), "svc-backup")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if user == nil || user.Username != "svc-backup" {
+		t.Errorf("unexpected user: %+v", user)
+	}
+
+	user, err = svc.GetByName(context.Background(), "nobody-here")
+	if err != nil || user != nil {
+		t.Errorf("expected nil, nil for a missing user, got %+v, %v", user, err)
+	}
+}
+
+func TestUserService_List(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return json.RawMessage(`[` + string(sampleUserJSON("")) + `]`), nil
+		},
+	}
+
+	svc := NewUserService(mock, Version{})
+	users, err := svc.List(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(users) != 1 || users[0].ID != 70 {
+		t.Errorf("unexpected users: %+v", users)
+	}
+	want := [][]any{{"local", "=", true}}
+	if !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("expected filter %v, got %v", want, mock.calls[0].Params)
+	}
+}
+
+func TestUserService_Update(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return sampleUserJSON(""), nil
+		},
+	}
+
+	svc := NewUserService(mock, Version{})
+	_, err := svc.Update(context.Background(), 70, UpdateUserOpts{
+		Email:     StringPtr(""),
+		Locked:    BoolPtr(true),
+		Groups:    []int64{},
+		Shell:     "/usr/bin/bash",
+		SSHPubKey: StringPtr("ssh-ed25519 AAAA"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if mock.calls[0].Method != "user.update" {
+		t.Fatalf("expected method user.update, got %s", mock.calls[0].Method)
+	}
+	args := mock.calls[0].Params.([]any)
+	if args[0] != int64(70) {
+		t.Errorf("expected id 70, got %v", args[0])
+	}
+	want := map[string]any{
+		"email":     nil,
+		"locked":    true,
+		"groups":    []int64{},
+		"shell":     "/usr/bin/bash",
+		"sshpubkey": "ssh-ed25519 AAAA",
+	}
+	if !reflect.DeepEqual(args[1], want) {
+		t.Errorf("expected params %v, got %v", want, args[1])
+	}
+}
+
+func TestUserService_Delete(t *testing.T) {
+	mock := &mockCaller{}
+
+	svc := NewUserService(mock, Version{})
+	if err := svc.Delete(context.Background(), 70, true); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []any{int64(70), map[string]any{"delete_group": true}}
+	if mock.calls[0].Method != "user.delete" || !reflect.DeepEqual(mock.calls[0].Params, want) {
+		t.Errorf("unexpected call: %s %v", mock.calls[0].Method, mock.calls[0].Params)
+	}
+}
+
+func TestUserService_NextUID(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			if method != "user.get_next_uid" {
+				t.Errorf("expected method user.get_next_uid, got %s", method)
+			}
+			return json.RawMessage(`3001`), nil
+		},
+	}
+
+	svc := NewUserService(mock, Version{})
+	uid, err := svc.NextUID(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if uid != 3001 {
+		t.Errorf("expected 3001, got %d", uid)
+	}
+}
+
+func TestUserService_SetPassword(t *testing.T) {
+	mock := &mockCaller{}
+
+	svc := NewUserService(mock, Version{})
+	if err := svc.SetPassword(context.Background(), "svc-backup", "", "n3w"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := svc.SetPassword(context.Background(), "svc-backup", "old", "n3w"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if mock.calls[0].Method != "user.set_password" {
+		t.Errorf("expected method user.set_password, got %s", mock.calls[0].Method)
+	}
+	first := mock.calls[0].Params.(map[string]any)
+	if _, ok := first["old_password"]; ok || first["new_password"] != "n3w" {
+		t.Errorf("unexpected params: %v", first)
+	}
+	if second := mock.calls[1].Params.(map[string]any); second["old_password"] != "old" {
+		t.Errorf("expected old_password to be sent, got %v", second)
+	}
+}
+
+func TestUserService_ShellChoices(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return json.RawMessage(`{"/usr/bin/bash": "bash", "/usr/sbin/nologin": "nologin"}`), nil
+		},
+	}
+
+	svc := NewUserService(mock, Version{})
+	choices, err := svc.ShellChoices(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if choices["/usr/bin/bash"] != "bash" || len(choices) != 2 {
+		t.Errorf("unexpected choices: %v", choices)
+	}
+	if !reflect.DeepEqual(mock.calls[0].Params, []any{[]int64{}}) {
+		t.Errorf("expected an empty group list, got %#v", mock.calls[0].Params)
+	}
+}
+
+func TestUserService_AddSSHPublicKey(t *testing.T) {
+	existing := "ssh-ed25519 AAAA laptop\n"
+	var updated any
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			if method == "user.update" {
+				updated = params.([]any)[1].(map[string]any)["sshpubkey"]
+				return nil, nil
+			}
+			return sampleUserJSON(existing), nil
+		},
+	}
+
+	svc := NewUserService(mock, Version{})
+	if _, err := svc.AddSSHPublicKey(context.Background(), 70, " ssh-ed25519 BBBB ci\n"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated != "ssh-ed25519 AAAA laptop\nssh-ed25519 BBBB ci" {
+		t.Errorf("unexpected sshpubkey: %q", updated)
+	}
+
+	// A key the user already has is not added again.
+	mock.calls = nil
+	if _, err := svc.AddSSHPublicKey(context.Background(), 70, "ssh-ed25519 AAAA laptop"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, c := range mock.calls {
+		if c.Method == "user.update" {
+			t.Error("expected no update for an existing key")
+		}
+	}
+}
+
+func TestUserService_RemoveSSHPublicKey(t *testing.T) {
+	existing := "ssh-ed25519 AAAA laptop\nssh-ed25519 BBBB ci"
+	var updated []any
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			if method == "user.update" {
+				updated = append(updated, params.([]any)[1].(map[string]any)["sshpubkey"])
+				return nil, nil
+			}
+			return sampleUserJSON(existing), nil
+		},
+	}
+
+	svc := NewUserService(mock, Version{})
+	if _, err := svc.RemoveSSHPublicKey(context.Background(), 70, "ssh-ed25519 BBBB ci"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	existing = "ssh-ed25519 AAAA laptop"
+	if _, err := svc.RemoveSSHPublicKey(context.Background(), 70, "ssh-ed25519 AAAA laptop"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := svc.RemoveSSHPublicKey(context.Background(), 70, "ssh-rsa CCCC other"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Removing the last key clears the field; removing an unknown key is a no-op.
+	want := []any{"ssh-ed25519 AAAA laptop", nil}
+	if !reflect.DeepEqual(updated, want) {
+		t.Errorf("expected updates %v, got %v", want, updated)
+	}
+}
+
+func TestUserService_SSHKey_UserNotFound(t *testing.T) {
+	mock := &mockCaller{
+		callFunc: func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+			return nil, errors.New("[ENOENT] None: User 99 does not exist")
+		},
+	}
+
+	svc := NewUserService(mock, Version{})
+	_, err := svc.AddSSHPublicKey(context.Background(), 99, "ssh-ed25519 AAAA")
+	if err == nil || !strings.Contains(err.Error(), "user 99 not found") {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
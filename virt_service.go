@@ -127,7 +127,7 @@ func NewVirtService(c AsyncCaller, v Version) *VirtService {
 
 // GetGlobalConfig returns the global virt configuration.
 func (s *VirtService) GetGlobalConfig(ctx context.Context) (*VirtGlobalConfig, error) {
-	result, err := s.client.Call(ctx, "virt.global.config", nil)
+	result, err := callMethod(ctx, s.client, s.version, "virt.global.config", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -145,7 +145,7 @@ func (s *VirtService) GetGlobalConfig(ctx context.Context) (*VirtGlobalConfig, e
 // Only non-nil fields in opts are sent.
 func (s *VirtService) UpdateGlobalConfig(ctx context.Context, opts UpdateVirtGlobalConfigOpts) (*VirtGlobalConfig, error) {
 	params := virtGlobalConfigOptsToParams(opts)
-	_, err := s.client.Call(ctx, "virt.global.update", params)
+	_, err := callMethod(ctx, s.client, s.version, "virt.global.update", params)
 	if err != nil {
 		return nil, err
 	}
@@ -156,7 +156,7 @@ func (s *VirtService) UpdateGlobalConfig(ctx context.Context, opts UpdateVirtGlo
 // CreateInstance creates a virt instance and returns the full object.
 func (s *VirtService) CreateInstance(ctx context.Context, opts CreateVirtInstanceOpts) (*VirtInstance, error) {
 	params := virtInstanceCreateOptsToParams(opts)
-	_, err := s.client.CallAndWait(ctx, "virt.instance.create", params)
+	_, err := callMethodAndWait(ctx, s.client, s.version, "virt.instance.create", params)
 	if err != nil {
 		return nil, err
 	}
@@ -166,7 +166,7 @@ func (s *VirtService) CreateInstance(ctx context.Context, opts CreateVirtInstanc
 
 // GetInstance returns a virt instance by name, or nil if not found.
 func (s *VirtService) GetInstance(ctx context.Context, name string) (*VirtInstance, error) {
-	result, err := s.client.Call(ctx, "virt.instance.get_instance", name)
+	result, err := callMethod(ctx, s.client, s.version, "virt.instance.get_instance", name)
 	if err != nil {
 		if isNotFoundError(err) {
 			return nil, nil
@@ -186,7 +186,7 @@ func (s *VirtService) GetInstance(ctx context.Context, name string) (*VirtInstan
 // UpdateInstance updates a virt instance and returns the full object.
 func (s *VirtService) UpdateInstance(ctx context.Context, name string, opts UpdateVirtInstanceOpts) (*VirtInstance, error) {
 	params := virtInstanceUpdateOptsToParams(opts)
-	_, err := s.client.CallAndWait(ctx, "virt.instance.update", []any{name, params})
+	_, err := callMethodAndWait(ctx, s.client, s.version, "virt.instance.update", []any{name, params})
 	if err != nil {
 		return nil, err
 	}
@@ -196,13 +196,13 @@ func (s *VirtService) UpdateInstance(ctx context.Context, name string, opts Upda
 
 // DeleteInstance deletes a virt instance by name.
 func (s *VirtService) DeleteInstance(ctx context.Context, name string) error {
-	_, err := s.client.CallAndWait(ctx, "virt.instance.delete", name)
+	_, err := callMethodAndWait(ctx, s.client, s.version, "virt.instance.delete", name)
 	return err
 }
 
 // StartInstance starts a virt instance by name.
 func (s *VirtService) StartInstance(ctx context.Context, name string) error {
-	_, err := s.client.CallAndWait(ctx, "virt.instance.start", name)
+	_, err := callMethodAndWait(ctx, s.client, s.version, "virt.instance.start", name)
 	return err
 }
 
@@ -212,7 +212,7 @@ func (s *VirtService) StopInstance(ctx context.Context, name string, opts StopVi
 	if opts.Timeout > 0 {
 		stopArgs["timeout"] = opts.Timeout
 	}
-	_, err := s.client.CallAndWait(ctx, "virt.instance.stop", []any{name, stopArgs})
+	_, err := callMethodAndWait(ctx, s.client, s.version, "virt.instance.stop", []any{name, stopArgs})
 	return err
 }
 
@@ -225,7 +225,7 @@ func (s *VirtService) ListInstances(ctx context.Context, filters [][]any) ([]Vir
 		params = []any{filters}
 	}
 
-	result, err := s.client.Call(ctx, "virt.instance.query", params)
+	result, err := callMethod(ctx, s.client, s.version, "virt.instance.query", params)
 	if err != nil {
 		return nil, err
 	}
@@ -244,7 +244,7 @@ func (s *VirtService) ListInstances(ctx context.Context, filters [][]any) ([]Vir
 
 // ListDevices returns all devices attached to a virt instance.
 func (s *VirtService) ListDevices(ctx context.Context, instanceID string) ([]VirtDevice, error) {
-	result, err := s.client.Call(ctx, "virt.instance.device_list", instanceID)
+	result, err := callMethod(ctx, s.client, s.version, "virt.instance.device_list", instanceID)
 	if err != nil {
 		return nil, err
 	}
@@ -264,13 +264,13 @@ func (s *VirtService) ListDevices(ctx context.Context, instanceID string) ([]Vir
 // AddDevice adds a device to a virt instance.
 func (s *VirtService) AddDevice(ctx context.Context, instanceID string, opts VirtDeviceOpts) error {
 	devMap := virtDeviceOptToParam(opts)
-	_, err := s.client.CallAndWait(ctx, "virt.instance.device_add", []any{instanceID, devMap})
+	_, err := callMethodAndWait(ctx, s.client, s.version, "virt.instance.device_add", []any{instanceID, devMap})
 	return err
 }
 
 // DeleteDevice removes a device from a virt instance by device name.
 func (s *VirtService) DeleteDevice(ctx context.Context, instanceID string, deviceName string) error {
-	_, err := s.client.CallAndWait(ctx, "virt.instance.device_delete", []any{instanceID, deviceName})
+	_, err := callMethodAndWait(ctx, s.client, s.version, "virt.instance.device_delete", []any{instanceID, deviceName})
 	return err
 }
 
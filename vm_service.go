@@ -176,7 +176,7 @@ func NewVMService(c AsyncCaller, v Version) *VMService {
 // The create response includes the full VM, so no re-read is needed.
 func (s *VMService) CreateVM(ctx context.Context, opts CreateVMOpts) (*VM, error) {
 	params := vmOptsToParams(opts)
-	result, err := s.client.Call(ctx, "vm.create", params)
+	result, err := callMethod(ctx, s.client, s.version, "vm.create", params)
 	if err != nil {
 		return nil, err
 	}
@@ -192,7 +192,7 @@ func (s *VMService) CreateVM(ctx context.Context, opts CreateVMOpts) (*VM, error
 
 // GetVM returns a VM by ID.
 func (s *VMService) GetVM(ctx context.Context, id int64) (*VM, error) {
-	result, err := s.client.Call(ctx, "vm.get_instance", id)
+	result, err := callMethod(ctx, s.client, s.version, "vm.get_instance", id)
 	if err != nil {
 		return nil, err
 	}
@@ -209,7 +209,7 @@ func (s *VMService) GetVM(ctx context.Context, id int64) (*VM, error) {
 // UpdateVM updates a VM and returns the full object via re-read.
 func (s *VMService) UpdateVM(ctx context.Context, id int64, opts UpdateVMOpts) (*VM, error) {
 	params := vmOptsToParams(opts)
-	_, err := s.client.Call(ctx, "vm.update", []any{id, params})
+	_, err := callMethod(ctx, s.client, s.version, "vm.update", []any{id, params})
 	if err != nil {
 		return nil, err
 	}
@@ -219,27 +219,27 @@ func (s *VMService) UpdateVM(ctx context.Context, id int64, opts UpdateVMOpts) (
 
 // DeleteVM deletes a VM by ID.
 func (s *VMService) DeleteVM(ctx context.Context, id int64) error {
-	_, err := s.client.Call(ctx, "vm.delete", id)
+	_, err := callMethod(ctx, s.client, s.version, "vm.delete", id)
 	return err
 }
 
 // StartVM starts a VM by ID.
 func (s *VMService) StartVM(ctx context.Context, id int64) error {
-	_, err := s.client.Call(ctx, "vm.start", id)
+	_, err := callMethod(ctx, s.client, s.version, "vm.start", id)
 	return err
 }
 
 // StopVM stops a VM by ID using CallAndWait since it is a long-running operation.
 func (s *VMService) StopVM(ctx context.Context, id int64, opts StopVMOpts) error {
 	params := stopVMOptsToParams(opts)
-	_, err := s.client.CallAndWait(ctx, "vm.stop", []any{id, params})
+	_, err := callMethodAndWait(ctx, s.client, s.version, "vm.stop", []any{id, params})
 	return err
 }
 
 // ListDevices returns all devices for a VM.
 func (s *VMService) ListDevices(ctx context.Context, vmID int64) ([]VMDevice, error) {
 	filter := []any{[]any{[]any{"vm", "=", vmID}}}
-	result, err := s.client.Call(ctx, "vm.device.query", filter)
+	result, err := callMethod(ctx, s.client, s.version, "vm.device.query", filter)
 	if err != nil {
 		return nil, err
 	}
@@ -259,7 +259,7 @@ func (s *VMService) ListDevices(ctx context.Context, vmID int64) ([]VMDevice, er
 // GetDevice returns a VM device by ID, or nil if not found.
 func (s *VMService) GetDevice(ctx context.Context, id int64) (*VMDevice, error) {
 	filter := []any{[]any{[]any{"id", "=", id}}}
-	result, err := s.client.Call(ctx, "vm.device.query", filter)
+	result, err := callMethod(ctx, s.client, s.version, "vm.device.query", filter)
 	if err != nil {
 		return nil, err
 	}
@@ -280,7 +280,7 @@ func (s *VMService) GetDevice(ctx context.Context, id int64) (*VMDevice, error)
 // CreateDevice creates a VM device and returns the full object.
 func (s *VMService) CreateDevice(ctx context.Context, opts CreateVMDeviceOpts) (*VMDevice, error) {
 	params := deviceOptsToParams(opts)
-	result, err := s.client.Call(ctx, "vm.device.create", params)
+	result, err := callMethod(ctx, s.client, s.version, "vm.device.create", params)
 	if err != nil {
 		return nil, err
 	}
@@ -297,7 +297,7 @@ func (s *VMService) CreateDevice(ctx context.Context, opts CreateVMDeviceOpts) (
 // UpdateDevice updates a VM device and returns the full object via re-read.
 func (s *VMService) UpdateDevice(ctx context.Context, id int64, opts UpdateVMDeviceOpts) (*VMDevice, error) {
 	params := deviceOptsToParams(opts)
-	_, err := s.client.Call(ctx, "vm.device.update", []any{id, params})
+	_, err := callMethod(ctx, s.client, s.version, "vm.device.update", []any{id, params})
 	if err != nil {
 		return nil, err
 	}
@@ -307,7 +307,7 @@ func (s *VMService) UpdateDevice(ctx context.Context, id int64, opts UpdateVMDev
 
 // DeleteDevice deletes a VM device by ID.
 func (s *VMService) DeleteDevice(ctx context.Context, id int64) error {
-	_, err := s.client.Call(ctx, "vm.device.delete", id)
+	_, err := callMethod(ctx, s.client, s.version, "vm.device.delete", id)
 	return err
 }
 
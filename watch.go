@@ -0,0 +1,192 @@
+package truenas
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// EventType is the kind of change a collection event reports.
+type EventType string
+
+const (
+	EventAdded   EventType = "ADDED"
+	EventChanged EventType = "CHANGED"
+	EventRemoved EventType = "REMOVED"
+)
+
+// WatchEvent is a typed change to an object in a query collection.
+type WatchEvent[T any] struct {
+	Type EventType
+	// ID is the object's id: the string itself for string ids such as
+	// dataset names, or the decimal text of numeric ids.
+	ID string
+	// Object is decoded from Fields. CHANGED events may carry only the
+	// changed fields, leaving the rest of Object zero; REMOVED events carry
+	// none.
+	Object T
+	// Fields is the raw object, or nil for REMOVED events.
+	Fields json.RawMessage
+	// Cleared is set when the middleware cleared the object's fields rather
+	// than updating them, so a mirror should replace its copy.
+	Cleared bool
+}
+
+// WatchOpts configures Watch.
+type WatchOpts struct {
+	// Seed queries the collection once subscribed and delivers every
+	// existing object as an ADDED event before any change, so a consumer can
+	// build a local mirror. Changes racing with the query may repeat an
+	// object, so consumers should upsert.
+	Seed bool
+	// Filters are the query filters used to seed, e.g.
+	// [][]any{{"pool", "=", "tank"}}. Events are not filtered.
+	Filters [][]any
+}
+
+// collectionUpdateResponse is the params object of a collection_update
+// notification.
+type collectionUpdateResponse struct {
+	Msg        string          `json:"msg"`
+	Collection string          `json:"collection"`
+	ID         json.RawMessage `json:"id"`
+	Fields     json.RawMessage `json:"fields"`
+	Cleared    bool            `json:"cleared"`
+}
+
+// ErrWatchUnsupported is returned by Watch for clients that cannot deliver
+// whole collection_update notifications (see EventSubscriber).
+var ErrWatchUnsupported = errors.New("client does not support watching collections")
+
+// Watch subscribes to a query collection, such as pool.dataset.query,
+// vm.query or app.query, and decodes its events into T, usually the
+// collection's response type (e.g. DatasetResponse). Collection names are
+// resolved for version v like service calls, so zfs.snapshot.query follows
+// its rename on newer releases. c must implement EventSubscriber, as the
+// WebSocket client does; other clients fail with ErrWatchUnsupported.
+//
+// Events are read from the connection as they arrive and queued until the
+// consumer takes them, so a slow consumer or a large seed never makes the
+// transport drop events. Malformed events are skipped. Close the
+// subscription to stop watching.
+func Watch[T any](ctx context.Context, c SubscribeCaller, v Version, collection string, opts WatchOpts) (*Subscription[WatchEvent[T]], error) {
+	events, ok := c.(EventSubscriber)
+	if !ok {
+		return nil, fmt.Errorf("watch %s: %w", collection, ErrWatchUnsupported)
+	}
+	spec, err := ResolveMethod(v, collection)
+	if err != nil {
+		return nil, err
+	}
+	rawSub, err := events.SubscribeEvents(ctx, spec.Method, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var seed []json.RawMessage
+	if opts.Seed {
+		var params any
+		if len(opts.Filters) > 0 {
+			params = []any{opts.Filters}
+		}
+		result, err := c.Call(ctx, spec.Method, spec.shapeParams(params))
+		if err == nil {
+			err = json.Unmarshal(result, &seed)
+		}
+		if err != nil {
+			rawSub.Close()
+			return nil, err
+		}
+	}
+
+	pending := make([]WatchEvent[T], 0, len(seed))
+	for _, item := range seed {
+		event, err := decodeWatchEvent[T](collectionUpdateResponse{Msg: "added", Fields: item})
+		if err == nil {
+			pending = append(pending, event)
+		}
+	}
+
+	typedCh := make(chan WatchEvent[T])
+	done := make(chan struct{})
+	go func() {
+		defer close(typedCh)
+		in := rawSub.C
+		for in != nil || len(pending) > 0 {
+			// Only offer an event when one is queued; a nil channel never
+			// receives.
+			var out chan WatchEvent[T]
+			var next WatchEvent[T]
+			if len(pending) > 0 {
+				out, next = typedCh, pending[0]
+			}
+			select {
+			case raw, ok := <-in:
+				if !ok {
+					in = nil
+					continue
+				}
+				var resp collectionUpdateResponse
+				if err := json.Unmarshal(raw, &resp); err != nil {
+					continue
+				}
+				if event, err := decodeWatchEvent[T](resp); err == nil {
+					pending = append(pending, event)
+				}
+			case out <- next:
+				pending = pending[1:]
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+	return &Subscription[WatchEvent[T]]{
+		C: typedCh,
+		cancel: func() {
+			once.Do(func() {
+				close(done)
+				rawSub.Close()
+			})
+		},
+	}, nil
+}
+
+func decodeWatchEvent[T any](resp collectionUpdateResponse) (WatchEvent[T], error) {
+	event := WatchEvent[T]{
+		Type:    EventType(strings.ToUpper(resp.Msg)),
+		ID:      eventID(resp.ID),
+		Cleared: resp.Cleared,
+	}
+	if event.Type == EventRemoved || len(resp.Fields) == 0 || string(resp.Fields) == "null" {
+		return event, nil
+	}
+	event.Fields = resp.Fields
+	if err := json.Unmarshal(resp.Fields, &event.Object); err != nil {
+		return event, err
+	}
+	if event.ID == "" {
+		var obj struct {
+			ID json.RawMessage `json:"id"`
+		}
+		_ = json.Unmarshal(resp.Fields, &obj)
+		event.ID = eventID(obj.ID)
+	}
+	return event, nil
+}
+
+// eventID renders a JSON id as text: strings unquoted, numbers as written.
+func eventID(raw json.RawMessage) string {
+	if len(raw) == 0 || string(raw) == "null" {
+		return ""
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s
+	}
+	return string(raw)
+}
@@ -0,0 +1,253 @@
+package truenas
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func newWatchMock(t *testing.T, wantCollection string, rawCh chan json.RawMessage) *mockEventSubscriber {
+	t.Helper()
+	return &mockEventSubscriber{
+		subscribeEventsFunc: func(ctx context.Context, collection string, params any) (*Subscription[json.RawMessage], error) {
+			if collection != wantCollection {
+				t.Errorf("expected collection %s, got %s", wantCollection, collection)
+			}
+			return &Subscription[json.RawMessage]{
+				C:      rawCh,
+				cancel: func() { close(rawCh) },
+			}, nil
+		},
+	}
+}
+
+func nextEvent[T any](t *testing.T, sub *Subscription[WatchEvent[T]]) WatchEvent[T] {
+	t.Helper()
+	select {
+	case event, ok := <-sub.C:
+		if !ok {
+			t.Fatal("channel closed unexpectedly")
+		}
+		return event
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for event")
+	}
+	return WatchEvent[T]{}
+}
+
+func TestWatch_Events(t *testing.T) {
+	rawCh := make(chan json.RawMessage, 5)
+	rawCh <- json.RawMessage(`{"msg": "added", "collection": "pool.dataset.query", "id": "tank/new", "fields": {"id": "tank/new", "name": "tank/new", "pool": "tank"}}`)
+	rawCh <- json.RawMessage(`{"msg": "changed", "collection": "pool.dataset.query", "id": "tank/new", "fields": {"id": "tank/new", "comments": {"value": "hi"}}, "cleared": true}`)
+	rawCh <- json.RawMessage(`not json`)
+	rawCh <- json.RawMessage(`{"msg": "removed", "collection": "pool.dataset.query", "id": "tank/new"}`)
+
+	mock := newWatchMock(t, "pool.dataset.query", rawCh)
+	sub, err := Watch[DatasetResponse](context.Background(), mock, Version{Major: 25, Minor: 4}, "pool.dataset.query", WatchOpts{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer sub.Close()
+
+	added := nextEvent(t, sub)
+	if added.Type != EventAdded || added.ID != "tank/new" || added.Object.Pool != "tank" {
+		t.Errorf("added = %+v", added)
+	}
+	changed := nextEvent(t, sub)
+	if changed.Type != EventChanged || changed.ID != "tank/new" || !changed.Cleared {
+		t.Errorf("changed = %+v", changed)
+	}
+	if changed.Object.Comments.Value != "hi" {
+		t.Errorf("changed.Object.Comments.Value = %q, want hi", changed.Object.Comments.Value)
+	}
+	// The malformed event is skipped.
+	removed := nextEvent(t, sub)
+	if removed.Type != EventRemoved || removed.ID != "tank/new" || removed.Fields != nil {
+		t.Errorf("removed = %+v", removed)
+	}
+
+	// No query is made without Seed.
+	for _, c := range mock.calls {
+		if c.Method != "SubscribeEvents" {
+			t.Errorf("unexpected call %s", c.Method)
+		}
+	}
+}
+
+func TestWatch_Seed(t *testing.T) {
+	rawCh := make(chan json.RawMessage, 1)
+	rawCh <- json.RawMessage(`{"msg": "changed", "collection": "vm.query", "id": 1, "fields": {"id": 1, "name": "web", "status": {"state": "STOPPED"}}}`)
+
+	mock := newWatchMock(t, "vm.query", rawCh)
+	var seedParams any
+	mock.callFunc = func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+		if method != "vm.query" {
+			t.Errorf("expected method vm.query, got %s", method)
+		}
+		seedParams = params
+		return json.RawMessage(`[{"id": 1, "name": "web", "status": {"state": "RUNNING"}}, {"id": 2, "name": "db", "status": {"state": "RUNNING"}}]`), nil
+	}
+
+	filters := [][]any{{"name", "^", "w"}}
+	sub, err := Watch[VMResponse](context.Background(), mock, Version{Major: 25, Minor: 4}, "vm.query", WatchOpts{Seed: true, Filters: filters})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer sub.Close()
+
+	if !reflect.DeepEqual(seedParams, []any{filters}) {
+		t.Errorf("seed params = %v, want %v", seedParams, []any{filters})
+	}
+	// Subscribing comes first so no change is missed between the two.
+	if mock.calls[0].Method != "SubscribeEvents" {
+		t.Errorf("first call = %s, want SubscribeEvents", mock.calls[0].Method)
+	}
+
+	want := []struct {
+		typ   EventType
+		id    string
+		state string
+	}{
+		{EventAdded, "1", "RUNNING"},
+		{EventAdded, "2", "RUNNING"},
+		{EventChanged, "1", "STOPPED"},
+	}
+	for i, w := range want {
+		event := nextEvent(t, sub)
+		if event.Type != w.typ || event.ID != w.id || event.Object.Status.State != w.state {
+			t.Errorf("event %d = %s %s %s, want %s %s %s", i, event.Type, event.ID, event.Object.Status.State, w.typ, w.id, w.state)
+		}
+	}
+}
+
+func TestWatch_ResolvesRenamedCollection(t *testing.T) {
+	rawCh := make(chan json.RawMessage)
+	mock := newWatchMock(t, "pool.snapshot.query", rawCh)
+
+	sub, err := Watch[SnapshotResponse](context.Background(), mock, Version{Major: 25, Minor: 10}, "zfs.snapshot.query", WatchOpts{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	sub.Close()
+
+	if _, ok := <-sub.C; ok {
+		t.Error("expected channel to be closed")
+	}
+}
+
+func TestWatch_SeedError(t *testing.T) {
+	rawCh := make(chan json.RawMessage)
+	closed := false
+	mock := &mockEventSubscriber{
+		subscribeEventsFunc: func(ctx context.Context, collection string, params any) (*Subscription[json.RawMessage], error) {
+			return &Subscription[json.RawMessage]{C: rawCh, cancel: func() { closed = true }}, nil
+		},
+	}
+	mock.callFunc = func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+		return nil, errors.New("connection lost")
+	}
+
+	_, err := Watch[AppResponse](context.Background(), mock, Version{Major: 25, Minor: 4}, "app.query", WatchOpts{Seed: true})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if !closed {
+		t.Error("expected the subscription to be closed after a failed seed")
+	}
+}
+
+func TestWatch_SubscribeError(t *testing.T) {
+	mock := &mockEventSubscriber{
+		subscribeEventsFunc: func(ctx context.Context, collection string, params any) (*Subscription[json.RawMessage], error) {
+			return nil, errors.New("operation not supported")
+		},
+	}
+
+	_, err := Watch[AppResponse](context.Background(), mock, Version{Major: 25, Minor: 4}, "app.query", WatchOpts{})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestWatch_RequiresEventSubscriber(t *testing.T) {
+	mock := &mockSubscribeCaller{}
+	_, err := Watch[AppResponse](context.Background(), mock, Version{Major: 25, Minor: 4}, "app.query", WatchOpts{})
+	if !errors.Is(err, ErrWatchUnsupported) {
+		t.Fatalf("expected ErrWatchUnsupported, got %v", err)
+	}
+	if len(mock.calls) != 0 {
+		t.Errorf("expected no calls, got %v", mock.calls)
+	}
+}
+
+// TestWatch_LargeSeedKeepsReadingEvents checks that events keep being read
+// off the transport's channel while a seed larger than the consumer's
+// appetite is pending. The WebSocket transport drops events for subscribers
+// whose channel is full.
+func TestWatch_LargeSeedKeepsReadingEvents(t *testing.T) {
+	const seedSize, eventCount = 300, 200
+	rawCh := make(chan json.RawMessage, 100)
+	mock := newWatchMock(t, "app.query", rawCh)
+	mock.callFunc = func(ctx context.Context, method string, params any) (json.RawMessage, error) {
+		seed := make([]map[string]any, seedSize)
+		for i := range seed {
+			seed[i] = map[string]any{"id": fmt.Sprintf("seed%d", i), "name": fmt.Sprintf("seed%d", i)}
+		}
+		return json.Marshal(seed)
+	}
+
+	sub, err := Watch[AppResponse](context.Background(), mock, Version{Major: 25, Minor: 4}, "app.query", WatchOpts{Seed: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer sub.Close()
+
+	// Send like the transport does, never blocking, while nothing reads
+	// sub.C. Wait for the channel to drain between halves so a Watch that
+	// stopped reading shows up as a timeout rather than a flaky drop.
+	for i := range eventCount {
+		if i == eventCount/2 {
+			deadline := time.Now().Add(2 * time.Second)
+			for len(rawCh) > 0 {
+				if time.Now().After(deadline) {
+					t.Fatal("events are not read while the seed is pending")
+				}
+				time.Sleep(time.Millisecond)
+			}
+		}
+		event := fmt.Sprintf(`{"msg": "added", "collection": "app.query", "id": "app%d", "fields": {"id": "app%d", "name": "app%d"}}`, i, i, i)
+		select {
+		case rawCh <- json.RawMessage(event):
+		default:
+			t.Fatalf("event %d dropped", i)
+		}
+	}
+
+	for i := range seedSize + eventCount {
+		want := fmt.Sprintf("seed%d", i)
+		if i >= seedSize {
+			want = fmt.Sprintf("app%d", i-seedSize)
+		}
+		if got := nextEvent(t, sub); got.ID != want {
+			t.Fatalf("event %d: ID = %q, want %q", i, got.ID, want)
+		}
+	}
+}
+
+func TestEventID(t *testing.T) {
+	tests := map[string]string{
+		`"tank/data"`: "tank/data",
+		`5`:           "5",
+		`null`:        "",
+		``:            "",
+	}
+	for raw, want := range tests {
+		if got := eventID(json.RawMessage(raw)); got != want {
+			t.Errorf("eventID(%s) = %q, want %q", raw, got, want)
+		}
+	}
+}
@@ -0,0 +1,11 @@
+// Package wire holds wire-format types and typed call stubs generated from
+// the embedded API schemas, one package per TrueNAS version (e.g.
+// wire/v2504). Regenerate with go generate ./wire.
+//
+// The services in the root package keep their own response types: they
+// serve several releases through the method registry and decode only the
+// fields they expose, while each package here describes one release and
+// calls methods by their literal name.
+package wire
+
+//go:generate go run ../cmd/apigen -o .